@@ -19,9 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Games_FullMethodName  = "/facundomedica.rps.v1.Query/Games"
-	Query_Count_FullMethodName  = "/facundomedica.rps.v1.Query/Count"
-	Query_Params_FullMethodName = "/facundomedica.rps.v1.Query/Params"
+	Query_Games_FullMethodName             = "/facundomedica.rps.v1.Query/Games"
+	Query_Count_FullMethodName             = "/facundomedica.rps.v1.Query/Count"
+	Query_StuckGames_FullMethodName        = "/facundomedica.rps.v1.Query/StuckGames"
+	Query_SettlementBacklog_FullMethodName = "/facundomedica.rps.v1.Query/SettlementBacklog"
+	Query_PlayerStats_FullMethodName       = "/facundomedica.rps.v1.Query/PlayerStats"
+	Query_Queue_FullMethodName             = "/facundomedica.rps.v1.Query/Queue"
+	Query_BetPools_FullMethodName          = "/facundomedica.rps.v1.Query/BetPools"
+	Query_Jackpot_FullMethodName           = "/facundomedica.rps.v1.Query/Jackpot"
+	Query_HouseBankroll_FullMethodName     = "/facundomedica.rps.v1.Query/HouseBankroll"
+	Query_SettledGame_FullMethodName       = "/facundomedica.rps.v1.Query/SettledGame"
+	Query_RevealAgent_FullMethodName       = "/facundomedica.rps.v1.Query/RevealAgent"
+	Query_EscrowedReveal_FullMethodName    = "/facundomedica.rps.v1.Query/EscrowedReveal"
+	Query_MoveCommit_FullMethodName        = "/facundomedica.rps.v1.Query/MoveCommit"
+	Query_GamePlayers_FullMethodName       = "/facundomedica.rps.v1.Query/GamePlayers"
+	Query_ComputeCommitment_FullMethodName = "/facundomedica.rps.v1.Query/ComputeCommitment"
+	Query_VerifyReveal_FullMethodName      = "/facundomedica.rps.v1.Query/VerifyReveal"
+	Query_Params_FullMethodName            = "/facundomedica.rps.v1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +45,40 @@ type QueryClient interface {
 	Games(ctx context.Context, in *QueryGamesRequest, opts ...grpc.CallOption) (*QueryGamesResponse, error)
 	// Count returns the historical number of games played.
 	Count(ctx context.Context, in *QueryCountRequest, opts ...grpc.CallOption) (*QueryCountResponse, error)
+	// StuckGames returns the games that were quarantined because they couldn't
+	// be settled.
+	StuckGames(ctx context.Context, in *QueryStuckGamesRequest, opts ...grpc.CallOption) (*QueryStuckGamesResponse, error)
+	// SettlementBacklog returns the number of games that are due but haven't
+	// been settled yet, counting up to 1000 of them.
+	SettlementBacklog(ctx context.Context, in *QuerySettlementBacklogRequest, opts ...grpc.CallOption) (*QuerySettlementBacklogResponse, error)
+	// PlayerStats returns the track record of a player.
+	PlayerStats(ctx context.Context, in *QueryPlayerStatsRequest, opts ...grpc.CallOption) (*QueryPlayerStatsResponse, error)
+	// Queue returns the players waiting in the matchmaking queue.
+	Queue(ctx context.Context, in *QueryQueueRequest, opts ...grpc.CallOption) (*QueryQueueResponse, error)
+	// BetPools returns the spectator betting pools of a game and their implied
+	// odds.
+	BetPools(ctx context.Context, in *QueryBetPoolsRequest, opts ...grpc.CallOption) (*QueryBetPoolsResponse, error)
+	// Jackpot returns the progressive jackpot.
+	Jackpot(ctx context.Context, in *QueryJackpotRequest, opts ...grpc.CallOption) (*QueryJackpotResponse, error)
+	// HouseBankroll returns the funds available to the house.
+	HouseBankroll(ctx context.Context, in *QueryHouseBankrollRequest, opts ...grpc.CallOption) (*QueryHouseBankrollResponse, error)
+	// SettledGame returns a recently settled game that can still be rematched.
+	SettledGame(ctx context.Context, in *QuerySettledGameRequest, opts ...grpc.CallOption) (*QuerySettledGameResponse, error)
+	// RevealAgent returns the reveal agent of a player.
+	RevealAgent(ctx context.Context, in *QueryRevealAgentRequest, opts ...grpc.CallOption) (*QueryRevealAgentResponse, error)
+	// EscrowedReveal returns the encrypted reveal a player escrowed for their
+	// reveal agent in a game.
+	EscrowedReveal(ctx context.Context, in *QueryEscrowedRevealRequest, opts ...grpc.CallOption) (*QueryEscrowedRevealResponse, error)
+	// MoveCommit returns the move commitment of a player in a game.
+	MoveCommit(ctx context.Context, in *QueryMoveCommitRequest, opts ...grpc.CallOption) (*QueryMoveCommitResponse, error)
+	// GamePlayers returns the players that committed a move to a game.
+	GamePlayers(ctx context.Context, in *QueryGamePlayersRequest, opts ...grpc.CallOption) (*QueryGamePlayersResponse, error)
+	// ComputeCommitment returns the commitment of a move with a salt, as
+	// expected by the messages committing a move.
+	ComputeCommitment(ctx context.Context, in *QueryComputeCommitmentRequest, opts ...grpc.CallOption) (*QueryComputeCommitmentResponse, error)
+	// VerifyReveal returns whether a player revealing a move with a salt in a
+	// game would be accepted, and why not otherwise.
+	VerifyReveal(ctx context.Context, in *QueryVerifyRevealRequest, opts ...grpc.CallOption) (*QueryVerifyRevealResponse, error)
 	// Params returns the module parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -61,6 +109,132 @@ func (c *queryClient) Count(ctx context.Context, in *QueryCountRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) StuckGames(ctx context.Context, in *QueryStuckGamesRequest, opts ...grpc.CallOption) (*QueryStuckGamesResponse, error) {
+	out := new(QueryStuckGamesResponse)
+	err := c.cc.Invoke(ctx, Query_StuckGames_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SettlementBacklog(ctx context.Context, in *QuerySettlementBacklogRequest, opts ...grpc.CallOption) (*QuerySettlementBacklogResponse, error) {
+	out := new(QuerySettlementBacklogResponse)
+	err := c.cc.Invoke(ctx, Query_SettlementBacklog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PlayerStats(ctx context.Context, in *QueryPlayerStatsRequest, opts ...grpc.CallOption) (*QueryPlayerStatsResponse, error) {
+	out := new(QueryPlayerStatsResponse)
+	err := c.cc.Invoke(ctx, Query_PlayerStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Queue(ctx context.Context, in *QueryQueueRequest, opts ...grpc.CallOption) (*QueryQueueResponse, error) {
+	out := new(QueryQueueResponse)
+	err := c.cc.Invoke(ctx, Query_Queue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BetPools(ctx context.Context, in *QueryBetPoolsRequest, opts ...grpc.CallOption) (*QueryBetPoolsResponse, error) {
+	out := new(QueryBetPoolsResponse)
+	err := c.cc.Invoke(ctx, Query_BetPools_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Jackpot(ctx context.Context, in *QueryJackpotRequest, opts ...grpc.CallOption) (*QueryJackpotResponse, error) {
+	out := new(QueryJackpotResponse)
+	err := c.cc.Invoke(ctx, Query_Jackpot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HouseBankroll(ctx context.Context, in *QueryHouseBankrollRequest, opts ...grpc.CallOption) (*QueryHouseBankrollResponse, error) {
+	out := new(QueryHouseBankrollResponse)
+	err := c.cc.Invoke(ctx, Query_HouseBankroll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SettledGame(ctx context.Context, in *QuerySettledGameRequest, opts ...grpc.CallOption) (*QuerySettledGameResponse, error) {
+	out := new(QuerySettledGameResponse)
+	err := c.cc.Invoke(ctx, Query_SettledGame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RevealAgent(ctx context.Context, in *QueryRevealAgentRequest, opts ...grpc.CallOption) (*QueryRevealAgentResponse, error) {
+	out := new(QueryRevealAgentResponse)
+	err := c.cc.Invoke(ctx, Query_RevealAgent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowedReveal(ctx context.Context, in *QueryEscrowedRevealRequest, opts ...grpc.CallOption) (*QueryEscrowedRevealResponse, error) {
+	out := new(QueryEscrowedRevealResponse)
+	err := c.cc.Invoke(ctx, Query_EscrowedReveal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MoveCommit(ctx context.Context, in *QueryMoveCommitRequest, opts ...grpc.CallOption) (*QueryMoveCommitResponse, error) {
+	out := new(QueryMoveCommitResponse)
+	err := c.cc.Invoke(ctx, Query_MoveCommit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GamePlayers(ctx context.Context, in *QueryGamePlayersRequest, opts ...grpc.CallOption) (*QueryGamePlayersResponse, error) {
+	out := new(QueryGamePlayersResponse)
+	err := c.cc.Invoke(ctx, Query_GamePlayers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ComputeCommitment(ctx context.Context, in *QueryComputeCommitmentRequest, opts ...grpc.CallOption) (*QueryComputeCommitmentResponse, error) {
+	out := new(QueryComputeCommitmentResponse)
+	err := c.cc.Invoke(ctx, Query_ComputeCommitment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyReveal(ctx context.Context, in *QueryVerifyRevealRequest, opts ...grpc.CallOption) (*QueryVerifyRevealResponse, error) {
+	out := new(QueryVerifyRevealResponse)
+	err := c.cc.Invoke(ctx, Query_VerifyReveal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
@@ -77,6 +251,40 @@ type QueryServer interface {
 	Games(context.Context, *QueryGamesRequest) (*QueryGamesResponse, error)
 	// Count returns the historical number of games played.
 	Count(context.Context, *QueryCountRequest) (*QueryCountResponse, error)
+	// StuckGames returns the games that were quarantined because they couldn't
+	// be settled.
+	StuckGames(context.Context, *QueryStuckGamesRequest) (*QueryStuckGamesResponse, error)
+	// SettlementBacklog returns the number of games that are due but haven't
+	// been settled yet, counting up to 1000 of them.
+	SettlementBacklog(context.Context, *QuerySettlementBacklogRequest) (*QuerySettlementBacklogResponse, error)
+	// PlayerStats returns the track record of a player.
+	PlayerStats(context.Context, *QueryPlayerStatsRequest) (*QueryPlayerStatsResponse, error)
+	// Queue returns the players waiting in the matchmaking queue.
+	Queue(context.Context, *QueryQueueRequest) (*QueryQueueResponse, error)
+	// BetPools returns the spectator betting pools of a game and their implied
+	// odds.
+	BetPools(context.Context, *QueryBetPoolsRequest) (*QueryBetPoolsResponse, error)
+	// Jackpot returns the progressive jackpot.
+	Jackpot(context.Context, *QueryJackpotRequest) (*QueryJackpotResponse, error)
+	// HouseBankroll returns the funds available to the house.
+	HouseBankroll(context.Context, *QueryHouseBankrollRequest) (*QueryHouseBankrollResponse, error)
+	// SettledGame returns a recently settled game that can still be rematched.
+	SettledGame(context.Context, *QuerySettledGameRequest) (*QuerySettledGameResponse, error)
+	// RevealAgent returns the reveal agent of a player.
+	RevealAgent(context.Context, *QueryRevealAgentRequest) (*QueryRevealAgentResponse, error)
+	// EscrowedReveal returns the encrypted reveal a player escrowed for their
+	// reveal agent in a game.
+	EscrowedReveal(context.Context, *QueryEscrowedRevealRequest) (*QueryEscrowedRevealResponse, error)
+	// MoveCommit returns the move commitment of a player in a game.
+	MoveCommit(context.Context, *QueryMoveCommitRequest) (*QueryMoveCommitResponse, error)
+	// GamePlayers returns the players that committed a move to a game.
+	GamePlayers(context.Context, *QueryGamePlayersRequest) (*QueryGamePlayersResponse, error)
+	// ComputeCommitment returns the commitment of a move with a salt, as
+	// expected by the messages committing a move.
+	ComputeCommitment(context.Context, *QueryComputeCommitmentRequest) (*QueryComputeCommitmentResponse, error)
+	// VerifyReveal returns whether a player revealing a move with a salt in a
+	// game would be accepted, and why not otherwise.
+	VerifyReveal(context.Context, *QueryVerifyRevealRequest) (*QueryVerifyRevealResponse, error)
 	// Params returns the module parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -92,6 +300,48 @@ func (UnimplementedQueryServer) Games(context.Context, *QueryGamesRequest) (*Que
 func (UnimplementedQueryServer) Count(context.Context, *QueryCountRequest) (*QueryCountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
 }
+func (UnimplementedQueryServer) StuckGames(context.Context, *QueryStuckGamesRequest) (*QueryStuckGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StuckGames not implemented")
+}
+func (UnimplementedQueryServer) SettlementBacklog(context.Context, *QuerySettlementBacklogRequest) (*QuerySettlementBacklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettlementBacklog not implemented")
+}
+func (UnimplementedQueryServer) PlayerStats(context.Context, *QueryPlayerStatsRequest) (*QueryPlayerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayerStats not implemented")
+}
+func (UnimplementedQueryServer) Queue(context.Context, *QueryQueueRequest) (*QueryQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Queue not implemented")
+}
+func (UnimplementedQueryServer) BetPools(context.Context, *QueryBetPoolsRequest) (*QueryBetPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BetPools not implemented")
+}
+func (UnimplementedQueryServer) Jackpot(context.Context, *QueryJackpotRequest) (*QueryJackpotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Jackpot not implemented")
+}
+func (UnimplementedQueryServer) HouseBankroll(context.Context, *QueryHouseBankrollRequest) (*QueryHouseBankrollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HouseBankroll not implemented")
+}
+func (UnimplementedQueryServer) SettledGame(context.Context, *QuerySettledGameRequest) (*QuerySettledGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettledGame not implemented")
+}
+func (UnimplementedQueryServer) RevealAgent(context.Context, *QueryRevealAgentRequest) (*QueryRevealAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealAgent not implemented")
+}
+func (UnimplementedQueryServer) EscrowedReveal(context.Context, *QueryEscrowedRevealRequest) (*QueryEscrowedRevealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowedReveal not implemented")
+}
+func (UnimplementedQueryServer) MoveCommit(context.Context, *QueryMoveCommitRequest) (*QueryMoveCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCommit not implemented")
+}
+func (UnimplementedQueryServer) GamePlayers(context.Context, *QueryGamePlayersRequest) (*QueryGamePlayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GamePlayers not implemented")
+}
+func (UnimplementedQueryServer) ComputeCommitment(context.Context, *QueryComputeCommitmentRequest) (*QueryComputeCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComputeCommitment not implemented")
+}
+func (UnimplementedQueryServer) VerifyReveal(context.Context, *QueryVerifyRevealRequest) (*QueryVerifyRevealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyReveal not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -144,6 +394,258 @@ func _Query_Count_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StuckGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStuckGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StuckGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StuckGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StuckGames(ctx, req.(*QueryStuckGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SettlementBacklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySettlementBacklogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SettlementBacklog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SettlementBacklog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SettlementBacklog(ctx, req.(*QuerySettlementBacklogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PlayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PlayerStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlayerStats(ctx, req.(*QueryPlayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Queue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Queue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Queue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Queue(ctx, req.(*QueryQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BetPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBetPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BetPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BetPools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BetPools(ctx, req.(*QueryBetPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Jackpot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryJackpotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Jackpot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Jackpot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Jackpot(ctx, req.(*QueryJackpotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HouseBankroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHouseBankrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HouseBankroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HouseBankroll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HouseBankroll(ctx, req.(*QueryHouseBankrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SettledGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySettledGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SettledGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SettledGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SettledGame(ctx, req.(*QuerySettledGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RevealAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRevealAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RevealAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RevealAgent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RevealAgent(ctx, req.(*QueryRevealAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowedReveal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowedRevealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowedReveal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EscrowedReveal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowedReveal(ctx, req.(*QueryEscrowedRevealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MoveCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMoveCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MoveCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MoveCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MoveCommit(ctx, req.(*QueryMoveCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GamePlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGamePlayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GamePlayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GamePlayers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GamePlayers(ctx, req.(*QueryGamePlayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ComputeCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryComputeCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ComputeCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ComputeCommitment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ComputeCommitment(ctx, req.(*QueryComputeCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyReveal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyRevealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyReveal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VerifyReveal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyReveal(ctx, req.(*QueryVerifyRevealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -177,6 +679,62 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Count",
 			Handler:    _Query_Count_Handler,
 		},
+		{
+			MethodName: "StuckGames",
+			Handler:    _Query_StuckGames_Handler,
+		},
+		{
+			MethodName: "SettlementBacklog",
+			Handler:    _Query_SettlementBacklog_Handler,
+		},
+		{
+			MethodName: "PlayerStats",
+			Handler:    _Query_PlayerStats_Handler,
+		},
+		{
+			MethodName: "Queue",
+			Handler:    _Query_Queue_Handler,
+		},
+		{
+			MethodName: "BetPools",
+			Handler:    _Query_BetPools_Handler,
+		},
+		{
+			MethodName: "Jackpot",
+			Handler:    _Query_Jackpot_Handler,
+		},
+		{
+			MethodName: "HouseBankroll",
+			Handler:    _Query_HouseBankroll_Handler,
+		},
+		{
+			MethodName: "SettledGame",
+			Handler:    _Query_SettledGame_Handler,
+		},
+		{
+			MethodName: "RevealAgent",
+			Handler:    _Query_RevealAgent_Handler,
+		},
+		{
+			MethodName: "EscrowedReveal",
+			Handler:    _Query_EscrowedReveal_Handler,
+		},
+		{
+			MethodName: "MoveCommit",
+			Handler:    _Query_MoveCommit_Handler,
+		},
+		{
+			MethodName: "GamePlayers",
+			Handler:    _Query_GamePlayers_Handler,
+		},
+		{
+			MethodName: "ComputeCommitment",
+			Handler:    _Query_ComputeCommitment_Handler,
+		},
+		{
+			MethodName: "VerifyReveal",
+			Handler:    _Query_VerifyReveal_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
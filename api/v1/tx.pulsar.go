@@ -17,11 +17,117 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_MsgNewGame_3_list)(nil)
+
+type _MsgNewGame_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgNewGame_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgNewGame_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgNewGame_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgNewGame_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgNewGame_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgNewGame_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgNewGame_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgNewGame_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgNewGame_6_list)(nil)
+
+type _MsgNewGame_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgNewGame_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgNewGame_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgNewGame_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgNewGame_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgNewGame_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgNewGame_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgNewGame_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgNewGame_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgNewGame           protoreflect.MessageDescriptor
-	fd_MsgNewGame_player    protoreflect.FieldDescriptor
-	fd_MsgNewGame_commit    protoreflect.FieldDescriptor
-	fd_MsgNewGame_entry_fee protoreflect.FieldDescriptor
+	md_MsgNewGame                   protoreflect.MessageDescriptor
+	fd_MsgNewGame_player            protoreflect.FieldDescriptor
+	fd_MsgNewGame_commit            protoreflect.FieldDescriptor
+	fd_MsgNewGame_entry_fee         protoreflect.FieldDescriptor
+	fd_MsgNewGame_commit_timeout    protoreflect.FieldDescriptor
+	fd_MsgNewGame_reveal_timeout    protoreflect.FieldDescriptor
+	fd_MsgNewGame_challenger_fee    protoreflect.FieldDescriptor
+	fd_MsgNewGame_commitment_scheme protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -30,6 +136,10 @@ func init() {
 	fd_MsgNewGame_player = md_MsgNewGame.Fields().ByName("player")
 	fd_MsgNewGame_commit = md_MsgNewGame.Fields().ByName("commit")
 	fd_MsgNewGame_entry_fee = md_MsgNewGame.Fields().ByName("entry_fee")
+	fd_MsgNewGame_commit_timeout = md_MsgNewGame.Fields().ByName("commit_timeout")
+	fd_MsgNewGame_reveal_timeout = md_MsgNewGame.Fields().ByName("reveal_timeout")
+	fd_MsgNewGame_challenger_fee = md_MsgNewGame.Fields().ByName("challenger_fee")
+	fd_MsgNewGame_commitment_scheme = md_MsgNewGame.Fields().ByName("commitment_scheme")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgNewGame)(nil)
@@ -103,18 +213,42 @@ func (x *fastReflection_MsgNewGame) Range(f func(protoreflect.FieldDescriptor, p
 			return
 		}
 	}
-	if x.Commit != "" {
-		value := protoreflect.ValueOfString(x.Commit)
+	if len(x.Commit) != 0 {
+		value := protoreflect.ValueOfBytes(x.Commit)
 		if !f(fd_MsgNewGame_commit, value) {
 			return
 		}
 	}
-	if x.EntryFee != nil {
-		value := protoreflect.ValueOfMessage(x.EntryFee.ProtoReflect())
+	if len(x.EntryFee) != 0 {
+		value := protoreflect.ValueOfList(&_MsgNewGame_3_list{list: &x.EntryFee})
 		if !f(fd_MsgNewGame_entry_fee, value) {
 			return
 		}
 	}
+	if x.CommitTimeout != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CommitTimeout)
+		if !f(fd_MsgNewGame_commit_timeout, value) {
+			return
+		}
+	}
+	if x.RevealTimeout != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RevealTimeout)
+		if !f(fd_MsgNewGame_reveal_timeout, value) {
+			return
+		}
+	}
+	if len(x.ChallengerFee) != 0 {
+		value := protoreflect.ValueOfList(&_MsgNewGame_6_list{list: &x.ChallengerFee})
+		if !f(fd_MsgNewGame_challenger_fee, value) {
+			return
+		}
+	}
+	if x.CommitmentScheme != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.CommitmentScheme))
+		if !f(fd_MsgNewGame_commitment_scheme, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -133,9 +267,17 @@ func (x *fastReflection_MsgNewGame) Has(fd protoreflect.FieldDescriptor) bool {
 	case "facundomedica.rps.v1.MsgNewGame.player":
 		return x.Player != ""
 	case "facundomedica.rps.v1.MsgNewGame.commit":
-		return x.Commit != ""
+		return len(x.Commit) != 0
 	case "facundomedica.rps.v1.MsgNewGame.entry_fee":
-		return x.EntryFee != nil
+		return len(x.EntryFee) != 0
+	case "facundomedica.rps.v1.MsgNewGame.commit_timeout":
+		return x.CommitTimeout != uint64(0)
+	case "facundomedica.rps.v1.MsgNewGame.reveal_timeout":
+		return x.RevealTimeout != uint64(0)
+	case "facundomedica.rps.v1.MsgNewGame.challenger_fee":
+		return len(x.ChallengerFee) != 0
+	case "facundomedica.rps.v1.MsgNewGame.commitment_scheme":
+		return x.CommitmentScheme != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgNewGame"))
@@ -155,9 +297,17 @@ func (x *fastReflection_MsgNewGame) Clear(fd protoreflect.FieldDescriptor) {
 	case "facundomedica.rps.v1.MsgNewGame.player":
 		x.Player = ""
 	case "facundomedica.rps.v1.MsgNewGame.commit":
-		x.Commit = ""
+		x.Commit = nil
 	case "facundomedica.rps.v1.MsgNewGame.entry_fee":
 		x.EntryFee = nil
+	case "facundomedica.rps.v1.MsgNewGame.commit_timeout":
+		x.CommitTimeout = uint64(0)
+	case "facundomedica.rps.v1.MsgNewGame.reveal_timeout":
+		x.RevealTimeout = uint64(0)
+	case "facundomedica.rps.v1.MsgNewGame.challenger_fee":
+		x.ChallengerFee = nil
+	case "facundomedica.rps.v1.MsgNewGame.commitment_scheme":
+		x.CommitmentScheme = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgNewGame"))
@@ -179,10 +329,28 @@ func (x *fastReflection_MsgNewGame) Get(descriptor protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfString(value)
 	case "facundomedica.rps.v1.MsgNewGame.commit":
 		value := x.Commit
-		return protoreflect.ValueOfString(value)
+		return protoreflect.ValueOfBytes(value)
 	case "facundomedica.rps.v1.MsgNewGame.entry_fee":
-		value := x.EntryFee
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+		if len(x.EntryFee) == 0 {
+			return protoreflect.ValueOfList(&_MsgNewGame_3_list{})
+		}
+		listValue := &_MsgNewGame_3_list{list: &x.EntryFee}
+		return protoreflect.ValueOfList(listValue)
+	case "facundomedica.rps.v1.MsgNewGame.commit_timeout":
+		value := x.CommitTimeout
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.MsgNewGame.reveal_timeout":
+		value := x.RevealTimeout
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.MsgNewGame.challenger_fee":
+		if len(x.ChallengerFee) == 0 {
+			return protoreflect.ValueOfList(&_MsgNewGame_6_list{})
+		}
+		listValue := &_MsgNewGame_6_list{list: &x.ChallengerFee}
+		return protoreflect.ValueOfList(listValue)
+	case "facundomedica.rps.v1.MsgNewGame.commitment_scheme":
+		value := x.CommitmentScheme
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgNewGame"))
@@ -206,9 +374,21 @@ func (x *fastReflection_MsgNewGame) Set(fd protoreflect.FieldDescriptor, value p
 	case "facundomedica.rps.v1.MsgNewGame.player":
 		x.Player = value.Interface().(string)
 	case "facundomedica.rps.v1.MsgNewGame.commit":
-		x.Commit = value.Interface().(string)
+		x.Commit = value.Bytes()
 	case "facundomedica.rps.v1.MsgNewGame.entry_fee":
-		x.EntryFee = value.Message().Interface().(*v1beta1.Coin)
+		lv := value.List()
+		clv := lv.(*_MsgNewGame_3_list)
+		x.EntryFee = *clv.list
+	case "facundomedica.rps.v1.MsgNewGame.commit_timeout":
+		x.CommitTimeout = value.Uint()
+	case "facundomedica.rps.v1.MsgNewGame.reveal_timeout":
+		x.RevealTimeout = value.Uint()
+	case "facundomedica.rps.v1.MsgNewGame.challenger_fee":
+		lv := value.List()
+		clv := lv.(*_MsgNewGame_6_list)
+		x.ChallengerFee = *clv.list
+	case "facundomedica.rps.v1.MsgNewGame.commitment_scheme":
+		x.CommitmentScheme = (CommitmentScheme)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgNewGame"))
@@ -231,13 +411,26 @@ func (x *fastReflection_MsgNewGame) Mutable(fd protoreflect.FieldDescriptor) pro
 	switch fd.FullName() {
 	case "facundomedica.rps.v1.MsgNewGame.entry_fee":
 		if x.EntryFee == nil {
-			x.EntryFee = new(v1beta1.Coin)
+			x.EntryFee = []*v1beta1.Coin{}
 		}
-		return protoreflect.ValueOfMessage(x.EntryFee.ProtoReflect())
+		value := &_MsgNewGame_3_list{list: &x.EntryFee}
+		return protoreflect.ValueOfList(value)
+	case "facundomedica.rps.v1.MsgNewGame.challenger_fee":
+		if x.ChallengerFee == nil {
+			x.ChallengerFee = []*v1beta1.Coin{}
+		}
+		value := &_MsgNewGame_6_list{list: &x.ChallengerFee}
+		return protoreflect.ValueOfList(value)
 	case "facundomedica.rps.v1.MsgNewGame.player":
 		panic(fmt.Errorf("field player of message facundomedica.rps.v1.MsgNewGame is not mutable"))
 	case "facundomedica.rps.v1.MsgNewGame.commit":
 		panic(fmt.Errorf("field commit of message facundomedica.rps.v1.MsgNewGame is not mutable"))
+	case "facundomedica.rps.v1.MsgNewGame.commit_timeout":
+		panic(fmt.Errorf("field commit_timeout of message facundomedica.rps.v1.MsgNewGame is not mutable"))
+	case "facundomedica.rps.v1.MsgNewGame.reveal_timeout":
+		panic(fmt.Errorf("field reveal_timeout of message facundomedica.rps.v1.MsgNewGame is not mutable"))
+	case "facundomedica.rps.v1.MsgNewGame.commitment_scheme":
+		panic(fmt.Errorf("field commitment_scheme of message facundomedica.rps.v1.MsgNewGame is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgNewGame"))
@@ -254,10 +447,19 @@ func (x *fastReflection_MsgNewGame) NewField(fd protoreflect.FieldDescriptor) pr
 	case "facundomedica.rps.v1.MsgNewGame.player":
 		return protoreflect.ValueOfString("")
 	case "facundomedica.rps.v1.MsgNewGame.commit":
-		return protoreflect.ValueOfString("")
+		return protoreflect.ValueOfBytes(nil)
 	case "facundomedica.rps.v1.MsgNewGame.entry_fee":
-		m := new(v1beta1.Coin)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgNewGame_3_list{list: &list})
+	case "facundomedica.rps.v1.MsgNewGame.commit_timeout":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.MsgNewGame.reveal_timeout":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.MsgNewGame.challenger_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgNewGame_6_list{list: &list})
+	case "facundomedica.rps.v1.MsgNewGame.commitment_scheme":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgNewGame"))
@@ -335,9 +537,26 @@ func (x *fastReflection_MsgNewGame) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.EntryFee != nil {
-			l = options.Size(x.EntryFee)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.EntryFee) > 0 {
+			for _, e := range x.EntryFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CommitTimeout != 0 {
+			n += 1 + runtime.Sov(uint64(x.CommitTimeout))
+		}
+		if x.RevealTimeout != 0 {
+			n += 1 + runtime.Sov(uint64(x.RevealTimeout))
+		}
+		if len(x.ChallengerFee) > 0 {
+			for _, e := range x.ChallengerFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CommitmentScheme != 0 {
+			n += 1 + runtime.Sov(uint64(x.CommitmentScheme))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -368,19 +587,52 @@ func (x *fastReflection_MsgNewGame) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.EntryFee != nil {
-			encoded, err := options.Marshal(x.EntryFee)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if x.CommitmentScheme != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommitmentScheme))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.ChallengerFee) > 0 {
+			for iNdEx := len(x.ChallengerFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ChallengerFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		}
+		if x.RevealTimeout != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RevealTimeout))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x28
+		}
+		if x.CommitTimeout != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommitTimeout))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.EntryFee) > 0 {
+			for iNdEx := len(x.EntryFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EntryFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
 		}
 		if len(x.Commit) > 0 {
 			i -= len(x.Commit)
@@ -481,7 +733,7 @@ func (x *fastReflection_MsgNewGame) ProtoMethods() *protoiface.Methods {
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -491,23 +743,25 @@ func (x *fastReflection_MsgNewGame) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Commit = string(dAtA[iNdEx:postIndex])
+				x.Commit = append(x.Commit[:0], dAtA[iNdEx:postIndex]...)
+				if x.Commit == nil {
+					x.Commit = []byte{}
+				}
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
@@ -538,13 +792,102 @@ func (x *fastReflection_MsgNewGame) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.EntryFee == nil {
-					x.EntryFee = &v1beta1.Coin{}
+				x.EntryFee = append(x.EntryFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EntryFee[len(x.EntryFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EntryFee); err != nil {
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitTimeout", wireType)
+				}
+				x.CommitTimeout = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CommitTimeout |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevealTimeout", wireType)
+				}
+				x.RevealTimeout = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RevealTimeout |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChallengerFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChallengerFee = append(x.ChallengerFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ChallengerFee[len(x.ChallengerFee)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitmentScheme", wireType)
+				}
+				x.CommitmentScheme = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CommitmentScheme |= CommitmentScheme(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -984,30 +1327,144 @@ func (x *fastReflection_MsgNewGameResponse) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_MsgCreateAndJoin_5_list)(nil)
+
+type _MsgCreateAndJoin_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgCreateAndJoin_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCreateAndJoin_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgCreateAndJoin_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreateAndJoin_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreateAndJoin_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateAndJoin_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreateAndJoin_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateAndJoin_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgCreateAndJoin_6_list)(nil)
+
+type _MsgCreateAndJoin_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgCreateAndJoin_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCreateAndJoin_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgCreateAndJoin_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreateAndJoin_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreateAndJoin_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateAndJoin_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreateAndJoin_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateAndJoin_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgCommitMove         protoreflect.MessageDescriptor
-	fd_MsgCommitMove_player  protoreflect.FieldDescriptor
-	fd_MsgCommitMove_game_id protoreflect.FieldDescriptor
-	fd_MsgCommitMove_commit  protoreflect.FieldDescriptor
+	md_MsgCreateAndJoin                              protoreflect.MessageDescriptor
+	fd_MsgCreateAndJoin_creator                      protoreflect.FieldDescriptor
+	fd_MsgCreateAndJoin_creator_commit               protoreflect.FieldDescriptor
+	fd_MsgCreateAndJoin_challenger                   protoreflect.FieldDescriptor
+	fd_MsgCreateAndJoin_challenger_commit            protoreflect.FieldDescriptor
+	fd_MsgCreateAndJoin_entry_fee                    protoreflect.FieldDescriptor
+	fd_MsgCreateAndJoin_challenger_fee               protoreflect.FieldDescriptor
+	fd_MsgCreateAndJoin_reveal_timeout               protoreflect.FieldDescriptor
+	fd_MsgCreateAndJoin_creator_commitment_scheme    protoreflect.FieldDescriptor
+	fd_MsgCreateAndJoin_challenger_commitment_scheme protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_facundomedica_rps_v1_tx_proto_init()
-	md_MsgCommitMove = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgCommitMove")
-	fd_MsgCommitMove_player = md_MsgCommitMove.Fields().ByName("player")
-	fd_MsgCommitMove_game_id = md_MsgCommitMove.Fields().ByName("game_id")
-	fd_MsgCommitMove_commit = md_MsgCommitMove.Fields().ByName("commit")
+	md_MsgCreateAndJoin = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgCreateAndJoin")
+	fd_MsgCreateAndJoin_creator = md_MsgCreateAndJoin.Fields().ByName("creator")
+	fd_MsgCreateAndJoin_creator_commit = md_MsgCreateAndJoin.Fields().ByName("creator_commit")
+	fd_MsgCreateAndJoin_challenger = md_MsgCreateAndJoin.Fields().ByName("challenger")
+	fd_MsgCreateAndJoin_challenger_commit = md_MsgCreateAndJoin.Fields().ByName("challenger_commit")
+	fd_MsgCreateAndJoin_entry_fee = md_MsgCreateAndJoin.Fields().ByName("entry_fee")
+	fd_MsgCreateAndJoin_challenger_fee = md_MsgCreateAndJoin.Fields().ByName("challenger_fee")
+	fd_MsgCreateAndJoin_reveal_timeout = md_MsgCreateAndJoin.Fields().ByName("reveal_timeout")
+	fd_MsgCreateAndJoin_creator_commitment_scheme = md_MsgCreateAndJoin.Fields().ByName("creator_commitment_scheme")
+	fd_MsgCreateAndJoin_challenger_commitment_scheme = md_MsgCreateAndJoin.Fields().ByName("challenger_commitment_scheme")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCommitMove)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgCreateAndJoin)(nil)
 
-type fastReflection_MsgCommitMove MsgCommitMove
+type fastReflection_MsgCreateAndJoin MsgCreateAndJoin
 
-func (x *MsgCommitMove) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCommitMove)(x)
+func (x *MsgCreateAndJoin) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateAndJoin)(x)
 }
 
-func (x *MsgCommitMove) slowProtoReflect() protoreflect.Message {
+func (x *MsgCreateAndJoin) slowProtoReflect() protoreflect.Message {
 	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1019,43 +1476,43 @@ func (x *MsgCommitMove) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCommitMove_messageType fastReflection_MsgCommitMove_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCommitMove_messageType{}
+var _fastReflection_MsgCreateAndJoin_messageType fastReflection_MsgCreateAndJoin_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateAndJoin_messageType{}
 
-type fastReflection_MsgCommitMove_messageType struct{}
+type fastReflection_MsgCreateAndJoin_messageType struct{}
 
-func (x fastReflection_MsgCommitMove_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCommitMove)(nil)
+func (x fastReflection_MsgCreateAndJoin_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateAndJoin)(nil)
 }
-func (x fastReflection_MsgCommitMove_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCommitMove)
+func (x fastReflection_MsgCreateAndJoin_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateAndJoin)
 }
-func (x fastReflection_MsgCommitMove_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCommitMove
+func (x fastReflection_MsgCreateAndJoin_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateAndJoin
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCommitMove) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCommitMove
+func (x *fastReflection_MsgCreateAndJoin) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateAndJoin
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCommitMove) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCommitMove_messageType
+func (x *fastReflection_MsgCreateAndJoin) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateAndJoin_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCommitMove) New() protoreflect.Message {
-	return new(fastReflection_MsgCommitMove)
+func (x *fastReflection_MsgCreateAndJoin) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateAndJoin)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCommitMove) Interface() protoreflect.ProtoMessage {
-	return (*MsgCommitMove)(x)
+func (x *fastReflection_MsgCreateAndJoin) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateAndJoin)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1063,22 +1520,58 @@ func (x *fastReflection_MsgCommitMove) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCommitMove) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Player != "" {
-		value := protoreflect.ValueOfString(x.Player)
-		if !f(fd_MsgCommitMove_player, value) {
+func (x *fastReflection_MsgCreateAndJoin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgCreateAndJoin_creator, value) {
 			return
 		}
 	}
-	if x.GameId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.GameId)
-		if !f(fd_MsgCommitMove_game_id, value) {
+	if len(x.CreatorCommit) != 0 {
+		value := protoreflect.ValueOfBytes(x.CreatorCommit)
+		if !f(fd_MsgCreateAndJoin_creator_commit, value) {
 			return
 		}
 	}
-	if x.Commit != "" {
-		value := protoreflect.ValueOfString(x.Commit)
-		if !f(fd_MsgCommitMove_commit, value) {
+	if x.Challenger != "" {
+		value := protoreflect.ValueOfString(x.Challenger)
+		if !f(fd_MsgCreateAndJoin_challenger, value) {
+			return
+		}
+	}
+	if len(x.ChallengerCommit) != 0 {
+		value := protoreflect.ValueOfBytes(x.ChallengerCommit)
+		if !f(fd_MsgCreateAndJoin_challenger_commit, value) {
+			return
+		}
+	}
+	if len(x.EntryFee) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateAndJoin_5_list{list: &x.EntryFee})
+		if !f(fd_MsgCreateAndJoin_entry_fee, value) {
+			return
+		}
+	}
+	if len(x.ChallengerFee) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateAndJoin_6_list{list: &x.ChallengerFee})
+		if !f(fd_MsgCreateAndJoin_challenger_fee, value) {
+			return
+		}
+	}
+	if x.RevealTimeout != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RevealTimeout)
+		if !f(fd_MsgCreateAndJoin_reveal_timeout, value) {
+			return
+		}
+	}
+	if x.CreatorCommitmentScheme != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.CreatorCommitmentScheme))
+		if !f(fd_MsgCreateAndJoin_creator_commitment_scheme, value) {
+			return
+		}
+	}
+	if x.ChallengerCommitmentScheme != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ChallengerCommitmentScheme))
+		if !f(fd_MsgCreateAndJoin_challenger_commitment_scheme, value) {
 			return
 		}
 	}
@@ -1095,19 +1588,31 @@ func (x *fastReflection_MsgCommitMove) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCommitMove) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgCreateAndJoin) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MsgCommitMove.player":
-		return x.Player != ""
-	case "facundomedica.rps.v1.MsgCommitMove.game_id":
-		return x.GameId != uint64(0)
-	case "facundomedica.rps.v1.MsgCommitMove.commit":
-		return x.Commit != ""
+	case "facundomedica.rps.v1.MsgCreateAndJoin.creator":
+		return x.Creator != ""
+	case "facundomedica.rps.v1.MsgCreateAndJoin.creator_commit":
+		return len(x.CreatorCommit) != 0
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger":
+		return x.Challenger != ""
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger_commit":
+		return len(x.ChallengerCommit) != 0
+	case "facundomedica.rps.v1.MsgCreateAndJoin.entry_fee":
+		return len(x.EntryFee) != 0
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger_fee":
+		return len(x.ChallengerFee) != 0
+	case "facundomedica.rps.v1.MsgCreateAndJoin.reveal_timeout":
+		return x.RevealTimeout != uint64(0)
+	case "facundomedica.rps.v1.MsgCreateAndJoin.creator_commitment_scheme":
+		return x.CreatorCommitmentScheme != 0
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger_commitment_scheme":
+		return x.ChallengerCommitmentScheme != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMove"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCreateAndJoin"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMove does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCreateAndJoin does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1117,19 +1622,31 @@ func (x *fastReflection_MsgCommitMove) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCommitMove) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgCreateAndJoin) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MsgCommitMove.player":
-		x.Player = ""
-	case "facundomedica.rps.v1.MsgCommitMove.game_id":
-		x.GameId = uint64(0)
-	case "facundomedica.rps.v1.MsgCommitMove.commit":
-		x.Commit = ""
+	case "facundomedica.rps.v1.MsgCreateAndJoin.creator":
+		x.Creator = ""
+	case "facundomedica.rps.v1.MsgCreateAndJoin.creator_commit":
+		x.CreatorCommit = nil
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger":
+		x.Challenger = ""
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger_commit":
+		x.ChallengerCommit = nil
+	case "facundomedica.rps.v1.MsgCreateAndJoin.entry_fee":
+		x.EntryFee = nil
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger_fee":
+		x.ChallengerFee = nil
+	case "facundomedica.rps.v1.MsgCreateAndJoin.reveal_timeout":
+		x.RevealTimeout = uint64(0)
+	case "facundomedica.rps.v1.MsgCreateAndJoin.creator_commitment_scheme":
+		x.CreatorCommitmentScheme = 0
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger_commitment_scheme":
+		x.ChallengerCommitmentScheme = 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMove"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCreateAndJoin"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMove does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCreateAndJoin does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1139,22 +1656,46 @@ func (x *fastReflection_MsgCommitMove) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCommitMove) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCreateAndJoin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "facundomedica.rps.v1.MsgCommitMove.player":
-		value := x.Player
+	case "facundomedica.rps.v1.MsgCreateAndJoin.creator":
+		value := x.Creator
 		return protoreflect.ValueOfString(value)
-	case "facundomedica.rps.v1.MsgCommitMove.game_id":
-		value := x.GameId
-		return protoreflect.ValueOfUint64(value)
-	case "facundomedica.rps.v1.MsgCommitMove.commit":
-		value := x.Commit
+	case "facundomedica.rps.v1.MsgCreateAndJoin.creator_commit":
+		value := x.CreatorCommit
+		return protoreflect.ValueOfBytes(value)
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger":
+		value := x.Challenger
 		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger_commit":
+		value := x.ChallengerCommit
+		return protoreflect.ValueOfBytes(value)
+	case "facundomedica.rps.v1.MsgCreateAndJoin.entry_fee":
+		if len(x.EntryFee) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateAndJoin_5_list{})
+		}
+		listValue := &_MsgCreateAndJoin_5_list{list: &x.EntryFee}
+		return protoreflect.ValueOfList(listValue)
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger_fee":
+		if len(x.ChallengerFee) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateAndJoin_6_list{})
+		}
+		listValue := &_MsgCreateAndJoin_6_list{list: &x.ChallengerFee}
+		return protoreflect.ValueOfList(listValue)
+	case "facundomedica.rps.v1.MsgCreateAndJoin.reveal_timeout":
+		value := x.RevealTimeout
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.MsgCreateAndJoin.creator_commitment_scheme":
+		value := x.CreatorCommitmentScheme
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger_commitment_scheme":
+		value := x.ChallengerCommitmentScheme
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMove"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCreateAndJoin"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMove does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCreateAndJoin does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1168,19 +1709,35 @@ func (x *fastReflection_MsgCommitMove) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCommitMove) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgCreateAndJoin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MsgCommitMove.player":
-		x.Player = value.Interface().(string)
-	case "facundomedica.rps.v1.MsgCommitMove.game_id":
-		x.GameId = value.Uint()
-	case "facundomedica.rps.v1.MsgCommitMove.commit":
-		x.Commit = value.Interface().(string)
+	case "facundomedica.rps.v1.MsgCreateAndJoin.creator":
+		x.Creator = value.Interface().(string)
+	case "facundomedica.rps.v1.MsgCreateAndJoin.creator_commit":
+		x.CreatorCommit = value.Bytes()
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger":
+		x.Challenger = value.Interface().(string)
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger_commit":
+		x.ChallengerCommit = value.Bytes()
+	case "facundomedica.rps.v1.MsgCreateAndJoin.entry_fee":
+		lv := value.List()
+		clv := lv.(*_MsgCreateAndJoin_5_list)
+		x.EntryFee = *clv.list
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger_fee":
+		lv := value.List()
+		clv := lv.(*_MsgCreateAndJoin_6_list)
+		x.ChallengerFee = *clv.list
+	case "facundomedica.rps.v1.MsgCreateAndJoin.reveal_timeout":
+		x.RevealTimeout = value.Uint()
+	case "facundomedica.rps.v1.MsgCreateAndJoin.creator_commitment_scheme":
+		x.CreatorCommitmentScheme = (CommitmentScheme)(value.Enum())
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger_commitment_scheme":
+		x.ChallengerCommitmentScheme = (CommitmentScheme)(value.Enum())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMove"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCreateAndJoin"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMove does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCreateAndJoin does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1194,48 +1751,82 @@ func (x *fastReflection_MsgCommitMove) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCommitMove) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCreateAndJoin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MsgCommitMove.player":
-		panic(fmt.Errorf("field player of message facundomedica.rps.v1.MsgCommitMove is not mutable"))
-	case "facundomedica.rps.v1.MsgCommitMove.game_id":
-		panic(fmt.Errorf("field game_id of message facundomedica.rps.v1.MsgCommitMove is not mutable"))
-	case "facundomedica.rps.v1.MsgCommitMove.commit":
-		panic(fmt.Errorf("field commit of message facundomedica.rps.v1.MsgCommitMove is not mutable"))
+	case "facundomedica.rps.v1.MsgCreateAndJoin.entry_fee":
+		if x.EntryFee == nil {
+			x.EntryFee = []*v1beta1.Coin{}
+		}
+		value := &_MsgCreateAndJoin_5_list{list: &x.EntryFee}
+		return protoreflect.ValueOfList(value)
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger_fee":
+		if x.ChallengerFee == nil {
+			x.ChallengerFee = []*v1beta1.Coin{}
+		}
+		value := &_MsgCreateAndJoin_6_list{list: &x.ChallengerFee}
+		return protoreflect.ValueOfList(value)
+	case "facundomedica.rps.v1.MsgCreateAndJoin.creator":
+		panic(fmt.Errorf("field creator of message facundomedica.rps.v1.MsgCreateAndJoin is not mutable"))
+	case "facundomedica.rps.v1.MsgCreateAndJoin.creator_commit":
+		panic(fmt.Errorf("field creator_commit of message facundomedica.rps.v1.MsgCreateAndJoin is not mutable"))
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger":
+		panic(fmt.Errorf("field challenger of message facundomedica.rps.v1.MsgCreateAndJoin is not mutable"))
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger_commit":
+		panic(fmt.Errorf("field challenger_commit of message facundomedica.rps.v1.MsgCreateAndJoin is not mutable"))
+	case "facundomedica.rps.v1.MsgCreateAndJoin.reveal_timeout":
+		panic(fmt.Errorf("field reveal_timeout of message facundomedica.rps.v1.MsgCreateAndJoin is not mutable"))
+	case "facundomedica.rps.v1.MsgCreateAndJoin.creator_commitment_scheme":
+		panic(fmt.Errorf("field creator_commitment_scheme of message facundomedica.rps.v1.MsgCreateAndJoin is not mutable"))
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger_commitment_scheme":
+		panic(fmt.Errorf("field challenger_commitment_scheme of message facundomedica.rps.v1.MsgCreateAndJoin is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMove"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCreateAndJoin"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMove does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCreateAndJoin does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCommitMove) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCreateAndJoin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MsgCommitMove.player":
+	case "facundomedica.rps.v1.MsgCreateAndJoin.creator":
 		return protoreflect.ValueOfString("")
-	case "facundomedica.rps.v1.MsgCommitMove.game_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "facundomedica.rps.v1.MsgCommitMove.commit":
+	case "facundomedica.rps.v1.MsgCreateAndJoin.creator_commit":
+		return protoreflect.ValueOfBytes(nil)
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger":
 		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger_commit":
+		return protoreflect.ValueOfBytes(nil)
+	case "facundomedica.rps.v1.MsgCreateAndJoin.entry_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgCreateAndJoin_5_list{list: &list})
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgCreateAndJoin_6_list{list: &list})
+	case "facundomedica.rps.v1.MsgCreateAndJoin.reveal_timeout":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.MsgCreateAndJoin.creator_commitment_scheme":
+		return protoreflect.ValueOfEnum(0)
+	case "facundomedica.rps.v1.MsgCreateAndJoin.challenger_commitment_scheme":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMove"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCreateAndJoin"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMove does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCreateAndJoin does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCommitMove) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgCreateAndJoin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgCommitMove", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgCreateAndJoin", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1243,7 +1834,7 @@ func (x *fastReflection_MsgCommitMove) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCommitMove) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgCreateAndJoin) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1254,7 +1845,7 @@ func (x *fastReflection_MsgCommitMove) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCommitMove) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgCreateAndJoin) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1266,7 +1857,7 @@ func (x *fastReflection_MsgCommitMove) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCommitMove) IsValid() bool {
+func (x *fastReflection_MsgCreateAndJoin) IsValid() bool {
 	return x != nil
 }
 
@@ -1276,9 +1867,9 @@ func (x *fastReflection_MsgCommitMove) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCommitMove) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgCreateAndJoin) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCommitMove)
+		x := input.Message.Interface().(*MsgCreateAndJoin)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1290,17 +1881,43 @@ func (x *fastReflection_MsgCommitMove) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Player)
+		l = len(x.Creator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.GameId != 0 {
-			n += 1 + runtime.Sov(uint64(x.GameId))
+		l = len(x.CreatorCommit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Commit)
+		l = len(x.Challenger)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ChallengerCommit)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.EntryFee) > 0 {
+			for _, e := range x.EntryFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ChallengerFee) > 0 {
+			for _, e := range x.ChallengerFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RevealTimeout != 0 {
+			n += 1 + runtime.Sov(uint64(x.RevealTimeout))
+		}
+		if x.CreatorCommitmentScheme != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatorCommitmentScheme))
+		}
+		if x.ChallengerCommitmentScheme != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChallengerCommitmentScheme))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1311,7 +1928,7 @@ func (x *fastReflection_MsgCommitMove) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCommitMove)
+		x := input.Message.Interface().(*MsgCreateAndJoin)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1330,22 +1947,78 @@ func (x *fastReflection_MsgCommitMove) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Commit) > 0 {
-			i -= len(x.Commit)
-			copy(dAtA[i:], x.Commit)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commit)))
+		if x.ChallengerCommitmentScheme != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChallengerCommitmentScheme))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.CreatorCommitmentScheme != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatorCommitmentScheme))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.RevealTimeout != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RevealTimeout))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.ChallengerFee) > 0 {
+			for iNdEx := len(x.ChallengerFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ChallengerFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.EntryFee) > 0 {
+			for iNdEx := len(x.EntryFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EntryFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.ChallengerCommit) > 0 {
+			i -= len(x.ChallengerCommit)
+			copy(dAtA[i:], x.ChallengerCommit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChallengerCommit)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Challenger) > 0 {
+			i -= len(x.Challenger)
+			copy(dAtA[i:], x.Challenger)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Challenger)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if x.GameId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.GameId))
+		if len(x.CreatorCommit) > 0 {
+			i -= len(x.CreatorCommit)
+			copy(dAtA[i:], x.CreatorCommit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CreatorCommit)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.Player) > 0 {
-			i -= len(x.Player)
-			copy(dAtA[i:], x.Player)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Player)))
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1360,7 +2033,7 @@ func (x *fastReflection_MsgCommitMove) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCommitMove)
+		x := input.Message.Interface().(*MsgCreateAndJoin)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1392,15 +2065,15 @@ func (x *fastReflection_MsgCommitMove) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCommitMove: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateAndJoin: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCommitMove: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateAndJoin: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1428,13 +2101,13 @@ func (x *fastReflection_MsgCommitMove) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Player = string(dAtA[iNdEx:postIndex])
+				x.Creator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatorCommit", wireType)
 				}
-				x.GameId = 0
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1444,14 +2117,29 @@ func (x *fastReflection_MsgCommitMove) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.GameId |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CreatorCommit = append(x.CreatorCommit[:0], dAtA[iNdEx:postIndex]...)
+				if x.CreatorCommit == nil {
+					x.CreatorCommit = []byte{}
+				}
+				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1479,34 +2167,193 @@ func (x *fastReflection_MsgCommitMove) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Commit = string(dAtA[iNdEx:postIndex])
+				x.Challenger = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChallengerCommit", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChallengerCommit = append(x.ChallengerCommit[:0], dAtA[iNdEx:postIndex]...)
+				if x.ChallengerCommit == nil {
+					x.ChallengerCommit = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EntryFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EntryFee = append(x.EntryFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EntryFee[len(x.EntryFee)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChallengerFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.ChallengerFee = append(x.ChallengerFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ChallengerFee[len(x.ChallengerFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevealTimeout", wireType)
+				}
+				x.RevealTimeout = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RevealTimeout |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatorCommitmentScheme", wireType)
+				}
+				x.CreatorCommitmentScheme = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatorCommitmentScheme |= CommitmentScheme(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChallengerCommitmentScheme", wireType)
+				}
+				x.ChallengerCommitmentScheme = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChallengerCommitmentScheme |= CommitmentScheme(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
 		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
 		Size:              size,
 		Marshal:           marshal,
@@ -1517,23 +2364,25 @@ func (x *fastReflection_MsgCommitMove) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgCommitMoveResponse protoreflect.MessageDescriptor
+	md_MsgCreateAndJoinResponse         protoreflect.MessageDescriptor
+	fd_MsgCreateAndJoinResponse_game_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_facundomedica_rps_v1_tx_proto_init()
-	md_MsgCommitMoveResponse = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgCommitMoveResponse")
+	md_MsgCreateAndJoinResponse = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgCreateAndJoinResponse")
+	fd_MsgCreateAndJoinResponse_game_id = md_MsgCreateAndJoinResponse.Fields().ByName("game_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCommitMoveResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgCreateAndJoinResponse)(nil)
 
-type fastReflection_MsgCommitMoveResponse MsgCommitMoveResponse
+type fastReflection_MsgCreateAndJoinResponse MsgCreateAndJoinResponse
 
-func (x *MsgCommitMoveResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCommitMoveResponse)(x)
+func (x *MsgCreateAndJoinResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateAndJoinResponse)(x)
 }
 
-func (x *MsgCommitMoveResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgCreateAndJoinResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1545,43 +2394,43 @@ func (x *MsgCommitMoveResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCommitMoveResponse_messageType fastReflection_MsgCommitMoveResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCommitMoveResponse_messageType{}
+var _fastReflection_MsgCreateAndJoinResponse_messageType fastReflection_MsgCreateAndJoinResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateAndJoinResponse_messageType{}
 
-type fastReflection_MsgCommitMoveResponse_messageType struct{}
+type fastReflection_MsgCreateAndJoinResponse_messageType struct{}
 
-func (x fastReflection_MsgCommitMoveResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCommitMoveResponse)(nil)
+func (x fastReflection_MsgCreateAndJoinResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateAndJoinResponse)(nil)
 }
-func (x fastReflection_MsgCommitMoveResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCommitMoveResponse)
+func (x fastReflection_MsgCreateAndJoinResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateAndJoinResponse)
 }
-func (x fastReflection_MsgCommitMoveResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCommitMoveResponse
+func (x fastReflection_MsgCreateAndJoinResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateAndJoinResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCommitMoveResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCommitMoveResponse
+func (x *fastReflection_MsgCreateAndJoinResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateAndJoinResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCommitMoveResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCommitMoveResponse_messageType
+func (x *fastReflection_MsgCreateAndJoinResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateAndJoinResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCommitMoveResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgCommitMoveResponse)
+func (x *fastReflection_MsgCreateAndJoinResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateAndJoinResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCommitMoveResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgCommitMoveResponse)(x)
+func (x *fastReflection_MsgCreateAndJoinResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateAndJoinResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1589,7 +2438,13 @@ func (x *fastReflection_MsgCommitMoveResponse) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCommitMoveResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgCreateAndJoinResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GameId)
+		if !f(fd_MsgCreateAndJoinResponse_game_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1603,13 +2458,15 @@ func (x *fastReflection_MsgCommitMoveResponse) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCommitMoveResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgCreateAndJoinResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgCreateAndJoinResponse.game_id":
+		return x.GameId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMoveResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCreateAndJoinResponse"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMoveResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCreateAndJoinResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1619,13 +2476,15 @@ func (x *fastReflection_MsgCommitMoveResponse) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCommitMoveResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgCreateAndJoinResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgCreateAndJoinResponse.game_id":
+		x.GameId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMoveResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCreateAndJoinResponse"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMoveResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCreateAndJoinResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1635,13 +2494,16 @@ func (x *fastReflection_MsgCommitMoveResponse) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCommitMoveResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCreateAndJoinResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.MsgCreateAndJoinResponse.game_id":
+		value := x.GameId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMoveResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCreateAndJoinResponse"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMoveResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCreateAndJoinResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1655,13 +2517,15 @@ func (x *fastReflection_MsgCommitMoveResponse) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCommitMoveResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgCreateAndJoinResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgCreateAndJoinResponse.game_id":
+		x.GameId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMoveResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCreateAndJoinResponse"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMoveResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCreateAndJoinResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1675,36 +2539,40 @@ func (x *fastReflection_MsgCommitMoveResponse) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCommitMoveResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCreateAndJoinResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgCreateAndJoinResponse.game_id":
+		panic(fmt.Errorf("field game_id of message facundomedica.rps.v1.MsgCreateAndJoinResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMoveResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCreateAndJoinResponse"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMoveResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCreateAndJoinResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCommitMoveResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCreateAndJoinResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgCreateAndJoinResponse.game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMoveResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCreateAndJoinResponse"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMoveResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCreateAndJoinResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCommitMoveResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgCreateAndJoinResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgCommitMoveResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgCreateAndJoinResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1712,7 +2580,7 @@ func (x *fastReflection_MsgCommitMoveResponse) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCommitMoveResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgCreateAndJoinResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1723,7 +2591,7 @@ func (x *fastReflection_MsgCommitMoveResponse) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCommitMoveResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgCreateAndJoinResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1735,7 +2603,7 @@ func (x *fastReflection_MsgCommitMoveResponse) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCommitMoveResponse) IsValid() bool {
+func (x *fastReflection_MsgCreateAndJoinResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -1745,9 +2613,9 @@ func (x *fastReflection_MsgCommitMoveResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCommitMoveResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgCreateAndJoinResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCommitMoveResponse)
+		x := input.Message.Interface().(*MsgCreateAndJoinResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1759,6 +2627,9 @@ func (x *fastReflection_MsgCommitMoveResponse) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
+		if x.GameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GameId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1769,7 +2640,7 @@ func (x *fastReflection_MsgCommitMoveResponse) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCommitMoveResponse)
+		x := input.Message.Interface().(*MsgCreateAndJoinResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1788,6 +2659,11 @@ func (x *fastReflection_MsgCommitMoveResponse) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.GameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GameId))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -1799,7 +2675,7 @@ func (x *fastReflection_MsgCommitMoveResponse) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCommitMoveResponse)
+		x := input.Message.Interface().(*MsgCreateAndJoinResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1831,12 +2707,31 @@ func (x *fastReflection_MsgCommitMoveResponse) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCommitMoveResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateAndJoinResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCommitMoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateAndJoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+				}
+				x.GameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1873,31 +2768,31 @@ func (x *fastReflection_MsgCommitMoveResponse) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_MsgRevealMove         protoreflect.MessageDescriptor
-	fd_MsgRevealMove_player  protoreflect.FieldDescriptor
-	fd_MsgRevealMove_game_id protoreflect.FieldDescriptor
-	fd_MsgRevealMove_move    protoreflect.FieldDescriptor
-	fd_MsgRevealMove_salt    protoreflect.FieldDescriptor
+	md_MsgCommitMove                   protoreflect.MessageDescriptor
+	fd_MsgCommitMove_player            protoreflect.FieldDescriptor
+	fd_MsgCommitMove_game_id           protoreflect.FieldDescriptor
+	fd_MsgCommitMove_commit            protoreflect.FieldDescriptor
+	fd_MsgCommitMove_commitment_scheme protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_facundomedica_rps_v1_tx_proto_init()
-	md_MsgRevealMove = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgRevealMove")
-	fd_MsgRevealMove_player = md_MsgRevealMove.Fields().ByName("player")
-	fd_MsgRevealMove_game_id = md_MsgRevealMove.Fields().ByName("game_id")
-	fd_MsgRevealMove_move = md_MsgRevealMove.Fields().ByName("move")
-	fd_MsgRevealMove_salt = md_MsgRevealMove.Fields().ByName("salt")
+	md_MsgCommitMove = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgCommitMove")
+	fd_MsgCommitMove_player = md_MsgCommitMove.Fields().ByName("player")
+	fd_MsgCommitMove_game_id = md_MsgCommitMove.Fields().ByName("game_id")
+	fd_MsgCommitMove_commit = md_MsgCommitMove.Fields().ByName("commit")
+	fd_MsgCommitMove_commitment_scheme = md_MsgCommitMove.Fields().ByName("commitment_scheme")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRevealMove)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgCommitMove)(nil)
 
-type fastReflection_MsgRevealMove MsgRevealMove
+type fastReflection_MsgCommitMove MsgCommitMove
 
-func (x *MsgRevealMove) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRevealMove)(x)
+func (x *MsgCommitMove) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCommitMove)(x)
 }
 
-func (x *MsgRevealMove) slowProtoReflect() protoreflect.Message {
+func (x *MsgCommitMove) slowProtoReflect() protoreflect.Message {
 	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1909,43 +2804,43 @@ func (x *MsgRevealMove) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRevealMove_messageType fastReflection_MsgRevealMove_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRevealMove_messageType{}
+var _fastReflection_MsgCommitMove_messageType fastReflection_MsgCommitMove_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCommitMove_messageType{}
 
-type fastReflection_MsgRevealMove_messageType struct{}
+type fastReflection_MsgCommitMove_messageType struct{}
 
-func (x fastReflection_MsgRevealMove_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRevealMove)(nil)
+func (x fastReflection_MsgCommitMove_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCommitMove)(nil)
 }
-func (x fastReflection_MsgRevealMove_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRevealMove)
+func (x fastReflection_MsgCommitMove_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCommitMove)
 }
-func (x fastReflection_MsgRevealMove_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRevealMove
+func (x fastReflection_MsgCommitMove_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCommitMove
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRevealMove) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRevealMove
+func (x *fastReflection_MsgCommitMove) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCommitMove
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRevealMove) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRevealMove_messageType
+func (x *fastReflection_MsgCommitMove) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCommitMove_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRevealMove) New() protoreflect.Message {
-	return new(fastReflection_MsgRevealMove)
+func (x *fastReflection_MsgCommitMove) New() protoreflect.Message {
+	return new(fastReflection_MsgCommitMove)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRevealMove) Interface() protoreflect.ProtoMessage {
-	return (*MsgRevealMove)(x)
+func (x *fastReflection_MsgCommitMove) Interface() protoreflect.ProtoMessage {
+	return (*MsgCommitMove)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1953,28 +2848,28 @@ func (x *fastReflection_MsgRevealMove) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRevealMove) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgCommitMove) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Player != "" {
 		value := protoreflect.ValueOfString(x.Player)
-		if !f(fd_MsgRevealMove_player, value) {
+		if !f(fd_MsgCommitMove_player, value) {
 			return
 		}
 	}
 	if x.GameId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.GameId)
-		if !f(fd_MsgRevealMove_game_id, value) {
+		if !f(fd_MsgCommitMove_game_id, value) {
 			return
 		}
 	}
-	if x.Move != "" {
-		value := protoreflect.ValueOfString(x.Move)
-		if !f(fd_MsgRevealMove_move, value) {
+	if len(x.Commit) != 0 {
+		value := protoreflect.ValueOfBytes(x.Commit)
+		if !f(fd_MsgCommitMove_commit, value) {
 			return
 		}
 	}
-	if x.Salt != "" {
-		value := protoreflect.ValueOfString(x.Salt)
-		if !f(fd_MsgRevealMove_salt, value) {
+	if x.CommitmentScheme != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.CommitmentScheme))
+		if !f(fd_MsgCommitMove_commitment_scheme, value) {
 			return
 		}
 	}
@@ -1991,21 +2886,21 @@ func (x *fastReflection_MsgRevealMove) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRevealMove) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgCommitMove) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MsgRevealMove.player":
+	case "facundomedica.rps.v1.MsgCommitMove.player":
 		return x.Player != ""
-	case "facundomedica.rps.v1.MsgRevealMove.game_id":
+	case "facundomedica.rps.v1.MsgCommitMove.game_id":
 		return x.GameId != uint64(0)
-	case "facundomedica.rps.v1.MsgRevealMove.move":
-		return x.Move != ""
-	case "facundomedica.rps.v1.MsgRevealMove.salt":
-		return x.Salt != ""
+	case "facundomedica.rps.v1.MsgCommitMove.commit":
+		return len(x.Commit) != 0
+	case "facundomedica.rps.v1.MsgCommitMove.commitment_scheme":
+		return x.CommitmentScheme != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMove"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMove"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMove does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMove does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2015,21 +2910,21 @@ func (x *fastReflection_MsgRevealMove) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevealMove) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgCommitMove) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MsgRevealMove.player":
+	case "facundomedica.rps.v1.MsgCommitMove.player":
 		x.Player = ""
-	case "facundomedica.rps.v1.MsgRevealMove.game_id":
+	case "facundomedica.rps.v1.MsgCommitMove.game_id":
 		x.GameId = uint64(0)
-	case "facundomedica.rps.v1.MsgRevealMove.move":
-		x.Move = ""
-	case "facundomedica.rps.v1.MsgRevealMove.salt":
-		x.Salt = ""
+	case "facundomedica.rps.v1.MsgCommitMove.commit":
+		x.Commit = nil
+	case "facundomedica.rps.v1.MsgCommitMove.commitment_scheme":
+		x.CommitmentScheme = 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMove"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMove"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMove does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMove does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2039,25 +2934,25 @@ func (x *fastReflection_MsgRevealMove) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRevealMove) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCommitMove) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "facundomedica.rps.v1.MsgRevealMove.player":
+	case "facundomedica.rps.v1.MsgCommitMove.player":
 		value := x.Player
 		return protoreflect.ValueOfString(value)
-	case "facundomedica.rps.v1.MsgRevealMove.game_id":
+	case "facundomedica.rps.v1.MsgCommitMove.game_id":
 		value := x.GameId
 		return protoreflect.ValueOfUint64(value)
-	case "facundomedica.rps.v1.MsgRevealMove.move":
-		value := x.Move
-		return protoreflect.ValueOfString(value)
-	case "facundomedica.rps.v1.MsgRevealMove.salt":
-		value := x.Salt
-		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.MsgCommitMove.commit":
+		value := x.Commit
+		return protoreflect.ValueOfBytes(value)
+	case "facundomedica.rps.v1.MsgCommitMove.commitment_scheme":
+		value := x.CommitmentScheme
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMove"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMove"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMove does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMove does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2071,21 +2966,21 @@ func (x *fastReflection_MsgRevealMove) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevealMove) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgCommitMove) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MsgRevealMove.player":
+	case "facundomedica.rps.v1.MsgCommitMove.player":
 		x.Player = value.Interface().(string)
-	case "facundomedica.rps.v1.MsgRevealMove.game_id":
+	case "facundomedica.rps.v1.MsgCommitMove.game_id":
 		x.GameId = value.Uint()
-	case "facundomedica.rps.v1.MsgRevealMove.move":
-		x.Move = value.Interface().(string)
-	case "facundomedica.rps.v1.MsgRevealMove.salt":
-		x.Salt = value.Interface().(string)
+	case "facundomedica.rps.v1.MsgCommitMove.commit":
+		x.Commit = value.Bytes()
+	case "facundomedica.rps.v1.MsgCommitMove.commitment_scheme":
+		x.CommitmentScheme = (CommitmentScheme)(value.Enum())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMove"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMove"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMove does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMove does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2099,52 +2994,52 @@ func (x *fastReflection_MsgRevealMove) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevealMove) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCommitMove) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MsgRevealMove.player":
-		panic(fmt.Errorf("field player of message facundomedica.rps.v1.MsgRevealMove is not mutable"))
-	case "facundomedica.rps.v1.MsgRevealMove.game_id":
-		panic(fmt.Errorf("field game_id of message facundomedica.rps.v1.MsgRevealMove is not mutable"))
-	case "facundomedica.rps.v1.MsgRevealMove.move":
-		panic(fmt.Errorf("field move of message facundomedica.rps.v1.MsgRevealMove is not mutable"))
-	case "facundomedica.rps.v1.MsgRevealMove.salt":
-		panic(fmt.Errorf("field salt of message facundomedica.rps.v1.MsgRevealMove is not mutable"))
+	case "facundomedica.rps.v1.MsgCommitMove.player":
+		panic(fmt.Errorf("field player of message facundomedica.rps.v1.MsgCommitMove is not mutable"))
+	case "facundomedica.rps.v1.MsgCommitMove.game_id":
+		panic(fmt.Errorf("field game_id of message facundomedica.rps.v1.MsgCommitMove is not mutable"))
+	case "facundomedica.rps.v1.MsgCommitMove.commit":
+		panic(fmt.Errorf("field commit of message facundomedica.rps.v1.MsgCommitMove is not mutable"))
+	case "facundomedica.rps.v1.MsgCommitMove.commitment_scheme":
+		panic(fmt.Errorf("field commitment_scheme of message facundomedica.rps.v1.MsgCommitMove is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMove"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMove"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMove does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMove does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRevealMove) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCommitMove) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MsgRevealMove.player":
+	case "facundomedica.rps.v1.MsgCommitMove.player":
 		return protoreflect.ValueOfString("")
-	case "facundomedica.rps.v1.MsgRevealMove.game_id":
+	case "facundomedica.rps.v1.MsgCommitMove.game_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "facundomedica.rps.v1.MsgRevealMove.move":
-		return protoreflect.ValueOfString("")
-	case "facundomedica.rps.v1.MsgRevealMove.salt":
-		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.MsgCommitMove.commit":
+		return protoreflect.ValueOfBytes(nil)
+	case "facundomedica.rps.v1.MsgCommitMove.commitment_scheme":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMove"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMove"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMove does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMove does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRevealMove) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgCommitMove) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgRevealMove", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgCommitMove", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2152,7 +3047,7 @@ func (x *fastReflection_MsgRevealMove) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRevealMove) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgCommitMove) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2163,7 +3058,7 @@ func (x *fastReflection_MsgRevealMove) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevealMove) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgCommitMove) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2175,7 +3070,7 @@ func (x *fastReflection_MsgRevealMove) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRevealMove) IsValid() bool {
+func (x *fastReflection_MsgCommitMove) IsValid() bool {
 	return x != nil
 }
 
@@ -2185,9 +3080,9 @@ func (x *fastReflection_MsgRevealMove) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRevealMove) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgCommitMove) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRevealMove)
+		x := input.Message.Interface().(*MsgCommitMove)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2206,13 +3101,12 @@ func (x *fastReflection_MsgRevealMove) ProtoMethods() *protoiface.Methods {
 		if x.GameId != 0 {
 			n += 1 + runtime.Sov(uint64(x.GameId))
 		}
-		l = len(x.Move)
+		l = len(x.Commit)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Salt)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.CommitmentScheme != 0 {
+			n += 1 + runtime.Sov(uint64(x.CommitmentScheme))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2224,7 +3118,7 @@ func (x *fastReflection_MsgRevealMove) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRevealMove)
+		x := input.Message.Interface().(*MsgCommitMove)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2243,17 +3137,15 @@ func (x *fastReflection_MsgRevealMove) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Salt) > 0 {
-			i -= len(x.Salt)
-			copy(dAtA[i:], x.Salt)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Salt)))
+		if x.CommitmentScheme != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommitmentScheme))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x20
 		}
-		if len(x.Move) > 0 {
-			i -= len(x.Move)
-			copy(dAtA[i:], x.Move)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Move)))
+		if len(x.Commit) > 0 {
+			i -= len(x.Commit)
+			copy(dAtA[i:], x.Commit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commit)))
 			i--
 			dAtA[i] = 0x1a
 		}
@@ -2280,7 +3172,7 @@ func (x *fastReflection_MsgRevealMove) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRevealMove)
+		x := input.Message.Interface().(*MsgCommitMove)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2312,10 +3204,10 @@ func (x *fastReflection_MsgRevealMove) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealMove: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCommitMove: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealMove: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCommitMove: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2371,9 +3263,9 @@ func (x *fastReflection_MsgRevealMove) ProtoMethods() *protoiface.Methods {
 				}
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2383,29 +3275,31 @@ func (x *fastReflection_MsgRevealMove) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Move = string(dAtA[iNdEx:postIndex])
+				x.Commit = append(x.Commit[:0], dAtA[iNdEx:postIndex]...)
+				if x.Commit == nil {
+					x.Commit = []byte{}
+				}
 				iNdEx = postIndex
 			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitmentScheme", wireType)
 				}
-				var stringLen uint64
+				x.CommitmentScheme = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2415,24 +3309,11 @@ func (x *fastReflection_MsgRevealMove) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.CommitmentScheme |= CommitmentScheme(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Salt = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2469,23 +3350,23 @@ func (x *fastReflection_MsgRevealMove) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgRevealMoveResponse protoreflect.MessageDescriptor
+	md_MsgCommitMoveResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_facundomedica_rps_v1_tx_proto_init()
-	md_MsgRevealMoveResponse = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgRevealMoveResponse")
+	md_MsgCommitMoveResponse = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgCommitMoveResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRevealMoveResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgCommitMoveResponse)(nil)
 
-type fastReflection_MsgRevealMoveResponse MsgRevealMoveResponse
+type fastReflection_MsgCommitMoveResponse MsgCommitMoveResponse
 
-func (x *MsgRevealMoveResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRevealMoveResponse)(x)
+func (x *MsgCommitMoveResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCommitMoveResponse)(x)
 }
 
-func (x *MsgRevealMoveResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgCommitMoveResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2497,43 +3378,43 @@ func (x *MsgRevealMoveResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRevealMoveResponse_messageType fastReflection_MsgRevealMoveResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRevealMoveResponse_messageType{}
+var _fastReflection_MsgCommitMoveResponse_messageType fastReflection_MsgCommitMoveResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCommitMoveResponse_messageType{}
 
-type fastReflection_MsgRevealMoveResponse_messageType struct{}
+type fastReflection_MsgCommitMoveResponse_messageType struct{}
 
-func (x fastReflection_MsgRevealMoveResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRevealMoveResponse)(nil)
+func (x fastReflection_MsgCommitMoveResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCommitMoveResponse)(nil)
 }
-func (x fastReflection_MsgRevealMoveResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRevealMoveResponse)
+func (x fastReflection_MsgCommitMoveResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCommitMoveResponse)
 }
-func (x fastReflection_MsgRevealMoveResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRevealMoveResponse
+func (x fastReflection_MsgCommitMoveResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCommitMoveResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRevealMoveResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRevealMoveResponse
+func (x *fastReflection_MsgCommitMoveResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCommitMoveResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRevealMoveResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRevealMoveResponse_messageType
+func (x *fastReflection_MsgCommitMoveResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCommitMoveResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRevealMoveResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgRevealMoveResponse)
+func (x *fastReflection_MsgCommitMoveResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCommitMoveResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRevealMoveResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgRevealMoveResponse)(x)
+func (x *fastReflection_MsgCommitMoveResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCommitMoveResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2541,7 +3422,7 @@ func (x *fastReflection_MsgRevealMoveResponse) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRevealMoveResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgCommitMoveResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -2555,13 +3436,13 @@ func (x *fastReflection_MsgRevealMoveResponse) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRevealMoveResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgCommitMoveResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMoveResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMoveResponse"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMoveResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMoveResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2571,13 +3452,13 @@ func (x *fastReflection_MsgRevealMoveResponse) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevealMoveResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgCommitMoveResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMoveResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMoveResponse"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMoveResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMoveResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2587,13 +3468,13 @@ func (x *fastReflection_MsgRevealMoveResponse) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRevealMoveResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCommitMoveResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMoveResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMoveResponse"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMoveResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMoveResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2607,13 +3488,13 @@ func (x *fastReflection_MsgRevealMoveResponse) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevealMoveResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgCommitMoveResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMoveResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMoveResponse"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMoveResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMoveResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2627,36 +3508,36 @@ func (x *fastReflection_MsgRevealMoveResponse) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevealMoveResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCommitMoveResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMoveResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMoveResponse"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMoveResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMoveResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRevealMoveResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCommitMoveResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMoveResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgCommitMoveResponse"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMoveResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgCommitMoveResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRevealMoveResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgCommitMoveResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgRevealMoveResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgCommitMoveResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2664,7 +3545,7 @@ func (x *fastReflection_MsgRevealMoveResponse) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRevealMoveResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgCommitMoveResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2675,7 +3556,7 @@ func (x *fastReflection_MsgRevealMoveResponse) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevealMoveResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgCommitMoveResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2687,7 +3568,7 @@ func (x *fastReflection_MsgRevealMoveResponse) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRevealMoveResponse) IsValid() bool {
+func (x *fastReflection_MsgCommitMoveResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2697,9 +3578,9 @@ func (x *fastReflection_MsgRevealMoveResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRevealMoveResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgCommitMoveResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRevealMoveResponse)
+		x := input.Message.Interface().(*MsgCommitMoveResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2721,7 +3602,7 @@ func (x *fastReflection_MsgRevealMoveResponse) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRevealMoveResponse)
+		x := input.Message.Interface().(*MsgCommitMoveResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2751,7 +3632,7 @@ func (x *fastReflection_MsgRevealMoveResponse) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRevealMoveResponse)
+		x := input.Message.Interface().(*MsgCommitMoveResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2783,10 +3664,10 @@ func (x *fastReflection_MsgRevealMoveResponse) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealMoveResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCommitMoveResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealMoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCommitMoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -2825,27 +3706,31 @@ func (x *fastReflection_MsgRevealMoveResponse) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_MsgUpdateParams           protoreflect.MessageDescriptor
-	fd_MsgUpdateParams_authority protoreflect.FieldDescriptor
-	fd_MsgUpdateParams_params    protoreflect.FieldDescriptor
+	md_MsgRevealMove         protoreflect.MessageDescriptor
+	fd_MsgRevealMove_player  protoreflect.FieldDescriptor
+	fd_MsgRevealMove_game_id protoreflect.FieldDescriptor
+	fd_MsgRevealMove_move    protoreflect.FieldDescriptor
+	fd_MsgRevealMove_salt    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_facundomedica_rps_v1_tx_proto_init()
-	md_MsgUpdateParams = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgUpdateParams")
-	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
-	fd_MsgUpdateParams_params = md_MsgUpdateParams.Fields().ByName("params")
+	md_MsgRevealMove = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgRevealMove")
+	fd_MsgRevealMove_player = md_MsgRevealMove.Fields().ByName("player")
+	fd_MsgRevealMove_game_id = md_MsgRevealMove.Fields().ByName("game_id")
+	fd_MsgRevealMove_move = md_MsgRevealMove.Fields().ByName("move")
+	fd_MsgRevealMove_salt = md_MsgRevealMove.Fields().ByName("salt")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRevealMove)(nil)
 
-type fastReflection_MsgUpdateParams MsgUpdateParams
+type fastReflection_MsgRevealMove MsgRevealMove
 
-func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParams)(x)
+func (x *MsgRevealMove) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevealMove)(x)
 }
 
-func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
+func (x *MsgRevealMove) slowProtoReflect() protoreflect.Message {
 	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2857,43 +3742,43 @@ func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateParams_messageType fastReflection_MsgUpdateParams_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateParams_messageType{}
+var _fastReflection_MsgRevealMove_messageType fastReflection_MsgRevealMove_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevealMove_messageType{}
 
-type fastReflection_MsgUpdateParams_messageType struct{}
+type fastReflection_MsgRevealMove_messageType struct{}
 
-func (x fastReflection_MsgUpdateParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParams)(nil)
+func (x fastReflection_MsgRevealMove_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevealMove)(nil)
 }
-func (x fastReflection_MsgUpdateParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParams)
+func (x fastReflection_MsgRevealMove_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealMove)
 }
-func (x fastReflection_MsgUpdateParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParams
+func (x fastReflection_MsgRevealMove_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealMove
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParams
+func (x *fastReflection_MsgRevealMove) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealMove
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateParams) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateParams_messageType
+func (x *fastReflection_MsgRevealMove) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevealMove_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateParams) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParams)
+func (x *fastReflection_MsgRevealMove) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealMove)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateParams)(x)
+func (x *fastReflection_MsgRevealMove) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevealMove)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2901,16 +3786,28 @@ func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Authority != "" {
-		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateParams_authority, value) {
+func (x *fastReflection_MsgRevealMove) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Player != "" {
+		value := protoreflect.ValueOfString(x.Player)
+		if !f(fd_MsgRevealMove_player, value) {
 			return
 		}
 	}
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_MsgUpdateParams_params, value) {
+	if x.GameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GameId)
+		if !f(fd_MsgRevealMove_game_id, value) {
+			return
+		}
+	}
+	if x.Move != "" {
+		value := protoreflect.ValueOfString(x.Move)
+		if !f(fd_MsgRevealMove_move, value) {
+			return
+		}
+	}
+	if len(x.Salt) != 0 {
+		value := protoreflect.ValueOfBytes(x.Salt)
+		if !f(fd_MsgRevealMove_salt, value) {
 			return
 		}
 	}
@@ -2927,17 +3824,21 @@ func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRevealMove) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MsgUpdateParams.authority":
-		return x.Authority != ""
-	case "facundomedica.rps.v1.MsgUpdateParams.params":
-		return x.Params != nil
+	case "facundomedica.rps.v1.MsgRevealMove.player":
+		return x.Player != ""
+	case "facundomedica.rps.v1.MsgRevealMove.game_id":
+		return x.GameId != uint64(0)
+	case "facundomedica.rps.v1.MsgRevealMove.move":
+		return x.Move != ""
+	case "facundomedica.rps.v1.MsgRevealMove.salt":
+		return len(x.Salt) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMove"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMove does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2947,17 +3848,21 @@ func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRevealMove) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MsgUpdateParams.authority":
-		x.Authority = ""
-	case "facundomedica.rps.v1.MsgUpdateParams.params":
-		x.Params = nil
+	case "facundomedica.rps.v1.MsgRevealMove.player":
+		x.Player = ""
+	case "facundomedica.rps.v1.MsgRevealMove.game_id":
+		x.GameId = uint64(0)
+	case "facundomedica.rps.v1.MsgRevealMove.move":
+		x.Move = ""
+	case "facundomedica.rps.v1.MsgRevealMove.salt":
+		x.Salt = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMove"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMove does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2967,19 +3872,25 @@ func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevealMove) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "facundomedica.rps.v1.MsgUpdateParams.authority":
-		value := x.Authority
+	case "facundomedica.rps.v1.MsgRevealMove.player":
+		value := x.Player
 		return protoreflect.ValueOfString(value)
-	case "facundomedica.rps.v1.MsgUpdateParams.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "facundomedica.rps.v1.MsgRevealMove.game_id":
+		value := x.GameId
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.MsgRevealMove.move":
+		value := x.Move
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.MsgRevealMove.salt":
+		value := x.Salt
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMove"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMove does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2993,17 +3904,21 @@ func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRevealMove) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MsgUpdateParams.authority":
-		x.Authority = value.Interface().(string)
-	case "facundomedica.rps.v1.MsgUpdateParams.params":
-		x.Params = value.Message().Interface().(*Params)
+	case "facundomedica.rps.v1.MsgRevealMove.player":
+		x.Player = value.Interface().(string)
+	case "facundomedica.rps.v1.MsgRevealMove.game_id":
+		x.GameId = value.Uint()
+	case "facundomedica.rps.v1.MsgRevealMove.move":
+		x.Move = value.Interface().(string)
+	case "facundomedica.rps.v1.MsgRevealMove.salt":
+		x.Salt = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMove"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMove does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3017,48 +3932,52 @@ func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevealMove) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MsgUpdateParams.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "facundomedica.rps.v1.MsgUpdateParams.authority":
-		panic(fmt.Errorf("field authority of message facundomedica.rps.v1.MsgUpdateParams is not mutable"))
+	case "facundomedica.rps.v1.MsgRevealMove.player":
+		panic(fmt.Errorf("field player of message facundomedica.rps.v1.MsgRevealMove is not mutable"))
+	case "facundomedica.rps.v1.MsgRevealMove.game_id":
+		panic(fmt.Errorf("field game_id of message facundomedica.rps.v1.MsgRevealMove is not mutable"))
+	case "facundomedica.rps.v1.MsgRevealMove.move":
+		panic(fmt.Errorf("field move of message facundomedica.rps.v1.MsgRevealMove is not mutable"))
+	case "facundomedica.rps.v1.MsgRevealMove.salt":
+		panic(fmt.Errorf("field salt of message facundomedica.rps.v1.MsgRevealMove is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMove"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMove does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevealMove) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MsgUpdateParams.authority":
+	case "facundomedica.rps.v1.MsgRevealMove.player":
 		return protoreflect.ValueOfString("")
-	case "facundomedica.rps.v1.MsgUpdateParams.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "facundomedica.rps.v1.MsgRevealMove.game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.MsgRevealMove.move":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.MsgRevealMove.salt":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMove"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMove does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRevealMove) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgUpdateParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgRevealMove", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3066,7 +3985,7 @@ func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRevealMove) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3077,7 +3996,7 @@ func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRevealMove) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3089,7 +4008,7 @@ func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateParams) IsValid() bool {
+func (x *fastReflection_MsgRevealMove) IsValid() bool {
 	return x != nil
 }
 
@@ -3099,9 +4018,9 @@ func (x *fastReflection_MsgUpdateParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRevealMove) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgRevealMove)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3113,12 +4032,19 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Authority)
+		l = len(x.Player)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Params != nil {
-			l = options.Size(x.Params)
+		if x.GameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GameId))
+		}
+		l = len(x.Move)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Salt)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -3131,7 +4057,7 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgRevealMove)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3150,24 +4076,29 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Salt) > 0 {
+			i -= len(x.Salt)
+			copy(dAtA[i:], x.Salt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Salt)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 		}
-		if len(x.Authority) > 0 {
-			i -= len(x.Authority)
-			copy(dAtA[i:], x.Authority)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+		if len(x.Move) > 0 {
+			i -= len(x.Move)
+			copy(dAtA[i:], x.Move)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Move)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.GameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GameId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Player) > 0 {
+			i -= len(x.Player)
+			copy(dAtA[i:], x.Player)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Player)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3182,7 +4113,7 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgRevealMove)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3214,15 +4145,15 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealMove: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealMove: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3250,13 +4181,32 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Authority = string(dAtA[iNdEx:postIndex])
+				x.Player = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+				}
+				x.GameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3266,26 +4216,56 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				x.Move = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Salt = append(x.Salt[:0], dAtA[iNdEx:postIndex]...)
+				if x.Salt == nil {
+					x.Salt = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -3324,23 +4304,23 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgUpdateParamsResponse protoreflect.MessageDescriptor
+	md_MsgRevealMoveResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_facundomedica_rps_v1_tx_proto_init()
-	md_MsgUpdateParamsResponse = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgUpdateParamsResponse")
+	md_MsgRevealMoveResponse = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgRevealMoveResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateParamsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRevealMoveResponse)(nil)
 
-type fastReflection_MsgUpdateParamsResponse MsgUpdateParamsResponse
+type fastReflection_MsgRevealMoveResponse MsgRevealMoveResponse
 
-func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParamsResponse)(x)
+func (x *MsgRevealMoveResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevealMoveResponse)(x)
 }
 
-func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgRevealMoveResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3352,43 +4332,43 @@ func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateParamsResponse_messageType fastReflection_MsgUpdateParamsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateParamsResponse_messageType{}
+var _fastReflection_MsgRevealMoveResponse_messageType fastReflection_MsgRevealMoveResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevealMoveResponse_messageType{}
 
-type fastReflection_MsgUpdateParamsResponse_messageType struct{}
+type fastReflection_MsgRevealMoveResponse_messageType struct{}
 
-func (x fastReflection_MsgUpdateParamsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParamsResponse)(nil)
+func (x fastReflection_MsgRevealMoveResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevealMoveResponse)(nil)
 }
-func (x fastReflection_MsgUpdateParamsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParamsResponse)
+func (x fastReflection_MsgRevealMoveResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealMoveResponse)
 }
-func (x fastReflection_MsgUpdateParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParamsResponse
+func (x fastReflection_MsgRevealMoveResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealMoveResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateParamsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParamsResponse
+func (x *fastReflection_MsgRevealMoveResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealMoveResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateParamsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateParamsResponse_messageType
+func (x *fastReflection_MsgRevealMoveResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevealMoveResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateParamsResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParamsResponse)
+func (x *fastReflection_MsgRevealMoveResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealMoveResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateParamsResponse)(x)
+func (x *fastReflection_MsgRevealMoveResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevealMoveResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3396,7 +4376,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRevealMoveResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -3410,13 +4390,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRevealMoveResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMoveResponse"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMoveResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3426,13 +4406,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRevealMoveResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMoveResponse"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMoveResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3442,13 +4422,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevealMoveResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMoveResponse"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParamsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMoveResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3462,13 +4442,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRevealMoveResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMoveResponse"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMoveResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3482,36 +4462,36 @@ func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevealMoveResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMoveResponse"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMoveResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevealMoveResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgRevealMoveResponse"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgRevealMoveResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRevealMoveResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgUpdateParamsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgRevealMoveResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3519,7 +4499,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRevealMoveResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3530,7 +4510,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRevealMoveResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3542,7 +4522,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
+func (x *fastReflection_MsgRevealMoveResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3552,9 +4532,9 @@ func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRevealMoveResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgRevealMoveResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3576,7 +4556,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgRevealMoveResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3606,7 +4586,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgRevealMoveResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3638,10 +4618,10 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealMoveResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealMoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -3679,278 +4659,11763 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: facundomedica/rps/v1/tx.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_MsgSetRevealAgent        protoreflect.MessageDescriptor
+	fd_MsgSetRevealAgent_player protoreflect.FieldDescriptor
+	fd_MsgSetRevealAgent_agent  protoreflect.FieldDescriptor
 )
 
-type MsgNewGame struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgSetRevealAgent = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgSetRevealAgent")
+	fd_MsgSetRevealAgent_player = md_MsgSetRevealAgent.Fields().ByName("player")
+	fd_MsgSetRevealAgent_agent = md_MsgSetRevealAgent.Fields().ByName("agent")
+}
 
-	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
-	// commit is the hex encoded commitment to the first move.
-	// hex encoded sha256 of "salt:move" where move is one of "rock", "paper",
-	// "scissors"
-	Commit string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
-	// entry_fee is the amount to put into stake for the game.
-	EntryFee *v1beta1.Coin `protobuf:"bytes,3,opt,name=entry_fee,json=entryFee,proto3" json:"entry_fee,omitempty"`
+var _ protoreflect.Message = (*fastReflection_MsgSetRevealAgent)(nil)
+
+type fastReflection_MsgSetRevealAgent MsgSetRevealAgent
+
+func (x *MsgSetRevealAgent) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetRevealAgent)(x)
 }
 
-func (x *MsgNewGame) Reset() {
-	*x = MsgNewGame{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[0]
+func (x *MsgSetRevealAgent) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *MsgNewGame) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_MsgSetRevealAgent_messageType fastReflection_MsgSetRevealAgent_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetRevealAgent_messageType{}
 
-func (*MsgNewGame) ProtoMessage() {}
+type fastReflection_MsgSetRevealAgent_messageType struct{}
 
-// Deprecated: Use MsgNewGame.ProtoReflect.Descriptor instead.
-func (*MsgNewGame) Descriptor() ([]byte, []int) {
-	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_MsgSetRevealAgent_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetRevealAgent)(nil)
 }
-
-func (x *MsgNewGame) GetPlayer() string {
-	if x != nil {
-		return x.Player
-	}
-	return ""
+func (x fastReflection_MsgSetRevealAgent_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetRevealAgent)
 }
-
-func (x *MsgNewGame) GetCommit() string {
-	if x != nil {
-		return x.Commit
-	}
-	return ""
+func (x fastReflection_MsgSetRevealAgent_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetRevealAgent
 }
 
-func (x *MsgNewGame) GetEntryFee() *v1beta1.Coin {
-	if x != nil {
-		return x.EntryFee
-	}
-	return nil
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetRevealAgent) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetRevealAgent
 }
 
-type MsgNewGameResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// game_id is the ID of the created game.
-	GameId uint64 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetRevealAgent) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetRevealAgent_messageType
 }
 
-func (x *MsgNewGameResponse) Reset() {
-	*x = MsgNewGameResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetRevealAgent) New() protoreflect.Message {
+	return new(fastReflection_MsgSetRevealAgent)
 }
 
-func (x *MsgNewGameResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetRevealAgent) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetRevealAgent)(x)
 }
 
-func (*MsgNewGameResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgNewGameResponse.ProtoReflect.Descriptor instead.
-func (*MsgNewGameResponse) Descriptor() ([]byte, []int) {
-	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{1}
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetRevealAgent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Player != "" {
+		value := protoreflect.ValueOfString(x.Player)
+		if !f(fd_MsgSetRevealAgent_player, value) {
+			return
+		}
+	}
+	if x.Agent != "" {
+		value := protoreflect.ValueOfString(x.Agent)
+		if !f(fd_MsgSetRevealAgent_agent, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetRevealAgent) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgSetRevealAgent.player":
+		return x.Player != ""
+	case "facundomedica.rps.v1.MsgSetRevealAgent.agent":
+		return x.Agent != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgSetRevealAgent"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgSetRevealAgent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetRevealAgent) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgSetRevealAgent.player":
+		x.Player = ""
+	case "facundomedica.rps.v1.MsgSetRevealAgent.agent":
+		x.Agent = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgSetRevealAgent"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgSetRevealAgent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetRevealAgent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.MsgSetRevealAgent.player":
+		value := x.Player
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.MsgSetRevealAgent.agent":
+		value := x.Agent
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgSetRevealAgent"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgSetRevealAgent does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetRevealAgent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgSetRevealAgent.player":
+		x.Player = value.Interface().(string)
+	case "facundomedica.rps.v1.MsgSetRevealAgent.agent":
+		x.Agent = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgSetRevealAgent"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgSetRevealAgent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetRevealAgent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgSetRevealAgent.player":
+		panic(fmt.Errorf("field player of message facundomedica.rps.v1.MsgSetRevealAgent is not mutable"))
+	case "facundomedica.rps.v1.MsgSetRevealAgent.agent":
+		panic(fmt.Errorf("field agent of message facundomedica.rps.v1.MsgSetRevealAgent is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgSetRevealAgent"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgSetRevealAgent does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetRevealAgent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgSetRevealAgent.player":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.MsgSetRevealAgent.agent":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgSetRevealAgent"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgSetRevealAgent does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetRevealAgent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgSetRevealAgent", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetRevealAgent) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetRevealAgent) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetRevealAgent) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetRevealAgent) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetRevealAgent)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Player)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Agent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetRevealAgent)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Agent) > 0 {
+			i -= len(x.Agent)
+			copy(dAtA[i:], x.Agent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Agent)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Player) > 0 {
+			i -= len(x.Player)
+			copy(dAtA[i:], x.Player)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Player)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetRevealAgent)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetRevealAgent: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetRevealAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Player = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Agent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetRevealAgentResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgSetRevealAgentResponse = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgSetRevealAgentResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetRevealAgentResponse)(nil)
+
+type fastReflection_MsgSetRevealAgentResponse MsgSetRevealAgentResponse
+
+func (x *MsgSetRevealAgentResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetRevealAgentResponse)(x)
+}
+
+func (x *MsgSetRevealAgentResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetRevealAgentResponse_messageType fastReflection_MsgSetRevealAgentResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetRevealAgentResponse_messageType{}
+
+type fastReflection_MsgSetRevealAgentResponse_messageType struct{}
+
+func (x fastReflection_MsgSetRevealAgentResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetRevealAgentResponse)(nil)
+}
+func (x fastReflection_MsgSetRevealAgentResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetRevealAgentResponse)
+}
+func (x fastReflection_MsgSetRevealAgentResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetRevealAgentResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetRevealAgentResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetRevealAgentResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetRevealAgentResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetRevealAgentResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetRevealAgentResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetRevealAgentResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetRevealAgentResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetRevealAgentResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetRevealAgentResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetRevealAgentResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgSetRevealAgentResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgSetRevealAgentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetRevealAgentResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgSetRevealAgentResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgSetRevealAgentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetRevealAgentResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgSetRevealAgentResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgSetRevealAgentResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetRevealAgentResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgSetRevealAgentResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgSetRevealAgentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetRevealAgentResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgSetRevealAgentResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgSetRevealAgentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetRevealAgentResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgSetRevealAgentResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgSetRevealAgentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetRevealAgentResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgSetRevealAgentResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetRevealAgentResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetRevealAgentResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetRevealAgentResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetRevealAgentResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetRevealAgentResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetRevealAgentResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetRevealAgentResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetRevealAgentResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetRevealAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgEscrowReveal                  protoreflect.MessageDescriptor
+	fd_MsgEscrowReveal_player           protoreflect.FieldDescriptor
+	fd_MsgEscrowReveal_game_id          protoreflect.FieldDescriptor
+	fd_MsgEscrowReveal_encrypted_reveal protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgEscrowReveal = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgEscrowReveal")
+	fd_MsgEscrowReveal_player = md_MsgEscrowReveal.Fields().ByName("player")
+	fd_MsgEscrowReveal_game_id = md_MsgEscrowReveal.Fields().ByName("game_id")
+	fd_MsgEscrowReveal_encrypted_reveal = md_MsgEscrowReveal.Fields().ByName("encrypted_reveal")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgEscrowReveal)(nil)
+
+type fastReflection_MsgEscrowReveal MsgEscrowReveal
+
+func (x *MsgEscrowReveal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgEscrowReveal)(x)
+}
+
+func (x *MsgEscrowReveal) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgEscrowReveal_messageType fastReflection_MsgEscrowReveal_messageType
+var _ protoreflect.MessageType = fastReflection_MsgEscrowReveal_messageType{}
+
+type fastReflection_MsgEscrowReveal_messageType struct{}
+
+func (x fastReflection_MsgEscrowReveal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgEscrowReveal)(nil)
+}
+func (x fastReflection_MsgEscrowReveal_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgEscrowReveal)
+}
+func (x fastReflection_MsgEscrowReveal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgEscrowReveal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgEscrowReveal) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgEscrowReveal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgEscrowReveal) Type() protoreflect.MessageType {
+	return _fastReflection_MsgEscrowReveal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgEscrowReveal) New() protoreflect.Message {
+	return new(fastReflection_MsgEscrowReveal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgEscrowReveal) Interface() protoreflect.ProtoMessage {
+	return (*MsgEscrowReveal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgEscrowReveal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Player != "" {
+		value := protoreflect.ValueOfString(x.Player)
+		if !f(fd_MsgEscrowReveal_player, value) {
+			return
+		}
+	}
+	if x.GameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GameId)
+		if !f(fd_MsgEscrowReveal_game_id, value) {
+			return
+		}
+	}
+	if len(x.EncryptedReveal) != 0 {
+		value := protoreflect.ValueOfBytes(x.EncryptedReveal)
+		if !f(fd_MsgEscrowReveal_encrypted_reveal, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgEscrowReveal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgEscrowReveal.player":
+		return x.Player != ""
+	case "facundomedica.rps.v1.MsgEscrowReveal.game_id":
+		return x.GameId != uint64(0)
+	case "facundomedica.rps.v1.MsgEscrowReveal.encrypted_reveal":
+		return len(x.EncryptedReveal) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgEscrowReveal"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgEscrowReveal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEscrowReveal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgEscrowReveal.player":
+		x.Player = ""
+	case "facundomedica.rps.v1.MsgEscrowReveal.game_id":
+		x.GameId = uint64(0)
+	case "facundomedica.rps.v1.MsgEscrowReveal.encrypted_reveal":
+		x.EncryptedReveal = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgEscrowReveal"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgEscrowReveal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgEscrowReveal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.MsgEscrowReveal.player":
+		value := x.Player
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.MsgEscrowReveal.game_id":
+		value := x.GameId
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.MsgEscrowReveal.encrypted_reveal":
+		value := x.EncryptedReveal
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgEscrowReveal"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgEscrowReveal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEscrowReveal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgEscrowReveal.player":
+		x.Player = value.Interface().(string)
+	case "facundomedica.rps.v1.MsgEscrowReveal.game_id":
+		x.GameId = value.Uint()
+	case "facundomedica.rps.v1.MsgEscrowReveal.encrypted_reveal":
+		x.EncryptedReveal = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgEscrowReveal"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgEscrowReveal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEscrowReveal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgEscrowReveal.player":
+		panic(fmt.Errorf("field player of message facundomedica.rps.v1.MsgEscrowReveal is not mutable"))
+	case "facundomedica.rps.v1.MsgEscrowReveal.game_id":
+		panic(fmt.Errorf("field game_id of message facundomedica.rps.v1.MsgEscrowReveal is not mutable"))
+	case "facundomedica.rps.v1.MsgEscrowReveal.encrypted_reveal":
+		panic(fmt.Errorf("field encrypted_reveal of message facundomedica.rps.v1.MsgEscrowReveal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgEscrowReveal"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgEscrowReveal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgEscrowReveal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgEscrowReveal.player":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.MsgEscrowReveal.game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.MsgEscrowReveal.encrypted_reveal":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgEscrowReveal"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgEscrowReveal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgEscrowReveal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgEscrowReveal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgEscrowReveal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEscrowReveal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgEscrowReveal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgEscrowReveal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgEscrowReveal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Player)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GameId))
+		}
+		l = len(x.EncryptedReveal)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgEscrowReveal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EncryptedReveal) > 0 {
+			i -= len(x.EncryptedReveal)
+			copy(dAtA[i:], x.EncryptedReveal)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EncryptedReveal)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.GameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GameId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Player) > 0 {
+			i -= len(x.Player)
+			copy(dAtA[i:], x.Player)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Player)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgEscrowReveal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEscrowReveal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEscrowReveal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Player = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+				}
+				x.GameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EncryptedReveal", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EncryptedReveal = append(x.EncryptedReveal[:0], dAtA[iNdEx:postIndex]...)
+				if x.EncryptedReveal == nil {
+					x.EncryptedReveal = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgEscrowRevealResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgEscrowRevealResponse = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgEscrowRevealResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgEscrowRevealResponse)(nil)
+
+type fastReflection_MsgEscrowRevealResponse MsgEscrowRevealResponse
+
+func (x *MsgEscrowRevealResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgEscrowRevealResponse)(x)
+}
+
+func (x *MsgEscrowRevealResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgEscrowRevealResponse_messageType fastReflection_MsgEscrowRevealResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgEscrowRevealResponse_messageType{}
+
+type fastReflection_MsgEscrowRevealResponse_messageType struct{}
+
+func (x fastReflection_MsgEscrowRevealResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgEscrowRevealResponse)(nil)
+}
+func (x fastReflection_MsgEscrowRevealResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgEscrowRevealResponse)
+}
+func (x fastReflection_MsgEscrowRevealResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgEscrowRevealResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgEscrowRevealResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgEscrowRevealResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgEscrowRevealResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgEscrowRevealResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgEscrowRevealResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgEscrowRevealResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgEscrowRevealResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgEscrowRevealResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgEscrowRevealResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgEscrowRevealResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgEscrowRevealResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgEscrowRevealResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEscrowRevealResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgEscrowRevealResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgEscrowRevealResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgEscrowRevealResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgEscrowRevealResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgEscrowRevealResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEscrowRevealResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgEscrowRevealResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgEscrowRevealResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEscrowRevealResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgEscrowRevealResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgEscrowRevealResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgEscrowRevealResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgEscrowRevealResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgEscrowRevealResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgEscrowRevealResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgEscrowRevealResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgEscrowRevealResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEscrowRevealResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgEscrowRevealResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgEscrowRevealResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgEscrowRevealResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgEscrowRevealResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgEscrowRevealResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEscrowRevealResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEscrowRevealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAgentRevealMove         protoreflect.MessageDescriptor
+	fd_MsgAgentRevealMove_agent   protoreflect.FieldDescriptor
+	fd_MsgAgentRevealMove_player  protoreflect.FieldDescriptor
+	fd_MsgAgentRevealMove_game_id protoreflect.FieldDescriptor
+	fd_MsgAgentRevealMove_move    protoreflect.FieldDescriptor
+	fd_MsgAgentRevealMove_salt    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgAgentRevealMove = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgAgentRevealMove")
+	fd_MsgAgentRevealMove_agent = md_MsgAgentRevealMove.Fields().ByName("agent")
+	fd_MsgAgentRevealMove_player = md_MsgAgentRevealMove.Fields().ByName("player")
+	fd_MsgAgentRevealMove_game_id = md_MsgAgentRevealMove.Fields().ByName("game_id")
+	fd_MsgAgentRevealMove_move = md_MsgAgentRevealMove.Fields().ByName("move")
+	fd_MsgAgentRevealMove_salt = md_MsgAgentRevealMove.Fields().ByName("salt")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAgentRevealMove)(nil)
+
+type fastReflection_MsgAgentRevealMove MsgAgentRevealMove
+
+func (x *MsgAgentRevealMove) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAgentRevealMove)(x)
+}
+
+func (x *MsgAgentRevealMove) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAgentRevealMove_messageType fastReflection_MsgAgentRevealMove_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAgentRevealMove_messageType{}
+
+type fastReflection_MsgAgentRevealMove_messageType struct{}
+
+func (x fastReflection_MsgAgentRevealMove_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAgentRevealMove)(nil)
+}
+func (x fastReflection_MsgAgentRevealMove_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAgentRevealMove)
+}
+func (x fastReflection_MsgAgentRevealMove_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAgentRevealMove
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAgentRevealMove) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAgentRevealMove
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAgentRevealMove) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAgentRevealMove_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAgentRevealMove) New() protoreflect.Message {
+	return new(fastReflection_MsgAgentRevealMove)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAgentRevealMove) Interface() protoreflect.ProtoMessage {
+	return (*MsgAgentRevealMove)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAgentRevealMove) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Agent != "" {
+		value := protoreflect.ValueOfString(x.Agent)
+		if !f(fd_MsgAgentRevealMove_agent, value) {
+			return
+		}
+	}
+	if x.Player != "" {
+		value := protoreflect.ValueOfString(x.Player)
+		if !f(fd_MsgAgentRevealMove_player, value) {
+			return
+		}
+	}
+	if x.GameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GameId)
+		if !f(fd_MsgAgentRevealMove_game_id, value) {
+			return
+		}
+	}
+	if x.Move != "" {
+		value := protoreflect.ValueOfString(x.Move)
+		if !f(fd_MsgAgentRevealMove_move, value) {
+			return
+		}
+	}
+	if len(x.Salt) != 0 {
+		value := protoreflect.ValueOfBytes(x.Salt)
+		if !f(fd_MsgAgentRevealMove_salt, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAgentRevealMove) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgAgentRevealMove.agent":
+		return x.Agent != ""
+	case "facundomedica.rps.v1.MsgAgentRevealMove.player":
+		return x.Player != ""
+	case "facundomedica.rps.v1.MsgAgentRevealMove.game_id":
+		return x.GameId != uint64(0)
+	case "facundomedica.rps.v1.MsgAgentRevealMove.move":
+		return x.Move != ""
+	case "facundomedica.rps.v1.MsgAgentRevealMove.salt":
+		return len(x.Salt) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgAgentRevealMove"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgAgentRevealMove does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAgentRevealMove) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgAgentRevealMove.agent":
+		x.Agent = ""
+	case "facundomedica.rps.v1.MsgAgentRevealMove.player":
+		x.Player = ""
+	case "facundomedica.rps.v1.MsgAgentRevealMove.game_id":
+		x.GameId = uint64(0)
+	case "facundomedica.rps.v1.MsgAgentRevealMove.move":
+		x.Move = ""
+	case "facundomedica.rps.v1.MsgAgentRevealMove.salt":
+		x.Salt = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgAgentRevealMove"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgAgentRevealMove does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAgentRevealMove) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.MsgAgentRevealMove.agent":
+		value := x.Agent
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.MsgAgentRevealMove.player":
+		value := x.Player
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.MsgAgentRevealMove.game_id":
+		value := x.GameId
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.MsgAgentRevealMove.move":
+		value := x.Move
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.MsgAgentRevealMove.salt":
+		value := x.Salt
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgAgentRevealMove"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgAgentRevealMove does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAgentRevealMove) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgAgentRevealMove.agent":
+		x.Agent = value.Interface().(string)
+	case "facundomedica.rps.v1.MsgAgentRevealMove.player":
+		x.Player = value.Interface().(string)
+	case "facundomedica.rps.v1.MsgAgentRevealMove.game_id":
+		x.GameId = value.Uint()
+	case "facundomedica.rps.v1.MsgAgentRevealMove.move":
+		x.Move = value.Interface().(string)
+	case "facundomedica.rps.v1.MsgAgentRevealMove.salt":
+		x.Salt = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgAgentRevealMove"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgAgentRevealMove does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAgentRevealMove) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgAgentRevealMove.agent":
+		panic(fmt.Errorf("field agent of message facundomedica.rps.v1.MsgAgentRevealMove is not mutable"))
+	case "facundomedica.rps.v1.MsgAgentRevealMove.player":
+		panic(fmt.Errorf("field player of message facundomedica.rps.v1.MsgAgentRevealMove is not mutable"))
+	case "facundomedica.rps.v1.MsgAgentRevealMove.game_id":
+		panic(fmt.Errorf("field game_id of message facundomedica.rps.v1.MsgAgentRevealMove is not mutable"))
+	case "facundomedica.rps.v1.MsgAgentRevealMove.move":
+		panic(fmt.Errorf("field move of message facundomedica.rps.v1.MsgAgentRevealMove is not mutable"))
+	case "facundomedica.rps.v1.MsgAgentRevealMove.salt":
+		panic(fmt.Errorf("field salt of message facundomedica.rps.v1.MsgAgentRevealMove is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgAgentRevealMove"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgAgentRevealMove does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAgentRevealMove) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgAgentRevealMove.agent":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.MsgAgentRevealMove.player":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.MsgAgentRevealMove.game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.MsgAgentRevealMove.move":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.MsgAgentRevealMove.salt":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgAgentRevealMove"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgAgentRevealMove does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAgentRevealMove) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgAgentRevealMove", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAgentRevealMove) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAgentRevealMove) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAgentRevealMove) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAgentRevealMove) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAgentRevealMove)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Agent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Player)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GameId))
+		}
+		l = len(x.Move)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Salt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAgentRevealMove)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Salt) > 0 {
+			i -= len(x.Salt)
+			copy(dAtA[i:], x.Salt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Salt)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Move) > 0 {
+			i -= len(x.Move)
+			copy(dAtA[i:], x.Move)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Move)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.GameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GameId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Player) > 0 {
+			i -= len(x.Player)
+			copy(dAtA[i:], x.Player)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Player)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Agent) > 0 {
+			i -= len(x.Agent)
+			copy(dAtA[i:], x.Agent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Agent)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAgentRevealMove)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAgentRevealMove: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAgentRevealMove: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Agent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Player = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+				}
+				x.GameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Move = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Salt = append(x.Salt[:0], dAtA[iNdEx:postIndex]...)
+				if x.Salt == nil {
+					x.Salt = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAgentRevealMoveResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgAgentRevealMoveResponse = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgAgentRevealMoveResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAgentRevealMoveResponse)(nil)
+
+type fastReflection_MsgAgentRevealMoveResponse MsgAgentRevealMoveResponse
+
+func (x *MsgAgentRevealMoveResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAgentRevealMoveResponse)(x)
+}
+
+func (x *MsgAgentRevealMoveResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAgentRevealMoveResponse_messageType fastReflection_MsgAgentRevealMoveResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAgentRevealMoveResponse_messageType{}
+
+type fastReflection_MsgAgentRevealMoveResponse_messageType struct{}
+
+func (x fastReflection_MsgAgentRevealMoveResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAgentRevealMoveResponse)(nil)
+}
+func (x fastReflection_MsgAgentRevealMoveResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAgentRevealMoveResponse)
+}
+func (x fastReflection_MsgAgentRevealMoveResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAgentRevealMoveResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAgentRevealMoveResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAgentRevealMoveResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAgentRevealMoveResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAgentRevealMoveResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAgentRevealMoveResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgAgentRevealMoveResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAgentRevealMoveResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgAgentRevealMoveResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAgentRevealMoveResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAgentRevealMoveResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgAgentRevealMoveResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgAgentRevealMoveResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAgentRevealMoveResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgAgentRevealMoveResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgAgentRevealMoveResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAgentRevealMoveResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgAgentRevealMoveResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgAgentRevealMoveResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAgentRevealMoveResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgAgentRevealMoveResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgAgentRevealMoveResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAgentRevealMoveResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgAgentRevealMoveResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgAgentRevealMoveResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAgentRevealMoveResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgAgentRevealMoveResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgAgentRevealMoveResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAgentRevealMoveResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgAgentRevealMoveResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAgentRevealMoveResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAgentRevealMoveResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAgentRevealMoveResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAgentRevealMoveResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAgentRevealMoveResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAgentRevealMoveResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAgentRevealMoveResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAgentRevealMoveResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAgentRevealMoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgJoinQueue                   protoreflect.MessageDescriptor
+	fd_MsgJoinQueue_player            protoreflect.FieldDescriptor
+	fd_MsgJoinQueue_commit            protoreflect.FieldDescriptor
+	fd_MsgJoinQueue_entry_fee         protoreflect.FieldDescriptor
+	fd_MsgJoinQueue_min_entry_fee     protoreflect.FieldDescriptor
+	fd_MsgJoinQueue_commitment_scheme protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgJoinQueue = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgJoinQueue")
+	fd_MsgJoinQueue_player = md_MsgJoinQueue.Fields().ByName("player")
+	fd_MsgJoinQueue_commit = md_MsgJoinQueue.Fields().ByName("commit")
+	fd_MsgJoinQueue_entry_fee = md_MsgJoinQueue.Fields().ByName("entry_fee")
+	fd_MsgJoinQueue_min_entry_fee = md_MsgJoinQueue.Fields().ByName("min_entry_fee")
+	fd_MsgJoinQueue_commitment_scheme = md_MsgJoinQueue.Fields().ByName("commitment_scheme")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgJoinQueue)(nil)
+
+type fastReflection_MsgJoinQueue MsgJoinQueue
+
+func (x *MsgJoinQueue) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgJoinQueue)(x)
+}
+
+func (x *MsgJoinQueue) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgJoinQueue_messageType fastReflection_MsgJoinQueue_messageType
+var _ protoreflect.MessageType = fastReflection_MsgJoinQueue_messageType{}
+
+type fastReflection_MsgJoinQueue_messageType struct{}
+
+func (x fastReflection_MsgJoinQueue_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgJoinQueue)(nil)
+}
+func (x fastReflection_MsgJoinQueue_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgJoinQueue)
+}
+func (x fastReflection_MsgJoinQueue_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgJoinQueue
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgJoinQueue) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgJoinQueue
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgJoinQueue) Type() protoreflect.MessageType {
+	return _fastReflection_MsgJoinQueue_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgJoinQueue) New() protoreflect.Message {
+	return new(fastReflection_MsgJoinQueue)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgJoinQueue) Interface() protoreflect.ProtoMessage {
+	return (*MsgJoinQueue)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgJoinQueue) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Player != "" {
+		value := protoreflect.ValueOfString(x.Player)
+		if !f(fd_MsgJoinQueue_player, value) {
+			return
+		}
+	}
+	if len(x.Commit) != 0 {
+		value := protoreflect.ValueOfBytes(x.Commit)
+		if !f(fd_MsgJoinQueue_commit, value) {
+			return
+		}
+	}
+	if x.EntryFee != nil {
+		value := protoreflect.ValueOfMessage(x.EntryFee.ProtoReflect())
+		if !f(fd_MsgJoinQueue_entry_fee, value) {
+			return
+		}
+	}
+	if x.MinEntryFee != nil {
+		value := protoreflect.ValueOfMessage(x.MinEntryFee.ProtoReflect())
+		if !f(fd_MsgJoinQueue_min_entry_fee, value) {
+			return
+		}
+	}
+	if x.CommitmentScheme != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.CommitmentScheme))
+		if !f(fd_MsgJoinQueue_commitment_scheme, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgJoinQueue) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgJoinQueue.player":
+		return x.Player != ""
+	case "facundomedica.rps.v1.MsgJoinQueue.commit":
+		return len(x.Commit) != 0
+	case "facundomedica.rps.v1.MsgJoinQueue.entry_fee":
+		return x.EntryFee != nil
+	case "facundomedica.rps.v1.MsgJoinQueue.min_entry_fee":
+		return x.MinEntryFee != nil
+	case "facundomedica.rps.v1.MsgJoinQueue.commitment_scheme":
+		return x.CommitmentScheme != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgJoinQueue"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgJoinQueue does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgJoinQueue) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgJoinQueue.player":
+		x.Player = ""
+	case "facundomedica.rps.v1.MsgJoinQueue.commit":
+		x.Commit = nil
+	case "facundomedica.rps.v1.MsgJoinQueue.entry_fee":
+		x.EntryFee = nil
+	case "facundomedica.rps.v1.MsgJoinQueue.min_entry_fee":
+		x.MinEntryFee = nil
+	case "facundomedica.rps.v1.MsgJoinQueue.commitment_scheme":
+		x.CommitmentScheme = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgJoinQueue"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgJoinQueue does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgJoinQueue) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.MsgJoinQueue.player":
+		value := x.Player
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.MsgJoinQueue.commit":
+		value := x.Commit
+		return protoreflect.ValueOfBytes(value)
+	case "facundomedica.rps.v1.MsgJoinQueue.entry_fee":
+		value := x.EntryFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "facundomedica.rps.v1.MsgJoinQueue.min_entry_fee":
+		value := x.MinEntryFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "facundomedica.rps.v1.MsgJoinQueue.commitment_scheme":
+		value := x.CommitmentScheme
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgJoinQueue"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgJoinQueue does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgJoinQueue) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgJoinQueue.player":
+		x.Player = value.Interface().(string)
+	case "facundomedica.rps.v1.MsgJoinQueue.commit":
+		x.Commit = value.Bytes()
+	case "facundomedica.rps.v1.MsgJoinQueue.entry_fee":
+		x.EntryFee = value.Message().Interface().(*v1beta1.Coin)
+	case "facundomedica.rps.v1.MsgJoinQueue.min_entry_fee":
+		x.MinEntryFee = value.Message().Interface().(*v1beta1.Coin)
+	case "facundomedica.rps.v1.MsgJoinQueue.commitment_scheme":
+		x.CommitmentScheme = (CommitmentScheme)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgJoinQueue"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgJoinQueue does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgJoinQueue) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgJoinQueue.entry_fee":
+		if x.EntryFee == nil {
+			x.EntryFee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.EntryFee.ProtoReflect())
+	case "facundomedica.rps.v1.MsgJoinQueue.min_entry_fee":
+		if x.MinEntryFee == nil {
+			x.MinEntryFee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.MinEntryFee.ProtoReflect())
+	case "facundomedica.rps.v1.MsgJoinQueue.player":
+		panic(fmt.Errorf("field player of message facundomedica.rps.v1.MsgJoinQueue is not mutable"))
+	case "facundomedica.rps.v1.MsgJoinQueue.commit":
+		panic(fmt.Errorf("field commit of message facundomedica.rps.v1.MsgJoinQueue is not mutable"))
+	case "facundomedica.rps.v1.MsgJoinQueue.commitment_scheme":
+		panic(fmt.Errorf("field commitment_scheme of message facundomedica.rps.v1.MsgJoinQueue is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgJoinQueue"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgJoinQueue does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgJoinQueue) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgJoinQueue.player":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.MsgJoinQueue.commit":
+		return protoreflect.ValueOfBytes(nil)
+	case "facundomedica.rps.v1.MsgJoinQueue.entry_fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "facundomedica.rps.v1.MsgJoinQueue.min_entry_fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "facundomedica.rps.v1.MsgJoinQueue.commitment_scheme":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgJoinQueue"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgJoinQueue does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgJoinQueue) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgJoinQueue", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgJoinQueue) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgJoinQueue) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgJoinQueue) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgJoinQueue) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgJoinQueue)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Player)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Commit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EntryFee != nil {
+			l = options.Size(x.EntryFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinEntryFee != nil {
+			l = options.Size(x.MinEntryFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CommitmentScheme != 0 {
+			n += 1 + runtime.Sov(uint64(x.CommitmentScheme))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgJoinQueue)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CommitmentScheme != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommitmentScheme))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MinEntryFee != nil {
+			encoded, err := options.Marshal(x.MinEntryFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.EntryFee != nil {
+			encoded, err := options.Marshal(x.EntryFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Commit) > 0 {
+			i -= len(x.Commit)
+			copy(dAtA[i:], x.Commit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commit)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Player) > 0 {
+			i -= len(x.Player)
+			copy(dAtA[i:], x.Player)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Player)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgJoinQueue)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgJoinQueue: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgJoinQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Player = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Commit = append(x.Commit[:0], dAtA[iNdEx:postIndex]...)
+				if x.Commit == nil {
+					x.Commit = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EntryFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EntryFee == nil {
+					x.EntryFee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EntryFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinEntryFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MinEntryFee == nil {
+					x.MinEntryFee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinEntryFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitmentScheme", wireType)
+				}
+				x.CommitmentScheme = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CommitmentScheme |= CommitmentScheme(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgJoinQueueResponse          protoreflect.MessageDescriptor
+	fd_MsgJoinQueueResponse_matched  protoreflect.FieldDescriptor
+	fd_MsgJoinQueueResponse_game_id  protoreflect.FieldDescriptor
+	fd_MsgJoinQueueResponse_entry_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgJoinQueueResponse = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgJoinQueueResponse")
+	fd_MsgJoinQueueResponse_matched = md_MsgJoinQueueResponse.Fields().ByName("matched")
+	fd_MsgJoinQueueResponse_game_id = md_MsgJoinQueueResponse.Fields().ByName("game_id")
+	fd_MsgJoinQueueResponse_entry_id = md_MsgJoinQueueResponse.Fields().ByName("entry_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgJoinQueueResponse)(nil)
+
+type fastReflection_MsgJoinQueueResponse MsgJoinQueueResponse
+
+func (x *MsgJoinQueueResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgJoinQueueResponse)(x)
+}
+
+func (x *MsgJoinQueueResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgJoinQueueResponse_messageType fastReflection_MsgJoinQueueResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgJoinQueueResponse_messageType{}
+
+type fastReflection_MsgJoinQueueResponse_messageType struct{}
+
+func (x fastReflection_MsgJoinQueueResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgJoinQueueResponse)(nil)
+}
+func (x fastReflection_MsgJoinQueueResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgJoinQueueResponse)
+}
+func (x fastReflection_MsgJoinQueueResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgJoinQueueResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgJoinQueueResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgJoinQueueResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgJoinQueueResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgJoinQueueResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgJoinQueueResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgJoinQueueResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgJoinQueueResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgJoinQueueResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgJoinQueueResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Matched != false {
+		value := protoreflect.ValueOfBool(x.Matched)
+		if !f(fd_MsgJoinQueueResponse_matched, value) {
+			return
+		}
+	}
+	if x.GameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GameId)
+		if !f(fd_MsgJoinQueueResponse_game_id, value) {
+			return
+		}
+	}
+	if x.EntryId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EntryId)
+		if !f(fd_MsgJoinQueueResponse_entry_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgJoinQueueResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgJoinQueueResponse.matched":
+		return x.Matched != false
+	case "facundomedica.rps.v1.MsgJoinQueueResponse.game_id":
+		return x.GameId != uint64(0)
+	case "facundomedica.rps.v1.MsgJoinQueueResponse.entry_id":
+		return x.EntryId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgJoinQueueResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgJoinQueueResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgJoinQueueResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgJoinQueueResponse.matched":
+		x.Matched = false
+	case "facundomedica.rps.v1.MsgJoinQueueResponse.game_id":
+		x.GameId = uint64(0)
+	case "facundomedica.rps.v1.MsgJoinQueueResponse.entry_id":
+		x.EntryId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgJoinQueueResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgJoinQueueResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgJoinQueueResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.MsgJoinQueueResponse.matched":
+		value := x.Matched
+		return protoreflect.ValueOfBool(value)
+	case "facundomedica.rps.v1.MsgJoinQueueResponse.game_id":
+		value := x.GameId
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.MsgJoinQueueResponse.entry_id":
+		value := x.EntryId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgJoinQueueResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgJoinQueueResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgJoinQueueResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgJoinQueueResponse.matched":
+		x.Matched = value.Bool()
+	case "facundomedica.rps.v1.MsgJoinQueueResponse.game_id":
+		x.GameId = value.Uint()
+	case "facundomedica.rps.v1.MsgJoinQueueResponse.entry_id":
+		x.EntryId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgJoinQueueResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgJoinQueueResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgJoinQueueResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgJoinQueueResponse.matched":
+		panic(fmt.Errorf("field matched of message facundomedica.rps.v1.MsgJoinQueueResponse is not mutable"))
+	case "facundomedica.rps.v1.MsgJoinQueueResponse.game_id":
+		panic(fmt.Errorf("field game_id of message facundomedica.rps.v1.MsgJoinQueueResponse is not mutable"))
+	case "facundomedica.rps.v1.MsgJoinQueueResponse.entry_id":
+		panic(fmt.Errorf("field entry_id of message facundomedica.rps.v1.MsgJoinQueueResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgJoinQueueResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgJoinQueueResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgJoinQueueResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgJoinQueueResponse.matched":
+		return protoreflect.ValueOfBool(false)
+	case "facundomedica.rps.v1.MsgJoinQueueResponse.game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.MsgJoinQueueResponse.entry_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgJoinQueueResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgJoinQueueResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgJoinQueueResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgJoinQueueResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgJoinQueueResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgJoinQueueResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgJoinQueueResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgJoinQueueResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgJoinQueueResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Matched {
+			n += 2
+		}
+		if x.GameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GameId))
+		}
+		if x.EntryId != 0 {
+			n += 1 + runtime.Sov(uint64(x.EntryId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgJoinQueueResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EntryId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EntryId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.GameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GameId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Matched {
+			i--
+			if x.Matched {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgJoinQueueResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgJoinQueueResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgJoinQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Matched", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Matched = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+				}
+				x.GameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EntryId", wireType)
+				}
+				x.EntryId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EntryId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgLeaveQueue          protoreflect.MessageDescriptor
+	fd_MsgLeaveQueue_player   protoreflect.FieldDescriptor
+	fd_MsgLeaveQueue_entry_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgLeaveQueue = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgLeaveQueue")
+	fd_MsgLeaveQueue_player = md_MsgLeaveQueue.Fields().ByName("player")
+	fd_MsgLeaveQueue_entry_id = md_MsgLeaveQueue.Fields().ByName("entry_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgLeaveQueue)(nil)
+
+type fastReflection_MsgLeaveQueue MsgLeaveQueue
+
+func (x *MsgLeaveQueue) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgLeaveQueue)(x)
+}
+
+func (x *MsgLeaveQueue) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgLeaveQueue_messageType fastReflection_MsgLeaveQueue_messageType
+var _ protoreflect.MessageType = fastReflection_MsgLeaveQueue_messageType{}
+
+type fastReflection_MsgLeaveQueue_messageType struct{}
+
+func (x fastReflection_MsgLeaveQueue_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgLeaveQueue)(nil)
+}
+func (x fastReflection_MsgLeaveQueue_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgLeaveQueue)
+}
+func (x fastReflection_MsgLeaveQueue_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgLeaveQueue
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgLeaveQueue) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgLeaveQueue
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgLeaveQueue) Type() protoreflect.MessageType {
+	return _fastReflection_MsgLeaveQueue_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgLeaveQueue) New() protoreflect.Message {
+	return new(fastReflection_MsgLeaveQueue)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgLeaveQueue) Interface() protoreflect.ProtoMessage {
+	return (*MsgLeaveQueue)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgLeaveQueue) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Player != "" {
+		value := protoreflect.ValueOfString(x.Player)
+		if !f(fd_MsgLeaveQueue_player, value) {
+			return
+		}
+	}
+	if x.EntryId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EntryId)
+		if !f(fd_MsgLeaveQueue_entry_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgLeaveQueue) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgLeaveQueue.player":
+		return x.Player != ""
+	case "facundomedica.rps.v1.MsgLeaveQueue.entry_id":
+		return x.EntryId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgLeaveQueue"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgLeaveQueue does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLeaveQueue) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgLeaveQueue.player":
+		x.Player = ""
+	case "facundomedica.rps.v1.MsgLeaveQueue.entry_id":
+		x.EntryId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgLeaveQueue"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgLeaveQueue does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgLeaveQueue) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.MsgLeaveQueue.player":
+		value := x.Player
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.MsgLeaveQueue.entry_id":
+		value := x.EntryId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgLeaveQueue"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgLeaveQueue does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLeaveQueue) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgLeaveQueue.player":
+		x.Player = value.Interface().(string)
+	case "facundomedica.rps.v1.MsgLeaveQueue.entry_id":
+		x.EntryId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgLeaveQueue"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgLeaveQueue does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLeaveQueue) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgLeaveQueue.player":
+		panic(fmt.Errorf("field player of message facundomedica.rps.v1.MsgLeaveQueue is not mutable"))
+	case "facundomedica.rps.v1.MsgLeaveQueue.entry_id":
+		panic(fmt.Errorf("field entry_id of message facundomedica.rps.v1.MsgLeaveQueue is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgLeaveQueue"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgLeaveQueue does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgLeaveQueue) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgLeaveQueue.player":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.MsgLeaveQueue.entry_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgLeaveQueue"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgLeaveQueue does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgLeaveQueue) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgLeaveQueue", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgLeaveQueue) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLeaveQueue) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgLeaveQueue) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgLeaveQueue) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgLeaveQueue)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Player)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EntryId != 0 {
+			n += 1 + runtime.Sov(uint64(x.EntryId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgLeaveQueue)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EntryId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EntryId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Player) > 0 {
+			i -= len(x.Player)
+			copy(dAtA[i:], x.Player)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Player)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgLeaveQueue)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLeaveQueue: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLeaveQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Player = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EntryId", wireType)
+				}
+				x.EntryId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EntryId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgLeaveQueueResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgLeaveQueueResponse = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgLeaveQueueResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgLeaveQueueResponse)(nil)
+
+type fastReflection_MsgLeaveQueueResponse MsgLeaveQueueResponse
+
+func (x *MsgLeaveQueueResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgLeaveQueueResponse)(x)
+}
+
+func (x *MsgLeaveQueueResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgLeaveQueueResponse_messageType fastReflection_MsgLeaveQueueResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgLeaveQueueResponse_messageType{}
+
+type fastReflection_MsgLeaveQueueResponse_messageType struct{}
+
+func (x fastReflection_MsgLeaveQueueResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgLeaveQueueResponse)(nil)
+}
+func (x fastReflection_MsgLeaveQueueResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgLeaveQueueResponse)
+}
+func (x fastReflection_MsgLeaveQueueResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgLeaveQueueResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgLeaveQueueResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgLeaveQueueResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgLeaveQueueResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgLeaveQueueResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgLeaveQueueResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgLeaveQueueResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgLeaveQueueResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgLeaveQueueResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgLeaveQueueResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgLeaveQueueResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgLeaveQueueResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgLeaveQueueResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLeaveQueueResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgLeaveQueueResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgLeaveQueueResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgLeaveQueueResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgLeaveQueueResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgLeaveQueueResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLeaveQueueResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgLeaveQueueResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgLeaveQueueResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLeaveQueueResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgLeaveQueueResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgLeaveQueueResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgLeaveQueueResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgLeaveQueueResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgLeaveQueueResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgLeaveQueueResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgLeaveQueueResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgLeaveQueueResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLeaveQueueResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgLeaveQueueResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgLeaveQueueResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgLeaveQueueResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgLeaveQueueResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgLeaveQueueResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLeaveQueueResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLeaveQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgPlaceBet_4_list)(nil)
+
+type _MsgPlaceBet_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgPlaceBet_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgPlaceBet_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgPlaceBet_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgPlaceBet_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgPlaceBet_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgPlaceBet_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgPlaceBet_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgPlaceBet_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgPlaceBet         protoreflect.MessageDescriptor
+	fd_MsgPlaceBet_bettor  protoreflect.FieldDescriptor
+	fd_MsgPlaceBet_game_id protoreflect.FieldDescriptor
+	fd_MsgPlaceBet_outcome protoreflect.FieldDescriptor
+	fd_MsgPlaceBet_amount  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgPlaceBet = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgPlaceBet")
+	fd_MsgPlaceBet_bettor = md_MsgPlaceBet.Fields().ByName("bettor")
+	fd_MsgPlaceBet_game_id = md_MsgPlaceBet.Fields().ByName("game_id")
+	fd_MsgPlaceBet_outcome = md_MsgPlaceBet.Fields().ByName("outcome")
+	fd_MsgPlaceBet_amount = md_MsgPlaceBet.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgPlaceBet)(nil)
+
+type fastReflection_MsgPlaceBet MsgPlaceBet
+
+func (x *MsgPlaceBet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgPlaceBet)(x)
+}
+
+func (x *MsgPlaceBet) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgPlaceBet_messageType fastReflection_MsgPlaceBet_messageType
+var _ protoreflect.MessageType = fastReflection_MsgPlaceBet_messageType{}
+
+type fastReflection_MsgPlaceBet_messageType struct{}
+
+func (x fastReflection_MsgPlaceBet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgPlaceBet)(nil)
+}
+func (x fastReflection_MsgPlaceBet_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgPlaceBet)
+}
+func (x fastReflection_MsgPlaceBet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPlaceBet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgPlaceBet) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPlaceBet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgPlaceBet) Type() protoreflect.MessageType {
+	return _fastReflection_MsgPlaceBet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgPlaceBet) New() protoreflect.Message {
+	return new(fastReflection_MsgPlaceBet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgPlaceBet) Interface() protoreflect.ProtoMessage {
+	return (*MsgPlaceBet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgPlaceBet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Bettor != "" {
+		value := protoreflect.ValueOfString(x.Bettor)
+		if !f(fd_MsgPlaceBet_bettor, value) {
+			return
+		}
+	}
+	if x.GameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GameId)
+		if !f(fd_MsgPlaceBet_game_id, value) {
+			return
+		}
+	}
+	if x.Outcome != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Outcome))
+		if !f(fd_MsgPlaceBet_outcome, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_MsgPlaceBet_4_list{list: &x.Amount})
+		if !f(fd_MsgPlaceBet_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgPlaceBet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgPlaceBet.bettor":
+		return x.Bettor != ""
+	case "facundomedica.rps.v1.MsgPlaceBet.game_id":
+		return x.GameId != uint64(0)
+	case "facundomedica.rps.v1.MsgPlaceBet.outcome":
+		return x.Outcome != 0
+	case "facundomedica.rps.v1.MsgPlaceBet.amount":
+		return len(x.Amount) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlaceBet"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlaceBet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPlaceBet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgPlaceBet.bettor":
+		x.Bettor = ""
+	case "facundomedica.rps.v1.MsgPlaceBet.game_id":
+		x.GameId = uint64(0)
+	case "facundomedica.rps.v1.MsgPlaceBet.outcome":
+		x.Outcome = 0
+	case "facundomedica.rps.v1.MsgPlaceBet.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlaceBet"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlaceBet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgPlaceBet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.MsgPlaceBet.bettor":
+		value := x.Bettor
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.MsgPlaceBet.game_id":
+		value := x.GameId
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.MsgPlaceBet.outcome":
+		value := x.Outcome
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "facundomedica.rps.v1.MsgPlaceBet.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_MsgPlaceBet_4_list{})
+		}
+		listValue := &_MsgPlaceBet_4_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlaceBet"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlaceBet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPlaceBet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgPlaceBet.bettor":
+		x.Bettor = value.Interface().(string)
+	case "facundomedica.rps.v1.MsgPlaceBet.game_id":
+		x.GameId = value.Uint()
+	case "facundomedica.rps.v1.MsgPlaceBet.outcome":
+		x.Outcome = (BetOutcome)(value.Enum())
+	case "facundomedica.rps.v1.MsgPlaceBet.amount":
+		lv := value.List()
+		clv := lv.(*_MsgPlaceBet_4_list)
+		x.Amount = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlaceBet"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlaceBet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPlaceBet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgPlaceBet.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_MsgPlaceBet_4_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "facundomedica.rps.v1.MsgPlaceBet.bettor":
+		panic(fmt.Errorf("field bettor of message facundomedica.rps.v1.MsgPlaceBet is not mutable"))
+	case "facundomedica.rps.v1.MsgPlaceBet.game_id":
+		panic(fmt.Errorf("field game_id of message facundomedica.rps.v1.MsgPlaceBet is not mutable"))
+	case "facundomedica.rps.v1.MsgPlaceBet.outcome":
+		panic(fmt.Errorf("field outcome of message facundomedica.rps.v1.MsgPlaceBet is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlaceBet"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlaceBet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgPlaceBet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgPlaceBet.bettor":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.MsgPlaceBet.game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.MsgPlaceBet.outcome":
+		return protoreflect.ValueOfEnum(0)
+	case "facundomedica.rps.v1.MsgPlaceBet.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgPlaceBet_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlaceBet"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlaceBet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgPlaceBet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgPlaceBet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgPlaceBet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPlaceBet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgPlaceBet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgPlaceBet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgPlaceBet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Bettor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GameId))
+		}
+		if x.Outcome != 0 {
+			n += 1 + runtime.Sov(uint64(x.Outcome))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPlaceBet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Outcome != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Outcome))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.GameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GameId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Bettor) > 0 {
+			i -= len(x.Bettor)
+			copy(dAtA[i:], x.Bettor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bettor)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPlaceBet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPlaceBet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPlaceBet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bettor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bettor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+				}
+				x.GameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+				}
+				x.Outcome = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Outcome |= BetOutcome(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgPlaceBetResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgPlaceBetResponse = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgPlaceBetResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgPlaceBetResponse)(nil)
+
+type fastReflection_MsgPlaceBetResponse MsgPlaceBetResponse
+
+func (x *MsgPlaceBetResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgPlaceBetResponse)(x)
+}
+
+func (x *MsgPlaceBetResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgPlaceBetResponse_messageType fastReflection_MsgPlaceBetResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgPlaceBetResponse_messageType{}
+
+type fastReflection_MsgPlaceBetResponse_messageType struct{}
+
+func (x fastReflection_MsgPlaceBetResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgPlaceBetResponse)(nil)
+}
+func (x fastReflection_MsgPlaceBetResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgPlaceBetResponse)
+}
+func (x fastReflection_MsgPlaceBetResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPlaceBetResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgPlaceBetResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPlaceBetResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgPlaceBetResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgPlaceBetResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgPlaceBetResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgPlaceBetResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgPlaceBetResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgPlaceBetResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgPlaceBetResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgPlaceBetResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlaceBetResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlaceBetResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPlaceBetResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlaceBetResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlaceBetResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgPlaceBetResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlaceBetResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlaceBetResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPlaceBetResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlaceBetResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlaceBetResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPlaceBetResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlaceBetResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlaceBetResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgPlaceBetResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlaceBetResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlaceBetResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgPlaceBetResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgPlaceBetResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgPlaceBetResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPlaceBetResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgPlaceBetResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgPlaceBetResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgPlaceBetResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPlaceBetResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPlaceBetResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPlaceBetResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPlaceBetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgPlayHouse_3_list)(nil)
+
+type _MsgPlayHouse_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgPlayHouse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgPlayHouse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgPlayHouse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgPlayHouse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgPlayHouse_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgPlayHouse_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgPlayHouse_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgPlayHouse_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgPlayHouse                   protoreflect.MessageDescriptor
+	fd_MsgPlayHouse_player            protoreflect.FieldDescriptor
+	fd_MsgPlayHouse_commit            protoreflect.FieldDescriptor
+	fd_MsgPlayHouse_entry_fee         protoreflect.FieldDescriptor
+	fd_MsgPlayHouse_commitment_scheme protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgPlayHouse = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgPlayHouse")
+	fd_MsgPlayHouse_player = md_MsgPlayHouse.Fields().ByName("player")
+	fd_MsgPlayHouse_commit = md_MsgPlayHouse.Fields().ByName("commit")
+	fd_MsgPlayHouse_entry_fee = md_MsgPlayHouse.Fields().ByName("entry_fee")
+	fd_MsgPlayHouse_commitment_scheme = md_MsgPlayHouse.Fields().ByName("commitment_scheme")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgPlayHouse)(nil)
+
+type fastReflection_MsgPlayHouse MsgPlayHouse
+
+func (x *MsgPlayHouse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgPlayHouse)(x)
+}
+
+func (x *MsgPlayHouse) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgPlayHouse_messageType fastReflection_MsgPlayHouse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgPlayHouse_messageType{}
+
+type fastReflection_MsgPlayHouse_messageType struct{}
+
+func (x fastReflection_MsgPlayHouse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgPlayHouse)(nil)
+}
+func (x fastReflection_MsgPlayHouse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgPlayHouse)
+}
+func (x fastReflection_MsgPlayHouse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPlayHouse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgPlayHouse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPlayHouse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgPlayHouse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgPlayHouse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgPlayHouse) New() protoreflect.Message {
+	return new(fastReflection_MsgPlayHouse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgPlayHouse) Interface() protoreflect.ProtoMessage {
+	return (*MsgPlayHouse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgPlayHouse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Player != "" {
+		value := protoreflect.ValueOfString(x.Player)
+		if !f(fd_MsgPlayHouse_player, value) {
+			return
+		}
+	}
+	if len(x.Commit) != 0 {
+		value := protoreflect.ValueOfBytes(x.Commit)
+		if !f(fd_MsgPlayHouse_commit, value) {
+			return
+		}
+	}
+	if len(x.EntryFee) != 0 {
+		value := protoreflect.ValueOfList(&_MsgPlayHouse_3_list{list: &x.EntryFee})
+		if !f(fd_MsgPlayHouse_entry_fee, value) {
+			return
+		}
+	}
+	if x.CommitmentScheme != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.CommitmentScheme))
+		if !f(fd_MsgPlayHouse_commitment_scheme, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgPlayHouse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgPlayHouse.player":
+		return x.Player != ""
+	case "facundomedica.rps.v1.MsgPlayHouse.commit":
+		return len(x.Commit) != 0
+	case "facundomedica.rps.v1.MsgPlayHouse.entry_fee":
+		return len(x.EntryFee) != 0
+	case "facundomedica.rps.v1.MsgPlayHouse.commitment_scheme":
+		return x.CommitmentScheme != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlayHouse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlayHouse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPlayHouse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgPlayHouse.player":
+		x.Player = ""
+	case "facundomedica.rps.v1.MsgPlayHouse.commit":
+		x.Commit = nil
+	case "facundomedica.rps.v1.MsgPlayHouse.entry_fee":
+		x.EntryFee = nil
+	case "facundomedica.rps.v1.MsgPlayHouse.commitment_scheme":
+		x.CommitmentScheme = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlayHouse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlayHouse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgPlayHouse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.MsgPlayHouse.player":
+		value := x.Player
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.MsgPlayHouse.commit":
+		value := x.Commit
+		return protoreflect.ValueOfBytes(value)
+	case "facundomedica.rps.v1.MsgPlayHouse.entry_fee":
+		if len(x.EntryFee) == 0 {
+			return protoreflect.ValueOfList(&_MsgPlayHouse_3_list{})
+		}
+		listValue := &_MsgPlayHouse_3_list{list: &x.EntryFee}
+		return protoreflect.ValueOfList(listValue)
+	case "facundomedica.rps.v1.MsgPlayHouse.commitment_scheme":
+		value := x.CommitmentScheme
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlayHouse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlayHouse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPlayHouse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgPlayHouse.player":
+		x.Player = value.Interface().(string)
+	case "facundomedica.rps.v1.MsgPlayHouse.commit":
+		x.Commit = value.Bytes()
+	case "facundomedica.rps.v1.MsgPlayHouse.entry_fee":
+		lv := value.List()
+		clv := lv.(*_MsgPlayHouse_3_list)
+		x.EntryFee = *clv.list
+	case "facundomedica.rps.v1.MsgPlayHouse.commitment_scheme":
+		x.CommitmentScheme = (CommitmentScheme)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlayHouse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlayHouse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPlayHouse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgPlayHouse.entry_fee":
+		if x.EntryFee == nil {
+			x.EntryFee = []*v1beta1.Coin{}
+		}
+		value := &_MsgPlayHouse_3_list{list: &x.EntryFee}
+		return protoreflect.ValueOfList(value)
+	case "facundomedica.rps.v1.MsgPlayHouse.player":
+		panic(fmt.Errorf("field player of message facundomedica.rps.v1.MsgPlayHouse is not mutable"))
+	case "facundomedica.rps.v1.MsgPlayHouse.commit":
+		panic(fmt.Errorf("field commit of message facundomedica.rps.v1.MsgPlayHouse is not mutable"))
+	case "facundomedica.rps.v1.MsgPlayHouse.commitment_scheme":
+		panic(fmt.Errorf("field commitment_scheme of message facundomedica.rps.v1.MsgPlayHouse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlayHouse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlayHouse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgPlayHouse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgPlayHouse.player":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.MsgPlayHouse.commit":
+		return protoreflect.ValueOfBytes(nil)
+	case "facundomedica.rps.v1.MsgPlayHouse.entry_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgPlayHouse_3_list{list: &list})
+	case "facundomedica.rps.v1.MsgPlayHouse.commitment_scheme":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlayHouse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlayHouse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgPlayHouse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgPlayHouse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgPlayHouse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPlayHouse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgPlayHouse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgPlayHouse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgPlayHouse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Player)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Commit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.EntryFee) > 0 {
+			for _, e := range x.EntryFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CommitmentScheme != 0 {
+			n += 1 + runtime.Sov(uint64(x.CommitmentScheme))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPlayHouse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CommitmentScheme != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommitmentScheme))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.EntryFee) > 0 {
+			for iNdEx := len(x.EntryFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EntryFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Commit) > 0 {
+			i -= len(x.Commit)
+			copy(dAtA[i:], x.Commit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commit)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Player) > 0 {
+			i -= len(x.Player)
+			copy(dAtA[i:], x.Player)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Player)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPlayHouse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPlayHouse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPlayHouse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Player = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Commit = append(x.Commit[:0], dAtA[iNdEx:postIndex]...)
+				if x.Commit == nil {
+					x.Commit = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EntryFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EntryFee = append(x.EntryFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EntryFee[len(x.EntryFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitmentScheme", wireType)
+				}
+				x.CommitmentScheme = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CommitmentScheme |= CommitmentScheme(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgPlayHouseResponse         protoreflect.MessageDescriptor
+	fd_MsgPlayHouseResponse_game_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgPlayHouseResponse = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgPlayHouseResponse")
+	fd_MsgPlayHouseResponse_game_id = md_MsgPlayHouseResponse.Fields().ByName("game_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgPlayHouseResponse)(nil)
+
+type fastReflection_MsgPlayHouseResponse MsgPlayHouseResponse
+
+func (x *MsgPlayHouseResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgPlayHouseResponse)(x)
+}
+
+func (x *MsgPlayHouseResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgPlayHouseResponse_messageType fastReflection_MsgPlayHouseResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgPlayHouseResponse_messageType{}
+
+type fastReflection_MsgPlayHouseResponse_messageType struct{}
+
+func (x fastReflection_MsgPlayHouseResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgPlayHouseResponse)(nil)
+}
+func (x fastReflection_MsgPlayHouseResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgPlayHouseResponse)
+}
+func (x fastReflection_MsgPlayHouseResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPlayHouseResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgPlayHouseResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPlayHouseResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgPlayHouseResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgPlayHouseResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgPlayHouseResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgPlayHouseResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgPlayHouseResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgPlayHouseResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgPlayHouseResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GameId)
+		if !f(fd_MsgPlayHouseResponse_game_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgPlayHouseResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgPlayHouseResponse.game_id":
+		return x.GameId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlayHouseResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlayHouseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPlayHouseResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgPlayHouseResponse.game_id":
+		x.GameId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlayHouseResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlayHouseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgPlayHouseResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.MsgPlayHouseResponse.game_id":
+		value := x.GameId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlayHouseResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlayHouseResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPlayHouseResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgPlayHouseResponse.game_id":
+		x.GameId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlayHouseResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlayHouseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPlayHouseResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgPlayHouseResponse.game_id":
+		panic(fmt.Errorf("field game_id of message facundomedica.rps.v1.MsgPlayHouseResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlayHouseResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlayHouseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgPlayHouseResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgPlayHouseResponse.game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgPlayHouseResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgPlayHouseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgPlayHouseResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgPlayHouseResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgPlayHouseResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPlayHouseResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgPlayHouseResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgPlayHouseResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgPlayHouseResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.GameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GameId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPlayHouseResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GameId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPlayHouseResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPlayHouseResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPlayHouseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+				}
+				x.GameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgFundHouse_2_list)(nil)
+
+type _MsgFundHouse_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgFundHouse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgFundHouse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgFundHouse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgFundHouse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgFundHouse_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgFundHouse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgFundHouse_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgFundHouse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgFundHouse           protoreflect.MessageDescriptor
+	fd_MsgFundHouse_authority protoreflect.FieldDescriptor
+	fd_MsgFundHouse_amount    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgFundHouse = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgFundHouse")
+	fd_MsgFundHouse_authority = md_MsgFundHouse.Fields().ByName("authority")
+	fd_MsgFundHouse_amount = md_MsgFundHouse.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgFundHouse)(nil)
+
+type fastReflection_MsgFundHouse MsgFundHouse
+
+func (x *MsgFundHouse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgFundHouse)(x)
+}
+
+func (x *MsgFundHouse) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgFundHouse_messageType fastReflection_MsgFundHouse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgFundHouse_messageType{}
+
+type fastReflection_MsgFundHouse_messageType struct{}
+
+func (x fastReflection_MsgFundHouse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgFundHouse)(nil)
+}
+func (x fastReflection_MsgFundHouse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgFundHouse)
+}
+func (x fastReflection_MsgFundHouse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFundHouse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgFundHouse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFundHouse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgFundHouse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgFundHouse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgFundHouse) New() protoreflect.Message {
+	return new(fastReflection_MsgFundHouse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgFundHouse) Interface() protoreflect.ProtoMessage {
+	return (*MsgFundHouse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgFundHouse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgFundHouse_authority, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_MsgFundHouse_2_list{list: &x.Amount})
+		if !f(fd_MsgFundHouse_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgFundHouse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgFundHouse.authority":
+		return x.Authority != ""
+	case "facundomedica.rps.v1.MsgFundHouse.amount":
+		return len(x.Amount) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgFundHouse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgFundHouse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFundHouse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgFundHouse.authority":
+		x.Authority = ""
+	case "facundomedica.rps.v1.MsgFundHouse.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgFundHouse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgFundHouse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgFundHouse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.MsgFundHouse.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.MsgFundHouse.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_MsgFundHouse_2_list{})
+		}
+		listValue := &_MsgFundHouse_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgFundHouse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgFundHouse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFundHouse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgFundHouse.authority":
+		x.Authority = value.Interface().(string)
+	case "facundomedica.rps.v1.MsgFundHouse.amount":
+		lv := value.List()
+		clv := lv.(*_MsgFundHouse_2_list)
+		x.Amount = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgFundHouse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgFundHouse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFundHouse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgFundHouse.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_MsgFundHouse_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "facundomedica.rps.v1.MsgFundHouse.authority":
+		panic(fmt.Errorf("field authority of message facundomedica.rps.v1.MsgFundHouse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgFundHouse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgFundHouse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgFundHouse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgFundHouse.authority":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.MsgFundHouse.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgFundHouse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgFundHouse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgFundHouse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgFundHouse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgFundHouse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgFundHouse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFundHouse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgFundHouse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgFundHouse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgFundHouse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFundHouse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFundHouse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFundHouse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFundHouse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgFundHouseResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgFundHouseResponse = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgFundHouseResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgFundHouseResponse)(nil)
+
+type fastReflection_MsgFundHouseResponse MsgFundHouseResponse
+
+func (x *MsgFundHouseResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgFundHouseResponse)(x)
+}
+
+func (x *MsgFundHouseResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgFundHouseResponse_messageType fastReflection_MsgFundHouseResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgFundHouseResponse_messageType{}
+
+type fastReflection_MsgFundHouseResponse_messageType struct{}
+
+func (x fastReflection_MsgFundHouseResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgFundHouseResponse)(nil)
+}
+func (x fastReflection_MsgFundHouseResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgFundHouseResponse)
+}
+func (x fastReflection_MsgFundHouseResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFundHouseResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgFundHouseResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFundHouseResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgFundHouseResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgFundHouseResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgFundHouseResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgFundHouseResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgFundHouseResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgFundHouseResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgFundHouseResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgFundHouseResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgFundHouseResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgFundHouseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFundHouseResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgFundHouseResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgFundHouseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgFundHouseResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgFundHouseResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgFundHouseResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFundHouseResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgFundHouseResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgFundHouseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFundHouseResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgFundHouseResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgFundHouseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgFundHouseResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgFundHouseResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgFundHouseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgFundHouseResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgFundHouseResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgFundHouseResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFundHouseResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgFundHouseResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgFundHouseResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgFundHouseResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFundHouseResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFundHouseResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFundHouseResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFundHouseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgOfferRematch                   protoreflect.MessageDescriptor
+	fd_MsgOfferRematch_player            protoreflect.FieldDescriptor
+	fd_MsgOfferRematch_game_id           protoreflect.FieldDescriptor
+	fd_MsgOfferRematch_commit            protoreflect.FieldDescriptor
+	fd_MsgOfferRematch_commitment_scheme protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgOfferRematch = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgOfferRematch")
+	fd_MsgOfferRematch_player = md_MsgOfferRematch.Fields().ByName("player")
+	fd_MsgOfferRematch_game_id = md_MsgOfferRematch.Fields().ByName("game_id")
+	fd_MsgOfferRematch_commit = md_MsgOfferRematch.Fields().ByName("commit")
+	fd_MsgOfferRematch_commitment_scheme = md_MsgOfferRematch.Fields().ByName("commitment_scheme")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgOfferRematch)(nil)
+
+type fastReflection_MsgOfferRematch MsgOfferRematch
+
+func (x *MsgOfferRematch) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgOfferRematch)(x)
+}
+
+func (x *MsgOfferRematch) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgOfferRematch_messageType fastReflection_MsgOfferRematch_messageType
+var _ protoreflect.MessageType = fastReflection_MsgOfferRematch_messageType{}
+
+type fastReflection_MsgOfferRematch_messageType struct{}
+
+func (x fastReflection_MsgOfferRematch_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgOfferRematch)(nil)
+}
+func (x fastReflection_MsgOfferRematch_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgOfferRematch)
+}
+func (x fastReflection_MsgOfferRematch_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgOfferRematch
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgOfferRematch) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgOfferRematch
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgOfferRematch) Type() protoreflect.MessageType {
+	return _fastReflection_MsgOfferRematch_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgOfferRematch) New() protoreflect.Message {
+	return new(fastReflection_MsgOfferRematch)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgOfferRematch) Interface() protoreflect.ProtoMessage {
+	return (*MsgOfferRematch)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgOfferRematch) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Player != "" {
+		value := protoreflect.ValueOfString(x.Player)
+		if !f(fd_MsgOfferRematch_player, value) {
+			return
+		}
+	}
+	if x.GameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GameId)
+		if !f(fd_MsgOfferRematch_game_id, value) {
+			return
+		}
+	}
+	if len(x.Commit) != 0 {
+		value := protoreflect.ValueOfBytes(x.Commit)
+		if !f(fd_MsgOfferRematch_commit, value) {
+			return
+		}
+	}
+	if x.CommitmentScheme != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.CommitmentScheme))
+		if !f(fd_MsgOfferRematch_commitment_scheme, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgOfferRematch) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgOfferRematch.player":
+		return x.Player != ""
+	case "facundomedica.rps.v1.MsgOfferRematch.game_id":
+		return x.GameId != uint64(0)
+	case "facundomedica.rps.v1.MsgOfferRematch.commit":
+		return len(x.Commit) != 0
+	case "facundomedica.rps.v1.MsgOfferRematch.commitment_scheme":
+		return x.CommitmentScheme != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgOfferRematch"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgOfferRematch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgOfferRematch) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgOfferRematch.player":
+		x.Player = ""
+	case "facundomedica.rps.v1.MsgOfferRematch.game_id":
+		x.GameId = uint64(0)
+	case "facundomedica.rps.v1.MsgOfferRematch.commit":
+		x.Commit = nil
+	case "facundomedica.rps.v1.MsgOfferRematch.commitment_scheme":
+		x.CommitmentScheme = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgOfferRematch"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgOfferRematch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgOfferRematch) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.MsgOfferRematch.player":
+		value := x.Player
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.MsgOfferRematch.game_id":
+		value := x.GameId
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.MsgOfferRematch.commit":
+		value := x.Commit
+		return protoreflect.ValueOfBytes(value)
+	case "facundomedica.rps.v1.MsgOfferRematch.commitment_scheme":
+		value := x.CommitmentScheme
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgOfferRematch"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgOfferRematch does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgOfferRematch) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgOfferRematch.player":
+		x.Player = value.Interface().(string)
+	case "facundomedica.rps.v1.MsgOfferRematch.game_id":
+		x.GameId = value.Uint()
+	case "facundomedica.rps.v1.MsgOfferRematch.commit":
+		x.Commit = value.Bytes()
+	case "facundomedica.rps.v1.MsgOfferRematch.commitment_scheme":
+		x.CommitmentScheme = (CommitmentScheme)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgOfferRematch"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgOfferRematch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgOfferRematch) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgOfferRematch.player":
+		panic(fmt.Errorf("field player of message facundomedica.rps.v1.MsgOfferRematch is not mutable"))
+	case "facundomedica.rps.v1.MsgOfferRematch.game_id":
+		panic(fmt.Errorf("field game_id of message facundomedica.rps.v1.MsgOfferRematch is not mutable"))
+	case "facundomedica.rps.v1.MsgOfferRematch.commit":
+		panic(fmt.Errorf("field commit of message facundomedica.rps.v1.MsgOfferRematch is not mutable"))
+	case "facundomedica.rps.v1.MsgOfferRematch.commitment_scheme":
+		panic(fmt.Errorf("field commitment_scheme of message facundomedica.rps.v1.MsgOfferRematch is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgOfferRematch"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgOfferRematch does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgOfferRematch) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgOfferRematch.player":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.MsgOfferRematch.game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.MsgOfferRematch.commit":
+		return protoreflect.ValueOfBytes(nil)
+	case "facundomedica.rps.v1.MsgOfferRematch.commitment_scheme":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgOfferRematch"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgOfferRematch does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgOfferRematch) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgOfferRematch", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgOfferRematch) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgOfferRematch) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgOfferRematch) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgOfferRematch) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgOfferRematch)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Player)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GameId))
+		}
+		l = len(x.Commit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CommitmentScheme != 0 {
+			n += 1 + runtime.Sov(uint64(x.CommitmentScheme))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgOfferRematch)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CommitmentScheme != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommitmentScheme))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Commit) > 0 {
+			i -= len(x.Commit)
+			copy(dAtA[i:], x.Commit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commit)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.GameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GameId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Player) > 0 {
+			i -= len(x.Player)
+			copy(dAtA[i:], x.Player)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Player)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgOfferRematch)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgOfferRematch: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgOfferRematch: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Player = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+				}
+				x.GameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Commit = append(x.Commit[:0], dAtA[iNdEx:postIndex]...)
+				if x.Commit == nil {
+					x.Commit = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitmentScheme", wireType)
+				}
+				x.CommitmentScheme = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CommitmentScheme |= CommitmentScheme(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgOfferRematchResponse         protoreflect.MessageDescriptor
+	fd_MsgOfferRematchResponse_game_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgOfferRematchResponse = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgOfferRematchResponse")
+	fd_MsgOfferRematchResponse_game_id = md_MsgOfferRematchResponse.Fields().ByName("game_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgOfferRematchResponse)(nil)
+
+type fastReflection_MsgOfferRematchResponse MsgOfferRematchResponse
+
+func (x *MsgOfferRematchResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgOfferRematchResponse)(x)
+}
+
+func (x *MsgOfferRematchResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgOfferRematchResponse_messageType fastReflection_MsgOfferRematchResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgOfferRematchResponse_messageType{}
+
+type fastReflection_MsgOfferRematchResponse_messageType struct{}
+
+func (x fastReflection_MsgOfferRematchResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgOfferRematchResponse)(nil)
+}
+func (x fastReflection_MsgOfferRematchResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgOfferRematchResponse)
+}
+func (x fastReflection_MsgOfferRematchResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgOfferRematchResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgOfferRematchResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgOfferRematchResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgOfferRematchResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgOfferRematchResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgOfferRematchResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgOfferRematchResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgOfferRematchResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgOfferRematchResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgOfferRematchResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GameId)
+		if !f(fd_MsgOfferRematchResponse_game_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgOfferRematchResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgOfferRematchResponse.game_id":
+		return x.GameId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgOfferRematchResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgOfferRematchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgOfferRematchResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgOfferRematchResponse.game_id":
+		x.GameId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgOfferRematchResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgOfferRematchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgOfferRematchResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.MsgOfferRematchResponse.game_id":
+		value := x.GameId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgOfferRematchResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgOfferRematchResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgOfferRematchResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgOfferRematchResponse.game_id":
+		x.GameId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgOfferRematchResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgOfferRematchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgOfferRematchResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgOfferRematchResponse.game_id":
+		panic(fmt.Errorf("field game_id of message facundomedica.rps.v1.MsgOfferRematchResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgOfferRematchResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgOfferRematchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgOfferRematchResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgOfferRematchResponse.game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgOfferRematchResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgOfferRematchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgOfferRematchResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgOfferRematchResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgOfferRematchResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgOfferRematchResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgOfferRematchResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgOfferRematchResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgOfferRematchResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.GameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GameId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgOfferRematchResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GameId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgOfferRematchResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgOfferRematchResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgOfferRematchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+				}
+				x.GameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateParams           protoreflect.MessageDescriptor
+	fd_MsgUpdateParams_authority protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_params    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgUpdateParams = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgUpdateParams")
+	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
+	fd_MsgUpdateParams_params = md_MsgUpdateParams.Fields().ByName("params")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
+
+type fastReflection_MsgUpdateParams MsgUpdateParams
+
+func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(x)
+}
+
+func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateParams_messageType fastReflection_MsgUpdateParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParams_messageType{}
+
+type fastReflection_MsgUpdateParams_messageType struct{}
+
+func (x fastReflection_MsgUpdateParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(nil)
+}
+func (x fastReflection_MsgUpdateParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
+}
+func (x fastReflection_MsgUpdateParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateParams) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateParams_authority, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_MsgUpdateParams_params, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgUpdateParams.authority":
+		return x.Authority != ""
+	case "facundomedica.rps.v1.MsgUpdateParams.params":
+		return x.Params != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgUpdateParams.authority":
+		x.Authority = ""
+	case "facundomedica.rps.v1.MsgUpdateParams.params":
+		x.Params = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.MsgUpdateParams.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.MsgUpdateParams.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgUpdateParams.authority":
+		x.Authority = value.Interface().(string)
+	case "facundomedica.rps.v1.MsgUpdateParams.params":
+		x.Params = value.Message().Interface().(*Params)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgUpdateParams.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "facundomedica.rps.v1.MsgUpdateParams.authority":
+		panic(fmt.Errorf("field authority of message facundomedica.rps.v1.MsgUpdateParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgUpdateParams.authority":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.MsgUpdateParams.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgUpdateParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateParamsResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgUpdateParamsResponse = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgUpdateParamsResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParamsResponse)(nil)
+
+type fastReflection_MsgUpdateParamsResponse MsgUpdateParamsResponse
+
+func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(x)
+}
+
+func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateParamsResponse_messageType fastReflection_MsgUpdateParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParamsResponse_messageType{}
+
+type fastReflection_MsgUpdateParamsResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(nil)
+}
+func (x fastReflection_MsgUpdateParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
+}
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParamsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParamsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParamsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgUpdateParamsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgResolveStuckGame           protoreflect.MessageDescriptor
+	fd_MsgResolveStuckGame_authority protoreflect.FieldDescriptor
+	fd_MsgResolveStuckGame_game_id   protoreflect.FieldDescriptor
+	fd_MsgResolveStuckGame_refund    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgResolveStuckGame = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgResolveStuckGame")
+	fd_MsgResolveStuckGame_authority = md_MsgResolveStuckGame.Fields().ByName("authority")
+	fd_MsgResolveStuckGame_game_id = md_MsgResolveStuckGame.Fields().ByName("game_id")
+	fd_MsgResolveStuckGame_refund = md_MsgResolveStuckGame.Fields().ByName("refund")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgResolveStuckGame)(nil)
+
+type fastReflection_MsgResolveStuckGame MsgResolveStuckGame
+
+func (x *MsgResolveStuckGame) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgResolveStuckGame)(x)
+}
+
+func (x *MsgResolveStuckGame) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgResolveStuckGame_messageType fastReflection_MsgResolveStuckGame_messageType
+var _ protoreflect.MessageType = fastReflection_MsgResolveStuckGame_messageType{}
+
+type fastReflection_MsgResolveStuckGame_messageType struct{}
+
+func (x fastReflection_MsgResolveStuckGame_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgResolveStuckGame)(nil)
+}
+func (x fastReflection_MsgResolveStuckGame_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgResolveStuckGame)
+}
+func (x fastReflection_MsgResolveStuckGame_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResolveStuckGame
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgResolveStuckGame) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResolveStuckGame
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgResolveStuckGame) Type() protoreflect.MessageType {
+	return _fastReflection_MsgResolveStuckGame_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgResolveStuckGame) New() protoreflect.Message {
+	return new(fastReflection_MsgResolveStuckGame)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgResolveStuckGame) Interface() protoreflect.ProtoMessage {
+	return (*MsgResolveStuckGame)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgResolveStuckGame) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgResolveStuckGame_authority, value) {
+			return
+		}
+	}
+	if x.GameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GameId)
+		if !f(fd_MsgResolveStuckGame_game_id, value) {
+			return
+		}
+	}
+	if x.Refund != false {
+		value := protoreflect.ValueOfBool(x.Refund)
+		if !f(fd_MsgResolveStuckGame_refund, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgResolveStuckGame) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgResolveStuckGame.authority":
+		return x.Authority != ""
+	case "facundomedica.rps.v1.MsgResolveStuckGame.game_id":
+		return x.GameId != uint64(0)
+	case "facundomedica.rps.v1.MsgResolveStuckGame.refund":
+		return x.Refund != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgResolveStuckGame"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgResolveStuckGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveStuckGame) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgResolveStuckGame.authority":
+		x.Authority = ""
+	case "facundomedica.rps.v1.MsgResolveStuckGame.game_id":
+		x.GameId = uint64(0)
+	case "facundomedica.rps.v1.MsgResolveStuckGame.refund":
+		x.Refund = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgResolveStuckGame"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgResolveStuckGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgResolveStuckGame) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.MsgResolveStuckGame.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.MsgResolveStuckGame.game_id":
+		value := x.GameId
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.MsgResolveStuckGame.refund":
+		value := x.Refund
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgResolveStuckGame"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgResolveStuckGame does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveStuckGame) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgResolveStuckGame.authority":
+		x.Authority = value.Interface().(string)
+	case "facundomedica.rps.v1.MsgResolveStuckGame.game_id":
+		x.GameId = value.Uint()
+	case "facundomedica.rps.v1.MsgResolveStuckGame.refund":
+		x.Refund = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgResolveStuckGame"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgResolveStuckGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveStuckGame) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgResolveStuckGame.authority":
+		panic(fmt.Errorf("field authority of message facundomedica.rps.v1.MsgResolveStuckGame is not mutable"))
+	case "facundomedica.rps.v1.MsgResolveStuckGame.game_id":
+		panic(fmt.Errorf("field game_id of message facundomedica.rps.v1.MsgResolveStuckGame is not mutable"))
+	case "facundomedica.rps.v1.MsgResolveStuckGame.refund":
+		panic(fmt.Errorf("field refund of message facundomedica.rps.v1.MsgResolveStuckGame is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgResolveStuckGame"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgResolveStuckGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgResolveStuckGame) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MsgResolveStuckGame.authority":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.MsgResolveStuckGame.game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.MsgResolveStuckGame.refund":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgResolveStuckGame"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgResolveStuckGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgResolveStuckGame) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgResolveStuckGame", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgResolveStuckGame) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveStuckGame) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgResolveStuckGame) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgResolveStuckGame) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgResolveStuckGame)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GameId))
+		}
+		if x.Refund {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgResolveStuckGame)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Refund {
+			i--
+			if x.Refund {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.GameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GameId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgResolveStuckGame)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResolveStuckGame: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResolveStuckGame: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+				}
+				x.GameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Refund = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgResolveStuckGameResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_tx_proto_init()
+	md_MsgResolveStuckGameResponse = File_facundomedica_rps_v1_tx_proto.Messages().ByName("MsgResolveStuckGameResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgResolveStuckGameResponse)(nil)
+
+type fastReflection_MsgResolveStuckGameResponse MsgResolveStuckGameResponse
+
+func (x *MsgResolveStuckGameResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgResolveStuckGameResponse)(x)
+}
+
+func (x *MsgResolveStuckGameResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgResolveStuckGameResponse_messageType fastReflection_MsgResolveStuckGameResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgResolveStuckGameResponse_messageType{}
+
+type fastReflection_MsgResolveStuckGameResponse_messageType struct{}
+
+func (x fastReflection_MsgResolveStuckGameResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgResolveStuckGameResponse)(nil)
+}
+func (x fastReflection_MsgResolveStuckGameResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgResolveStuckGameResponse)
+}
+func (x fastReflection_MsgResolveStuckGameResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResolveStuckGameResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgResolveStuckGameResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResolveStuckGameResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgResolveStuckGameResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgResolveStuckGameResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgResolveStuckGameResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgResolveStuckGameResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgResolveStuckGameResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgResolveStuckGameResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgResolveStuckGameResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgResolveStuckGameResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgResolveStuckGameResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgResolveStuckGameResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveStuckGameResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgResolveStuckGameResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgResolveStuckGameResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgResolveStuckGameResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgResolveStuckGameResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgResolveStuckGameResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveStuckGameResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgResolveStuckGameResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgResolveStuckGameResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveStuckGameResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgResolveStuckGameResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgResolveStuckGameResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgResolveStuckGameResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MsgResolveStuckGameResponse"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MsgResolveStuckGameResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgResolveStuckGameResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MsgResolveStuckGameResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgResolveStuckGameResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveStuckGameResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgResolveStuckGameResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgResolveStuckGameResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgResolveStuckGameResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgResolveStuckGameResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgResolveStuckGameResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResolveStuckGameResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResolveStuckGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: facundomedica/rps/v1/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MsgNewGame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// commit is the commitment to the first move, see MoveCommit.
+	Commit []byte `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	// entry_fee is the amount to put into stake for the game, it can be made of
+	// multiple denoms.
+	EntryFee []*v1beta1.Coin `protobuf:"bytes,3,rep,name=entry_fee,json=entryFee,proto3" json:"entry_fee,omitempty"`
+	// commit_timeout and reveal_timeout optionally override the module timeouts
+	// for this game, in seconds or blocks depending on the module timeout mode.
+	// Zero uses the module default.
+	CommitTimeout uint64 `protobuf:"varint,4,opt,name=commit_timeout,json=commitTimeout,proto3" json:"commit_timeout,omitempty"`
+	RevealTimeout uint64 `protobuf:"varint,5,opt,name=reveal_timeout,json=revealTimeout,proto3" json:"reveal_timeout,omitempty"`
+	// challenger_fee optionally sets a different stake for the challenger, so
+	// the creator can offer odds (e.g. staking 3 against 1). Empty means the
+	// challenger matches the entry_fee.
+	ChallengerFee []*v1beta1.Coin `protobuf:"bytes,6,rep,name=challenger_fee,json=challengerFee,proto3" json:"challenger_fee,omitempty"`
+	// commitment_scheme is the scheme commit was computed with, it must be
+	// enabled in the params. Unspecified means sha256.
+	CommitmentScheme CommitmentScheme `protobuf:"varint,7,opt,name=commitment_scheme,json=commitmentScheme,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"commitment_scheme,omitempty"`
+}
+
+func (x *MsgNewGame) Reset() {
+	*x = MsgNewGame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgNewGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgNewGame) ProtoMessage() {}
+
+// Deprecated: Use MsgNewGame.ProtoReflect.Descriptor instead.
+func (*MsgNewGame) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgNewGame) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *MsgNewGame) GetCommit() []byte {
+	if x != nil {
+		return x.Commit
+	}
+	return nil
+}
+
+func (x *MsgNewGame) GetEntryFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.EntryFee
+	}
+	return nil
+}
+
+func (x *MsgNewGame) GetCommitTimeout() uint64 {
+	if x != nil {
+		return x.CommitTimeout
+	}
+	return 0
+}
+
+func (x *MsgNewGame) GetRevealTimeout() uint64 {
+	if x != nil {
+		return x.RevealTimeout
+	}
+	return 0
+}
+
+func (x *MsgNewGame) GetChallengerFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.ChallengerFee
+	}
+	return nil
+}
+
+func (x *MsgNewGame) GetCommitmentScheme() CommitmentScheme {
+	if x != nil {
+		return x.CommitmentScheme
+	}
+	return CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED
+}
+
+type MsgNewGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// game_id is the ID of the created game.
+	GameId uint64 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *MsgNewGameResponse) Reset() {
+	*x = MsgNewGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgNewGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgNewGameResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgNewGameResponse.ProtoReflect.Descriptor instead.
+func (*MsgNewGameResponse) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MsgNewGameResponse) GetGameId() uint64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type MsgCreateAndJoin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// creator_commit is the commitment to the creator's move.
+	CreatorCommit []byte `protobuf:"bytes,2,opt,name=creator_commit,json=creatorCommit,proto3" json:"creator_commit,omitempty"`
+	Challenger    string `protobuf:"bytes,3,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	// challenger_commit is the commitment to the challenger's move.
+	ChallengerCommit []byte `protobuf:"bytes,4,opt,name=challenger_commit,json=challengerCommit,proto3" json:"challenger_commit,omitempty"`
+	// entry_fee is the creator's stake.
+	EntryFee []*v1beta1.Coin `protobuf:"bytes,5,rep,name=entry_fee,json=entryFee,proto3" json:"entry_fee,omitempty"`
+	// challenger_fee is the challenger's stake, empty means it matches the
+	// entry_fee.
+	ChallengerFee []*v1beta1.Coin `protobuf:"bytes,6,rep,name=challenger_fee,json=challengerFee,proto3" json:"challenger_fee,omitempty"`
+	// reveal_timeout optionally overrides the module reveal timeout, as in
+	// MsgNewGame. The game starts full, so there's no commit timeout.
+	RevealTimeout uint64 `protobuf:"varint,7,opt,name=reveal_timeout,json=revealTimeout,proto3" json:"reveal_timeout,omitempty"`
+	// creator_commitment_scheme and challenger_commitment_scheme are the
+	// schemes each commitment was computed with, see MsgNewGame.
+	CreatorCommitmentScheme    CommitmentScheme `protobuf:"varint,8,opt,name=creator_commitment_scheme,json=creatorCommitmentScheme,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"creator_commitment_scheme,omitempty"`
+	ChallengerCommitmentScheme CommitmentScheme `protobuf:"varint,9,opt,name=challenger_commitment_scheme,json=challengerCommitmentScheme,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"challenger_commitment_scheme,omitempty"`
+}
+
+func (x *MsgCreateAndJoin) Reset() {
+	*x = MsgCreateAndJoin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateAndJoin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateAndJoin) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateAndJoin.ProtoReflect.Descriptor instead.
+func (*MsgCreateAndJoin) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgCreateAndJoin) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgCreateAndJoin) GetCreatorCommit() []byte {
+	if x != nil {
+		return x.CreatorCommit
+	}
+	return nil
+}
+
+func (x *MsgCreateAndJoin) GetChallenger() string {
+	if x != nil {
+		return x.Challenger
+	}
+	return ""
+}
+
+func (x *MsgCreateAndJoin) GetChallengerCommit() []byte {
+	if x != nil {
+		return x.ChallengerCommit
+	}
+	return nil
+}
+
+func (x *MsgCreateAndJoin) GetEntryFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.EntryFee
+	}
+	return nil
+}
+
+func (x *MsgCreateAndJoin) GetChallengerFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.ChallengerFee
+	}
+	return nil
+}
+
+func (x *MsgCreateAndJoin) GetRevealTimeout() uint64 {
+	if x != nil {
+		return x.RevealTimeout
+	}
+	return 0
+}
+
+func (x *MsgCreateAndJoin) GetCreatorCommitmentScheme() CommitmentScheme {
+	if x != nil {
+		return x.CreatorCommitmentScheme
+	}
+	return CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED
+}
+
+func (x *MsgCreateAndJoin) GetChallengerCommitmentScheme() CommitmentScheme {
+	if x != nil {
+		return x.ChallengerCommitmentScheme
+	}
+	return CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED
+}
+
+type MsgCreateAndJoinResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// game_id is the ID of the created game.
+	GameId uint64 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *MsgCreateAndJoinResponse) Reset() {
+	*x = MsgCreateAndJoinResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateAndJoinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateAndJoinResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateAndJoinResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateAndJoinResponse) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MsgCreateAndJoinResponse) GetGameId() uint64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type MsgCommitMove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// game_id is the ID of the game to commit the move to.
+	GameId uint64 `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// commit is the commitment to the move, see MoveCommit.
+	Commit []byte `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	// commitment_scheme is the scheme commit was computed with, see
+	// MsgNewGame.
+	CommitmentScheme CommitmentScheme `protobuf:"varint,4,opt,name=commitment_scheme,json=commitmentScheme,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"commitment_scheme,omitempty"`
+}
+
+func (x *MsgCommitMove) Reset() {
+	*x = MsgCommitMove{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCommitMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCommitMove) ProtoMessage() {}
+
+// Deprecated: Use MsgCommitMove.ProtoReflect.Descriptor instead.
+func (*MsgCommitMove) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgCommitMove) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *MsgCommitMove) GetGameId() uint64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *MsgCommitMove) GetCommit() []byte {
+	if x != nil {
+		return x.Commit
+	}
+	return nil
+}
+
+func (x *MsgCommitMove) GetCommitmentScheme() CommitmentScheme {
+	if x != nil {
+		return x.CommitmentScheme
+	}
+	return CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED
+}
+
+type MsgCommitMoveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgCommitMoveResponse) Reset() {
+	*x = MsgCommitMoveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCommitMoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCommitMoveResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCommitMoveResponse.ProtoReflect.Descriptor instead.
+func (*MsgCommitMoveResponse) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{5}
+}
+
+type MsgRevealMove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// game_id is the ID of the game to reveal the move for.
+	GameId uint64 `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// move is the move to reveal. It's encoded as its name, e.g. "rock", like
+	// before moves were an enum, so legacy clients and their signatures keep
+	// working.
+	Move string `protobuf:"bytes,3,opt,name=move,proto3" json:"move,omitempty"`
+	// salt is the salt used to create the commitment, at least 16 bytes.
+	Salt []byte `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
+}
+
+func (x *MsgRevealMove) Reset() {
+	*x = MsgRevealMove{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRevealMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRevealMove) ProtoMessage() {}
+
+// Deprecated: Use MsgRevealMove.ProtoReflect.Descriptor instead.
+func (*MsgRevealMove) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgRevealMove) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *MsgRevealMove) GetGameId() uint64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *MsgRevealMove) GetMove() string {
+	if x != nil {
+		return x.Move
+	}
+	return ""
+}
+
+func (x *MsgRevealMove) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+type MsgRevealMoveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgRevealMoveResponse) Reset() {
+	*x = MsgRevealMoveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRevealMoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRevealMoveResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRevealMoveResponse.ProtoReflect.Descriptor instead.
+func (*MsgRevealMoveResponse) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+type MsgSetRevealAgent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// agent is the address allowed to reveal the player's moves, an empty agent
+	// removes the current one.
+	Agent string `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`
+}
+
+func (x *MsgSetRevealAgent) Reset() {
+	*x = MsgSetRevealAgent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetRevealAgent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetRevealAgent) ProtoMessage() {}
+
+// Deprecated: Use MsgSetRevealAgent.ProtoReflect.Descriptor instead.
+func (*MsgSetRevealAgent) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgSetRevealAgent) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *MsgSetRevealAgent) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+type MsgSetRevealAgentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSetRevealAgentResponse) Reset() {
+	*x = MsgSetRevealAgentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetRevealAgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetRevealAgentResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetRevealAgentResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetRevealAgentResponse) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{9}
+}
+
+type MsgEscrowReveal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// game_id is the ID of the game the move was committed to.
+	GameId uint64 `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// encrypted_reveal is the move and salt encrypted for the reveal agent, the
+	// module doesn't read it.
+	EncryptedReveal []byte `protobuf:"bytes,3,opt,name=encrypted_reveal,json=encryptedReveal,proto3" json:"encrypted_reveal,omitempty"`
+}
+
+func (x *MsgEscrowReveal) Reset() {
+	*x = MsgEscrowReveal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgEscrowReveal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgEscrowReveal) ProtoMessage() {}
+
+// Deprecated: Use MsgEscrowReveal.ProtoReflect.Descriptor instead.
+func (*MsgEscrowReveal) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgEscrowReveal) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *MsgEscrowReveal) GetGameId() uint64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *MsgEscrowReveal) GetEncryptedReveal() []byte {
+	if x != nil {
+		return x.EncryptedReveal
+	}
+	return nil
+}
+
+type MsgEscrowRevealResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgEscrowRevealResponse) Reset() {
+	*x = MsgEscrowRevealResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgEscrowRevealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgEscrowRevealResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgEscrowRevealResponse.ProtoReflect.Descriptor instead.
+func (*MsgEscrowRevealResponse) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{11}
+}
+
+type MsgAgentRevealMove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// agent is the reveal agent of the player, it signs the message.
+	Agent string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	// player is the player the move is revealed for.
+	Player string `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	GameId uint64 `protobuf:"varint,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// move is encoded as its name, like in MsgRevealMove.
+	Move string `protobuf:"bytes,4,opt,name=move,proto3" json:"move,omitempty"`
+	Salt []byte `protobuf:"bytes,5,opt,name=salt,proto3" json:"salt,omitempty"`
+}
+
+func (x *MsgAgentRevealMove) Reset() {
+	*x = MsgAgentRevealMove{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAgentRevealMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAgentRevealMove) ProtoMessage() {}
+
+// Deprecated: Use MsgAgentRevealMove.ProtoReflect.Descriptor instead.
+func (*MsgAgentRevealMove) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgAgentRevealMove) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *MsgAgentRevealMove) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *MsgAgentRevealMove) GetGameId() uint64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *MsgAgentRevealMove) GetMove() string {
+	if x != nil {
+		return x.Move
+	}
+	return ""
+}
+
+func (x *MsgAgentRevealMove) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+type MsgAgentRevealMoveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgAgentRevealMoveResponse) Reset() {
+	*x = MsgAgentRevealMoveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAgentRevealMoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAgentRevealMoveResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgAgentRevealMoveResponse.ProtoReflect.Descriptor instead.
+func (*MsgAgentRevealMoveResponse) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{13}
+}
+
+type MsgJoinQueue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// commit is the commitment to the move, see MoveCommit.
+	Commit []byte `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	// entry_fee is the maximum amount to put into stake for the game, it's
+	// escrowed while waiting and the excess is refunded when matched.
+	EntryFee *v1beta1.Coin `protobuf:"bytes,3,opt,name=entry_fee,json=entryFee,proto3" json:"entry_fee,omitempty"`
+	// min_entry_fee is the minimum amount the player is willing to play for,
+	// it must have the same denom as entry_fee. If empty, only games for exactly
+	// entry_fee are accepted.
+	MinEntryFee *v1beta1.Coin `protobuf:"bytes,4,opt,name=min_entry_fee,json=minEntryFee,proto3" json:"min_entry_fee,omitempty"`
+	// commitment_scheme is the scheme commit was computed with, see
+	// MsgNewGame.
+	CommitmentScheme CommitmentScheme `protobuf:"varint,5,opt,name=commitment_scheme,json=commitmentScheme,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"commitment_scheme,omitempty"`
+}
+
+func (x *MsgJoinQueue) Reset() {
+	*x = MsgJoinQueue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgJoinQueue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgJoinQueue) ProtoMessage() {}
+
+// Deprecated: Use MsgJoinQueue.ProtoReflect.Descriptor instead.
+func (*MsgJoinQueue) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MsgJoinQueue) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *MsgJoinQueue) GetCommit() []byte {
+	if x != nil {
+		return x.Commit
+	}
+	return nil
+}
+
+func (x *MsgJoinQueue) GetEntryFee() *v1beta1.Coin {
+	if x != nil {
+		return x.EntryFee
+	}
+	return nil
+}
+
+func (x *MsgJoinQueue) GetMinEntryFee() *v1beta1.Coin {
+	if x != nil {
+		return x.MinEntryFee
+	}
+	return nil
+}
+
+func (x *MsgJoinQueue) GetCommitmentScheme() CommitmentScheme {
+	if x != nil {
+		return x.CommitmentScheme
+	}
+	return CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED
+}
+
+type MsgJoinQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// matched is true if the player was paired with an opponent.
+	Matched bool `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	// game_id is the ID of the created game, only set if matched.
+	GameId uint64 `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// entry_id is the ID of the queue entry, only set if not matched.
+	EntryId uint64 `protobuf:"varint,3,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+}
+
+func (x *MsgJoinQueueResponse) Reset() {
+	*x = MsgJoinQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgJoinQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgJoinQueueResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgJoinQueueResponse.ProtoReflect.Descriptor instead.
+func (*MsgJoinQueueResponse) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MsgJoinQueueResponse) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *MsgJoinQueueResponse) GetGameId() uint64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *MsgJoinQueueResponse) GetEntryId() uint64 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+type MsgLeaveQueue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// entry_id is the ID of the queue entry to remove.
+	EntryId uint64 `protobuf:"varint,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+}
+
+func (x *MsgLeaveQueue) Reset() {
+	*x = MsgLeaveQueue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgLeaveQueue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgLeaveQueue) ProtoMessage() {}
+
+// Deprecated: Use MsgLeaveQueue.ProtoReflect.Descriptor instead.
+func (*MsgLeaveQueue) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MsgLeaveQueue) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *MsgLeaveQueue) GetEntryId() uint64 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
 }
 
-func (x *MsgNewGameResponse) GetGameId() uint64 {
+type MsgLeaveQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgLeaveQueueResponse) Reset() {
+	*x = MsgLeaveQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgLeaveQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgLeaveQueueResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgLeaveQueueResponse.ProtoReflect.Descriptor instead.
+func (*MsgLeaveQueueResponse) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{17}
+}
+
+type MsgPlaceBet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bettor string `protobuf:"bytes,1,opt,name=bettor,proto3" json:"bettor,omitempty"`
+	GameId uint64 `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// outcome is the outcome of the game the bet is on. Additional bets on the
+	// same game must be on the same outcome.
+	Outcome BetOutcome `protobuf:"varint,3,opt,name=outcome,proto3,enum=facundomedica.rps.v1.BetOutcome" json:"outcome,omitempty"`
+	// amount is the amount to bet, it's added to any previous bet on the game.
+	Amount []*v1beta1.Coin `protobuf:"bytes,4,rep,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *MsgPlaceBet) Reset() {
+	*x = MsgPlaceBet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPlaceBet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPlaceBet) ProtoMessage() {}
+
+// Deprecated: Use MsgPlaceBet.ProtoReflect.Descriptor instead.
+func (*MsgPlaceBet) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MsgPlaceBet) GetBettor() string {
+	if x != nil {
+		return x.Bettor
+	}
+	return ""
+}
+
+func (x *MsgPlaceBet) GetGameId() uint64 {
 	if x != nil {
 		return x.GameId
 	}
 	return 0
 }
 
-type MsgCommitMove struct {
+func (x *MsgPlaceBet) GetOutcome() BetOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return BetOutcome_BET_OUTCOME_UNSPECIFIED
+}
+
+func (x *MsgPlaceBet) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type MsgPlaceBetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgPlaceBetResponse) Reset() {
+	*x = MsgPlaceBetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPlaceBetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPlaceBetResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgPlaceBetResponse.ProtoReflect.Descriptor instead.
+func (*MsgPlaceBetResponse) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{19}
+}
+
+type MsgPlayHouse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
-	// game_id is the ID of the game to commit the move to.
-	GameId uint64 `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	// commit is the hex encoded commitment to the move.
-	// SHA256("32byte-salt" + "move") where move is one of "rock", "paper",
-	// "scissors"
-	Commit string `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	// commit is the commitment to the move, see MoveCommit.
+	Commit []byte `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	// entry_fee is the stake of the player, the house matches it.
+	EntryFee []*v1beta1.Coin `protobuf:"bytes,3,rep,name=entry_fee,json=entryFee,proto3" json:"entry_fee,omitempty"`
+	// commitment_scheme is the scheme commit was computed with, see
+	// MsgNewGame.
+	CommitmentScheme CommitmentScheme `protobuf:"varint,4,opt,name=commitment_scheme,json=commitmentScheme,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"commitment_scheme,omitempty"`
 }
 
-func (x *MsgCommitMove) Reset() {
-	*x = MsgCommitMove{}
+func (x *MsgPlayHouse) Reset() {
+	*x = MsgPlayHouse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[2]
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCommitMove) String() string {
+func (x *MsgPlayHouse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCommitMove) ProtoMessage() {}
+func (*MsgPlayHouse) ProtoMessage() {}
 
-// Deprecated: Use MsgCommitMove.ProtoReflect.Descriptor instead.
-func (*MsgCommitMove) Descriptor() ([]byte, []int) {
-	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgPlayHouse.ProtoReflect.Descriptor instead.
+func (*MsgPlayHouse) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *MsgCommitMove) GetPlayer() string {
+func (x *MsgPlayHouse) GetPlayer() string {
 	if x != nil {
 		return x.Player
 	}
 	return ""
 }
 
-func (x *MsgCommitMove) GetGameId() uint64 {
+func (x *MsgPlayHouse) GetCommit() []byte {
+	if x != nil {
+		return x.Commit
+	}
+	return nil
+}
+
+func (x *MsgPlayHouse) GetEntryFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.EntryFee
+	}
+	return nil
+}
+
+func (x *MsgPlayHouse) GetCommitmentScheme() CommitmentScheme {
+	if x != nil {
+		return x.CommitmentScheme
+	}
+	return CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED
+}
+
+type MsgPlayHouseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// game_id is the ID of the created game.
+	GameId uint64 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *MsgPlayHouseResponse) Reset() {
+	*x = MsgPlayHouseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPlayHouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPlayHouseResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgPlayHouseResponse.ProtoReflect.Descriptor instead.
+func (*MsgPlayHouseResponse) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MsgPlayHouseResponse) GetGameId() uint64 {
 	if x != nil {
 		return x.GameId
 	}
 	return 0
 }
 
-func (x *MsgCommitMove) GetCommit() string {
+type MsgFundHouse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address that controls the module (defaults to x/gov
+	// unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// amount is added to the house bankroll, it can't be withdrawn.
+	Amount []*v1beta1.Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *MsgFundHouse) Reset() {
+	*x = MsgFundHouse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgFundHouse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgFundHouse) ProtoMessage() {}
+
+// Deprecated: Use MsgFundHouse.ProtoReflect.Descriptor instead.
+func (*MsgFundHouse) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MsgFundHouse) GetAuthority() string {
 	if x != nil {
-		return x.Commit
+		return x.Authority
 	}
 	return ""
 }
 
-type MsgCommitMoveResponse struct {
+func (x *MsgFundHouse) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type MsgFundHouseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgCommitMoveResponse) Reset() {
-	*x = MsgCommitMoveResponse{}
+func (x *MsgFundHouseResponse) Reset() {
+	*x = MsgFundHouseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[3]
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCommitMoveResponse) String() string {
+func (x *MsgFundHouseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCommitMoveResponse) ProtoMessage() {}
+func (*MsgFundHouseResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCommitMoveResponse.ProtoReflect.Descriptor instead.
-func (*MsgCommitMoveResponse) Descriptor() ([]byte, []int) {
-	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MsgFundHouseResponse.ProtoReflect.Descriptor instead.
+func (*MsgFundHouseResponse) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{23}
 }
 
-type MsgRevealMove struct {
+type MsgOfferRematch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
-	// game_id is the ID of the game to reveal the move for.
+	// game_id is the ID of the settled game.
 	GameId uint64 `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	// move is the move to reveal.
-	// Must be one of "rock", "paper", "scissors"
-	Move string `protobuf:"bytes,3,opt,name=move,proto3" json:"move,omitempty"`
-	// salt is the salt used to create the commitment.
-	Salt string `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
+	// commit is the commitment to the move, see MoveCommit.
+	Commit []byte `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	// commitment_scheme is the scheme commit was computed with, see
+	// MsgNewGame.
+	CommitmentScheme CommitmentScheme `protobuf:"varint,4,opt,name=commitment_scheme,json=commitmentScheme,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"commitment_scheme,omitempty"`
 }
 
-func (x *MsgRevealMove) Reset() {
-	*x = MsgRevealMove{}
+func (x *MsgOfferRematch) Reset() {
+	*x = MsgOfferRematch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[4]
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRevealMove) String() string {
+func (x *MsgOfferRematch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRevealMove) ProtoMessage() {}
+func (*MsgOfferRematch) ProtoMessage() {}
 
-// Deprecated: Use MsgRevealMove.ProtoReflect.Descriptor instead.
-func (*MsgRevealMove) Descriptor() ([]byte, []int) {
-	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgOfferRematch.ProtoReflect.Descriptor instead.
+func (*MsgOfferRematch) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *MsgRevealMove) GetPlayer() string {
+func (x *MsgOfferRematch) GetPlayer() string {
 	if x != nil {
 		return x.Player
 	}
 	return ""
 }
 
-func (x *MsgRevealMove) GetGameId() uint64 {
+func (x *MsgOfferRematch) GetGameId() uint64 {
 	if x != nil {
 		return x.GameId
 	}
 	return 0
 }
 
-func (x *MsgRevealMove) GetMove() string {
+func (x *MsgOfferRematch) GetCommit() []byte {
 	if x != nil {
-		return x.Move
+		return x.Commit
 	}
-	return ""
+	return nil
 }
 
-func (x *MsgRevealMove) GetSalt() string {
+func (x *MsgOfferRematch) GetCommitmentScheme() CommitmentScheme {
 	if x != nil {
-		return x.Salt
+		return x.CommitmentScheme
 	}
-	return ""
+	return CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED
 }
 
-type MsgRevealMoveResponse struct {
+type MsgOfferRematchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// game_id is the ID of the created game.
+	GameId uint64 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (x *MsgRevealMoveResponse) Reset() {
-	*x = MsgRevealMoveResponse{}
+func (x *MsgOfferRematchResponse) Reset() {
+	*x = MsgOfferRematchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[5]
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRevealMoveResponse) String() string {
+func (x *MsgOfferRematchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRevealMoveResponse) ProtoMessage() {}
+func (*MsgOfferRematchResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgRevealMoveResponse.ProtoReflect.Descriptor instead.
-func (*MsgRevealMoveResponse) Descriptor() ([]byte, []int) {
-	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgOfferRematchResponse.ProtoReflect.Descriptor instead.
+func (*MsgOfferRematchResponse) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MsgOfferRematchResponse) GetGameId() uint64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
 }
 
 // MsgUpdateParams is the Msg/UpdateParams request type.
@@ -3970,7 +16435,7 @@ type MsgUpdateParams struct {
 func (x *MsgUpdateParams) Reset() {
 	*x = MsgUpdateParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[6]
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3984,7 +16449,7 @@ func (*MsgUpdateParams) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{6}
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *MsgUpdateParams) GetAuthority() string {
@@ -4012,7 +16477,7 @@ type MsgUpdateParamsResponse struct {
 func (x *MsgUpdateParamsResponse) Reset() {
 	*x = MsgUpdateParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[7]
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4026,7 +16491,93 @@ func (*MsgUpdateParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{7}
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{27}
+}
+
+// MsgResolveStuckGame is the Msg/ResolveStuckGame request type.
+type MsgResolveStuckGame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address that controls the module
+	// NOTE: Defaults to the governance module unless overwritten.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// game_id is the ID of the stuck game to resolve.
+	GameId uint64 `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// refund defines whether the entry fee must be refunded to every player that
+	// committed a move. If false, the game is discarded and the escrowed funds
+	// stay in the module account.
+	Refund bool `protobuf:"varint,3,opt,name=refund,proto3" json:"refund,omitempty"`
+}
+
+func (x *MsgResolveStuckGame) Reset() {
+	*x = MsgResolveStuckGame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgResolveStuckGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgResolveStuckGame) ProtoMessage() {}
+
+// Deprecated: Use MsgResolveStuckGame.ProtoReflect.Descriptor instead.
+func (*MsgResolveStuckGame) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MsgResolveStuckGame) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgResolveStuckGame) GetGameId() uint64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *MsgResolveStuckGame) GetRefund() bool {
+	if x != nil {
+		return x.Refund
+	}
+	return false
+}
+
+// MsgResolveStuckGameResponse defines the response structure for executing a
+// MsgResolveStuckGame message.
+type MsgResolveStuckGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgResolveStuckGameResponse) Reset() {
+	*x = MsgResolveStuckGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_tx_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgResolveStuckGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgResolveStuckGameResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgResolveStuckGameResponse.ProtoReflect.Descriptor instead.
+func (*MsgResolveStuckGameResponse) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_tx_proto_rawDescGZIP(), []int{29}
 }
 
 var File_facundomedica_rps_v1_tx_proto protoreflect.FileDescriptor
@@ -4045,99 +16596,437 @@ var file_facundomedica_rps_v1_tx_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79,
 	0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x01, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x47,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbb, 0x04, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x47,
 	0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x3c, 0x0a,
-	0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f,
-	0x00, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x46, 0x65, 0x65, 0x3a, 0x2c, 0x82, 0xe7, 0xb0,
-	0x2a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x66, 0x61, 0x63,
-	0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f, 0x4d,
-	0x73, 0x67, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x12, 0x4d, 0x73, 0x67,
-	0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0c, 0xfa, 0xde, 0x1f, 0x08, 0x48, 0x65, 0x78, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c,
+	0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x46, 0x65, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x65,
+	0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x83, 0x01, 0x0a, 0x0e, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x41, 0xc8,
+	0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a,
+	0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73,
+	0x52, 0x0d, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12,
+	0x53, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x66, 0x61, 0x63,
+	0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x65, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x3a, 0x2c, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64,
+	0x69, 0x63, 0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x47, 0x61,
+	0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x22, 0xaf, 0x06, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x6e, 0x64, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x0e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x0c, 0xfa, 0xde, 0x1f, 0x08, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
+	0x38, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x11, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x0c, 0xfa, 0xde, 0x1f, 0x08, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x7e, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f,
+	0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x46, 0x65, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x76, 0x65, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x62, 0x0a, 0x19, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x17, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x68, 0x0a, 0x1c, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x66, 0x61,
+	0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x52, 0x1a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x3a,
+	0x42, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x82, 0xe7, 0xb0,
+	0x2a, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x22, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72,
+	0x70, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x4a,
+	0x6f, 0x69, 0x6e, 0x22, 0x33, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x6e, 0x64, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x0d, 0x4d, 0x73, 0x67,
+	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x86, 0x02, 0x0a, 0x0d, 0x4d, 0x73, 0x67,
 	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6c,
 	0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
 	0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07,
 	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67,
-	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x3a, 0x2f, 0x82,
-	0xe7, 0xb0, 0x2a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x66,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0c, 0xfa, 0xde, 0x1f, 0x08, 0x48, 0x65, 0x78, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x53, 0x0a, 0x11, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f,
+	0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x10,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x3a, 0x2f, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x1f, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2f,
+	0x72, 0x70, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x76,
+	0x65, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x0d, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x30, 0x0a, 0x06,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x04, 0x4d,
+	0x6f, 0x76, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x61, 0x6c,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0c, 0xfa, 0xde, 0x1f, 0x08, 0x48, 0x65, 0x78,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x3a, 0x2f, 0x82, 0xe7, 0xb0,
+	0x2a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x66, 0x61, 0x63,
+	0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x22, 0x17, 0x0a, 0x15,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x2e, 0x0a,
+	0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x3a, 0x33, 0x82,
+	0xe7, 0xb0, 0x2a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x66,
 	0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70, 0x73,
-	0x2f, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x22, 0x17,
-	0x0a, 0x15, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x52,
-	0x65, 0x76, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6c, 0x61,
+	0x2f, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65,
+	0x61, 0x6c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xba, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x76,
+	0x65, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x29,
+	0x0a, 0x10, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x65,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x3a, 0x31, 0x82, 0xe7, 0xb0, 0x2a, 0x06,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x21, 0x66, 0x61, 0x63, 0x75, 0x6e,
+	0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f, 0x4d, 0x73, 0x67,
+	0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x22, 0x19, 0x0a, 0x17,
+	0x4d, 0x73, 0x67, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x88, 0x02, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x2e,
+	0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x30,
+	0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x6d, 0x6f, 0x76,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x04, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x73,
+	0x61, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0c, 0xfa, 0xde, 0x1f, 0x08, 0x48,
+	0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x3a, 0x33, 0x82,
+	0xe7, 0xb0, 0x2a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x8a, 0xe7, 0xb0, 0x2a, 0x24, 0x66, 0x61,
+	0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f,
+	0x4d, 0x73, 0x67, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x4d, 0x6f,
+	0x76, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x76, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xee, 0x02, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x0c, 0xfa, 0xde, 0x1f, 0x08, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x3c, 0x0a, 0x09, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x46, 0x65, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0b, 0x6d, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x46, 0x65, 0x65, 0x12, 0x53, 0x0a, 0x11,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64,
+	0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52,
+	0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x3a, 0x2e, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x1e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61,
+	0x2f, 0x72, 0x70, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x22, 0x64, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6c, 0x61,
 	0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x67,
-	0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67, 0x61,
-	0x6d, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x3a, 0x2f, 0x82, 0xe7,
-	0xb0, 0x2a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x66, 0x61,
-	0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f,
-	0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x22, 0x17, 0x0a,
-	0x15, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc0, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x12, 0x3f, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69,
-	0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x3a, 0x34, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x21, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d,
-	0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x89, 0x03, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x55, 0x0a, 0x07,
-	0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x3a, 0x2f, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d,
+	0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xbe, 0x02, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x65, 0x74,
+	0x12, 0x30, 0x0a, 0x06, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x62, 0x65, 0x74, 0x74,
+	0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x07, 0x6f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x66,
+	0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x79, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f,
+	0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x3a, 0x2d, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x8a,
+	0xe7, 0xb0, 0x2a, 0x1d, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x65,
+	0x74, 0x22, 0x15, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xeb, 0x02, 0x0a, 0x0c, 0x4d, 0x73, 0x67,
+	0x50, 0x6c, 0x61, 0x79, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0c, 0xfa, 0xde, 0x1f,
+	0x08, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x7e, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69,
+	0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x46, 0x65,
+	0x65, 0x12, 0x53, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x66,
+	0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x3a, 0x2e, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d,
+	0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x50, 0x6c, 0x61,
+	0x79, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x50, 0x6c, 0x61,
+	0x79, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0xf4, 0x01, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x46,
+	0x75, 0x6e, 0x64, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x79, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f,
+	0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0,
+	0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x31, 0x82, 0xe7, 0xb0,
+	0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e,
+	0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70,
+	0x73, 0x2f, 0x4d, 0x73, 0x67, 0x46, 0x75, 0x6e, 0x64, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x22, 0x16,
+	0x0a, 0x14, 0x4d, 0x73, 0x67, 0x46, 0x75, 0x6e, 0x64, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8a, 0x02, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0c, 0xfa, 0xde, 0x1f, 0x08, 0x48, 0x65, 0x78, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x53, 0x0a, 0x11, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f,
+	0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x10,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x3a, 0x31, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x21, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2f,
+	0x72, 0x70, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x22, 0x32, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52,
+	0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0xc0, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64,
+	0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x3a, 0x34, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x21, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f,
+	0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x75, 0x63, 0x6b, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x3a, 0x38, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x25, 0x66, 0x61, 0x63, 0x75, 0x6e,
+	0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x75, 0x63, 0x6b, 0x47, 0x61, 0x6d, 0x65,
+	0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74,
+	0x75, 0x63, 0x6b, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0xdc, 0x0b, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x55, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x47, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77,
+	0x47, 0x61, 0x6d, 0x65, 0x1a, 0x28, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4e,
+	0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e,
+	0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x23, 0x2e, 0x66,
+	0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x76,
+	0x65, 0x1a, 0x2b, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67,
+	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x4a, 0x6f, 0x69, 0x6e, 0x12,
+	0x26, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e,
+	0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x6e, 0x64, 0x4a, 0x6f, 0x69, 0x6e, 0x1a, 0x2e, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64,
 	0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x1a, 0x28, 0x2e, 0x66, 0x61, 0x63, 0x75,
+	0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x4a, 0x6f, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x65, 0x61,
+	0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x23, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d,
+	0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x1a, 0x2b, 0x2e, 0x66, 0x61, 0x63,
+	0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x76, 0x65, 0x61, 0x6c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x2e, 0x66, 0x61, 0x63, 0x75,
 	0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x76,
-	0x65, 0x12, 0x23, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63,
-	0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x1a, 0x2b, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f,
+	0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x1a, 0x2f, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0c, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x76,
+	0x65, 0x61, 0x6c, 0x12, 0x25, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64,
+	0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x73,
+	0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x1a, 0x2d, 0x2e, 0x66, 0x61, 0x63,
+	0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x76, 0x65, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x0f, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x28, 0x2e, 0x66,
+	0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x65,
+	0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x1a, 0x30, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f,
 	0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76,
-	0x65, 0x12, 0x23, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63,
-	0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65,
-	0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x1a, 0x2b, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f,
+	0x67, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x09, 0x4a, 0x6f, 0x69, 0x6e,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x22, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d,
+	0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x1a, 0x2a, 0x2e, 0x66, 0x61, 0x63, 0x75,
+	0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x12, 0x23, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64,
+	0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x1a, 0x2b, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e,
+	0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x65,
+	0x74, 0x12, 0x21, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x42, 0x65, 0x74, 0x1a, 0x29, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5b, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x79, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x22, 0x2e, 0x66,
+	0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x6c, 0x61, 0x79, 0x48, 0x6f, 0x75, 0x73, 0x65,
+	0x1a, 0x2a, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61,
+	0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x6c, 0x61, 0x79, 0x48,
+	0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x09,
+	0x46, 0x75, 0x6e, 0x64, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x22, 0x2e, 0x66, 0x61, 0x63, 0x75,
+	0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x46, 0x75, 0x6e, 0x64, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x1a, 0x2a, 0x2e,
+	0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x75, 0x6e, 0x64, 0x48, 0x6f, 0x75, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0c, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x25, 0x2e, 0x66, 0x61, 0x63, 0x75,
+	0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x1a, 0x2d, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61,
+	0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x64, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x25, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e,
+	0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2d, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f,
 	0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x25, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64,
-	0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2d, 0x2e, 0x66, 0x61, 0x63,
-	0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01,
-	0x42, 0xd2, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f,
-	0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54,
-	0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69,
-	0x63, 0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x63, 0x75, 0x6e,
-	0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f, 0x76, 0x31, 0x3b,
-	0x72, 0x70, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x52, 0x58, 0xaa, 0x02, 0x14, 0x46, 0x61,
-	0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x52, 0x70, 0x73, 0x2e,
-	0x56, 0x31, 0xca, 0x02, 0x14, 0x46, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69,
-	0x63, 0x61, 0x5c, 0x52, 0x70, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x20, 0x46, 0x61, 0x63, 0x75,
-	0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x5c, 0x52, 0x70, 0x73, 0x5c, 0x56, 0x31,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x46,
-	0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x3a, 0x3a, 0x52, 0x70,
-	0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x53, 0x74, 0x75, 0x63, 0x6b, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x2e, 0x66, 0x61, 0x63, 0x75,
+	0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x75, 0x63, 0x6b,
+	0x47, 0x61, 0x6d, 0x65, 0x1a, 0x31, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x75, 0x63, 0x6b, 0x47, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xd2,
+	0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61,
+	0x2f, 0x72, 0x70, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f,
+	0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x70,
+	0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x52, 0x58, 0xaa, 0x02, 0x14, 0x46, 0x61, 0x63, 0x75,
+	0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x52, 0x70, 0x73, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x14, 0x46, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61,
+	0x5c, 0x52, 0x70, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x20, 0x46, 0x61, 0x63, 0x75, 0x6e, 0x64,
+	0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x5c, 0x52, 0x70, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x46, 0x61, 0x63,
+	0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x3a, 0x3a, 0x52, 0x70, 0x73, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4152,35 +17041,97 @@ func file_facundomedica_rps_v1_tx_proto_rawDescGZIP() []byte {
 	return file_facundomedica_rps_v1_tx_proto_rawDescData
 }
 
-var file_facundomedica_rps_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_facundomedica_rps_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_facundomedica_rps_v1_tx_proto_goTypes = []interface{}{
-	(*MsgNewGame)(nil),              // 0: facundomedica.rps.v1.MsgNewGame
-	(*MsgNewGameResponse)(nil),      // 1: facundomedica.rps.v1.MsgNewGameResponse
-	(*MsgCommitMove)(nil),           // 2: facundomedica.rps.v1.MsgCommitMove
-	(*MsgCommitMoveResponse)(nil),   // 3: facundomedica.rps.v1.MsgCommitMoveResponse
-	(*MsgRevealMove)(nil),           // 4: facundomedica.rps.v1.MsgRevealMove
-	(*MsgRevealMoveResponse)(nil),   // 5: facundomedica.rps.v1.MsgRevealMoveResponse
-	(*MsgUpdateParams)(nil),         // 6: facundomedica.rps.v1.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil), // 7: facundomedica.rps.v1.MsgUpdateParamsResponse
-	(*v1beta1.Coin)(nil),            // 8: cosmos.base.v1beta1.Coin
-	(*Params)(nil),                  // 9: facundomedica.rps.v1.Params
+	(*MsgNewGame)(nil),                  // 0: facundomedica.rps.v1.MsgNewGame
+	(*MsgNewGameResponse)(nil),          // 1: facundomedica.rps.v1.MsgNewGameResponse
+	(*MsgCreateAndJoin)(nil),            // 2: facundomedica.rps.v1.MsgCreateAndJoin
+	(*MsgCreateAndJoinResponse)(nil),    // 3: facundomedica.rps.v1.MsgCreateAndJoinResponse
+	(*MsgCommitMove)(nil),               // 4: facundomedica.rps.v1.MsgCommitMove
+	(*MsgCommitMoveResponse)(nil),       // 5: facundomedica.rps.v1.MsgCommitMoveResponse
+	(*MsgRevealMove)(nil),               // 6: facundomedica.rps.v1.MsgRevealMove
+	(*MsgRevealMoveResponse)(nil),       // 7: facundomedica.rps.v1.MsgRevealMoveResponse
+	(*MsgSetRevealAgent)(nil),           // 8: facundomedica.rps.v1.MsgSetRevealAgent
+	(*MsgSetRevealAgentResponse)(nil),   // 9: facundomedica.rps.v1.MsgSetRevealAgentResponse
+	(*MsgEscrowReveal)(nil),             // 10: facundomedica.rps.v1.MsgEscrowReveal
+	(*MsgEscrowRevealResponse)(nil),     // 11: facundomedica.rps.v1.MsgEscrowRevealResponse
+	(*MsgAgentRevealMove)(nil),          // 12: facundomedica.rps.v1.MsgAgentRevealMove
+	(*MsgAgentRevealMoveResponse)(nil),  // 13: facundomedica.rps.v1.MsgAgentRevealMoveResponse
+	(*MsgJoinQueue)(nil),                // 14: facundomedica.rps.v1.MsgJoinQueue
+	(*MsgJoinQueueResponse)(nil),        // 15: facundomedica.rps.v1.MsgJoinQueueResponse
+	(*MsgLeaveQueue)(nil),               // 16: facundomedica.rps.v1.MsgLeaveQueue
+	(*MsgLeaveQueueResponse)(nil),       // 17: facundomedica.rps.v1.MsgLeaveQueueResponse
+	(*MsgPlaceBet)(nil),                 // 18: facundomedica.rps.v1.MsgPlaceBet
+	(*MsgPlaceBetResponse)(nil),         // 19: facundomedica.rps.v1.MsgPlaceBetResponse
+	(*MsgPlayHouse)(nil),                // 20: facundomedica.rps.v1.MsgPlayHouse
+	(*MsgPlayHouseResponse)(nil),        // 21: facundomedica.rps.v1.MsgPlayHouseResponse
+	(*MsgFundHouse)(nil),                // 22: facundomedica.rps.v1.MsgFundHouse
+	(*MsgFundHouseResponse)(nil),        // 23: facundomedica.rps.v1.MsgFundHouseResponse
+	(*MsgOfferRematch)(nil),             // 24: facundomedica.rps.v1.MsgOfferRematch
+	(*MsgOfferRematchResponse)(nil),     // 25: facundomedica.rps.v1.MsgOfferRematchResponse
+	(*MsgUpdateParams)(nil),             // 26: facundomedica.rps.v1.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),     // 27: facundomedica.rps.v1.MsgUpdateParamsResponse
+	(*MsgResolveStuckGame)(nil),         // 28: facundomedica.rps.v1.MsgResolveStuckGame
+	(*MsgResolveStuckGameResponse)(nil), // 29: facundomedica.rps.v1.MsgResolveStuckGameResponse
+	(*v1beta1.Coin)(nil),                // 30: cosmos.base.v1beta1.Coin
+	(CommitmentScheme)(0),               // 31: facundomedica.rps.v1.CommitmentScheme
+	(BetOutcome)(0),                     // 32: facundomedica.rps.v1.BetOutcome
+	(*Params)(nil),                      // 33: facundomedica.rps.v1.Params
 }
 var file_facundomedica_rps_v1_tx_proto_depIdxs = []int32{
-	8, // 0: facundomedica.rps.v1.MsgNewGame.entry_fee:type_name -> cosmos.base.v1beta1.Coin
-	9, // 1: facundomedica.rps.v1.MsgUpdateParams.params:type_name -> facundomedica.rps.v1.Params
-	0, // 2: facundomedica.rps.v1.Msg.NewGame:input_type -> facundomedica.rps.v1.MsgNewGame
-	2, // 3: facundomedica.rps.v1.Msg.CommitMove:input_type -> facundomedica.rps.v1.MsgCommitMove
-	4, // 4: facundomedica.rps.v1.Msg.RevealMove:input_type -> facundomedica.rps.v1.MsgRevealMove
-	6, // 5: facundomedica.rps.v1.Msg.UpdateParams:input_type -> facundomedica.rps.v1.MsgUpdateParams
-	1, // 6: facundomedica.rps.v1.Msg.NewGame:output_type -> facundomedica.rps.v1.MsgNewGameResponse
-	3, // 7: facundomedica.rps.v1.Msg.CommitMove:output_type -> facundomedica.rps.v1.MsgCommitMoveResponse
-	5, // 8: facundomedica.rps.v1.Msg.RevealMove:output_type -> facundomedica.rps.v1.MsgRevealMoveResponse
-	7, // 9: facundomedica.rps.v1.Msg.UpdateParams:output_type -> facundomedica.rps.v1.MsgUpdateParamsResponse
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	30, // 0: facundomedica.rps.v1.MsgNewGame.entry_fee:type_name -> cosmos.base.v1beta1.Coin
+	30, // 1: facundomedica.rps.v1.MsgNewGame.challenger_fee:type_name -> cosmos.base.v1beta1.Coin
+	31, // 2: facundomedica.rps.v1.MsgNewGame.commitment_scheme:type_name -> facundomedica.rps.v1.CommitmentScheme
+	30, // 3: facundomedica.rps.v1.MsgCreateAndJoin.entry_fee:type_name -> cosmos.base.v1beta1.Coin
+	30, // 4: facundomedica.rps.v1.MsgCreateAndJoin.challenger_fee:type_name -> cosmos.base.v1beta1.Coin
+	31, // 5: facundomedica.rps.v1.MsgCreateAndJoin.creator_commitment_scheme:type_name -> facundomedica.rps.v1.CommitmentScheme
+	31, // 6: facundomedica.rps.v1.MsgCreateAndJoin.challenger_commitment_scheme:type_name -> facundomedica.rps.v1.CommitmentScheme
+	31, // 7: facundomedica.rps.v1.MsgCommitMove.commitment_scheme:type_name -> facundomedica.rps.v1.CommitmentScheme
+	30, // 8: facundomedica.rps.v1.MsgJoinQueue.entry_fee:type_name -> cosmos.base.v1beta1.Coin
+	30, // 9: facundomedica.rps.v1.MsgJoinQueue.min_entry_fee:type_name -> cosmos.base.v1beta1.Coin
+	31, // 10: facundomedica.rps.v1.MsgJoinQueue.commitment_scheme:type_name -> facundomedica.rps.v1.CommitmentScheme
+	32, // 11: facundomedica.rps.v1.MsgPlaceBet.outcome:type_name -> facundomedica.rps.v1.BetOutcome
+	30, // 12: facundomedica.rps.v1.MsgPlaceBet.amount:type_name -> cosmos.base.v1beta1.Coin
+	30, // 13: facundomedica.rps.v1.MsgPlayHouse.entry_fee:type_name -> cosmos.base.v1beta1.Coin
+	31, // 14: facundomedica.rps.v1.MsgPlayHouse.commitment_scheme:type_name -> facundomedica.rps.v1.CommitmentScheme
+	30, // 15: facundomedica.rps.v1.MsgFundHouse.amount:type_name -> cosmos.base.v1beta1.Coin
+	31, // 16: facundomedica.rps.v1.MsgOfferRematch.commitment_scheme:type_name -> facundomedica.rps.v1.CommitmentScheme
+	33, // 17: facundomedica.rps.v1.MsgUpdateParams.params:type_name -> facundomedica.rps.v1.Params
+	0,  // 18: facundomedica.rps.v1.Msg.NewGame:input_type -> facundomedica.rps.v1.MsgNewGame
+	4,  // 19: facundomedica.rps.v1.Msg.CommitMove:input_type -> facundomedica.rps.v1.MsgCommitMove
+	2,  // 20: facundomedica.rps.v1.Msg.CreateAndJoin:input_type -> facundomedica.rps.v1.MsgCreateAndJoin
+	6,  // 21: facundomedica.rps.v1.Msg.RevealMove:input_type -> facundomedica.rps.v1.MsgRevealMove
+	8,  // 22: facundomedica.rps.v1.Msg.SetRevealAgent:input_type -> facundomedica.rps.v1.MsgSetRevealAgent
+	10, // 23: facundomedica.rps.v1.Msg.EscrowReveal:input_type -> facundomedica.rps.v1.MsgEscrowReveal
+	12, // 24: facundomedica.rps.v1.Msg.AgentRevealMove:input_type -> facundomedica.rps.v1.MsgAgentRevealMove
+	14, // 25: facundomedica.rps.v1.Msg.JoinQueue:input_type -> facundomedica.rps.v1.MsgJoinQueue
+	16, // 26: facundomedica.rps.v1.Msg.LeaveQueue:input_type -> facundomedica.rps.v1.MsgLeaveQueue
+	18, // 27: facundomedica.rps.v1.Msg.PlaceBet:input_type -> facundomedica.rps.v1.MsgPlaceBet
+	20, // 28: facundomedica.rps.v1.Msg.PlayHouse:input_type -> facundomedica.rps.v1.MsgPlayHouse
+	22, // 29: facundomedica.rps.v1.Msg.FundHouse:input_type -> facundomedica.rps.v1.MsgFundHouse
+	24, // 30: facundomedica.rps.v1.Msg.OfferRematch:input_type -> facundomedica.rps.v1.MsgOfferRematch
+	26, // 31: facundomedica.rps.v1.Msg.UpdateParams:input_type -> facundomedica.rps.v1.MsgUpdateParams
+	28, // 32: facundomedica.rps.v1.Msg.ResolveStuckGame:input_type -> facundomedica.rps.v1.MsgResolveStuckGame
+	1,  // 33: facundomedica.rps.v1.Msg.NewGame:output_type -> facundomedica.rps.v1.MsgNewGameResponse
+	5,  // 34: facundomedica.rps.v1.Msg.CommitMove:output_type -> facundomedica.rps.v1.MsgCommitMoveResponse
+	3,  // 35: facundomedica.rps.v1.Msg.CreateAndJoin:output_type -> facundomedica.rps.v1.MsgCreateAndJoinResponse
+	7,  // 36: facundomedica.rps.v1.Msg.RevealMove:output_type -> facundomedica.rps.v1.MsgRevealMoveResponse
+	9,  // 37: facundomedica.rps.v1.Msg.SetRevealAgent:output_type -> facundomedica.rps.v1.MsgSetRevealAgentResponse
+	11, // 38: facundomedica.rps.v1.Msg.EscrowReveal:output_type -> facundomedica.rps.v1.MsgEscrowRevealResponse
+	13, // 39: facundomedica.rps.v1.Msg.AgentRevealMove:output_type -> facundomedica.rps.v1.MsgAgentRevealMoveResponse
+	15, // 40: facundomedica.rps.v1.Msg.JoinQueue:output_type -> facundomedica.rps.v1.MsgJoinQueueResponse
+	17, // 41: facundomedica.rps.v1.Msg.LeaveQueue:output_type -> facundomedica.rps.v1.MsgLeaveQueueResponse
+	19, // 42: facundomedica.rps.v1.Msg.PlaceBet:output_type -> facundomedica.rps.v1.MsgPlaceBetResponse
+	21, // 43: facundomedica.rps.v1.Msg.PlayHouse:output_type -> facundomedica.rps.v1.MsgPlayHouseResponse
+	23, // 44: facundomedica.rps.v1.Msg.FundHouse:output_type -> facundomedica.rps.v1.MsgFundHouseResponse
+	25, // 45: facundomedica.rps.v1.Msg.OfferRematch:output_type -> facundomedica.rps.v1.MsgOfferRematchResponse
+	27, // 46: facundomedica.rps.v1.Msg.UpdateParams:output_type -> facundomedica.rps.v1.MsgUpdateParamsResponse
+	29, // 47: facundomedica.rps.v1.Msg.ResolveStuckGame:output_type -> facundomedica.rps.v1.MsgResolveStuckGameResponse
+	33, // [33:48] is the sub-list for method output_type
+	18, // [18:33] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_facundomedica_rps_v1_tx_proto_init() }
@@ -4215,7 +17166,7 @@ func file_facundomedica_rps_v1_tx_proto_init() {
 			}
 		}
 		file_facundomedica_rps_v1_tx_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCommitMove); i {
+			switch v := v.(*MsgCreateAndJoin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4227,7 +17178,7 @@ func file_facundomedica_rps_v1_tx_proto_init() {
 			}
 		}
 		file_facundomedica_rps_v1_tx_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCommitMoveResponse); i {
+			switch v := v.(*MsgCreateAndJoinResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4239,7 +17190,7 @@ func file_facundomedica_rps_v1_tx_proto_init() {
 			}
 		}
 		file_facundomedica_rps_v1_tx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRevealMove); i {
+			switch v := v.(*MsgCommitMove); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4251,7 +17202,7 @@ func file_facundomedica_rps_v1_tx_proto_init() {
 			}
 		}
 		file_facundomedica_rps_v1_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRevealMoveResponse); i {
+			switch v := v.(*MsgCommitMoveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4263,7 +17214,7 @@ func file_facundomedica_rps_v1_tx_proto_init() {
 			}
 		}
 		file_facundomedica_rps_v1_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateParams); i {
+			switch v := v.(*MsgRevealMove); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4275,6 +17226,246 @@ func file_facundomedica_rps_v1_tx_proto_init() {
 			}
 		}
 		file_facundomedica_rps_v1_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRevealMoveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetRevealAgent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetRevealAgentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgEscrowReveal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgEscrowRevealResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAgentRevealMove); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAgentRevealMoveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgJoinQueue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgJoinQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgLeaveQueue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgLeaveQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPlaceBet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPlaceBetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPlayHouse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPlayHouseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgFundHouse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgFundHouseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgOfferRematch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgOfferRematchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgUpdateParamsResponse); i {
 			case 0:
 				return &v.state
@@ -4286,6 +17477,30 @@ func file_facundomedica_rps_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgResolveStuckGame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_tx_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgResolveStuckGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4293,7 +17508,7 @@ func file_facundomedica_rps_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_facundomedica_rps_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_NewGame_FullMethodName      = "/facundomedica.rps.v1.Msg/NewGame"
-	Msg_CommitMove_FullMethodName   = "/facundomedica.rps.v1.Msg/CommitMove"
-	Msg_RevealMove_FullMethodName   = "/facundomedica.rps.v1.Msg/RevealMove"
-	Msg_UpdateParams_FullMethodName = "/facundomedica.rps.v1.Msg/UpdateParams"
+	Msg_NewGame_FullMethodName          = "/facundomedica.rps.v1.Msg/NewGame"
+	Msg_CommitMove_FullMethodName       = "/facundomedica.rps.v1.Msg/CommitMove"
+	Msg_CreateAndJoin_FullMethodName    = "/facundomedica.rps.v1.Msg/CreateAndJoin"
+	Msg_RevealMove_FullMethodName       = "/facundomedica.rps.v1.Msg/RevealMove"
+	Msg_SetRevealAgent_FullMethodName   = "/facundomedica.rps.v1.Msg/SetRevealAgent"
+	Msg_EscrowReveal_FullMethodName     = "/facundomedica.rps.v1.Msg/EscrowReveal"
+	Msg_AgentRevealMove_FullMethodName  = "/facundomedica.rps.v1.Msg/AgentRevealMove"
+	Msg_JoinQueue_FullMethodName        = "/facundomedica.rps.v1.Msg/JoinQueue"
+	Msg_LeaveQueue_FullMethodName       = "/facundomedica.rps.v1.Msg/LeaveQueue"
+	Msg_PlaceBet_FullMethodName         = "/facundomedica.rps.v1.Msg/PlaceBet"
+	Msg_PlayHouse_FullMethodName        = "/facundomedica.rps.v1.Msg/PlayHouse"
+	Msg_FundHouse_FullMethodName        = "/facundomedica.rps.v1.Msg/FundHouse"
+	Msg_OfferRematch_FullMethodName     = "/facundomedica.rps.v1.Msg/OfferRematch"
+	Msg_UpdateParams_FullMethodName     = "/facundomedica.rps.v1.Msg/UpdateParams"
+	Msg_ResolveStuckGame_FullMethodName = "/facundomedica.rps.v1.Msg/ResolveStuckGame"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,9 +42,43 @@ const (
 type MsgClient interface {
 	NewGame(ctx context.Context, in *MsgNewGame, opts ...grpc.CallOption) (*MsgNewGameResponse, error)
 	CommitMove(ctx context.Context, in *MsgCommitMove, opts ...grpc.CallOption) (*MsgCommitMoveResponse, error)
+	// CreateAndJoin creates a game between two players that already agreed to
+	// play, committing both moves at once. It must be signed by both players.
+	CreateAndJoin(ctx context.Context, in *MsgCreateAndJoin, opts ...grpc.CallOption) (*MsgCreateAndJoinResponse, error)
 	RevealMove(ctx context.Context, in *MsgRevealMove, opts ...grpc.CallOption) (*MsgRevealMoveResponse, error)
+	// SetRevealAgent registers the address allowed to reveal moves on behalf of
+	// the player.
+	SetRevealAgent(ctx context.Context, in *MsgSetRevealAgent, opts ...grpc.CallOption) (*MsgSetRevealAgentResponse, error)
+	// EscrowReveal stores the reveal of a move encrypted for the reveal agent,
+	// so the agent can reveal it if the player goes offline.
+	EscrowReveal(ctx context.Context, in *MsgEscrowReveal, opts ...grpc.CallOption) (*MsgEscrowRevealResponse, error)
+	// AgentRevealMove reveals the move of a player, signed by their reveal
+	// agent. The reveal is checked against the player's commitment.
+	AgentRevealMove(ctx context.Context, in *MsgAgentRevealMove, opts ...grpc.CallOption) (*MsgAgentRevealMoveResponse, error)
+	// JoinQueue joins the matchmaking queue, the player is paired with the
+	// player waiting with an overlapping stake range for the highest stake, or
+	// enqueued if there's none. A player can only wait once for each denom.
+	JoinQueue(ctx context.Context, in *MsgJoinQueue, opts ...grpc.CallOption) (*MsgJoinQueueResponse, error)
+	// LeaveQueue leaves the matchmaking queue, refunding the entry fee.
+	LeaveQueue(ctx context.Context, in *MsgLeaveQueue, opts ...grpc.CallOption) (*MsgLeaveQueueResponse, error)
+	// PlaceBet places a spectator bet on the outcome of a game that is still in
+	// its commit phase.
+	PlaceBet(ctx context.Context, in *MsgPlaceBet, opts ...grpc.CallOption) (*MsgPlaceBetResponse, error)
+	// PlayHouse starts a game against the house, which matches the entry fee
+	// with funds from the house bankroll.
+	PlayHouse(ctx context.Context, in *MsgPlayHouse, opts ...grpc.CallOption) (*MsgPlayHouseResponse, error)
+	// FundHouse moves funds from the community pool to the house bankroll, it
+	// can only be executed by the module authority.
+	FundHouse(ctx context.Context, in *MsgFundHouse, opts ...grpc.CallOption) (*MsgFundHouseResponse, error)
+	// OfferRematch creates a game reserved for the opponent of a settled game,
+	// with the same stakes and rules. The opponent accepts it with
+	// MsgCommitMove.
+	OfferRematch(ctx context.Context, in *MsgOfferRematch, opts ...grpc.CallOption) (*MsgOfferRematchResponse, error)
 	// UpdateParams updates the module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ResolveStuckGame resolves a game that was quarantined by the EndBlocker
+	// because it couldn't be settled.
+	ResolveStuckGame(ctx context.Context, in *MsgResolveStuckGame, opts ...grpc.CallOption) (*MsgResolveStuckGameResponse, error)
 }
 
 type msgClient struct {
@@ -62,6 +107,15 @@ func (c *msgClient) CommitMove(ctx context.Context, in *MsgCommitMove, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CreateAndJoin(ctx context.Context, in *MsgCreateAndJoin, opts ...grpc.CallOption) (*MsgCreateAndJoinResponse, error) {
+	out := new(MsgCreateAndJoinResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateAndJoin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) RevealMove(ctx context.Context, in *MsgRevealMove, opts ...grpc.CallOption) (*MsgRevealMoveResponse, error) {
 	out := new(MsgRevealMoveResponse)
 	err := c.cc.Invoke(ctx, Msg_RevealMove_FullMethodName, in, out, opts...)
@@ -71,6 +125,87 @@ func (c *msgClient) RevealMove(ctx context.Context, in *MsgRevealMove, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) SetRevealAgent(ctx context.Context, in *MsgSetRevealAgent, opts ...grpc.CallOption) (*MsgSetRevealAgentResponse, error) {
+	out := new(MsgSetRevealAgentResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRevealAgent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EscrowReveal(ctx context.Context, in *MsgEscrowReveal, opts ...grpc.CallOption) (*MsgEscrowRevealResponse, error) {
+	out := new(MsgEscrowRevealResponse)
+	err := c.cc.Invoke(ctx, Msg_EscrowReveal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AgentRevealMove(ctx context.Context, in *MsgAgentRevealMove, opts ...grpc.CallOption) (*MsgAgentRevealMoveResponse, error) {
+	out := new(MsgAgentRevealMoveResponse)
+	err := c.cc.Invoke(ctx, Msg_AgentRevealMove_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) JoinQueue(ctx context.Context, in *MsgJoinQueue, opts ...grpc.CallOption) (*MsgJoinQueueResponse, error) {
+	out := new(MsgJoinQueueResponse)
+	err := c.cc.Invoke(ctx, Msg_JoinQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LeaveQueue(ctx context.Context, in *MsgLeaveQueue, opts ...grpc.CallOption) (*MsgLeaveQueueResponse, error) {
+	out := new(MsgLeaveQueueResponse)
+	err := c.cc.Invoke(ctx, Msg_LeaveQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlaceBet(ctx context.Context, in *MsgPlaceBet, opts ...grpc.CallOption) (*MsgPlaceBetResponse, error) {
+	out := new(MsgPlaceBetResponse)
+	err := c.cc.Invoke(ctx, Msg_PlaceBet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlayHouse(ctx context.Context, in *MsgPlayHouse, opts ...grpc.CallOption) (*MsgPlayHouseResponse, error) {
+	out := new(MsgPlayHouseResponse)
+	err := c.cc.Invoke(ctx, Msg_PlayHouse_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundHouse(ctx context.Context, in *MsgFundHouse, opts ...grpc.CallOption) (*MsgFundHouseResponse, error) {
+	out := new(MsgFundHouseResponse)
+	err := c.cc.Invoke(ctx, Msg_FundHouse_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OfferRematch(ctx context.Context, in *MsgOfferRematch, opts ...grpc.CallOption) (*MsgOfferRematchResponse, error) {
+	out := new(MsgOfferRematchResponse)
+	err := c.cc.Invoke(ctx, Msg_OfferRematch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -80,15 +215,58 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ResolveStuckGame(ctx context.Context, in *MsgResolveStuckGame, opts ...grpc.CallOption) (*MsgResolveStuckGameResponse, error) {
+	out := new(MsgResolveStuckGameResponse)
+	err := c.cc.Invoke(ctx, Msg_ResolveStuckGame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	NewGame(context.Context, *MsgNewGame) (*MsgNewGameResponse, error)
 	CommitMove(context.Context, *MsgCommitMove) (*MsgCommitMoveResponse, error)
+	// CreateAndJoin creates a game between two players that already agreed to
+	// play, committing both moves at once. It must be signed by both players.
+	CreateAndJoin(context.Context, *MsgCreateAndJoin) (*MsgCreateAndJoinResponse, error)
 	RevealMove(context.Context, *MsgRevealMove) (*MsgRevealMoveResponse, error)
+	// SetRevealAgent registers the address allowed to reveal moves on behalf of
+	// the player.
+	SetRevealAgent(context.Context, *MsgSetRevealAgent) (*MsgSetRevealAgentResponse, error)
+	// EscrowReveal stores the reveal of a move encrypted for the reveal agent,
+	// so the agent can reveal it if the player goes offline.
+	EscrowReveal(context.Context, *MsgEscrowReveal) (*MsgEscrowRevealResponse, error)
+	// AgentRevealMove reveals the move of a player, signed by their reveal
+	// agent. The reveal is checked against the player's commitment.
+	AgentRevealMove(context.Context, *MsgAgentRevealMove) (*MsgAgentRevealMoveResponse, error)
+	// JoinQueue joins the matchmaking queue, the player is paired with the
+	// player waiting with an overlapping stake range for the highest stake, or
+	// enqueued if there's none. A player can only wait once for each denom.
+	JoinQueue(context.Context, *MsgJoinQueue) (*MsgJoinQueueResponse, error)
+	// LeaveQueue leaves the matchmaking queue, refunding the entry fee.
+	LeaveQueue(context.Context, *MsgLeaveQueue) (*MsgLeaveQueueResponse, error)
+	// PlaceBet places a spectator bet on the outcome of a game that is still in
+	// its commit phase.
+	PlaceBet(context.Context, *MsgPlaceBet) (*MsgPlaceBetResponse, error)
+	// PlayHouse starts a game against the house, which matches the entry fee
+	// with funds from the house bankroll.
+	PlayHouse(context.Context, *MsgPlayHouse) (*MsgPlayHouseResponse, error)
+	// FundHouse moves funds from the community pool to the house bankroll, it
+	// can only be executed by the module authority.
+	FundHouse(context.Context, *MsgFundHouse) (*MsgFundHouseResponse, error)
+	// OfferRematch creates a game reserved for the opponent of a settled game,
+	// with the same stakes and rules. The opponent accepts it with
+	// MsgCommitMove.
+	OfferRematch(context.Context, *MsgOfferRematch) (*MsgOfferRematchResponse, error)
 	// UpdateParams updates the module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ResolveStuckGame resolves a game that was quarantined by the EndBlocker
+	// because it couldn't be settled.
+	ResolveStuckGame(context.Context, *MsgResolveStuckGame) (*MsgResolveStuckGameResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -102,12 +280,45 @@ func (UnimplementedMsgServer) NewGame(context.Context, *MsgNewGame) (*MsgNewGame
 func (UnimplementedMsgServer) CommitMove(context.Context, *MsgCommitMove) (*MsgCommitMoveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommitMove not implemented")
 }
+func (UnimplementedMsgServer) CreateAndJoin(context.Context, *MsgCreateAndJoin) (*MsgCreateAndJoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAndJoin not implemented")
+}
 func (UnimplementedMsgServer) RevealMove(context.Context, *MsgRevealMove) (*MsgRevealMoveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevealMove not implemented")
 }
+func (UnimplementedMsgServer) SetRevealAgent(context.Context, *MsgSetRevealAgent) (*MsgSetRevealAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRevealAgent not implemented")
+}
+func (UnimplementedMsgServer) EscrowReveal(context.Context, *MsgEscrowReveal) (*MsgEscrowRevealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowReveal not implemented")
+}
+func (UnimplementedMsgServer) AgentRevealMove(context.Context, *MsgAgentRevealMove) (*MsgAgentRevealMoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AgentRevealMove not implemented")
+}
+func (UnimplementedMsgServer) JoinQueue(context.Context, *MsgJoinQueue) (*MsgJoinQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinQueue not implemented")
+}
+func (UnimplementedMsgServer) LeaveQueue(context.Context, *MsgLeaveQueue) (*MsgLeaveQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveQueue not implemented")
+}
+func (UnimplementedMsgServer) PlaceBet(context.Context, *MsgPlaceBet) (*MsgPlaceBetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBet not implemented")
+}
+func (UnimplementedMsgServer) PlayHouse(context.Context, *MsgPlayHouse) (*MsgPlayHouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayHouse not implemented")
+}
+func (UnimplementedMsgServer) FundHouse(context.Context, *MsgFundHouse) (*MsgFundHouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundHouse not implemented")
+}
+func (UnimplementedMsgServer) OfferRematch(context.Context, *MsgOfferRematch) (*MsgOfferRematchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OfferRematch not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) ResolveStuckGame(context.Context, *MsgResolveStuckGame) (*MsgResolveStuckGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveStuckGame not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -157,6 +368,24 @@ func _Msg_CommitMove_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateAndJoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAndJoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAndJoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateAndJoin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAndJoin(ctx, req.(*MsgCreateAndJoin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_RevealMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRevealMove)
 	if err := dec(in); err != nil {
@@ -175,6 +404,168 @@ func _Msg_RevealMove_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetRevealAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRevealAgent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRevealAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRevealAgent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRevealAgent(ctx, req.(*MsgSetRevealAgent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EscrowReveal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEscrowReveal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EscrowReveal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_EscrowReveal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EscrowReveal(ctx, req.(*MsgEscrowReveal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AgentRevealMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAgentRevealMove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AgentRevealMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AgentRevealMove_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AgentRevealMove(ctx, req.(*MsgAgentRevealMove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_JoinQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinQueue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).JoinQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_JoinQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinQueue(ctx, req.(*MsgJoinQueue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LeaveQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLeaveQueue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LeaveQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LeaveQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LeaveQueue(ctx, req.(*MsgLeaveQueue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceBet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceBet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceBet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PlaceBet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceBet(ctx, req.(*MsgPlaceBet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlayHouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlayHouse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlayHouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PlayHouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlayHouse(ctx, req.(*MsgPlayHouse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundHouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundHouse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundHouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FundHouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundHouse(ctx, req.(*MsgFundHouse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OfferRematch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOfferRematch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OfferRematch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_OfferRematch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OfferRematch(ctx, req.(*MsgOfferRematch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -193,6 +584,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ResolveStuckGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveStuckGame)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveStuckGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResolveStuckGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveStuckGame(ctx, req.(*MsgResolveStuckGame))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -208,14 +617,58 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CommitMove",
 			Handler:    _Msg_CommitMove_Handler,
 		},
+		{
+			MethodName: "CreateAndJoin",
+			Handler:    _Msg_CreateAndJoin_Handler,
+		},
 		{
 			MethodName: "RevealMove",
 			Handler:    _Msg_RevealMove_Handler,
 		},
+		{
+			MethodName: "SetRevealAgent",
+			Handler:    _Msg_SetRevealAgent_Handler,
+		},
+		{
+			MethodName: "EscrowReveal",
+			Handler:    _Msg_EscrowReveal_Handler,
+		},
+		{
+			MethodName: "AgentRevealMove",
+			Handler:    _Msg_AgentRevealMove_Handler,
+		},
+		{
+			MethodName: "JoinQueue",
+			Handler:    _Msg_JoinQueue_Handler,
+		},
+		{
+			MethodName: "LeaveQueue",
+			Handler:    _Msg_LeaveQueue_Handler,
+		},
+		{
+			MethodName: "PlaceBet",
+			Handler:    _Msg_PlaceBet_Handler,
+		},
+		{
+			MethodName: "PlayHouse",
+			Handler:    _Msg_PlayHouse_Handler,
+		},
+		{
+			MethodName: "FundHouse",
+			Handler:    _Msg_FundHouse_Handler,
+		},
+		{
+			MethodName: "OfferRematch",
+			Handler:    _Msg_OfferRematch_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ResolveStuckGame",
+			Handler:    _Msg_ResolveStuckGame_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "facundomedica/rps/v1/tx.proto",
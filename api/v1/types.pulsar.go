@@ -17,10 +17,276 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_13_list)(nil)
+
+type _Params_13_list struct {
+	list *[]string
+}
+
+func (x *_Params_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_13_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AllowedDenoms as it is not of Message kind"))
+}
+
+func (x *_Params_13_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_13_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_17_list)(nil)
+
+type _Params_17_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_17_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_17_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_19_list)(nil)
+
+type _Params_19_list struct {
+	list *[]CommitmentScheme
+}
+
+func (x *_Params_19_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_19_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
+}
+
+func (x *_Params_19_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (CommitmentScheme)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_19_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (CommitmentScheme)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_19_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field CommitmentSchemes as it is not of Message kind"))
+}
+
+func (x *_Params_19_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_19_list) NewElement() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_Params_19_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_22_list)(nil)
+
+type _Params_22_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_22_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_22_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_22_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_22_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_22_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_22_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_22_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_22_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_23_list)(nil)
+
+type _Params_23_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_23_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_23_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_23_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_23_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_23_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_23_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_23_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_23_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                protoreflect.MessageDescriptor
-	fd_Params_commit_timeout protoreflect.FieldDescriptor
-	fd_Params_reveal_timeout protoreflect.FieldDescriptor
+	md_Params                           protoreflect.MessageDescriptor
+	fd_Params_commit_timeout            protoreflect.FieldDescriptor
+	fd_Params_reveal_timeout            protoreflect.FieldDescriptor
+	fd_Params_max_settlements_per_block protoreflect.FieldDescriptor
+	fd_Params_timeout_mode              protoreflect.FieldDescriptor
+	fd_Params_commit_timeout_blocks     protoreflect.FieldDescriptor
+	fd_Params_reveal_timeout_blocks     protoreflect.FieldDescriptor
+	fd_Params_min_timeout               protoreflect.FieldDescriptor
+	fd_Params_max_timeout               protoreflect.FieldDescriptor
+	fd_Params_min_timeout_blocks        protoreflect.FieldDescriptor
+	fd_Params_max_timeout_blocks        protoreflect.FieldDescriptor
+	fd_Params_no_show_penalty           protoreflect.FieldDescriptor
+	fd_Params_no_show_cooldown          protoreflect.FieldDescriptor
+	fd_Params_allowed_denoms            protoreflect.FieldDescriptor
+	fd_Params_betting_fee               protoreflect.FieldDescriptor
+	fd_Params_jackpot_fee               protoreflect.FieldDescriptor
+	fd_Params_jackpot_streak            protoreflect.FieldDescriptor
+	fd_Params_house_max_exposure        protoreflect.FieldDescriptor
+	fd_Params_rematch_window            protoreflect.FieldDescriptor
+	fd_Params_commitment_schemes        protoreflect.FieldDescriptor
+	fd_Params_queue_match_grace         protoreflect.FieldDescriptor
+	fd_Params_queue_match_grace_blocks  protoreflect.FieldDescriptor
+	fd_Params_jackpot_min_stake         protoreflect.FieldDescriptor
+	fd_Params_house_max_open_exposure   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -28,6 +294,27 @@ func init() {
 	md_Params = File_facundomedica_rps_v1_types_proto.Messages().ByName("Params")
 	fd_Params_commit_timeout = md_Params.Fields().ByName("commit_timeout")
 	fd_Params_reveal_timeout = md_Params.Fields().ByName("reveal_timeout")
+	fd_Params_max_settlements_per_block = md_Params.Fields().ByName("max_settlements_per_block")
+	fd_Params_timeout_mode = md_Params.Fields().ByName("timeout_mode")
+	fd_Params_commit_timeout_blocks = md_Params.Fields().ByName("commit_timeout_blocks")
+	fd_Params_reveal_timeout_blocks = md_Params.Fields().ByName("reveal_timeout_blocks")
+	fd_Params_min_timeout = md_Params.Fields().ByName("min_timeout")
+	fd_Params_max_timeout = md_Params.Fields().ByName("max_timeout")
+	fd_Params_min_timeout_blocks = md_Params.Fields().ByName("min_timeout_blocks")
+	fd_Params_max_timeout_blocks = md_Params.Fields().ByName("max_timeout_blocks")
+	fd_Params_no_show_penalty = md_Params.Fields().ByName("no_show_penalty")
+	fd_Params_no_show_cooldown = md_Params.Fields().ByName("no_show_cooldown")
+	fd_Params_allowed_denoms = md_Params.Fields().ByName("allowed_denoms")
+	fd_Params_betting_fee = md_Params.Fields().ByName("betting_fee")
+	fd_Params_jackpot_fee = md_Params.Fields().ByName("jackpot_fee")
+	fd_Params_jackpot_streak = md_Params.Fields().ByName("jackpot_streak")
+	fd_Params_house_max_exposure = md_Params.Fields().ByName("house_max_exposure")
+	fd_Params_rematch_window = md_Params.Fields().ByName("rematch_window")
+	fd_Params_commitment_schemes = md_Params.Fields().ByName("commitment_schemes")
+	fd_Params_queue_match_grace = md_Params.Fields().ByName("queue_match_grace")
+	fd_Params_queue_match_grace_blocks = md_Params.Fields().ByName("queue_match_grace_blocks")
+	fd_Params_jackpot_min_stake = md_Params.Fields().ByName("jackpot_min_stake")
+	fd_Params_house_max_open_exposure = md_Params.Fields().ByName("house_max_open_exposure")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -107,6 +394,132 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MaxSettlementsPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxSettlementsPerBlock)
+		if !f(fd_Params_max_settlements_per_block, value) {
+			return
+		}
+	}
+	if x.TimeoutMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TimeoutMode))
+		if !f(fd_Params_timeout_mode, value) {
+			return
+		}
+	}
+	if x.CommitTimeoutBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CommitTimeoutBlocks)
+		if !f(fd_Params_commit_timeout_blocks, value) {
+			return
+		}
+	}
+	if x.RevealTimeoutBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RevealTimeoutBlocks)
+		if !f(fd_Params_reveal_timeout_blocks, value) {
+			return
+		}
+	}
+	if x.MinTimeout != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinTimeout)
+		if !f(fd_Params_min_timeout, value) {
+			return
+		}
+	}
+	if x.MaxTimeout != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTimeout)
+		if !f(fd_Params_max_timeout, value) {
+			return
+		}
+	}
+	if x.MinTimeoutBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinTimeoutBlocks)
+		if !f(fd_Params_min_timeout_blocks, value) {
+			return
+		}
+	}
+	if x.MaxTimeoutBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTimeoutBlocks)
+		if !f(fd_Params_max_timeout_blocks, value) {
+			return
+		}
+	}
+	if x.NoShowPenalty != "" {
+		value := protoreflect.ValueOfString(x.NoShowPenalty)
+		if !f(fd_Params_no_show_penalty, value) {
+			return
+		}
+	}
+	if x.NoShowCooldown != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NoShowCooldown)
+		if !f(fd_Params_no_show_cooldown, value) {
+			return
+		}
+	}
+	if len(x.AllowedDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Params_13_list{list: &x.AllowedDenoms})
+		if !f(fd_Params_allowed_denoms, value) {
+			return
+		}
+	}
+	if x.BettingFee != "" {
+		value := protoreflect.ValueOfString(x.BettingFee)
+		if !f(fd_Params_betting_fee, value) {
+			return
+		}
+	}
+	if x.JackpotFee != "" {
+		value := protoreflect.ValueOfString(x.JackpotFee)
+		if !f(fd_Params_jackpot_fee, value) {
+			return
+		}
+	}
+	if x.JackpotStreak != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.JackpotStreak)
+		if !f(fd_Params_jackpot_streak, value) {
+			return
+		}
+	}
+	if len(x.HouseMaxExposure) != 0 {
+		value := protoreflect.ValueOfList(&_Params_17_list{list: &x.HouseMaxExposure})
+		if !f(fd_Params_house_max_exposure, value) {
+			return
+		}
+	}
+	if x.RematchWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RematchWindow)
+		if !f(fd_Params_rematch_window, value) {
+			return
+		}
+	}
+	if len(x.CommitmentSchemes) != 0 {
+		value := protoreflect.ValueOfList(&_Params_19_list{list: &x.CommitmentSchemes})
+		if !f(fd_Params_commitment_schemes, value) {
+			return
+		}
+	}
+	if x.QueueMatchGrace != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.QueueMatchGrace)
+		if !f(fd_Params_queue_match_grace, value) {
+			return
+		}
+	}
+	if x.QueueMatchGraceBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.QueueMatchGraceBlocks)
+		if !f(fd_Params_queue_match_grace_blocks, value) {
+			return
+		}
+	}
+	if len(x.JackpotMinStake) != 0 {
+		value := protoreflect.ValueOfList(&_Params_22_list{list: &x.JackpotMinStake})
+		if !f(fd_Params_jackpot_min_stake, value) {
+			return
+		}
+	}
+	if len(x.HouseMaxOpenExposure) != 0 {
+		value := protoreflect.ValueOfList(&_Params_23_list{list: &x.HouseMaxOpenExposure})
+		if !f(fd_Params_house_max_open_exposure, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -126,6 +539,48 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.CommitTimeout != uint64(0)
 	case "facundomedica.rps.v1.Params.reveal_timeout":
 		return x.RevealTimeout != uint64(0)
+	case "facundomedica.rps.v1.Params.max_settlements_per_block":
+		return x.MaxSettlementsPerBlock != uint64(0)
+	case "facundomedica.rps.v1.Params.timeout_mode":
+		return x.TimeoutMode != 0
+	case "facundomedica.rps.v1.Params.commit_timeout_blocks":
+		return x.CommitTimeoutBlocks != uint64(0)
+	case "facundomedica.rps.v1.Params.reveal_timeout_blocks":
+		return x.RevealTimeoutBlocks != uint64(0)
+	case "facundomedica.rps.v1.Params.min_timeout":
+		return x.MinTimeout != uint64(0)
+	case "facundomedica.rps.v1.Params.max_timeout":
+		return x.MaxTimeout != uint64(0)
+	case "facundomedica.rps.v1.Params.min_timeout_blocks":
+		return x.MinTimeoutBlocks != uint64(0)
+	case "facundomedica.rps.v1.Params.max_timeout_blocks":
+		return x.MaxTimeoutBlocks != uint64(0)
+	case "facundomedica.rps.v1.Params.no_show_penalty":
+		return x.NoShowPenalty != ""
+	case "facundomedica.rps.v1.Params.no_show_cooldown":
+		return x.NoShowCooldown != uint64(0)
+	case "facundomedica.rps.v1.Params.allowed_denoms":
+		return len(x.AllowedDenoms) != 0
+	case "facundomedica.rps.v1.Params.betting_fee":
+		return x.BettingFee != ""
+	case "facundomedica.rps.v1.Params.jackpot_fee":
+		return x.JackpotFee != ""
+	case "facundomedica.rps.v1.Params.jackpot_streak":
+		return x.JackpotStreak != uint64(0)
+	case "facundomedica.rps.v1.Params.house_max_exposure":
+		return len(x.HouseMaxExposure) != 0
+	case "facundomedica.rps.v1.Params.rematch_window":
+		return x.RematchWindow != uint64(0)
+	case "facundomedica.rps.v1.Params.commitment_schemes":
+		return len(x.CommitmentSchemes) != 0
+	case "facundomedica.rps.v1.Params.queue_match_grace":
+		return x.QueueMatchGrace != uint64(0)
+	case "facundomedica.rps.v1.Params.queue_match_grace_blocks":
+		return x.QueueMatchGraceBlocks != uint64(0)
+	case "facundomedica.rps.v1.Params.jackpot_min_stake":
+		return len(x.JackpotMinStake) != 0
+	case "facundomedica.rps.v1.Params.house_max_open_exposure":
+		return len(x.HouseMaxOpenExposure) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Params"))
@@ -146,6 +601,48 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.CommitTimeout = uint64(0)
 	case "facundomedica.rps.v1.Params.reveal_timeout":
 		x.RevealTimeout = uint64(0)
+	case "facundomedica.rps.v1.Params.max_settlements_per_block":
+		x.MaxSettlementsPerBlock = uint64(0)
+	case "facundomedica.rps.v1.Params.timeout_mode":
+		x.TimeoutMode = 0
+	case "facundomedica.rps.v1.Params.commit_timeout_blocks":
+		x.CommitTimeoutBlocks = uint64(0)
+	case "facundomedica.rps.v1.Params.reveal_timeout_blocks":
+		x.RevealTimeoutBlocks = uint64(0)
+	case "facundomedica.rps.v1.Params.min_timeout":
+		x.MinTimeout = uint64(0)
+	case "facundomedica.rps.v1.Params.max_timeout":
+		x.MaxTimeout = uint64(0)
+	case "facundomedica.rps.v1.Params.min_timeout_blocks":
+		x.MinTimeoutBlocks = uint64(0)
+	case "facundomedica.rps.v1.Params.max_timeout_blocks":
+		x.MaxTimeoutBlocks = uint64(0)
+	case "facundomedica.rps.v1.Params.no_show_penalty":
+		x.NoShowPenalty = ""
+	case "facundomedica.rps.v1.Params.no_show_cooldown":
+		x.NoShowCooldown = uint64(0)
+	case "facundomedica.rps.v1.Params.allowed_denoms":
+		x.AllowedDenoms = nil
+	case "facundomedica.rps.v1.Params.betting_fee":
+		x.BettingFee = ""
+	case "facundomedica.rps.v1.Params.jackpot_fee":
+		x.JackpotFee = ""
+	case "facundomedica.rps.v1.Params.jackpot_streak":
+		x.JackpotStreak = uint64(0)
+	case "facundomedica.rps.v1.Params.house_max_exposure":
+		x.HouseMaxExposure = nil
+	case "facundomedica.rps.v1.Params.rematch_window":
+		x.RematchWindow = uint64(0)
+	case "facundomedica.rps.v1.Params.commitment_schemes":
+		x.CommitmentSchemes = nil
+	case "facundomedica.rps.v1.Params.queue_match_grace":
+		x.QueueMatchGrace = uint64(0)
+	case "facundomedica.rps.v1.Params.queue_match_grace_blocks":
+		x.QueueMatchGraceBlocks = uint64(0)
+	case "facundomedica.rps.v1.Params.jackpot_min_stake":
+		x.JackpotMinStake = nil
+	case "facundomedica.rps.v1.Params.house_max_open_exposure":
+		x.HouseMaxOpenExposure = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Params"))
@@ -168,6 +665,84 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "facundomedica.rps.v1.Params.reveal_timeout":
 		value := x.RevealTimeout
 		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.Params.max_settlements_per_block":
+		value := x.MaxSettlementsPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.Params.timeout_mode":
+		value := x.TimeoutMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "facundomedica.rps.v1.Params.commit_timeout_blocks":
+		value := x.CommitTimeoutBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.Params.reveal_timeout_blocks":
+		value := x.RevealTimeoutBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.Params.min_timeout":
+		value := x.MinTimeout
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.Params.max_timeout":
+		value := x.MaxTimeout
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.Params.min_timeout_blocks":
+		value := x.MinTimeoutBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.Params.max_timeout_blocks":
+		value := x.MaxTimeoutBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.Params.no_show_penalty":
+		value := x.NoShowPenalty
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.Params.no_show_cooldown":
+		value := x.NoShowCooldown
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.Params.allowed_denoms":
+		if len(x.AllowedDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Params_13_list{})
+		}
+		listValue := &_Params_13_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "facundomedica.rps.v1.Params.betting_fee":
+		value := x.BettingFee
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.Params.jackpot_fee":
+		value := x.JackpotFee
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.Params.jackpot_streak":
+		value := x.JackpotStreak
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.Params.house_max_exposure":
+		if len(x.HouseMaxExposure) == 0 {
+			return protoreflect.ValueOfList(&_Params_17_list{})
+		}
+		listValue := &_Params_17_list{list: &x.HouseMaxExposure}
+		return protoreflect.ValueOfList(listValue)
+	case "facundomedica.rps.v1.Params.rematch_window":
+		value := x.RematchWindow
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.Params.commitment_schemes":
+		if len(x.CommitmentSchemes) == 0 {
+			return protoreflect.ValueOfList(&_Params_19_list{})
+		}
+		listValue := &_Params_19_list{list: &x.CommitmentSchemes}
+		return protoreflect.ValueOfList(listValue)
+	case "facundomedica.rps.v1.Params.queue_match_grace":
+		value := x.QueueMatchGrace
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.Params.queue_match_grace_blocks":
+		value := x.QueueMatchGraceBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.Params.jackpot_min_stake":
+		if len(x.JackpotMinStake) == 0 {
+			return protoreflect.ValueOfList(&_Params_22_list{})
+		}
+		listValue := &_Params_22_list{list: &x.JackpotMinStake}
+		return protoreflect.ValueOfList(listValue)
+	case "facundomedica.rps.v1.Params.house_max_open_exposure":
+		if len(x.HouseMaxOpenExposure) == 0 {
+			return protoreflect.ValueOfList(&_Params_23_list{})
+		}
+		listValue := &_Params_23_list{list: &x.HouseMaxOpenExposure}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Params"))
@@ -192,6 +767,58 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.CommitTimeout = value.Uint()
 	case "facundomedica.rps.v1.Params.reveal_timeout":
 		x.RevealTimeout = value.Uint()
+	case "facundomedica.rps.v1.Params.max_settlements_per_block":
+		x.MaxSettlementsPerBlock = value.Uint()
+	case "facundomedica.rps.v1.Params.timeout_mode":
+		x.TimeoutMode = (TimeoutMode)(value.Enum())
+	case "facundomedica.rps.v1.Params.commit_timeout_blocks":
+		x.CommitTimeoutBlocks = value.Uint()
+	case "facundomedica.rps.v1.Params.reveal_timeout_blocks":
+		x.RevealTimeoutBlocks = value.Uint()
+	case "facundomedica.rps.v1.Params.min_timeout":
+		x.MinTimeout = value.Uint()
+	case "facundomedica.rps.v1.Params.max_timeout":
+		x.MaxTimeout = value.Uint()
+	case "facundomedica.rps.v1.Params.min_timeout_blocks":
+		x.MinTimeoutBlocks = value.Uint()
+	case "facundomedica.rps.v1.Params.max_timeout_blocks":
+		x.MaxTimeoutBlocks = value.Uint()
+	case "facundomedica.rps.v1.Params.no_show_penalty":
+		x.NoShowPenalty = value.Interface().(string)
+	case "facundomedica.rps.v1.Params.no_show_cooldown":
+		x.NoShowCooldown = value.Uint()
+	case "facundomedica.rps.v1.Params.allowed_denoms":
+		lv := value.List()
+		clv := lv.(*_Params_13_list)
+		x.AllowedDenoms = *clv.list
+	case "facundomedica.rps.v1.Params.betting_fee":
+		x.BettingFee = value.Interface().(string)
+	case "facundomedica.rps.v1.Params.jackpot_fee":
+		x.JackpotFee = value.Interface().(string)
+	case "facundomedica.rps.v1.Params.jackpot_streak":
+		x.JackpotStreak = value.Uint()
+	case "facundomedica.rps.v1.Params.house_max_exposure":
+		lv := value.List()
+		clv := lv.(*_Params_17_list)
+		x.HouseMaxExposure = *clv.list
+	case "facundomedica.rps.v1.Params.rematch_window":
+		x.RematchWindow = value.Uint()
+	case "facundomedica.rps.v1.Params.commitment_schemes":
+		lv := value.List()
+		clv := lv.(*_Params_19_list)
+		x.CommitmentSchemes = *clv.list
+	case "facundomedica.rps.v1.Params.queue_match_grace":
+		x.QueueMatchGrace = value.Uint()
+	case "facundomedica.rps.v1.Params.queue_match_grace_blocks":
+		x.QueueMatchGraceBlocks = value.Uint()
+	case "facundomedica.rps.v1.Params.jackpot_min_stake":
+		lv := value.List()
+		clv := lv.(*_Params_22_list)
+		x.JackpotMinStake = *clv.list
+	case "facundomedica.rps.v1.Params.house_max_open_exposure":
+		lv := value.List()
+		clv := lv.(*_Params_23_list)
+		x.HouseMaxOpenExposure = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Params"))
@@ -212,10 +839,72 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "facundomedica.rps.v1.Params.allowed_denoms":
+		if x.AllowedDenoms == nil {
+			x.AllowedDenoms = []string{}
+		}
+		value := &_Params_13_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(value)
+	case "facundomedica.rps.v1.Params.house_max_exposure":
+		if x.HouseMaxExposure == nil {
+			x.HouseMaxExposure = []*v1beta1.Coin{}
+		}
+		value := &_Params_17_list{list: &x.HouseMaxExposure}
+		return protoreflect.ValueOfList(value)
+	case "facundomedica.rps.v1.Params.commitment_schemes":
+		if x.CommitmentSchemes == nil {
+			x.CommitmentSchemes = []CommitmentScheme{}
+		}
+		value := &_Params_19_list{list: &x.CommitmentSchemes}
+		return protoreflect.ValueOfList(value)
+	case "facundomedica.rps.v1.Params.jackpot_min_stake":
+		if x.JackpotMinStake == nil {
+			x.JackpotMinStake = []*v1beta1.Coin{}
+		}
+		value := &_Params_22_list{list: &x.JackpotMinStake}
+		return protoreflect.ValueOfList(value)
+	case "facundomedica.rps.v1.Params.house_max_open_exposure":
+		if x.HouseMaxOpenExposure == nil {
+			x.HouseMaxOpenExposure = []*v1beta1.Coin{}
+		}
+		value := &_Params_23_list{list: &x.HouseMaxOpenExposure}
+		return protoreflect.ValueOfList(value)
 	case "facundomedica.rps.v1.Params.commit_timeout":
 		panic(fmt.Errorf("field commit_timeout of message facundomedica.rps.v1.Params is not mutable"))
 	case "facundomedica.rps.v1.Params.reveal_timeout":
 		panic(fmt.Errorf("field reveal_timeout of message facundomedica.rps.v1.Params is not mutable"))
+	case "facundomedica.rps.v1.Params.max_settlements_per_block":
+		panic(fmt.Errorf("field max_settlements_per_block of message facundomedica.rps.v1.Params is not mutable"))
+	case "facundomedica.rps.v1.Params.timeout_mode":
+		panic(fmt.Errorf("field timeout_mode of message facundomedica.rps.v1.Params is not mutable"))
+	case "facundomedica.rps.v1.Params.commit_timeout_blocks":
+		panic(fmt.Errorf("field commit_timeout_blocks of message facundomedica.rps.v1.Params is not mutable"))
+	case "facundomedica.rps.v1.Params.reveal_timeout_blocks":
+		panic(fmt.Errorf("field reveal_timeout_blocks of message facundomedica.rps.v1.Params is not mutable"))
+	case "facundomedica.rps.v1.Params.min_timeout":
+		panic(fmt.Errorf("field min_timeout of message facundomedica.rps.v1.Params is not mutable"))
+	case "facundomedica.rps.v1.Params.max_timeout":
+		panic(fmt.Errorf("field max_timeout of message facundomedica.rps.v1.Params is not mutable"))
+	case "facundomedica.rps.v1.Params.min_timeout_blocks":
+		panic(fmt.Errorf("field min_timeout_blocks of message facundomedica.rps.v1.Params is not mutable"))
+	case "facundomedica.rps.v1.Params.max_timeout_blocks":
+		panic(fmt.Errorf("field max_timeout_blocks of message facundomedica.rps.v1.Params is not mutable"))
+	case "facundomedica.rps.v1.Params.no_show_penalty":
+		panic(fmt.Errorf("field no_show_penalty of message facundomedica.rps.v1.Params is not mutable"))
+	case "facundomedica.rps.v1.Params.no_show_cooldown":
+		panic(fmt.Errorf("field no_show_cooldown of message facundomedica.rps.v1.Params is not mutable"))
+	case "facundomedica.rps.v1.Params.betting_fee":
+		panic(fmt.Errorf("field betting_fee of message facundomedica.rps.v1.Params is not mutable"))
+	case "facundomedica.rps.v1.Params.jackpot_fee":
+		panic(fmt.Errorf("field jackpot_fee of message facundomedica.rps.v1.Params is not mutable"))
+	case "facundomedica.rps.v1.Params.jackpot_streak":
+		panic(fmt.Errorf("field jackpot_streak of message facundomedica.rps.v1.Params is not mutable"))
+	case "facundomedica.rps.v1.Params.rematch_window":
+		panic(fmt.Errorf("field rematch_window of message facundomedica.rps.v1.Params is not mutable"))
+	case "facundomedica.rps.v1.Params.queue_match_grace":
+		panic(fmt.Errorf("field queue_match_grace of message facundomedica.rps.v1.Params is not mutable"))
+	case "facundomedica.rps.v1.Params.queue_match_grace_blocks":
+		panic(fmt.Errorf("field queue_match_grace_blocks of message facundomedica.rps.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Params"))
@@ -233,6 +922,53 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "facundomedica.rps.v1.Params.reveal_timeout":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.Params.max_settlements_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.Params.timeout_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "facundomedica.rps.v1.Params.commit_timeout_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.Params.reveal_timeout_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.Params.min_timeout":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.Params.max_timeout":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.Params.min_timeout_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.Params.max_timeout_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.Params.no_show_penalty":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.Params.no_show_cooldown":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.Params.allowed_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_13_list{list: &list})
+	case "facundomedica.rps.v1.Params.betting_fee":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.Params.jackpot_fee":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.Params.jackpot_streak":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.Params.house_max_exposure":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_17_list{list: &list})
+	case "facundomedica.rps.v1.Params.rematch_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.Params.commitment_schemes":
+		list := []CommitmentScheme{}
+		return protoreflect.ValueOfList(&_Params_19_list{list: &list})
+	case "facundomedica.rps.v1.Params.queue_match_grace":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.Params.queue_match_grace_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.Params.jackpot_min_stake":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_22_list{list: &list})
+	case "facundomedica.rps.v1.Params.house_max_open_exposure":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_23_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Params"))
@@ -308,6 +1044,88 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.RevealTimeout != 0 {
 			n += 1 + runtime.Sov(uint64(x.RevealTimeout))
 		}
+		if x.MaxSettlementsPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxSettlementsPerBlock))
+		}
+		if x.TimeoutMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutMode))
+		}
+		if x.CommitTimeoutBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.CommitTimeoutBlocks))
+		}
+		if x.RevealTimeoutBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.RevealTimeoutBlocks))
+		}
+		if x.MinTimeout != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinTimeout))
+		}
+		if x.MaxTimeout != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTimeout))
+		}
+		if x.MinTimeoutBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinTimeoutBlocks))
+		}
+		if x.MaxTimeoutBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTimeoutBlocks))
+		}
+		l = len(x.NoShowPenalty)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NoShowCooldown != 0 {
+			n += 1 + runtime.Sov(uint64(x.NoShowCooldown))
+		}
+		if len(x.AllowedDenoms) > 0 {
+			for _, s := range x.AllowedDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.BettingFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.JackpotFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.JackpotStreak != 0 {
+			n += 2 + runtime.Sov(uint64(x.JackpotStreak))
+		}
+		if len(x.HouseMaxExposure) > 0 {
+			for _, e := range x.HouseMaxExposure {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RematchWindow != 0 {
+			n += 2 + runtime.Sov(uint64(x.RematchWindow))
+		}
+		if len(x.CommitmentSchemes) > 0 {
+			l = 0
+			for _, e := range x.CommitmentSchemes {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 2 + runtime.Sov(uint64(l)) + l
+		}
+		if x.QueueMatchGrace != 0 {
+			n += 2 + runtime.Sov(uint64(x.QueueMatchGrace))
+		}
+		if x.QueueMatchGraceBlocks != 0 {
+			n += 2 + runtime.Sov(uint64(x.QueueMatchGraceBlocks))
+		}
+		if len(x.JackpotMinStake) > 0 {
+			for _, e := range x.JackpotMinStake {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.HouseMaxOpenExposure) > 0 {
+			for _, e := range x.HouseMaxOpenExposure {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -337,18 +1155,198 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.RevealTimeout != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RevealTimeout))
+		if len(x.HouseMaxOpenExposure) > 0 {
+			for iNdEx := len(x.HouseMaxOpenExposure) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.HouseMaxOpenExposure[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xba
+			}
+		}
+		if len(x.JackpotMinStake) > 0 {
+			for iNdEx := len(x.JackpotMinStake) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.JackpotMinStake[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xb2
+			}
+		}
+		if x.QueueMatchGraceBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.QueueMatchGraceBlocks))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa8
 		}
-		if x.CommitTimeout != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommitTimeout))
+		if x.QueueMatchGrace != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.QueueMatchGrace))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
+		if len(x.CommitmentSchemes) > 0 {
+			var pksize2 int
+			for _, num := range x.CommitmentSchemes {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.CommitmentSchemes {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if x.RematchWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RematchWindow))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if len(x.HouseMaxExposure) > 0 {
+			for iNdEx := len(x.HouseMaxExposure) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.HouseMaxExposure[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if x.JackpotStreak != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.JackpotStreak))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if len(x.JackpotFee) > 0 {
+			i -= len(x.JackpotFee)
+			copy(dAtA[i:], x.JackpotFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.JackpotFee)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.BettingFee) > 0 {
+			i -= len(x.BettingFee)
+			copy(dAtA[i:], x.BettingFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BettingFee)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.AllowedDenoms) > 0 {
+			for iNdEx := len(x.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedDenoms[iNdEx])
+				copy(dAtA[i:], x.AllowedDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if x.NoShowCooldown != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NoShowCooldown))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.NoShowPenalty) > 0 {
+			i -= len(x.NoShowPenalty)
+			copy(dAtA[i:], x.NoShowPenalty)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NoShowPenalty)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.MaxTimeoutBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTimeoutBlocks))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.MinTimeoutBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinTimeoutBlocks))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.MaxTimeout != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTimeout))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.MinTimeout != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinTimeout))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.RevealTimeoutBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RevealTimeoutBlocks))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.CommitTimeoutBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommitTimeoutBlocks))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.TimeoutMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutMode))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxSettlementsPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSettlementsPerBlock))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.RevealTimeout != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RevealTimeout))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.CommitTimeout != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommitTimeout))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
 		} else {
 			input.Buf = dAtA
 		}
@@ -434,115 +1432,4736 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSettlementsPerBlock", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				x.MaxSettlementsPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSettlementsPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutMode", wireType)
+				}
+				x.TimeoutMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeoutMode |= TimeoutMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitTimeoutBlocks", wireType)
+				}
+				x.CommitTimeoutBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CommitTimeoutBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevealTimeoutBlocks", wireType)
+				}
+				x.RevealTimeoutBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RevealTimeoutBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinTimeout", wireType)
+				}
+				x.MinTimeout = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinTimeout |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTimeout", wireType)
+				}
+				x.MaxTimeout = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTimeout |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinTimeoutBlocks", wireType)
+				}
+				x.MinTimeoutBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinTimeoutBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTimeoutBlocks", wireType)
+				}
+				x.MaxTimeoutBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTimeoutBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoShowPenalty", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.NoShowPenalty = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoShowCooldown", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_Game                protoreflect.MessageDescriptor
-	fd_Game_id             protoreflect.FieldDescriptor
-	fd_Game_entry_fee      protoreflect.FieldDescriptor
-	fd_Game_commit_timeout protoreflect.FieldDescriptor
-	fd_Game_reveal_timeout protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_facundomedica_rps_v1_types_proto_init()
-	md_Game = File_facundomedica_rps_v1_types_proto.Messages().ByName("Game")
-	fd_Game_id = md_Game.Fields().ByName("id")
-	fd_Game_entry_fee = md_Game.Fields().ByName("entry_fee")
-	fd_Game_commit_timeout = md_Game.Fields().ByName("commit_timeout")
-	fd_Game_reveal_timeout = md_Game.Fields().ByName("reveal_timeout")
-}
-
-var _ protoreflect.Message = (*fastReflection_Game)(nil)
-
-type fastReflection_Game Game
-
-func (x *Game) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Game)(x)
-}
-
-func (x *Game) slowProtoReflect() protoreflect.Message {
-	mi := &file_facundomedica_rps_v1_types_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+				x.NoShowCooldown = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NoShowCooldown |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedDenoms = append(x.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BettingFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BettingFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JackpotFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.JackpotFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JackpotStreak", wireType)
+				}
+				x.JackpotStreak = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.JackpotStreak |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HouseMaxExposure", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HouseMaxExposure = append(x.HouseMaxExposure, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HouseMaxExposure[len(x.HouseMaxExposure)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RematchWindow", wireType)
+				}
+				x.RematchWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RematchWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType == 0 {
+					var v CommitmentScheme
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= CommitmentScheme(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.CommitmentSchemes = append(x.CommitmentSchemes, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					if elementCount != 0 && len(x.CommitmentSchemes) == 0 {
+						x.CommitmentSchemes = make([]CommitmentScheme, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v CommitmentScheme
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= CommitmentScheme(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.CommitmentSchemes = append(x.CommitmentSchemes, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitmentSchemes", wireType)
+				}
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueueMatchGrace", wireType)
+				}
+				x.QueueMatchGrace = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.QueueMatchGrace |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 21:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueueMatchGraceBlocks", wireType)
+				}
+				x.QueueMatchGraceBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.QueueMatchGraceBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JackpotMinStake", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.JackpotMinStake = append(x.JackpotMinStake, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.JackpotMinStake[len(x.JackpotMinStake)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 23:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HouseMaxOpenExposure", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HouseMaxOpenExposure = append(x.HouseMaxOpenExposure, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HouseMaxOpenExposure[len(x.HouseMaxOpenExposure)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Game_2_list)(nil)
+
+type _Game_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Game_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Game_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Game_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Game_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Game_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Game_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Game_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Game_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Game_10_list)(nil)
+
+type _Game_10_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Game_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Game_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Game_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Game_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Game_10_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Game_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Game_10_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Game_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Game                       protoreflect.MessageDescriptor
+	fd_Game_id                    protoreflect.FieldDescriptor
+	fd_Game_entry_fee             protoreflect.FieldDescriptor
+	fd_Game_commit_timeout        protoreflect.FieldDescriptor
+	fd_Game_reveal_timeout        protoreflect.FieldDescriptor
+	fd_Game_timeout_mode          protoreflect.FieldDescriptor
+	fd_Game_commit_timeout_height protoreflect.FieldDescriptor
+	fd_Game_reveal_timeout_height protoreflect.FieldDescriptor
+	fd_Game_commit_duration       protoreflect.FieldDescriptor
+	fd_Game_reveal_duration       protoreflect.FieldDescriptor
+	fd_Game_challenger_fee        protoreflect.FieldDescriptor
+	fd_Game_creator               protoreflect.FieldDescriptor
+	fd_Game_house                 protoreflect.FieldDescriptor
+	fd_Game_house_seed            protoreflect.FieldDescriptor
+	fd_Game_reserved_for          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_types_proto_init()
+	md_Game = File_facundomedica_rps_v1_types_proto.Messages().ByName("Game")
+	fd_Game_id = md_Game.Fields().ByName("id")
+	fd_Game_entry_fee = md_Game.Fields().ByName("entry_fee")
+	fd_Game_commit_timeout = md_Game.Fields().ByName("commit_timeout")
+	fd_Game_reveal_timeout = md_Game.Fields().ByName("reveal_timeout")
+	fd_Game_timeout_mode = md_Game.Fields().ByName("timeout_mode")
+	fd_Game_commit_timeout_height = md_Game.Fields().ByName("commit_timeout_height")
+	fd_Game_reveal_timeout_height = md_Game.Fields().ByName("reveal_timeout_height")
+	fd_Game_commit_duration = md_Game.Fields().ByName("commit_duration")
+	fd_Game_reveal_duration = md_Game.Fields().ByName("reveal_duration")
+	fd_Game_challenger_fee = md_Game.Fields().ByName("challenger_fee")
+	fd_Game_creator = md_Game.Fields().ByName("creator")
+	fd_Game_house = md_Game.Fields().ByName("house")
+	fd_Game_house_seed = md_Game.Fields().ByName("house_seed")
+	fd_Game_reserved_for = md_Game.Fields().ByName("reserved_for")
+}
+
+var _ protoreflect.Message = (*fastReflection_Game)(nil)
+
+type fastReflection_Game Game
+
+func (x *Game) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Game)(x)
+}
+
+func (x *Game) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Game_messageType fastReflection_Game_messageType
+var _ protoreflect.MessageType = fastReflection_Game_messageType{}
+
+type fastReflection_Game_messageType struct{}
+
+func (x fastReflection_Game_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Game)(nil)
+}
+func (x fastReflection_Game_messageType) New() protoreflect.Message {
+	return new(fastReflection_Game)
+}
+func (x fastReflection_Game_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Game
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Game) Descriptor() protoreflect.MessageDescriptor {
+	return md_Game
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Game) Type() protoreflect.MessageType {
+	return _fastReflection_Game_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Game) New() protoreflect.Message {
+	return new(fastReflection_Game)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Game) Interface() protoreflect.ProtoMessage {
+	return (*Game)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Game) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Game_id, value) {
+			return
+		}
+	}
+	if len(x.EntryFee) != 0 {
+		value := protoreflect.ValueOfList(&_Game_2_list{list: &x.EntryFee})
+		if !f(fd_Game_entry_fee, value) {
+			return
+		}
+	}
+	if x.CommitTimeout != nil {
+		value := protoreflect.ValueOfMessage(x.CommitTimeout.ProtoReflect())
+		if !f(fd_Game_commit_timeout, value) {
+			return
+		}
+	}
+	if x.RevealTimeout != nil {
+		value := protoreflect.ValueOfMessage(x.RevealTimeout.ProtoReflect())
+		if !f(fd_Game_reveal_timeout, value) {
+			return
+		}
+	}
+	if x.TimeoutMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TimeoutMode))
+		if !f(fd_Game_timeout_mode, value) {
+			return
+		}
+	}
+	if x.CommitTimeoutHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CommitTimeoutHeight)
+		if !f(fd_Game_commit_timeout_height, value) {
+			return
+		}
+	}
+	if x.RevealTimeoutHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RevealTimeoutHeight)
+		if !f(fd_Game_reveal_timeout_height, value) {
+			return
+		}
+	}
+	if x.CommitDuration != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CommitDuration)
+		if !f(fd_Game_commit_duration, value) {
+			return
+		}
+	}
+	if x.RevealDuration != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RevealDuration)
+		if !f(fd_Game_reveal_duration, value) {
+			return
+		}
+	}
+	if len(x.ChallengerFee) != 0 {
+		value := protoreflect.ValueOfList(&_Game_10_list{list: &x.ChallengerFee})
+		if !f(fd_Game_challenger_fee, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_Game_creator, value) {
+			return
+		}
+	}
+	if x.House != false {
+		value := protoreflect.ValueOfBool(x.House)
+		if !f(fd_Game_house, value) {
+			return
+		}
+	}
+	if len(x.HouseSeed) != 0 {
+		value := protoreflect.ValueOfBytes(x.HouseSeed)
+		if !f(fd_Game_house_seed, value) {
+			return
+		}
+	}
+	if x.ReservedFor != "" {
+		value := protoreflect.ValueOfString(x.ReservedFor)
+		if !f(fd_Game_reserved_for, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Game) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.Game.id":
+		return x.Id != uint64(0)
+	case "facundomedica.rps.v1.Game.entry_fee":
+		return len(x.EntryFee) != 0
+	case "facundomedica.rps.v1.Game.commit_timeout":
+		return x.CommitTimeout != nil
+	case "facundomedica.rps.v1.Game.reveal_timeout":
+		return x.RevealTimeout != nil
+	case "facundomedica.rps.v1.Game.timeout_mode":
+		return x.TimeoutMode != 0
+	case "facundomedica.rps.v1.Game.commit_timeout_height":
+		return x.CommitTimeoutHeight != int64(0)
+	case "facundomedica.rps.v1.Game.reveal_timeout_height":
+		return x.RevealTimeoutHeight != int64(0)
+	case "facundomedica.rps.v1.Game.commit_duration":
+		return x.CommitDuration != uint64(0)
+	case "facundomedica.rps.v1.Game.reveal_duration":
+		return x.RevealDuration != uint64(0)
+	case "facundomedica.rps.v1.Game.challenger_fee":
+		return len(x.ChallengerFee) != 0
+	case "facundomedica.rps.v1.Game.creator":
+		return x.Creator != ""
+	case "facundomedica.rps.v1.Game.house":
+		return x.House != false
+	case "facundomedica.rps.v1.Game.house_seed":
+		return len(x.HouseSeed) != 0
+	case "facundomedica.rps.v1.Game.reserved_for":
+		return x.ReservedFor != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Game"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.Game does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Game) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.Game.id":
+		x.Id = uint64(0)
+	case "facundomedica.rps.v1.Game.entry_fee":
+		x.EntryFee = nil
+	case "facundomedica.rps.v1.Game.commit_timeout":
+		x.CommitTimeout = nil
+	case "facundomedica.rps.v1.Game.reveal_timeout":
+		x.RevealTimeout = nil
+	case "facundomedica.rps.v1.Game.timeout_mode":
+		x.TimeoutMode = 0
+	case "facundomedica.rps.v1.Game.commit_timeout_height":
+		x.CommitTimeoutHeight = int64(0)
+	case "facundomedica.rps.v1.Game.reveal_timeout_height":
+		x.RevealTimeoutHeight = int64(0)
+	case "facundomedica.rps.v1.Game.commit_duration":
+		x.CommitDuration = uint64(0)
+	case "facundomedica.rps.v1.Game.reveal_duration":
+		x.RevealDuration = uint64(0)
+	case "facundomedica.rps.v1.Game.challenger_fee":
+		x.ChallengerFee = nil
+	case "facundomedica.rps.v1.Game.creator":
+		x.Creator = ""
+	case "facundomedica.rps.v1.Game.house":
+		x.House = false
+	case "facundomedica.rps.v1.Game.house_seed":
+		x.HouseSeed = nil
+	case "facundomedica.rps.v1.Game.reserved_for":
+		x.ReservedFor = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Game"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.Game does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Game) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.Game.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.Game.entry_fee":
+		if len(x.EntryFee) == 0 {
+			return protoreflect.ValueOfList(&_Game_2_list{})
+		}
+		listValue := &_Game_2_list{list: &x.EntryFee}
+		return protoreflect.ValueOfList(listValue)
+	case "facundomedica.rps.v1.Game.commit_timeout":
+		value := x.CommitTimeout
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "facundomedica.rps.v1.Game.reveal_timeout":
+		value := x.RevealTimeout
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "facundomedica.rps.v1.Game.timeout_mode":
+		value := x.TimeoutMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "facundomedica.rps.v1.Game.commit_timeout_height":
+		value := x.CommitTimeoutHeight
+		return protoreflect.ValueOfInt64(value)
+	case "facundomedica.rps.v1.Game.reveal_timeout_height":
+		value := x.RevealTimeoutHeight
+		return protoreflect.ValueOfInt64(value)
+	case "facundomedica.rps.v1.Game.commit_duration":
+		value := x.CommitDuration
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.Game.reveal_duration":
+		value := x.RevealDuration
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.Game.challenger_fee":
+		if len(x.ChallengerFee) == 0 {
+			return protoreflect.ValueOfList(&_Game_10_list{})
+		}
+		listValue := &_Game_10_list{list: &x.ChallengerFee}
+		return protoreflect.ValueOfList(listValue)
+	case "facundomedica.rps.v1.Game.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.Game.house":
+		value := x.House
+		return protoreflect.ValueOfBool(value)
+	case "facundomedica.rps.v1.Game.house_seed":
+		value := x.HouseSeed
+		return protoreflect.ValueOfBytes(value)
+	case "facundomedica.rps.v1.Game.reserved_for":
+		value := x.ReservedFor
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Game"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.Game does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Game) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.Game.id":
+		x.Id = value.Uint()
+	case "facundomedica.rps.v1.Game.entry_fee":
+		lv := value.List()
+		clv := lv.(*_Game_2_list)
+		x.EntryFee = *clv.list
+	case "facundomedica.rps.v1.Game.commit_timeout":
+		x.CommitTimeout = value.Message().Interface().(*timestamppb.Timestamp)
+	case "facundomedica.rps.v1.Game.reveal_timeout":
+		x.RevealTimeout = value.Message().Interface().(*timestamppb.Timestamp)
+	case "facundomedica.rps.v1.Game.timeout_mode":
+		x.TimeoutMode = (TimeoutMode)(value.Enum())
+	case "facundomedica.rps.v1.Game.commit_timeout_height":
+		x.CommitTimeoutHeight = value.Int()
+	case "facundomedica.rps.v1.Game.reveal_timeout_height":
+		x.RevealTimeoutHeight = value.Int()
+	case "facundomedica.rps.v1.Game.commit_duration":
+		x.CommitDuration = value.Uint()
+	case "facundomedica.rps.v1.Game.reveal_duration":
+		x.RevealDuration = value.Uint()
+	case "facundomedica.rps.v1.Game.challenger_fee":
+		lv := value.List()
+		clv := lv.(*_Game_10_list)
+		x.ChallengerFee = *clv.list
+	case "facundomedica.rps.v1.Game.creator":
+		x.Creator = value.Interface().(string)
+	case "facundomedica.rps.v1.Game.house":
+		x.House = value.Bool()
+	case "facundomedica.rps.v1.Game.house_seed":
+		x.HouseSeed = value.Bytes()
+	case "facundomedica.rps.v1.Game.reserved_for":
+		x.ReservedFor = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Game"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.Game does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Game) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.Game.entry_fee":
+		if x.EntryFee == nil {
+			x.EntryFee = []*v1beta1.Coin{}
+		}
+		value := &_Game_2_list{list: &x.EntryFee}
+		return protoreflect.ValueOfList(value)
+	case "facundomedica.rps.v1.Game.commit_timeout":
+		if x.CommitTimeout == nil {
+			x.CommitTimeout = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.CommitTimeout.ProtoReflect())
+	case "facundomedica.rps.v1.Game.reveal_timeout":
+		if x.RevealTimeout == nil {
+			x.RevealTimeout = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.RevealTimeout.ProtoReflect())
+	case "facundomedica.rps.v1.Game.challenger_fee":
+		if x.ChallengerFee == nil {
+			x.ChallengerFee = []*v1beta1.Coin{}
+		}
+		value := &_Game_10_list{list: &x.ChallengerFee}
+		return protoreflect.ValueOfList(value)
+	case "facundomedica.rps.v1.Game.id":
+		panic(fmt.Errorf("field id of message facundomedica.rps.v1.Game is not mutable"))
+	case "facundomedica.rps.v1.Game.timeout_mode":
+		panic(fmt.Errorf("field timeout_mode of message facundomedica.rps.v1.Game is not mutable"))
+	case "facundomedica.rps.v1.Game.commit_timeout_height":
+		panic(fmt.Errorf("field commit_timeout_height of message facundomedica.rps.v1.Game is not mutable"))
+	case "facundomedica.rps.v1.Game.reveal_timeout_height":
+		panic(fmt.Errorf("field reveal_timeout_height of message facundomedica.rps.v1.Game is not mutable"))
+	case "facundomedica.rps.v1.Game.commit_duration":
+		panic(fmt.Errorf("field commit_duration of message facundomedica.rps.v1.Game is not mutable"))
+	case "facundomedica.rps.v1.Game.reveal_duration":
+		panic(fmt.Errorf("field reveal_duration of message facundomedica.rps.v1.Game is not mutable"))
+	case "facundomedica.rps.v1.Game.creator":
+		panic(fmt.Errorf("field creator of message facundomedica.rps.v1.Game is not mutable"))
+	case "facundomedica.rps.v1.Game.house":
+		panic(fmt.Errorf("field house of message facundomedica.rps.v1.Game is not mutable"))
+	case "facundomedica.rps.v1.Game.house_seed":
+		panic(fmt.Errorf("field house_seed of message facundomedica.rps.v1.Game is not mutable"))
+	case "facundomedica.rps.v1.Game.reserved_for":
+		panic(fmt.Errorf("field reserved_for of message facundomedica.rps.v1.Game is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Game"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.Game does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Game) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.Game.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.Game.entry_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Game_2_list{list: &list})
+	case "facundomedica.rps.v1.Game.commit_timeout":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "facundomedica.rps.v1.Game.reveal_timeout":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "facundomedica.rps.v1.Game.timeout_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "facundomedica.rps.v1.Game.commit_timeout_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "facundomedica.rps.v1.Game.reveal_timeout_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "facundomedica.rps.v1.Game.commit_duration":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.Game.reveal_duration":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.Game.challenger_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Game_10_list{list: &list})
+	case "facundomedica.rps.v1.Game.creator":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.Game.house":
+		return protoreflect.ValueOfBool(false)
+	case "facundomedica.rps.v1.Game.house_seed":
+		return protoreflect.ValueOfBytes(nil)
+	case "facundomedica.rps.v1.Game.reserved_for":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Game"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.Game does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Game) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.Game", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Game) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Game) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Game) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Game) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Game)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if len(x.EntryFee) > 0 {
+			for _, e := range x.EntryFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CommitTimeout != nil {
+			l = options.Size(x.CommitTimeout)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RevealTimeout != nil {
+			l = options.Size(x.RevealTimeout)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TimeoutMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutMode))
+		}
+		if x.CommitTimeoutHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CommitTimeoutHeight))
+		}
+		if x.RevealTimeoutHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.RevealTimeoutHeight))
+		}
+		if x.CommitDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.CommitDuration))
+		}
+		if x.RevealDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.RevealDuration))
+		}
+		if len(x.ChallengerFee) > 0 {
+			for _, e := range x.ChallengerFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.House {
+			n += 2
+		}
+		l = len(x.HouseSeed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReservedFor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Game)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ReservedFor) > 0 {
+			i -= len(x.ReservedFor)
+			copy(dAtA[i:], x.ReservedFor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReservedFor)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.HouseSeed) > 0 {
+			i -= len(x.HouseSeed)
+			copy(dAtA[i:], x.HouseSeed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HouseSeed)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.House {
+			i--
+			if x.House {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.ChallengerFee) > 0 {
+			for iNdEx := len(x.ChallengerFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ChallengerFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.RevealDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RevealDuration))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.CommitDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommitDuration))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.RevealTimeoutHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RevealTimeoutHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.CommitTimeoutHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommitTimeoutHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.TimeoutMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutMode))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.RevealTimeout != nil {
+			encoded, err := options.Marshal(x.RevealTimeout)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.CommitTimeout != nil {
+			encoded, err := options.Marshal(x.CommitTimeout)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.EntryFee) > 0 {
+			for iNdEx := len(x.EntryFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EntryFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Game)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Game: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Game: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EntryFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EntryFee = append(x.EntryFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EntryFee[len(x.EntryFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitTimeout", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CommitTimeout == nil {
+					x.CommitTimeout = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CommitTimeout); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevealTimeout", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RevealTimeout == nil {
+					x.RevealTimeout = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RevealTimeout); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutMode", wireType)
+				}
+				x.TimeoutMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeoutMode |= TimeoutMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitTimeoutHeight", wireType)
+				}
+				x.CommitTimeoutHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CommitTimeoutHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevealTimeoutHeight", wireType)
+				}
+				x.RevealTimeoutHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RevealTimeoutHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitDuration", wireType)
+				}
+				x.CommitDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CommitDuration |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevealDuration", wireType)
+				}
+				x.RevealDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RevealDuration |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChallengerFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChallengerFee = append(x.ChallengerFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ChallengerFee[len(x.ChallengerFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field House", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.House = bool(v != 0)
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HouseSeed", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HouseSeed = append(x.HouseSeed[:0], dAtA[iNdEx:postIndex]...)
+				if x.HouseSeed == nil {
+					x.HouseSeed = []byte{}
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReservedFor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReservedFor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MoveCommit_4_list)(nil)
+
+type _MoveCommit_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MoveCommit_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MoveCommit_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MoveCommit_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MoveCommit_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MoveCommit_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MoveCommit_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MoveCommit_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MoveCommit_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MoveCommit                  protoreflect.MessageDescriptor
+	fd_MoveCommit_commit           protoreflect.FieldDescriptor
+	fd_MoveCommit_created_at       protoreflect.FieldDescriptor
+	fd_MoveCommit_stake            protoreflect.FieldDescriptor
+	fd_MoveCommit_encrypted_reveal protoreflect.FieldDescriptor
+	fd_MoveCommit_scheme           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_types_proto_init()
+	md_MoveCommit = File_facundomedica_rps_v1_types_proto.Messages().ByName("MoveCommit")
+	fd_MoveCommit_commit = md_MoveCommit.Fields().ByName("commit")
+	fd_MoveCommit_created_at = md_MoveCommit.Fields().ByName("created_at")
+	fd_MoveCommit_stake = md_MoveCommit.Fields().ByName("stake")
+	fd_MoveCommit_encrypted_reveal = md_MoveCommit.Fields().ByName("encrypted_reveal")
+	fd_MoveCommit_scheme = md_MoveCommit.Fields().ByName("scheme")
+}
+
+var _ protoreflect.Message = (*fastReflection_MoveCommit)(nil)
+
+type fastReflection_MoveCommit MoveCommit
+
+func (x *MoveCommit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MoveCommit)(x)
+}
+
+func (x *MoveCommit) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MoveCommit_messageType fastReflection_MoveCommit_messageType
+var _ protoreflect.MessageType = fastReflection_MoveCommit_messageType{}
+
+type fastReflection_MoveCommit_messageType struct{}
+
+func (x fastReflection_MoveCommit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MoveCommit)(nil)
+}
+func (x fastReflection_MoveCommit_messageType) New() protoreflect.Message {
+	return new(fastReflection_MoveCommit)
+}
+func (x fastReflection_MoveCommit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MoveCommit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MoveCommit) Descriptor() protoreflect.MessageDescriptor {
+	return md_MoveCommit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MoveCommit) Type() protoreflect.MessageType {
+	return _fastReflection_MoveCommit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MoveCommit) New() protoreflect.Message {
+	return new(fastReflection_MoveCommit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MoveCommit) Interface() protoreflect.ProtoMessage {
+	return (*MoveCommit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MoveCommit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Commit) != 0 {
+		value := protoreflect.ValueOfBytes(x.Commit)
+		if !f(fd_MoveCommit_commit, value) {
+			return
+		}
+	}
+	if x.CreatedAt != nil {
+		value := protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+		if !f(fd_MoveCommit_created_at, value) {
+			return
+		}
+	}
+	if len(x.Stake) != 0 {
+		value := protoreflect.ValueOfList(&_MoveCommit_4_list{list: &x.Stake})
+		if !f(fd_MoveCommit_stake, value) {
+			return
+		}
+	}
+	if len(x.EncryptedReveal) != 0 {
+		value := protoreflect.ValueOfBytes(x.EncryptedReveal)
+		if !f(fd_MoveCommit_encrypted_reveal, value) {
+			return
+		}
+	}
+	if x.Scheme != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Scheme))
+		if !f(fd_MoveCommit_scheme, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MoveCommit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MoveCommit.commit":
+		return len(x.Commit) != 0
+	case "facundomedica.rps.v1.MoveCommit.created_at":
+		return x.CreatedAt != nil
+	case "facundomedica.rps.v1.MoveCommit.stake":
+		return len(x.Stake) != 0
+	case "facundomedica.rps.v1.MoveCommit.encrypted_reveal":
+		return len(x.EncryptedReveal) != 0
+	case "facundomedica.rps.v1.MoveCommit.scheme":
+		return x.Scheme != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveCommit"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MoveCommit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MoveCommit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MoveCommit.commit":
+		x.Commit = nil
+	case "facundomedica.rps.v1.MoveCommit.created_at":
+		x.CreatedAt = nil
+	case "facundomedica.rps.v1.MoveCommit.stake":
+		x.Stake = nil
+	case "facundomedica.rps.v1.MoveCommit.encrypted_reveal":
+		x.EncryptedReveal = nil
+	case "facundomedica.rps.v1.MoveCommit.scheme":
+		x.Scheme = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveCommit"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MoveCommit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MoveCommit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.MoveCommit.commit":
+		value := x.Commit
+		return protoreflect.ValueOfBytes(value)
+	case "facundomedica.rps.v1.MoveCommit.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "facundomedica.rps.v1.MoveCommit.stake":
+		if len(x.Stake) == 0 {
+			return protoreflect.ValueOfList(&_MoveCommit_4_list{})
+		}
+		listValue := &_MoveCommit_4_list{list: &x.Stake}
+		return protoreflect.ValueOfList(listValue)
+	case "facundomedica.rps.v1.MoveCommit.encrypted_reveal":
+		value := x.EncryptedReveal
+		return protoreflect.ValueOfBytes(value)
+	case "facundomedica.rps.v1.MoveCommit.scheme":
+		value := x.Scheme
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveCommit"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MoveCommit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MoveCommit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MoveCommit.commit":
+		x.Commit = value.Bytes()
+	case "facundomedica.rps.v1.MoveCommit.created_at":
+		x.CreatedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "facundomedica.rps.v1.MoveCommit.stake":
+		lv := value.List()
+		clv := lv.(*_MoveCommit_4_list)
+		x.Stake = *clv.list
+	case "facundomedica.rps.v1.MoveCommit.encrypted_reveal":
+		x.EncryptedReveal = value.Bytes()
+	case "facundomedica.rps.v1.MoveCommit.scheme":
+		x.Scheme = (CommitmentScheme)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveCommit"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MoveCommit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MoveCommit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MoveCommit.created_at":
+		if x.CreatedAt == nil {
+			x.CreatedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+	case "facundomedica.rps.v1.MoveCommit.stake":
+		if x.Stake == nil {
+			x.Stake = []*v1beta1.Coin{}
+		}
+		value := &_MoveCommit_4_list{list: &x.Stake}
+		return protoreflect.ValueOfList(value)
+	case "facundomedica.rps.v1.MoveCommit.commit":
+		panic(fmt.Errorf("field commit of message facundomedica.rps.v1.MoveCommit is not mutable"))
+	case "facundomedica.rps.v1.MoveCommit.encrypted_reveal":
+		panic(fmt.Errorf("field encrypted_reveal of message facundomedica.rps.v1.MoveCommit is not mutable"))
+	case "facundomedica.rps.v1.MoveCommit.scheme":
+		panic(fmt.Errorf("field scheme of message facundomedica.rps.v1.MoveCommit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveCommit"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MoveCommit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MoveCommit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MoveCommit.commit":
+		return protoreflect.ValueOfBytes(nil)
+	case "facundomedica.rps.v1.MoveCommit.created_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "facundomedica.rps.v1.MoveCommit.stake":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MoveCommit_4_list{list: &list})
+	case "facundomedica.rps.v1.MoveCommit.encrypted_reveal":
+		return protoreflect.ValueOfBytes(nil)
+	case "facundomedica.rps.v1.MoveCommit.scheme":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveCommit"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MoveCommit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MoveCommit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MoveCommit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MoveCommit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MoveCommit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MoveCommit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MoveCommit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MoveCommit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Commit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CreatedAt != nil {
+			l = options.Size(x.CreatedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Stake) > 0 {
+			for _, e := range x.Stake {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.EncryptedReveal)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Scheme != 0 {
+			n += 1 + runtime.Sov(uint64(x.Scheme))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MoveCommit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Scheme != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Scheme))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.EncryptedReveal) > 0 {
+			i -= len(x.EncryptedReveal)
+			copy(dAtA[i:], x.EncryptedReveal)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EncryptedReveal)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Stake) > 0 {
+			for iNdEx := len(x.Stake) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Stake[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.CreatedAt != nil {
+			encoded, err := options.Marshal(x.CreatedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Commit) > 0 {
+			i -= len(x.Commit)
+			copy(dAtA[i:], x.Commit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commit)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MoveCommit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MoveCommit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MoveCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Commit = append(x.Commit[:0], dAtA[iNdEx:postIndex]...)
+				if x.Commit == nil {
+					x.Commit = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CreatedAt == nil {
+					x.CreatedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreatedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Stake = append(x.Stake, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stake[len(x.Stake)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EncryptedReveal", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EncryptedReveal = append(x.EncryptedReveal[:0], dAtA[iNdEx:postIndex]...)
+				if x.EncryptedReveal == nil {
+					x.EncryptedReveal = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
+				}
+				x.Scheme = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Scheme |= CommitmentScheme(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MoveReveal            protoreflect.MessageDescriptor
+	fd_MoveReveal_move       protoreflect.FieldDescriptor
+	fd_MoveReveal_salt       protoreflect.FieldDescriptor
+	fd_MoveReveal_created_at protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_types_proto_init()
+	md_MoveReveal = File_facundomedica_rps_v1_types_proto.Messages().ByName("MoveReveal")
+	fd_MoveReveal_move = md_MoveReveal.Fields().ByName("move")
+	fd_MoveReveal_salt = md_MoveReveal.Fields().ByName("salt")
+	fd_MoveReveal_created_at = md_MoveReveal.Fields().ByName("created_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_MoveReveal)(nil)
+
+type fastReflection_MoveReveal MoveReveal
+
+func (x *MoveReveal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MoveReveal)(x)
+}
+
+func (x *MoveReveal) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MoveReveal_messageType fastReflection_MoveReveal_messageType
+var _ protoreflect.MessageType = fastReflection_MoveReveal_messageType{}
+
+type fastReflection_MoveReveal_messageType struct{}
+
+func (x fastReflection_MoveReveal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MoveReveal)(nil)
+}
+func (x fastReflection_MoveReveal_messageType) New() protoreflect.Message {
+	return new(fastReflection_MoveReveal)
+}
+func (x fastReflection_MoveReveal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MoveReveal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MoveReveal) Descriptor() protoreflect.MessageDescriptor {
+	return md_MoveReveal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MoveReveal) Type() protoreflect.MessageType {
+	return _fastReflection_MoveReveal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MoveReveal) New() protoreflect.Message {
+	return new(fastReflection_MoveReveal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MoveReveal) Interface() protoreflect.ProtoMessage {
+	return (*MoveReveal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MoveReveal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Move != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Move))
+		if !f(fd_MoveReveal_move, value) {
+			return
+		}
+	}
+	if len(x.Salt) != 0 {
+		value := protoreflect.ValueOfBytes(x.Salt)
+		if !f(fd_MoveReveal_salt, value) {
+			return
+		}
+	}
+	if x.CreatedAt != nil {
+		value := protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+		if !f(fd_MoveReveal_created_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MoveReveal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MoveReveal.move":
+		return x.Move != 0
+	case "facundomedica.rps.v1.MoveReveal.salt":
+		return len(x.Salt) != 0
+	case "facundomedica.rps.v1.MoveReveal.created_at":
+		return x.CreatedAt != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveReveal"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MoveReveal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MoveReveal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MoveReveal.move":
+		x.Move = 0
+	case "facundomedica.rps.v1.MoveReveal.salt":
+		x.Salt = nil
+	case "facundomedica.rps.v1.MoveReveal.created_at":
+		x.CreatedAt = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveReveal"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MoveReveal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MoveReveal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.MoveReveal.move":
+		value := x.Move
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "facundomedica.rps.v1.MoveReveal.salt":
+		value := x.Salt
+		return protoreflect.ValueOfBytes(value)
+	case "facundomedica.rps.v1.MoveReveal.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveReveal"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MoveReveal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MoveReveal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MoveReveal.move":
+		x.Move = (Move)(value.Enum())
+	case "facundomedica.rps.v1.MoveReveal.salt":
+		x.Salt = value.Bytes()
+	case "facundomedica.rps.v1.MoveReveal.created_at":
+		x.CreatedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveReveal"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MoveReveal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MoveReveal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MoveReveal.created_at":
+		if x.CreatedAt == nil {
+			x.CreatedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+	case "facundomedica.rps.v1.MoveReveal.move":
+		panic(fmt.Errorf("field move of message facundomedica.rps.v1.MoveReveal is not mutable"))
+	case "facundomedica.rps.v1.MoveReveal.salt":
+		panic(fmt.Errorf("field salt of message facundomedica.rps.v1.MoveReveal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveReveal"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MoveReveal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MoveReveal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.MoveReveal.move":
+		return protoreflect.ValueOfEnum(0)
+	case "facundomedica.rps.v1.MoveReveal.salt":
+		return protoreflect.ValueOfBytes(nil)
+	case "facundomedica.rps.v1.MoveReveal.created_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveReveal"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.MoveReveal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MoveReveal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MoveReveal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MoveReveal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MoveReveal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MoveReveal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MoveReveal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MoveReveal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Move != 0 {
+			n += 1 + runtime.Sov(uint64(x.Move))
+		}
+		l = len(x.Salt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CreatedAt != nil {
+			l = options.Size(x.CreatedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MoveReveal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CreatedAt != nil {
+			encoded, err := options.Marshal(x.CreatedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Salt) > 0 {
+			i -= len(x.Salt)
+			copy(dAtA[i:], x.Salt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Salt)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Move != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Move))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MoveReveal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MoveReveal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MoveReveal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
+				}
+				x.Move = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Move |= Move(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Salt = append(x.Salt[:0], dAtA[iNdEx:postIndex]...)
+				if x.Salt == nil {
+					x.Salt = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CreatedAt == nil {
+					x.CreatedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreatedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PlayerStats                protoreflect.MessageDescriptor
+	fd_PlayerStats_no_shows       protoreflect.FieldDescriptor
+	fd_PlayerStats_cooldown_until protoreflect.FieldDescriptor
+	fd_PlayerStats_wins           protoreflect.FieldDescriptor
+	fd_PlayerStats_win_streak     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_types_proto_init()
+	md_PlayerStats = File_facundomedica_rps_v1_types_proto.Messages().ByName("PlayerStats")
+	fd_PlayerStats_no_shows = md_PlayerStats.Fields().ByName("no_shows")
+	fd_PlayerStats_cooldown_until = md_PlayerStats.Fields().ByName("cooldown_until")
+	fd_PlayerStats_wins = md_PlayerStats.Fields().ByName("wins")
+	fd_PlayerStats_win_streak = md_PlayerStats.Fields().ByName("win_streak")
+}
+
+var _ protoreflect.Message = (*fastReflection_PlayerStats)(nil)
+
+type fastReflection_PlayerStats PlayerStats
+
+func (x *PlayerStats) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PlayerStats)(x)
+}
+
+func (x *PlayerStats) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PlayerStats_messageType fastReflection_PlayerStats_messageType
+var _ protoreflect.MessageType = fastReflection_PlayerStats_messageType{}
+
+type fastReflection_PlayerStats_messageType struct{}
+
+func (x fastReflection_PlayerStats_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PlayerStats)(nil)
+}
+func (x fastReflection_PlayerStats_messageType) New() protoreflect.Message {
+	return new(fastReflection_PlayerStats)
+}
+func (x fastReflection_PlayerStats_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PlayerStats
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PlayerStats) Descriptor() protoreflect.MessageDescriptor {
+	return md_PlayerStats
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PlayerStats) Type() protoreflect.MessageType {
+	return _fastReflection_PlayerStats_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PlayerStats) New() protoreflect.Message {
+	return new(fastReflection_PlayerStats)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PlayerStats) Interface() protoreflect.ProtoMessage {
+	return (*PlayerStats)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PlayerStats) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.NoShows != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NoShows)
+		if !f(fd_PlayerStats_no_shows, value) {
+			return
+		}
+	}
+	if x.CooldownUntil != nil {
+		value := protoreflect.ValueOfMessage(x.CooldownUntil.ProtoReflect())
+		if !f(fd_PlayerStats_cooldown_until, value) {
+			return
+		}
+	}
+	if x.Wins != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Wins)
+		if !f(fd_PlayerStats_wins, value) {
+			return
+		}
+	}
+	if x.WinStreak != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WinStreak)
+		if !f(fd_PlayerStats_win_streak, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PlayerStats) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.PlayerStats.no_shows":
+		return x.NoShows != uint64(0)
+	case "facundomedica.rps.v1.PlayerStats.cooldown_until":
+		return x.CooldownUntil != nil
+	case "facundomedica.rps.v1.PlayerStats.wins":
+		return x.Wins != uint64(0)
+	case "facundomedica.rps.v1.PlayerStats.win_streak":
+		return x.WinStreak != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.PlayerStats"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.PlayerStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PlayerStats) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.PlayerStats.no_shows":
+		x.NoShows = uint64(0)
+	case "facundomedica.rps.v1.PlayerStats.cooldown_until":
+		x.CooldownUntil = nil
+	case "facundomedica.rps.v1.PlayerStats.wins":
+		x.Wins = uint64(0)
+	case "facundomedica.rps.v1.PlayerStats.win_streak":
+		x.WinStreak = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.PlayerStats"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.PlayerStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PlayerStats) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.PlayerStats.no_shows":
+		value := x.NoShows
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.PlayerStats.cooldown_until":
+		value := x.CooldownUntil
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "facundomedica.rps.v1.PlayerStats.wins":
+		value := x.Wins
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.PlayerStats.win_streak":
+		value := x.WinStreak
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.PlayerStats"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.PlayerStats does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PlayerStats) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.PlayerStats.no_shows":
+		x.NoShows = value.Uint()
+	case "facundomedica.rps.v1.PlayerStats.cooldown_until":
+		x.CooldownUntil = value.Message().Interface().(*timestamppb.Timestamp)
+	case "facundomedica.rps.v1.PlayerStats.wins":
+		x.Wins = value.Uint()
+	case "facundomedica.rps.v1.PlayerStats.win_streak":
+		x.WinStreak = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.PlayerStats"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.PlayerStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PlayerStats) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.PlayerStats.cooldown_until":
+		if x.CooldownUntil == nil {
+			x.CooldownUntil = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.CooldownUntil.ProtoReflect())
+	case "facundomedica.rps.v1.PlayerStats.no_shows":
+		panic(fmt.Errorf("field no_shows of message facundomedica.rps.v1.PlayerStats is not mutable"))
+	case "facundomedica.rps.v1.PlayerStats.wins":
+		panic(fmt.Errorf("field wins of message facundomedica.rps.v1.PlayerStats is not mutable"))
+	case "facundomedica.rps.v1.PlayerStats.win_streak":
+		panic(fmt.Errorf("field win_streak of message facundomedica.rps.v1.PlayerStats is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.PlayerStats"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.PlayerStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PlayerStats) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.PlayerStats.no_shows":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.PlayerStats.cooldown_until":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "facundomedica.rps.v1.PlayerStats.wins":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.PlayerStats.win_streak":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.PlayerStats"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.PlayerStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PlayerStats) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.PlayerStats", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PlayerStats) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PlayerStats) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PlayerStats) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PlayerStats) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PlayerStats)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.NoShows != 0 {
+			n += 1 + runtime.Sov(uint64(x.NoShows))
+		}
+		if x.CooldownUntil != nil {
+			l = options.Size(x.CooldownUntil)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Wins != 0 {
+			n += 1 + runtime.Sov(uint64(x.Wins))
+		}
+		if x.WinStreak != 0 {
+			n += 1 + runtime.Sov(uint64(x.WinStreak))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PlayerStats)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.WinStreak != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WinStreak))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Wins != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Wins))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.CooldownUntil != nil {
+			encoded, err := options.Marshal(x.CooldownUntil)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.NoShows != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NoShows))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PlayerStats)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PlayerStats: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PlayerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoShows", wireType)
+				}
+				x.NoShows = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NoShows |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CooldownUntil", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CooldownUntil == nil {
+					x.CooldownUntil = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CooldownUntil); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Wins", wireType)
+				}
+				x.Wins = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Wins |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WinStreak", wireType)
+				}
+				x.WinStreak = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WinStreak |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueueEntry               protoreflect.MessageDescriptor
+	fd_QueueEntry_id            protoreflect.FieldDescriptor
+	fd_QueueEntry_player        protoreflect.FieldDescriptor
+	fd_QueueEntry_commit        protoreflect.FieldDescriptor
+	fd_QueueEntry_entry_fee     protoreflect.FieldDescriptor
+	fd_QueueEntry_created_at    protoreflect.FieldDescriptor
+	fd_QueueEntry_min_entry_fee protoreflect.FieldDescriptor
+	fd_QueueEntry_scheme        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_types_proto_init()
+	md_QueueEntry = File_facundomedica_rps_v1_types_proto.Messages().ByName("QueueEntry")
+	fd_QueueEntry_id = md_QueueEntry.Fields().ByName("id")
+	fd_QueueEntry_player = md_QueueEntry.Fields().ByName("player")
+	fd_QueueEntry_commit = md_QueueEntry.Fields().ByName("commit")
+	fd_QueueEntry_entry_fee = md_QueueEntry.Fields().ByName("entry_fee")
+	fd_QueueEntry_created_at = md_QueueEntry.Fields().ByName("created_at")
+	fd_QueueEntry_min_entry_fee = md_QueueEntry.Fields().ByName("min_entry_fee")
+	fd_QueueEntry_scheme = md_QueueEntry.Fields().ByName("scheme")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueueEntry)(nil)
+
+type fastReflection_QueueEntry QueueEntry
+
+func (x *QueueEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueueEntry)(x)
+}
+
+func (x *QueueEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueueEntry_messageType fastReflection_QueueEntry_messageType
+var _ protoreflect.MessageType = fastReflection_QueueEntry_messageType{}
+
+type fastReflection_QueueEntry_messageType struct{}
+
+func (x fastReflection_QueueEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueueEntry)(nil)
+}
+func (x fastReflection_QueueEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueueEntry)
+}
+func (x fastReflection_QueueEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueueEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueueEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueueEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueueEntry) Type() protoreflect.MessageType {
+	return _fastReflection_QueueEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueueEntry) New() protoreflect.Message {
+	return new(fastReflection_QueueEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueueEntry) Interface() protoreflect.ProtoMessage {
+	return (*QueueEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueueEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_QueueEntry_id, value) {
+			return
+		}
+	}
+	if x.Player != "" {
+		value := protoreflect.ValueOfString(x.Player)
+		if !f(fd_QueueEntry_player, value) {
+			return
+		}
+	}
+	if len(x.Commit) != 0 {
+		value := protoreflect.ValueOfBytes(x.Commit)
+		if !f(fd_QueueEntry_commit, value) {
+			return
+		}
+	}
+	if x.EntryFee != nil {
+		value := protoreflect.ValueOfMessage(x.EntryFee.ProtoReflect())
+		if !f(fd_QueueEntry_entry_fee, value) {
+			return
+		}
+	}
+	if x.CreatedAt != nil {
+		value := protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+		if !f(fd_QueueEntry_created_at, value) {
+			return
+		}
+	}
+	if x.MinEntryFee != nil {
+		value := protoreflect.ValueOfMessage(x.MinEntryFee.ProtoReflect())
+		if !f(fd_QueueEntry_min_entry_fee, value) {
+			return
+		}
+	}
+	if x.Scheme != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Scheme))
+		if !f(fd_QueueEntry_scheme, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueueEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.QueueEntry.id":
+		return x.Id != uint64(0)
+	case "facundomedica.rps.v1.QueueEntry.player":
+		return x.Player != ""
+	case "facundomedica.rps.v1.QueueEntry.commit":
+		return len(x.Commit) != 0
+	case "facundomedica.rps.v1.QueueEntry.entry_fee":
+		return x.EntryFee != nil
+	case "facundomedica.rps.v1.QueueEntry.created_at":
+		return x.CreatedAt != nil
+	case "facundomedica.rps.v1.QueueEntry.min_entry_fee":
+		return x.MinEntryFee != nil
+	case "facundomedica.rps.v1.QueueEntry.scheme":
+		return x.Scheme != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.QueueEntry"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.QueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueueEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.QueueEntry.id":
+		x.Id = uint64(0)
+	case "facundomedica.rps.v1.QueueEntry.player":
+		x.Player = ""
+	case "facundomedica.rps.v1.QueueEntry.commit":
+		x.Commit = nil
+	case "facundomedica.rps.v1.QueueEntry.entry_fee":
+		x.EntryFee = nil
+	case "facundomedica.rps.v1.QueueEntry.created_at":
+		x.CreatedAt = nil
+	case "facundomedica.rps.v1.QueueEntry.min_entry_fee":
+		x.MinEntryFee = nil
+	case "facundomedica.rps.v1.QueueEntry.scheme":
+		x.Scheme = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.QueueEntry"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.QueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueueEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "facundomedica.rps.v1.QueueEntry.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.QueueEntry.player":
+		value := x.Player
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.QueueEntry.commit":
+		value := x.Commit
+		return protoreflect.ValueOfBytes(value)
+	case "facundomedica.rps.v1.QueueEntry.entry_fee":
+		value := x.EntryFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "facundomedica.rps.v1.QueueEntry.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "facundomedica.rps.v1.QueueEntry.min_entry_fee":
+		value := x.MinEntryFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "facundomedica.rps.v1.QueueEntry.scheme":
+		value := x.Scheme
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.QueueEntry"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.QueueEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueueEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.QueueEntry.id":
+		x.Id = value.Uint()
+	case "facundomedica.rps.v1.QueueEntry.player":
+		x.Player = value.Interface().(string)
+	case "facundomedica.rps.v1.QueueEntry.commit":
+		x.Commit = value.Bytes()
+	case "facundomedica.rps.v1.QueueEntry.entry_fee":
+		x.EntryFee = value.Message().Interface().(*v1beta1.Coin)
+	case "facundomedica.rps.v1.QueueEntry.created_at":
+		x.CreatedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "facundomedica.rps.v1.QueueEntry.min_entry_fee":
+		x.MinEntryFee = value.Message().Interface().(*v1beta1.Coin)
+	case "facundomedica.rps.v1.QueueEntry.scheme":
+		x.Scheme = (CommitmentScheme)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.QueueEntry"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.QueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueueEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.QueueEntry.entry_fee":
+		if x.EntryFee == nil {
+			x.EntryFee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.EntryFee.ProtoReflect())
+	case "facundomedica.rps.v1.QueueEntry.created_at":
+		if x.CreatedAt == nil {
+			x.CreatedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+	case "facundomedica.rps.v1.QueueEntry.min_entry_fee":
+		if x.MinEntryFee == nil {
+			x.MinEntryFee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.MinEntryFee.ProtoReflect())
+	case "facundomedica.rps.v1.QueueEntry.id":
+		panic(fmt.Errorf("field id of message facundomedica.rps.v1.QueueEntry is not mutable"))
+	case "facundomedica.rps.v1.QueueEntry.player":
+		panic(fmt.Errorf("field player of message facundomedica.rps.v1.QueueEntry is not mutable"))
+	case "facundomedica.rps.v1.QueueEntry.commit":
+		panic(fmt.Errorf("field commit of message facundomedica.rps.v1.QueueEntry is not mutable"))
+	case "facundomedica.rps.v1.QueueEntry.scheme":
+		panic(fmt.Errorf("field scheme of message facundomedica.rps.v1.QueueEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.QueueEntry"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.QueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueueEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "facundomedica.rps.v1.QueueEntry.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.QueueEntry.player":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.QueueEntry.commit":
+		return protoreflect.ValueOfBytes(nil)
+	case "facundomedica.rps.v1.QueueEntry.entry_fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "facundomedica.rps.v1.QueueEntry.created_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "facundomedica.rps.v1.QueueEntry.min_entry_fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "facundomedica.rps.v1.QueueEntry.scheme":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.QueueEntry"))
+		}
+		panic(fmt.Errorf("message facundomedica.rps.v1.QueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueueEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.QueueEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueueEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueueEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueueEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueueEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueueEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Player)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Commit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EntryFee != nil {
+			l = options.Size(x.EntryFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CreatedAt != nil {
+			l = options.Size(x.CreatedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinEntryFee != nil {
+			l = options.Size(x.MinEntryFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Scheme != 0 {
+			n += 1 + runtime.Sov(uint64(x.Scheme))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueueEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Scheme != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Scheme))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.MinEntryFee != nil {
+			encoded, err := options.Marshal(x.MinEntryFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.CreatedAt != nil {
+			encoded, err := options.Marshal(x.CreatedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.EntryFee != nil {
+			encoded, err := options.Marshal(x.EntryFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Commit) > 0 {
+			i -= len(x.Commit)
+			copy(dAtA[i:], x.Commit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commit)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Player) > 0 {
+			i -= len(x.Player)
+			copy(dAtA[i:], x.Player)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Player)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueueEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueueEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Player = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Commit = append(x.Commit[:0], dAtA[iNdEx:postIndex]...)
+				if x.Commit == nil {
+					x.Commit = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EntryFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EntryFee == nil {
+					x.EntryFee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EntryFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CreatedAt == nil {
+					x.CreatedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreatedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinEntryFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MinEntryFee == nil {
+					x.MinEntryFee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinEntryFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
+				}
+				x.Scheme = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Scheme |= CommitmentScheme(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Bet_2_list)(nil)
+
+type _Bet_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Bet_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Bet_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Bet_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Bet_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Bet_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bet_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Bet_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bet_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Bet            protoreflect.MessageDescriptor
+	fd_Bet_outcome    protoreflect.FieldDescriptor
+	fd_Bet_amount     protoreflect.FieldDescriptor
+	fd_Bet_created_at protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_facundomedica_rps_v1_types_proto_init()
+	md_Bet = File_facundomedica_rps_v1_types_proto.Messages().ByName("Bet")
+	fd_Bet_outcome = md_Bet.Fields().ByName("outcome")
+	fd_Bet_amount = md_Bet.Fields().ByName("amount")
+	fd_Bet_created_at = md_Bet.Fields().ByName("created_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_Bet)(nil)
+
+type fastReflection_Bet Bet
+
+func (x *Bet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Bet)(x)
+}
+
+func (x *Bet) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
 		return ms
 	}
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Game_messageType fastReflection_Game_messageType
-var _ protoreflect.MessageType = fastReflection_Game_messageType{}
+var _fastReflection_Bet_messageType fastReflection_Bet_messageType
+var _ protoreflect.MessageType = fastReflection_Bet_messageType{}
 
-type fastReflection_Game_messageType struct{}
+type fastReflection_Bet_messageType struct{}
 
-func (x fastReflection_Game_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Game)(nil)
+func (x fastReflection_Bet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Bet)(nil)
 }
-func (x fastReflection_Game_messageType) New() protoreflect.Message {
-	return new(fastReflection_Game)
+func (x fastReflection_Bet_messageType) New() protoreflect.Message {
+	return new(fastReflection_Bet)
 }
-func (x fastReflection_Game_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Game
+func (x fastReflection_Bet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Bet
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Game) Descriptor() protoreflect.MessageDescriptor {
-	return md_Game
+func (x *fastReflection_Bet) Descriptor() protoreflect.MessageDescriptor {
+	return md_Bet
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Game) Type() protoreflect.MessageType {
-	return _fastReflection_Game_messageType
+func (x *fastReflection_Bet) Type() protoreflect.MessageType {
+	return _fastReflection_Bet_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Game) New() protoreflect.Message {
-	return new(fastReflection_Game)
+func (x *fastReflection_Bet) New() protoreflect.Message {
+	return new(fastReflection_Bet)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Game) Interface() protoreflect.ProtoMessage {
-	return (*Game)(x)
+func (x *fastReflection_Bet) Interface() protoreflect.ProtoMessage {
+	return (*Bet)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -550,28 +6169,22 @@ func (x *fastReflection_Game) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Game) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_Game_id, value) {
-			return
-		}
-	}
-	if x.EntryFee != nil {
-		value := protoreflect.ValueOfMessage(x.EntryFee.ProtoReflect())
-		if !f(fd_Game_entry_fee, value) {
+func (x *fastReflection_Bet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Outcome != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Outcome))
+		if !f(fd_Bet_outcome, value) {
 			return
 		}
 	}
-	if x.CommitTimeout != nil {
-		value := protoreflect.ValueOfMessage(x.CommitTimeout.ProtoReflect())
-		if !f(fd_Game_commit_timeout, value) {
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_Bet_2_list{list: &x.Amount})
+		if !f(fd_Bet_amount, value) {
 			return
 		}
 	}
-	if x.RevealTimeout != nil {
-		value := protoreflect.ValueOfMessage(x.RevealTimeout.ProtoReflect())
-		if !f(fd_Game_reveal_timeout, value) {
+	if x.CreatedAt != nil {
+		value := protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+		if !f(fd_Bet_created_at, value) {
 			return
 		}
 	}
@@ -588,21 +6201,19 @@ func (x *fastReflection_Game) Range(f func(protoreflect.FieldDescriptor, protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Game) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Bet) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.Game.id":
-		return x.Id != uint64(0)
-	case "facundomedica.rps.v1.Game.entry_fee":
-		return x.EntryFee != nil
-	case "facundomedica.rps.v1.Game.commit_timeout":
-		return x.CommitTimeout != nil
-	case "facundomedica.rps.v1.Game.reveal_timeout":
-		return x.RevealTimeout != nil
+	case "facundomedica.rps.v1.Bet.outcome":
+		return x.Outcome != 0
+	case "facundomedica.rps.v1.Bet.amount":
+		return len(x.Amount) != 0
+	case "facundomedica.rps.v1.Bet.created_at":
+		return x.CreatedAt != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Game"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Bet"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.Game does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.Bet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -612,21 +6223,19 @@ func (x *fastReflection_Game) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Game) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Bet) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.Game.id":
-		x.Id = uint64(0)
-	case "facundomedica.rps.v1.Game.entry_fee":
-		x.EntryFee = nil
-	case "facundomedica.rps.v1.Game.commit_timeout":
-		x.CommitTimeout = nil
-	case "facundomedica.rps.v1.Game.reveal_timeout":
-		x.RevealTimeout = nil
+	case "facundomedica.rps.v1.Bet.outcome":
+		x.Outcome = 0
+	case "facundomedica.rps.v1.Bet.amount":
+		x.Amount = nil
+	case "facundomedica.rps.v1.Bet.created_at":
+		x.CreatedAt = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Game"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Bet"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.Game does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.Bet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -636,25 +6245,25 @@ func (x *fastReflection_Game) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Game) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Bet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "facundomedica.rps.v1.Game.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
-	case "facundomedica.rps.v1.Game.entry_fee":
-		value := x.EntryFee
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "facundomedica.rps.v1.Game.commit_timeout":
-		value := x.CommitTimeout
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "facundomedica.rps.v1.Game.reveal_timeout":
-		value := x.RevealTimeout
+	case "facundomedica.rps.v1.Bet.outcome":
+		value := x.Outcome
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "facundomedica.rps.v1.Bet.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_Bet_2_list{})
+		}
+		listValue := &_Bet_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	case "facundomedica.rps.v1.Bet.created_at":
+		value := x.CreatedAt
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Game"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Bet"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.Game does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.Bet does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -668,21 +6277,21 @@ func (x *fastReflection_Game) Get(descriptor protoreflect.FieldDescriptor) proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Game) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Bet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.Game.id":
-		x.Id = value.Uint()
-	case "facundomedica.rps.v1.Game.entry_fee":
-		x.EntryFee = value.Message().Interface().(*v1beta1.Coin)
-	case "facundomedica.rps.v1.Game.commit_timeout":
-		x.CommitTimeout = value.Message().Interface().(*timestamppb.Timestamp)
-	case "facundomedica.rps.v1.Game.reveal_timeout":
-		x.RevealTimeout = value.Message().Interface().(*timestamppb.Timestamp)
+	case "facundomedica.rps.v1.Bet.outcome":
+		x.Outcome = (BetOutcome)(value.Enum())
+	case "facundomedica.rps.v1.Bet.amount":
+		lv := value.List()
+		clv := lv.(*_Bet_2_list)
+		x.Amount = *clv.list
+	case "facundomedica.rps.v1.Bet.created_at":
+		x.CreatedAt = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Game"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Bet"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.Game does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.Bet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -696,64 +6305,57 @@ func (x *fastReflection_Game) Set(fd protoreflect.FieldDescriptor, value protore
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Game) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Bet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.Game.entry_fee":
-		if x.EntryFee == nil {
-			x.EntryFee = new(v1beta1.Coin)
-		}
-		return protoreflect.ValueOfMessage(x.EntryFee.ProtoReflect())
-	case "facundomedica.rps.v1.Game.commit_timeout":
-		if x.CommitTimeout == nil {
-			x.CommitTimeout = new(timestamppb.Timestamp)
+	case "facundomedica.rps.v1.Bet.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
 		}
-		return protoreflect.ValueOfMessage(x.CommitTimeout.ProtoReflect())
-	case "facundomedica.rps.v1.Game.reveal_timeout":
-		if x.RevealTimeout == nil {
-			x.RevealTimeout = new(timestamppb.Timestamp)
+		value := &_Bet_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "facundomedica.rps.v1.Bet.created_at":
+		if x.CreatedAt == nil {
+			x.CreatedAt = new(timestamppb.Timestamp)
 		}
-		return protoreflect.ValueOfMessage(x.RevealTimeout.ProtoReflect())
-	case "facundomedica.rps.v1.Game.id":
-		panic(fmt.Errorf("field id of message facundomedica.rps.v1.Game is not mutable"))
+		return protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+	case "facundomedica.rps.v1.Bet.outcome":
+		panic(fmt.Errorf("field outcome of message facundomedica.rps.v1.Bet is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Game"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Bet"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.Game does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.Bet does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Game) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Bet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.Game.id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "facundomedica.rps.v1.Game.entry_fee":
-		m := new(v1beta1.Coin)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "facundomedica.rps.v1.Game.commit_timeout":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "facundomedica.rps.v1.Game.reveal_timeout":
+	case "facundomedica.rps.v1.Bet.outcome":
+		return protoreflect.ValueOfEnum(0)
+	case "facundomedica.rps.v1.Bet.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Bet_2_list{list: &list})
+	case "facundomedica.rps.v1.Bet.created_at":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Game"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.Bet"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.Game does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.Bet does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Game) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Bet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.Game", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.Bet", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -761,7 +6363,7 @@ func (x *fastReflection_Game) WhichOneof(d protoreflect.OneofDescriptor) protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Game) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Bet) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -772,7 +6374,7 @@ func (x *fastReflection_Game) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Game) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Bet) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -784,7 +6386,7 @@ func (x *fastReflection_Game) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Game) IsValid() bool {
+func (x *fastReflection_Bet) IsValid() bool {
 	return x != nil
 }
 
@@ -794,9 +6396,9 @@ func (x *fastReflection_Game) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Game) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Bet) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Game)
+		x := input.Message.Interface().(*Bet)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -808,19 +6410,17 @@ func (x *fastReflection_Game) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
+		if x.Outcome != 0 {
+			n += 1 + runtime.Sov(uint64(x.Outcome))
 		}
-		if x.EntryFee != nil {
-			l = options.Size(x.EntryFee)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.CommitTimeout != nil {
-			l = options.Size(x.CommitTimeout)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if x.RevealTimeout != nil {
-			l = options.Size(x.RevealTimeout)
+		if x.CreatedAt != nil {
+			l = options.Size(x.CreatedAt)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -833,7 +6433,7 @@ func (x *fastReflection_Game) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Game)
+		x := input.Message.Interface().(*Bet)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -852,22 +6452,8 @@ func (x *fastReflection_Game) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.RevealTimeout != nil {
-			encoded, err := options.Marshal(x.RevealTimeout)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.CommitTimeout != nil {
-			encoded, err := options.Marshal(x.CommitTimeout)
+		if x.CreatedAt != nil {
+			encoded, err := options.Marshal(x.CreatedAt)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -880,22 +6466,24 @@ func (x *fastReflection_Game) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x1a
 		}
-		if x.EntryFee != nil {
-			encoded, err := options.Marshal(x.EntryFee)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if x.Outcome != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Outcome))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -910,7 +6498,7 @@ func (x *fastReflection_Game) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Game)
+		x := input.Message.Interface().(*Bet)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -942,17 +6530,17 @@ func (x *fastReflection_Game) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Game: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Bet: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Game: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Bet: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
 				}
-				x.Id = 0
+				x.Outcome = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -962,14 +6550,14 @@ func (x *fastReflection_Game) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
+					x.Outcome |= BetOutcome(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EntryFee", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -996,52 +6584,14 @@ func (x *fastReflection_Game) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.EntryFee == nil {
-					x.EntryFee = &v1beta1.Coin{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EntryFee); err != nil {
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitTimeout", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.CommitTimeout == nil {
-					x.CommitTimeout = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CommitTimeout); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevealTimeout", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1068,10 +6618,10 @@ func (x *fastReflection_Game) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.RevealTimeout == nil {
-					x.RevealTimeout = &timestamppb.Timestamp{}
+				if x.CreatedAt == nil {
+					x.CreatedAt = &timestamppb.Timestamp{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RevealTimeout); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreatedAt); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1110,29 +6660,145 @@ func (x *fastReflection_Game) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_SettledGame_4_list)(nil)
+
+type _SettledGame_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_SettledGame_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SettledGame_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SettledGame_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SettledGame_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SettledGame_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SettledGame_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SettledGame_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SettledGame_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_SettledGame_5_list)(nil)
+
+type _SettledGame_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_SettledGame_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SettledGame_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SettledGame_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SettledGame_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SettledGame_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SettledGame_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SettledGame_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SettledGame_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MoveCommit            protoreflect.MessageDescriptor
-	fd_MoveCommit_commit     protoreflect.FieldDescriptor
-	fd_MoveCommit_created_at protoreflect.FieldDescriptor
+	md_SettledGame                  protoreflect.MessageDescriptor
+	fd_SettledGame_id               protoreflect.FieldDescriptor
+	fd_SettledGame_creator          protoreflect.FieldDescriptor
+	fd_SettledGame_challenger       protoreflect.FieldDescriptor
+	fd_SettledGame_creator_stake    protoreflect.FieldDescriptor
+	fd_SettledGame_challenger_stake protoreflect.FieldDescriptor
+	fd_SettledGame_timeout_mode     protoreflect.FieldDescriptor
+	fd_SettledGame_commit_duration  protoreflect.FieldDescriptor
+	fd_SettledGame_reveal_duration  protoreflect.FieldDescriptor
+	fd_SettledGame_expires_at       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_facundomedica_rps_v1_types_proto_init()
-	md_MoveCommit = File_facundomedica_rps_v1_types_proto.Messages().ByName("MoveCommit")
-	fd_MoveCommit_commit = md_MoveCommit.Fields().ByName("commit")
-	fd_MoveCommit_created_at = md_MoveCommit.Fields().ByName("created_at")
+	md_SettledGame = File_facundomedica_rps_v1_types_proto.Messages().ByName("SettledGame")
+	fd_SettledGame_id = md_SettledGame.Fields().ByName("id")
+	fd_SettledGame_creator = md_SettledGame.Fields().ByName("creator")
+	fd_SettledGame_challenger = md_SettledGame.Fields().ByName("challenger")
+	fd_SettledGame_creator_stake = md_SettledGame.Fields().ByName("creator_stake")
+	fd_SettledGame_challenger_stake = md_SettledGame.Fields().ByName("challenger_stake")
+	fd_SettledGame_timeout_mode = md_SettledGame.Fields().ByName("timeout_mode")
+	fd_SettledGame_commit_duration = md_SettledGame.Fields().ByName("commit_duration")
+	fd_SettledGame_reveal_duration = md_SettledGame.Fields().ByName("reveal_duration")
+	fd_SettledGame_expires_at = md_SettledGame.Fields().ByName("expires_at")
 }
 
-var _ protoreflect.Message = (*fastReflection_MoveCommit)(nil)
+var _ protoreflect.Message = (*fastReflection_SettledGame)(nil)
 
-type fastReflection_MoveCommit MoveCommit
+type fastReflection_SettledGame SettledGame
 
-func (x *MoveCommit) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MoveCommit)(x)
+func (x *SettledGame) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SettledGame)(x)
 }
 
-func (x *MoveCommit) slowProtoReflect() protoreflect.Message {
-	mi := &file_facundomedica_rps_v1_types_proto_msgTypes[2]
+func (x *SettledGame) slowProtoReflect() protoreflect.Message {
+	mi := &file_facundomedica_rps_v1_types_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1143,43 +6809,43 @@ func (x *MoveCommit) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MoveCommit_messageType fastReflection_MoveCommit_messageType
-var _ protoreflect.MessageType = fastReflection_MoveCommit_messageType{}
+var _fastReflection_SettledGame_messageType fastReflection_SettledGame_messageType
+var _ protoreflect.MessageType = fastReflection_SettledGame_messageType{}
 
-type fastReflection_MoveCommit_messageType struct{}
+type fastReflection_SettledGame_messageType struct{}
 
-func (x fastReflection_MoveCommit_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MoveCommit)(nil)
+func (x fastReflection_SettledGame_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SettledGame)(nil)
 }
-func (x fastReflection_MoveCommit_messageType) New() protoreflect.Message {
-	return new(fastReflection_MoveCommit)
+func (x fastReflection_SettledGame_messageType) New() protoreflect.Message {
+	return new(fastReflection_SettledGame)
 }
-func (x fastReflection_MoveCommit_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MoveCommit
+func (x fastReflection_SettledGame_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SettledGame
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MoveCommit) Descriptor() protoreflect.MessageDescriptor {
-	return md_MoveCommit
+func (x *fastReflection_SettledGame) Descriptor() protoreflect.MessageDescriptor {
+	return md_SettledGame
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MoveCommit) Type() protoreflect.MessageType {
-	return _fastReflection_MoveCommit_messageType
+func (x *fastReflection_SettledGame) Type() protoreflect.MessageType {
+	return _fastReflection_SettledGame_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MoveCommit) New() protoreflect.Message {
-	return new(fastReflection_MoveCommit)
+func (x *fastReflection_SettledGame) New() protoreflect.Message {
+	return new(fastReflection_SettledGame)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MoveCommit) Interface() protoreflect.ProtoMessage {
-	return (*MoveCommit)(x)
+func (x *fastReflection_SettledGame) Interface() protoreflect.ProtoMessage {
+	return (*SettledGame)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1187,16 +6853,58 @@ func (x *fastReflection_MoveCommit) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MoveCommit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Commit != "" {
-		value := protoreflect.ValueOfString(x.Commit)
-		if !f(fd_MoveCommit_commit, value) {
+func (x *fastReflection_SettledGame) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_SettledGame_id, value) {
 			return
 		}
 	}
-	if x.CreatedAt != nil {
-		value := protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
-		if !f(fd_MoveCommit_created_at, value) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_SettledGame_creator, value) {
+			return
+		}
+	}
+	if x.Challenger != "" {
+		value := protoreflect.ValueOfString(x.Challenger)
+		if !f(fd_SettledGame_challenger, value) {
+			return
+		}
+	}
+	if len(x.CreatorStake) != 0 {
+		value := protoreflect.ValueOfList(&_SettledGame_4_list{list: &x.CreatorStake})
+		if !f(fd_SettledGame_creator_stake, value) {
+			return
+		}
+	}
+	if len(x.ChallengerStake) != 0 {
+		value := protoreflect.ValueOfList(&_SettledGame_5_list{list: &x.ChallengerStake})
+		if !f(fd_SettledGame_challenger_stake, value) {
+			return
+		}
+	}
+	if x.TimeoutMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TimeoutMode))
+		if !f(fd_SettledGame_timeout_mode, value) {
+			return
+		}
+	}
+	if x.CommitDuration != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CommitDuration)
+		if !f(fd_SettledGame_commit_duration, value) {
+			return
+		}
+	}
+	if x.RevealDuration != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RevealDuration)
+		if !f(fd_SettledGame_reveal_duration, value) {
+			return
+		}
+	}
+	if x.ExpiresAt != nil {
+		value := protoreflect.ValueOfMessage(x.ExpiresAt.ProtoReflect())
+		if !f(fd_SettledGame_expires_at, value) {
 			return
 		}
 	}
@@ -1213,17 +6921,31 @@ func (x *fastReflection_MoveCommit) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MoveCommit) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SettledGame) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MoveCommit.commit":
-		return x.Commit != ""
-	case "facundomedica.rps.v1.MoveCommit.created_at":
-		return x.CreatedAt != nil
+	case "facundomedica.rps.v1.SettledGame.id":
+		return x.Id != uint64(0)
+	case "facundomedica.rps.v1.SettledGame.creator":
+		return x.Creator != ""
+	case "facundomedica.rps.v1.SettledGame.challenger":
+		return x.Challenger != ""
+	case "facundomedica.rps.v1.SettledGame.creator_stake":
+		return len(x.CreatorStake) != 0
+	case "facundomedica.rps.v1.SettledGame.challenger_stake":
+		return len(x.ChallengerStake) != 0
+	case "facundomedica.rps.v1.SettledGame.timeout_mode":
+		return x.TimeoutMode != 0
+	case "facundomedica.rps.v1.SettledGame.commit_duration":
+		return x.CommitDuration != uint64(0)
+	case "facundomedica.rps.v1.SettledGame.reveal_duration":
+		return x.RevealDuration != uint64(0)
+	case "facundomedica.rps.v1.SettledGame.expires_at":
+		return x.ExpiresAt != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveCommit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.SettledGame"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MoveCommit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.SettledGame does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1233,17 +6955,31 @@ func (x *fastReflection_MoveCommit) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MoveCommit) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SettledGame) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MoveCommit.commit":
-		x.Commit = ""
-	case "facundomedica.rps.v1.MoveCommit.created_at":
-		x.CreatedAt = nil
+	case "facundomedica.rps.v1.SettledGame.id":
+		x.Id = uint64(0)
+	case "facundomedica.rps.v1.SettledGame.creator":
+		x.Creator = ""
+	case "facundomedica.rps.v1.SettledGame.challenger":
+		x.Challenger = ""
+	case "facundomedica.rps.v1.SettledGame.creator_stake":
+		x.CreatorStake = nil
+	case "facundomedica.rps.v1.SettledGame.challenger_stake":
+		x.ChallengerStake = nil
+	case "facundomedica.rps.v1.SettledGame.timeout_mode":
+		x.TimeoutMode = 0
+	case "facundomedica.rps.v1.SettledGame.commit_duration":
+		x.CommitDuration = uint64(0)
+	case "facundomedica.rps.v1.SettledGame.reveal_duration":
+		x.RevealDuration = uint64(0)
+	case "facundomedica.rps.v1.SettledGame.expires_at":
+		x.ExpiresAt = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveCommit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.SettledGame"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MoveCommit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.SettledGame does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1253,19 +6989,46 @@ func (x *fastReflection_MoveCommit) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MoveCommit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SettledGame) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "facundomedica.rps.v1.MoveCommit.commit":
-		value := x.Commit
+	case "facundomedica.rps.v1.SettledGame.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.SettledGame.creator":
+		value := x.Creator
 		return protoreflect.ValueOfString(value)
-	case "facundomedica.rps.v1.MoveCommit.created_at":
-		value := x.CreatedAt
+	case "facundomedica.rps.v1.SettledGame.challenger":
+		value := x.Challenger
+		return protoreflect.ValueOfString(value)
+	case "facundomedica.rps.v1.SettledGame.creator_stake":
+		if len(x.CreatorStake) == 0 {
+			return protoreflect.ValueOfList(&_SettledGame_4_list{})
+		}
+		listValue := &_SettledGame_4_list{list: &x.CreatorStake}
+		return protoreflect.ValueOfList(listValue)
+	case "facundomedica.rps.v1.SettledGame.challenger_stake":
+		if len(x.ChallengerStake) == 0 {
+			return protoreflect.ValueOfList(&_SettledGame_5_list{})
+		}
+		listValue := &_SettledGame_5_list{list: &x.ChallengerStake}
+		return protoreflect.ValueOfList(listValue)
+	case "facundomedica.rps.v1.SettledGame.timeout_mode":
+		value := x.TimeoutMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "facundomedica.rps.v1.SettledGame.commit_duration":
+		value := x.CommitDuration
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.SettledGame.reveal_duration":
+		value := x.RevealDuration
+		return protoreflect.ValueOfUint64(value)
+	case "facundomedica.rps.v1.SettledGame.expires_at":
+		value := x.ExpiresAt
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveCommit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.SettledGame"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MoveCommit does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.SettledGame does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1279,17 +7042,35 @@ func (x *fastReflection_MoveCommit) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MoveCommit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SettledGame) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MoveCommit.commit":
-		x.Commit = value.Interface().(string)
-	case "facundomedica.rps.v1.MoveCommit.created_at":
-		x.CreatedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "facundomedica.rps.v1.SettledGame.id":
+		x.Id = value.Uint()
+	case "facundomedica.rps.v1.SettledGame.creator":
+		x.Creator = value.Interface().(string)
+	case "facundomedica.rps.v1.SettledGame.challenger":
+		x.Challenger = value.Interface().(string)
+	case "facundomedica.rps.v1.SettledGame.creator_stake":
+		lv := value.List()
+		clv := lv.(*_SettledGame_4_list)
+		x.CreatorStake = *clv.list
+	case "facundomedica.rps.v1.SettledGame.challenger_stake":
+		lv := value.List()
+		clv := lv.(*_SettledGame_5_list)
+		x.ChallengerStake = *clv.list
+	case "facundomedica.rps.v1.SettledGame.timeout_mode":
+		x.TimeoutMode = (TimeoutMode)(value.Enum())
+	case "facundomedica.rps.v1.SettledGame.commit_duration":
+		x.CommitDuration = value.Uint()
+	case "facundomedica.rps.v1.SettledGame.reveal_duration":
+		x.RevealDuration = value.Uint()
+	case "facundomedica.rps.v1.SettledGame.expires_at":
+		x.ExpiresAt = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveCommit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.SettledGame"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MoveCommit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.SettledGame does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1303,48 +7084,86 @@ func (x *fastReflection_MoveCommit) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MoveCommit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SettledGame) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MoveCommit.created_at":
-		if x.CreatedAt == nil {
-			x.CreatedAt = new(timestamppb.Timestamp)
+	case "facundomedica.rps.v1.SettledGame.creator_stake":
+		if x.CreatorStake == nil {
+			x.CreatorStake = []*v1beta1.Coin{}
 		}
-		return protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
-	case "facundomedica.rps.v1.MoveCommit.commit":
-		panic(fmt.Errorf("field commit of message facundomedica.rps.v1.MoveCommit is not mutable"))
+		value := &_SettledGame_4_list{list: &x.CreatorStake}
+		return protoreflect.ValueOfList(value)
+	case "facundomedica.rps.v1.SettledGame.challenger_stake":
+		if x.ChallengerStake == nil {
+			x.ChallengerStake = []*v1beta1.Coin{}
+		}
+		value := &_SettledGame_5_list{list: &x.ChallengerStake}
+		return protoreflect.ValueOfList(value)
+	case "facundomedica.rps.v1.SettledGame.expires_at":
+		if x.ExpiresAt == nil {
+			x.ExpiresAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpiresAt.ProtoReflect())
+	case "facundomedica.rps.v1.SettledGame.id":
+		panic(fmt.Errorf("field id of message facundomedica.rps.v1.SettledGame is not mutable"))
+	case "facundomedica.rps.v1.SettledGame.creator":
+		panic(fmt.Errorf("field creator of message facundomedica.rps.v1.SettledGame is not mutable"))
+	case "facundomedica.rps.v1.SettledGame.challenger":
+		panic(fmt.Errorf("field challenger of message facundomedica.rps.v1.SettledGame is not mutable"))
+	case "facundomedica.rps.v1.SettledGame.timeout_mode":
+		panic(fmt.Errorf("field timeout_mode of message facundomedica.rps.v1.SettledGame is not mutable"))
+	case "facundomedica.rps.v1.SettledGame.commit_duration":
+		panic(fmt.Errorf("field commit_duration of message facundomedica.rps.v1.SettledGame is not mutable"))
+	case "facundomedica.rps.v1.SettledGame.reveal_duration":
+		panic(fmt.Errorf("field reveal_duration of message facundomedica.rps.v1.SettledGame is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveCommit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.SettledGame"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MoveCommit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.SettledGame does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MoveCommit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SettledGame) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "facundomedica.rps.v1.MoveCommit.commit":
+	case "facundomedica.rps.v1.SettledGame.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.SettledGame.creator":
 		return protoreflect.ValueOfString("")
-	case "facundomedica.rps.v1.MoveCommit.created_at":
+	case "facundomedica.rps.v1.SettledGame.challenger":
+		return protoreflect.ValueOfString("")
+	case "facundomedica.rps.v1.SettledGame.creator_stake":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_SettledGame_4_list{list: &list})
+	case "facundomedica.rps.v1.SettledGame.challenger_stake":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_SettledGame_5_list{list: &list})
+	case "facundomedica.rps.v1.SettledGame.timeout_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "facundomedica.rps.v1.SettledGame.commit_duration":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.SettledGame.reveal_duration":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "facundomedica.rps.v1.SettledGame.expires_at":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveCommit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.SettledGame"))
 		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MoveCommit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message facundomedica.rps.v1.SettledGame does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MoveCommit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SettledGame) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MoveCommit", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.SettledGame", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1352,7 +7171,7 @@ func (x *fastReflection_MoveCommit) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MoveCommit) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SettledGame) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1363,7 +7182,7 @@ func (x *fastReflection_MoveCommit) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MoveCommit) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SettledGame) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1375,7 +7194,7 @@ func (x *fastReflection_MoveCommit) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MoveCommit) IsValid() bool {
+func (x *fastReflection_SettledGame) IsValid() bool {
 	return x != nil
 }
 
@@ -1385,9 +7204,9 @@ func (x *fastReflection_MoveCommit) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MoveCommit) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SettledGame) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MoveCommit)
+		x := input.Message.Interface().(*SettledGame)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1399,12 +7218,40 @@ func (x *fastReflection_MoveCommit) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Commit)
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Creator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.CreatedAt != nil {
-			l = options.Size(x.CreatedAt)
+		l = len(x.Challenger)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.CreatorStake) > 0 {
+			for _, e := range x.CreatorStake {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ChallengerStake) > 0 {
+			for _, e := range x.ChallengerStake {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TimeoutMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutMode))
+		}
+		if x.CommitDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.CommitDuration))
+		}
+		if x.RevealDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.RevealDuration))
+		}
+		if x.ExpiresAt != nil {
+			l = options.Size(x.ExpiresAt)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -1417,7 +7264,7 @@ func (x *fastReflection_MoveCommit) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MoveCommit)
+		x := input.Message.Interface().(*SettledGame)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1436,8 +7283,8 @@ func (x *fastReflection_MoveCommit) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.CreatedAt != nil {
-			encoded, err := options.Marshal(x.CreatedAt)
+		if x.ExpiresAt != nil {
+			encoded, err := options.Marshal(x.ExpiresAt)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1448,14 +7295,73 @@ func (x *fastReflection_MoveCommit) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
+			dAtA[i] = 0x4a
+		}
+		if x.RevealDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RevealDuration))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.CommitDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommitDuration))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.TimeoutMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutMode))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.ChallengerStake) > 0 {
+			for iNdEx := len(x.ChallengerStake) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ChallengerStake[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.CreatorStake) > 0 {
+			for iNdEx := len(x.CreatorStake) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CreatorStake[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Challenger) > 0 {
+			i -= len(x.Challenger)
+			copy(dAtA[i:], x.Challenger)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Challenger)))
+			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Commit) > 0 {
-			i -= len(x.Commit)
-			copy(dAtA[i:], x.Commit)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commit)))
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1468,7 +7374,7 @@ func (x *fastReflection_MoveCommit) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MoveCommit)
+		x := input.Message.Interface().(*SettledGame)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1500,15 +7406,34 @@ func (x *fastReflection_MoveCommit) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MoveCommit: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SettledGame: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MoveCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SettledGame: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1536,11 +7461,43 @@ func (x *fastReflection_MoveCommit) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Commit = string(dAtA[iNdEx:postIndex])
+				x.Creator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Challenger = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatorStake", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1567,10 +7524,135 @@ func (x *fastReflection_MoveCommit) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.CreatedAt == nil {
-					x.CreatedAt = &timestamppb.Timestamp{}
+				x.CreatorStake = append(x.CreatorStake, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreatorStake[len(x.CreatorStake)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreatedAt); err != nil {
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChallengerStake", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChallengerStake = append(x.ChallengerStake, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ChallengerStake[len(x.ChallengerStake)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutMode", wireType)
+				}
+				x.TimeoutMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeoutMode |= TimeoutMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitDuration", wireType)
+				}
+				x.CommitDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CommitDuration |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevealDuration", wireType)
+				}
+				x.RevealDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RevealDuration |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpiresAt == nil {
+					x.ExpiresAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiresAt); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1593,791 +7675,1097 @@ func (x *fastReflection_MoveCommit) ProtoMethods() *protoiface.Methods {
 			}
 		}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: facundomedica/rps/v1/types.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TimeoutMode defines how the deadlines of a game are expressed.
+type TimeoutMode int32
+
+const (
+	// TIMEOUT_MODE_UNSPECIFIED defaults to TIMEOUT_MODE_TIME.
+	TimeoutMode_TIMEOUT_MODE_UNSPECIFIED TimeoutMode = 0
+	// TIMEOUT_MODE_TIME uses deadlines expressed as timestamps, calculated by
+	// adding seconds to the block time.
+	TimeoutMode_TIMEOUT_MODE_TIME TimeoutMode = 1
+	// TIMEOUT_MODE_HEIGHT uses deadlines expressed as block heights, calculated
+	// by adding blocks to the block height.
+	TimeoutMode_TIMEOUT_MODE_HEIGHT TimeoutMode = 2
+)
+
+// Enum value maps for TimeoutMode.
+var (
+	TimeoutMode_name = map[int32]string{
+		0: "TIMEOUT_MODE_UNSPECIFIED",
+		1: "TIMEOUT_MODE_TIME",
+		2: "TIMEOUT_MODE_HEIGHT",
+	}
+	TimeoutMode_value = map[string]int32{
+		"TIMEOUT_MODE_UNSPECIFIED": 0,
+		"TIMEOUT_MODE_TIME":        1,
+		"TIMEOUT_MODE_HEIGHT":      2,
+	}
+)
+
+func (x TimeoutMode) Enum() *TimeoutMode {
+	p := new(TimeoutMode)
+	*p = x
+	return p
+}
+
+func (x TimeoutMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeoutMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_facundomedica_rps_v1_types_proto_enumTypes[0].Descriptor()
+}
+
+func (TimeoutMode) Type() protoreflect.EnumType {
+	return &file_facundomedica_rps_v1_types_proto_enumTypes[0]
+}
+
+func (x TimeoutMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeoutMode.Descriptor instead.
+func (TimeoutMode) EnumDescriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+// Move is a move of rock, paper, scissors. Commitments are computed over the
+// lowercase name of the move without the prefix, e.g. "rock:salt".
+type Move int32
+
+const (
+	Move_MOVE_UNSPECIFIED Move = 0
+	Move_MOVE_ROCK        Move = 1
+	Move_MOVE_PAPER       Move = 2
+	Move_MOVE_SCISSORS    Move = 3
+)
+
+// Enum value maps for Move.
+var (
+	Move_name = map[int32]string{
+		0: "MOVE_UNSPECIFIED",
+		1: "MOVE_ROCK",
+		2: "MOVE_PAPER",
+		3: "MOVE_SCISSORS",
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	Move_value = map[string]int32{
+		"MOVE_UNSPECIFIED": 0,
+		"MOVE_ROCK":        1,
+		"MOVE_PAPER":       2,
+		"MOVE_SCISSORS":    3,
 	}
+)
+
+func (x Move) Enum() *Move {
+	p := new(Move)
+	*p = x
+	return p
+}
+
+func (x Move) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Move) Descriptor() protoreflect.EnumDescriptor {
+	return file_facundomedica_rps_v1_types_proto_enumTypes[1].Descriptor()
+}
+
+func (Move) Type() protoreflect.EnumType {
+	return &file_facundomedica_rps_v1_types_proto_enumTypes[1]
 }
 
+func (x Move) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Move.Descriptor instead.
+func (Move) EnumDescriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+// CommitmentScheme is the hash function a commitment is computed with, over
+// "move:salt" like in MoveCommit.
+type CommitmentScheme int32
+
+const (
+	// COMMITMENT_SCHEME_UNSPECIFIED defaults to COMMITMENT_SCHEME_SHA256.
+	CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED CommitmentScheme = 0
+	// COMMITMENT_SCHEME_SHA256 uses SHA-256, it's always enabled.
+	CommitmentScheme_COMMITMENT_SCHEME_SHA256 CommitmentScheme = 1
+	// COMMITMENT_SCHEME_KECCAK256 uses Keccak-256, as in the EVM.
+	CommitmentScheme_COMMITMENT_SCHEME_KECCAK256 CommitmentScheme = 2
+)
+
+// Enum value maps for CommitmentScheme.
 var (
-	md_MoveReveal            protoreflect.MessageDescriptor
-	fd_MoveReveal_move       protoreflect.FieldDescriptor
-	fd_MoveReveal_salt       protoreflect.FieldDescriptor
-	fd_MoveReveal_created_at protoreflect.FieldDescriptor
+	CommitmentScheme_name = map[int32]string{
+		0: "COMMITMENT_SCHEME_UNSPECIFIED",
+		1: "COMMITMENT_SCHEME_SHA256",
+		2: "COMMITMENT_SCHEME_KECCAK256",
+	}
+	CommitmentScheme_value = map[string]int32{
+		"COMMITMENT_SCHEME_UNSPECIFIED": 0,
+		"COMMITMENT_SCHEME_SHA256":      1,
+		"COMMITMENT_SCHEME_KECCAK256":   2,
+	}
 )
 
-func init() {
-	file_facundomedica_rps_v1_types_proto_init()
-	md_MoveReveal = File_facundomedica_rps_v1_types_proto.Messages().ByName("MoveReveal")
-	fd_MoveReveal_move = md_MoveReveal.Fields().ByName("move")
-	fd_MoveReveal_salt = md_MoveReveal.Fields().ByName("salt")
-	fd_MoveReveal_created_at = md_MoveReveal.Fields().ByName("created_at")
+func (x CommitmentScheme) Enum() *CommitmentScheme {
+	p := new(CommitmentScheme)
+	*p = x
+	return p
 }
 
-var _ protoreflect.Message = (*fastReflection_MoveReveal)(nil)
+func (x CommitmentScheme) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-type fastReflection_MoveReveal MoveReveal
+func (CommitmentScheme) Descriptor() protoreflect.EnumDescriptor {
+	return file_facundomedica_rps_v1_types_proto_enumTypes[2].Descriptor()
+}
 
-func (x *MoveReveal) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MoveReveal)(x)
+func (CommitmentScheme) Type() protoreflect.EnumType {
+	return &file_facundomedica_rps_v1_types_proto_enumTypes[2]
 }
 
-func (x *MoveReveal) slowProtoReflect() protoreflect.Message {
-	mi := &file_facundomedica_rps_v1_types_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x CommitmentScheme) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommitmentScheme.Descriptor instead.
+func (CommitmentScheme) EnumDescriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+// BetOutcome is the outcome of a game spectators can bet on.
+type BetOutcome int32
+
+const (
+	BetOutcome_BET_OUTCOME_UNSPECIFIED BetOutcome = 0
+	// BET_OUTCOME_CREATOR is a win of the player that created the game.
+	BetOutcome_BET_OUTCOME_CREATOR BetOutcome = 1
+	// BET_OUTCOME_CHALLENGER is a win of the player that joined the game.
+	BetOutcome_BET_OUTCOME_CHALLENGER BetOutcome = 2
+	// BET_OUTCOME_DRAW is a draw, both players revealed the same move.
+	BetOutcome_BET_OUTCOME_DRAW BetOutcome = 3
+)
+
+// Enum value maps for BetOutcome.
+var (
+	BetOutcome_name = map[int32]string{
+		0: "BET_OUTCOME_UNSPECIFIED",
+		1: "BET_OUTCOME_CREATOR",
+		2: "BET_OUTCOME_CHALLENGER",
+		3: "BET_OUTCOME_DRAW",
+	}
+	BetOutcome_value = map[string]int32{
+		"BET_OUTCOME_UNSPECIFIED": 0,
+		"BET_OUTCOME_CREATOR":     1,
+		"BET_OUTCOME_CHALLENGER":  2,
+		"BET_OUTCOME_DRAW":        3,
+	}
+)
+
+func (x BetOutcome) Enum() *BetOutcome {
+	p := new(BetOutcome)
+	*p = x
+	return p
+}
+
+func (x BetOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BetOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_facundomedica_rps_v1_types_proto_enumTypes[3].Descriptor()
+}
+
+func (BetOutcome) Type() protoreflect.EnumType {
+	return &file_facundomedica_rps_v1_types_proto_enumTypes[3]
+}
+
+func (x BetOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BetOutcome.Descriptor instead.
+func (BetOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+// Params defines the parameters of the module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommitTimeout uint64 `protobuf:"varint,1,opt,name=commit_timeout,json=commitTimeout,proto3" json:"commit_timeout,omitempty"` // in seconds
+	RevealTimeout uint64 `protobuf:"varint,2,opt,name=reveal_timeout,json=revealTimeout,proto3" json:"reveal_timeout,omitempty"` // in seconds
+	// max_settlements_per_block is the maximum number of games settled by the
+	// EndBlocker in a single block, the rest are carried to the next block.
+	// The settled games whose rematch window is over are pruned up to the
+	// same limit. Zero means no limit.
+	MaxSettlementsPerBlock uint64 `protobuf:"varint,3,opt,name=max_settlements_per_block,json=maxSettlementsPerBlock,proto3" json:"max_settlements_per_block,omitempty"`
+	// timeout_mode is the mode used for the deadlines of new games.
+	TimeoutMode         TimeoutMode `protobuf:"varint,4,opt,name=timeout_mode,json=timeoutMode,proto3,enum=facundomedica.rps.v1.TimeoutMode" json:"timeout_mode,omitempty"`
+	CommitTimeoutBlocks uint64      `protobuf:"varint,5,opt,name=commit_timeout_blocks,json=commitTimeoutBlocks,proto3" json:"commit_timeout_blocks,omitempty"` // in blocks, used by TIMEOUT_MODE_HEIGHT
+	RevealTimeoutBlocks uint64      `protobuf:"varint,6,opt,name=reveal_timeout_blocks,json=revealTimeoutBlocks,proto3" json:"reveal_timeout_blocks,omitempty"` // in blocks, used by TIMEOUT_MODE_HEIGHT
+	// bounds for the timeouts chosen by game creators, a zero max disables
+	// the overrides.
+	MinTimeout       uint64 `protobuf:"varint,7,opt,name=min_timeout,json=minTimeout,proto3" json:"min_timeout,omitempty"`                      // in seconds
+	MaxTimeout       uint64 `protobuf:"varint,8,opt,name=max_timeout,json=maxTimeout,proto3" json:"max_timeout,omitempty"`                      // in seconds
+	MinTimeoutBlocks uint64 `protobuf:"varint,9,opt,name=min_timeout_blocks,json=minTimeoutBlocks,proto3" json:"min_timeout_blocks,omitempty"`  // in blocks, used by TIMEOUT_MODE_HEIGHT
+	MaxTimeoutBlocks uint64 `protobuf:"varint,10,opt,name=max_timeout_blocks,json=maxTimeoutBlocks,proto3" json:"max_timeout_blocks,omitempty"` // in blocks, used by TIMEOUT_MODE_HEIGHT
+	// no_show_penalty is the fraction of the stake of a player that didn't
+	// reveal their move which is sent to the community pool. It only applies
+	// when nobody revealed, otherwise the opponent takes the whole stake.
+	NoShowPenalty string `protobuf:"bytes,11,opt,name=no_show_penalty,json=noShowPenalty,proto3" json:"no_show_penalty,omitempty"`
+	// no_show_cooldown is the time a player that didn't reveal their move
+	// must wait before joining a new game.
+	NoShowCooldown uint64 `protobuf:"varint,12,opt,name=no_show_cooldown,json=noShowCooldown,proto3" json:"no_show_cooldown,omitempty"` // in seconds
+	// allowed_denoms are the denoms that can be used in entry fees, if empty
+	// any denom is allowed.
+	AllowedDenoms []string `protobuf:"bytes,13,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// betting_fee is the fraction of the spectator betting pools of a game
+	// that is sent to the community pool when the bets are settled.
+	BettingFee string `protobuf:"bytes,14,opt,name=betting_fee,json=bettingFee,proto3" json:"betting_fee,omitempty"`
+	// jackpot_fee is the fraction of the pot of every game won by a player
+	// that goes into the progressive jackpot.
+	JackpotFee string `protobuf:"bytes,15,opt,name=jackpot_fee,json=jackpotFee,proto3" json:"jackpot_fee,omitempty"`
+	// jackpot_streak is the number of games a player has to win in a row to
+	// take the jackpot. Zero disables the payouts.
+	JackpotStreak uint64 `protobuf:"varint,16,opt,name=jackpot_streak,json=jackpotStreak,proto3" json:"jackpot_streak,omitempty"`
+	// house_max_exposure is the maximum stake the house puts into a single
+	// game against a player, for each denom. Empty disables house games.
+	HouseMaxExposure []*v1beta1.Coin `protobuf:"bytes,17,rep,name=house_max_exposure,json=houseMaxExposure,proto3" json:"house_max_exposure,omitempty"`
+	// rematch_window is how long the players of a settled game can offer a
+	// rematch, in seconds. Zero disables rematches.
+	RematchWindow uint64 `protobuf:"varint,18,opt,name=rematch_window,json=rematchWindow,proto3" json:"rematch_window,omitempty"`
+	// commitment_schemes are the commitment schemes players can use besides
+	// COMMITMENT_SCHEME_SHA256, which is always enabled.
+	CommitmentSchemes []CommitmentScheme `protobuf:"varint,19,rep,packed,name=commitment_schemes,json=commitmentSchemes,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"commitment_schemes,omitempty"`
+	// queue_match_grace is added to the reveal window of the games created by
+	// the matchmaking queue, as the player that was waiting may not be online
+	// when matched.
+	QueueMatchGrace       uint64 `protobuf:"varint,20,opt,name=queue_match_grace,json=queueMatchGrace,proto3" json:"queue_match_grace,omitempty"`                     // in seconds
+	QueueMatchGraceBlocks uint64 `protobuf:"varint,21,opt,name=queue_match_grace_blocks,json=queueMatchGraceBlocks,proto3" json:"queue_match_grace_blocks,omitempty"` // in blocks, used by TIMEOUT_MODE_HEIGHT
+	// jackpot_min_stake is the minimum stake both players of a game must put
+	// in, in any of its denoms, for a win to count toward the jackpot streak.
+	// Empty disables the minimum.
+	JackpotMinStake []*v1beta1.Coin `protobuf:"bytes,22,rep,name=jackpot_min_stake,json=jackpotMinStake,proto3" json:"jackpot_min_stake,omitempty"`
+	// house_max_open_exposure is the maximum total stake the house puts into
+	// the games in progress, for each denom. Empty only bounds it by the
+	// bankroll.
+	HouseMaxOpenExposure []*v1beta1.Coin `protobuf:"bytes,23,rep,name=house_max_open_exposure,json=houseMaxOpenExposure,proto3" json:"house_max_open_exposure,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_types_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		ms.StoreMessageInfo(mi)
 	}
-	return mi.MessageOf(x)
 }
 
-var _fastReflection_MoveReveal_messageType fastReflection_MoveReveal_messageType
-var _ protoreflect.MessageType = fastReflection_MoveReveal_messageType{}
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-type fastReflection_MoveReveal_messageType struct{}
+func (*Params) ProtoMessage() {}
 
-func (x fastReflection_MoveReveal_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MoveReveal)(nil)
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_types_proto_rawDescGZIP(), []int{0}
 }
-func (x fastReflection_MoveReveal_messageType) New() protoreflect.Message {
-	return new(fastReflection_MoveReveal)
+
+func (x *Params) GetCommitTimeout() uint64 {
+	if x != nil {
+		return x.CommitTimeout
+	}
+	return 0
 }
-func (x fastReflection_MoveReveal_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MoveReveal
+
+func (x *Params) GetRevealTimeout() uint64 {
+	if x != nil {
+		return x.RevealTimeout
+	}
+	return 0
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_MoveReveal) Descriptor() protoreflect.MessageDescriptor {
-	return md_MoveReveal
+func (x *Params) GetMaxSettlementsPerBlock() uint64 {
+	if x != nil {
+		return x.MaxSettlementsPerBlock
+	}
+	return 0
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MoveReveal) Type() protoreflect.MessageType {
-	return _fastReflection_MoveReveal_messageType
+func (x *Params) GetTimeoutMode() TimeoutMode {
+	if x != nil {
+		return x.TimeoutMode
+	}
+	return TimeoutMode_TIMEOUT_MODE_UNSPECIFIED
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MoveReveal) New() protoreflect.Message {
-	return new(fastReflection_MoveReveal)
+func (x *Params) GetCommitTimeoutBlocks() uint64 {
+	if x != nil {
+		return x.CommitTimeoutBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetRevealTimeoutBlocks() uint64 {
+	if x != nil {
+		return x.RevealTimeoutBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetMinTimeout() uint64 {
+	if x != nil {
+		return x.MinTimeout
+	}
+	return 0
+}
+
+func (x *Params) GetMaxTimeout() uint64 {
+	if x != nil {
+		return x.MaxTimeout
+	}
+	return 0
+}
+
+func (x *Params) GetMinTimeoutBlocks() uint64 {
+	if x != nil {
+		return x.MinTimeoutBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetMaxTimeoutBlocks() uint64 {
+	if x != nil {
+		return x.MaxTimeoutBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetNoShowPenalty() string {
+	if x != nil {
+		return x.NoShowPenalty
+	}
+	return ""
+}
+
+func (x *Params) GetNoShowCooldown() uint64 {
+	if x != nil {
+		return x.NoShowCooldown
+	}
+	return 0
+}
+
+func (x *Params) GetAllowedDenoms() []string {
+	if x != nil {
+		return x.AllowedDenoms
+	}
+	return nil
+}
+
+func (x *Params) GetBettingFee() string {
+	if x != nil {
+		return x.BettingFee
+	}
+	return ""
+}
+
+func (x *Params) GetJackpotFee() string {
+	if x != nil {
+		return x.JackpotFee
+	}
+	return ""
+}
+
+func (x *Params) GetJackpotStreak() uint64 {
+	if x != nil {
+		return x.JackpotStreak
+	}
+	return 0
+}
+
+func (x *Params) GetHouseMaxExposure() []*v1beta1.Coin {
+	if x != nil {
+		return x.HouseMaxExposure
+	}
+	return nil
+}
+
+func (x *Params) GetRematchWindow() uint64 {
+	if x != nil {
+		return x.RematchWindow
+	}
+	return 0
+}
+
+func (x *Params) GetCommitmentSchemes() []CommitmentScheme {
+	if x != nil {
+		return x.CommitmentSchemes
+	}
+	return nil
+}
+
+func (x *Params) GetQueueMatchGrace() uint64 {
+	if x != nil {
+		return x.QueueMatchGrace
+	}
+	return 0
+}
+
+func (x *Params) GetQueueMatchGraceBlocks() uint64 {
+	if x != nil {
+		return x.QueueMatchGraceBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetJackpotMinStake() []*v1beta1.Coin {
+	if x != nil {
+		return x.JackpotMinStake
+	}
+	return nil
+}
+
+func (x *Params) GetHouseMaxOpenExposure() []*v1beta1.Coin {
+	if x != nil {
+		return x.HouseMaxOpenExposure
+	}
+	return nil
+}
+
+type Game struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// entry_fee is the stake the creator puts into the game, it can be made
+	// of multiple denoms.
+	EntryFee      []*v1beta1.Coin        `protobuf:"bytes,2,rep,name=entry_fee,json=entryFee,proto3" json:"entry_fee,omitempty"`
+	CommitTimeout *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=commit_timeout,json=commitTimeout,proto3" json:"commit_timeout,omitempty"`
+	RevealTimeout *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=reveal_timeout,json=revealTimeout,proto3" json:"reveal_timeout,omitempty"`
+	// timeout_mode is the mode the game was created with, it defines which
+	// deadlines are used.
+	TimeoutMode         TimeoutMode `protobuf:"varint,5,opt,name=timeout_mode,json=timeoutMode,proto3,enum=facundomedica.rps.v1.TimeoutMode" json:"timeout_mode,omitempty"`
+	CommitTimeoutHeight int64       `protobuf:"varint,6,opt,name=commit_timeout_height,json=commitTimeoutHeight,proto3" json:"commit_timeout_height,omitempty"`
+	RevealTimeoutHeight int64       `protobuf:"varint,7,opt,name=reveal_timeout_height,json=revealTimeoutHeight,proto3" json:"reveal_timeout_height,omitempty"`
+	// commit_duration and reveal_duration are the length of the commit and
+	// reveal windows of this game, in seconds or blocks depending on the
+	// timeout_mode.
+	CommitDuration uint64 `protobuf:"varint,8,opt,name=commit_duration,json=commitDuration,proto3" json:"commit_duration,omitempty"`
+	RevealDuration uint64 `protobuf:"varint,9,opt,name=reveal_duration,json=revealDuration,proto3" json:"reveal_duration,omitempty"`
+	// challenger_fee is the stake the challenger puts into the game. When
+	// empty the challenger matches the entry_fee, otherwise the creator is
+	// offering odds.
+	ChallengerFee []*v1beta1.Coin `protobuf:"bytes,10,rep,name=challenger_fee,json=challengerFee,proto3" json:"challenger_fee,omitempty"`
+	// creator is the player that created the game, games created before it
+	// was tracked leave it empty and don't accept bets.
+	Creator string `protobuf:"bytes,11,opt,name=creator,proto3" json:"creator,omitempty"`
+	// house is set for games played against the module, the house is the
+	// challenger and its stake is taken from the house bankroll.
+	House bool `protobuf:"varint,12,opt,name=house,proto3" json:"house,omitempty"`
+	// house_seed is the hash of the block after the one the house game was
+	// created in, set by the EndBlocker of that block so it's unknown when the
+	// player commits. The house move is derived from it and the salt of the
+	// player, see utils.HouseMove.
+	HouseSeed []byte `protobuf:"bytes,13,opt,name=house_seed,json=houseSeed,proto3" json:"house_seed,omitempty"`
+	// reserved_for is the only player that can join the game, set for
+	// rematches.
+	ReservedFor string `protobuf:"bytes,14,opt,name=reserved_for,json=reservedFor,proto3" json:"reserved_for,omitempty"`
+}
+
+func (x *Game) Reset() {
+	*x = Game{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_MoveReveal) Interface() protoreflect.ProtoMessage {
-	return (*MoveReveal)(x)
+func (x *Game) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_MoveReveal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Move != "" {
-		value := protoreflect.ValueOfString(x.Move)
-		if !f(fd_MoveReveal_move, value) {
-			return
-		}
-	}
-	if x.Salt != "" {
-		value := protoreflect.ValueOfString(x.Salt)
-		if !f(fd_MoveReveal_salt, value) {
-			return
-		}
-	}
-	if x.CreatedAt != nil {
-		value := protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
-		if !f(fd_MoveReveal_created_at, value) {
-			return
-		}
-	}
-}
+func (*Game) ProtoMessage() {}
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_MoveReveal) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "facundomedica.rps.v1.MoveReveal.move":
-		return x.Move != ""
-	case "facundomedica.rps.v1.MoveReveal.salt":
-		return x.Salt != ""
-	case "facundomedica.rps.v1.MoveReveal.created_at":
-		return x.CreatedAt != nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveReveal"))
-		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MoveReveal does not contain field %s", fd.FullName()))
-	}
+// Deprecated: Use Game.ProtoReflect.Descriptor instead.
+func (*Game) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_types_proto_rawDescGZIP(), []int{1}
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MoveReveal) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "facundomedica.rps.v1.MoveReveal.move":
-		x.Move = ""
-	case "facundomedica.rps.v1.MoveReveal.salt":
-		x.Salt = ""
-	case "facundomedica.rps.v1.MoveReveal.created_at":
-		x.CreatedAt = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveReveal"))
-		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MoveReveal does not contain field %s", fd.FullName()))
+func (x *Game) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
+	return 0
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MoveReveal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "facundomedica.rps.v1.MoveReveal.move":
-		value := x.Move
-		return protoreflect.ValueOfString(value)
-	case "facundomedica.rps.v1.MoveReveal.salt":
-		value := x.Salt
-		return protoreflect.ValueOfString(value)
-	case "facundomedica.rps.v1.MoveReveal.created_at":
-		value := x.CreatedAt
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveReveal"))
-		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MoveReveal does not contain field %s", descriptor.FullName()))
+func (x *Game) GetEntryFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.EntryFee
 	}
+	return nil
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MoveReveal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "facundomedica.rps.v1.MoveReveal.move":
-		x.Move = value.Interface().(string)
-	case "facundomedica.rps.v1.MoveReveal.salt":
-		x.Salt = value.Interface().(string)
-	case "facundomedica.rps.v1.MoveReveal.created_at":
-		x.CreatedAt = value.Message().Interface().(*timestamppb.Timestamp)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveReveal"))
-		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MoveReveal does not contain field %s", fd.FullName()))
+func (x *Game) GetCommitTimeout() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CommitTimeout
 	}
+	return nil
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MoveReveal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "facundomedica.rps.v1.MoveReveal.created_at":
-		if x.CreatedAt == nil {
-			x.CreatedAt = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
-	case "facundomedica.rps.v1.MoveReveal.move":
-		panic(fmt.Errorf("field move of message facundomedica.rps.v1.MoveReveal is not mutable"))
-	case "facundomedica.rps.v1.MoveReveal.salt":
-		panic(fmt.Errorf("field salt of message facundomedica.rps.v1.MoveReveal is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveReveal"))
-		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MoveReveal does not contain field %s", fd.FullName()))
+func (x *Game) GetRevealTimeout() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevealTimeout
 	}
+	return nil
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MoveReveal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "facundomedica.rps.v1.MoveReveal.move":
-		return protoreflect.ValueOfString("")
-	case "facundomedica.rps.v1.MoveReveal.salt":
-		return protoreflect.ValueOfString("")
-	case "facundomedica.rps.v1.MoveReveal.created_at":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: facundomedica.rps.v1.MoveReveal"))
-		}
-		panic(fmt.Errorf("message facundomedica.rps.v1.MoveReveal does not contain field %s", fd.FullName()))
+func (x *Game) GetTimeoutMode() TimeoutMode {
+	if x != nil {
+		return x.TimeoutMode
 	}
+	return TimeoutMode_TIMEOUT_MODE_UNSPECIFIED
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MoveReveal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in facundomedica.rps.v1.MoveReveal", d.FullName()))
+func (x *Game) GetCommitTimeoutHeight() int64 {
+	if x != nil {
+		return x.CommitTimeoutHeight
 	}
-	panic("unreachable")
+	return 0
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MoveReveal) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *Game) GetRevealTimeoutHeight() int64 {
+	if x != nil {
+		return x.RevealTimeoutHeight
+	}
+	return 0
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MoveReveal) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *Game) GetCommitDuration() uint64 {
+	if x != nil {
+		return x.CommitDuration
+	}
+	return 0
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_MoveReveal) IsValid() bool {
-	return x != nil
+func (x *Game) GetRevealDuration() uint64 {
+	if x != nil {
+		return x.RevealDuration
+	}
+	return 0
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_MoveReveal) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MoveReveal)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		l = len(x.Move)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Salt)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.CreatedAt != nil {
-			l = options.Size(x.CreatedAt)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *Game) GetChallengerFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.ChallengerFee
 	}
+	return nil
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MoveReveal)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.CreatedAt != nil {
-			encoded, err := options.Marshal(x.CreatedAt)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Salt) > 0 {
-			i -= len(x.Salt)
-			copy(dAtA[i:], x.Salt)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Salt)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Move) > 0 {
-			i -= len(x.Move)
-			copy(dAtA[i:], x.Move)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Move)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+func (x *Game) GetCreator() string {
+	if x != nil {
+		return x.Creator
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MoveReveal)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MoveReveal: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MoveReveal: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Move = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Salt = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.CreatedAt == nil {
-					x.CreatedAt = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreatedAt); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+	return ""
+}
+
+func (x *Game) GetHouse() bool {
+	if x != nil {
+		return x.House
+	}
+	return false
+}
+
+func (x *Game) GetHouseSeed() []byte {
+	if x != nil {
+		return x.HouseSeed
+	}
+	return nil
+}
+
+func (x *Game) GetReservedFor() string {
+	if x != nil {
+		return x.ReservedFor
+	}
+	return ""
+}
+
+type MoveCommit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// commit is the hash of "move:salt", where move is the name of the
+	// move, e.g. "rock", and salt the lowercase hex encoding of the salt. The
+	// hash function is the one of the scheme, sha256 by default.
+	Commit    []byte                 `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// stake is what the player put into the game. Commits made before stakes
+	// were tracked per player leave it empty and use the game entry_fee.
+	Stake []*v1beta1.Coin `protobuf:"bytes,4,rep,name=stake,proto3" json:"stake,omitempty"`
+	// encrypted_reveal is the reveal escrowed for the player's reveal agent,
+	// if any.
+	EncryptedReveal []byte `protobuf:"bytes,5,opt,name=encrypted_reveal,json=encryptedReveal,proto3" json:"encrypted_reveal,omitempty"`
+	// scheme is the commitment scheme the commit was computed with.
+	Scheme CommitmentScheme `protobuf:"varint,6,opt,name=scheme,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"scheme,omitempty"`
+}
+
+func (x *MoveCommit) Reset() {
+	*x = MoveCommit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveCommit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCommit) ProtoMessage() {}
+
+// Deprecated: Use MoveCommit.ProtoReflect.Descriptor instead.
+func (*MoveCommit) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MoveCommit) GetCommit() []byte {
+	if x != nil {
+		return x.Commit
+	}
+	return nil
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (x *MoveCommit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return nil
+}
+
+func (x *MoveCommit) GetStake() []*v1beta1.Coin {
+	if x != nil {
+		return x.Stake
 	}
+	return nil
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: facundomedica/rps/v1/types.proto
+func (x *MoveCommit) GetEncryptedReveal() []byte {
+	if x != nil {
+		return x.EncryptedReveal
+	}
+	return nil
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *MoveCommit) GetScheme() CommitmentScheme {
+	if x != nil {
+		return x.Scheme
+	}
+	return CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED
+}
 
-// Params defines the parameters of the module.
-type Params struct {
+type MoveReveal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommitTimeout uint64 `protobuf:"varint,1,opt,name=commit_timeout,json=commitTimeout,proto3" json:"commit_timeout,omitempty"` // in seconds
-	RevealTimeout uint64 `protobuf:"varint,2,opt,name=reveal_timeout,json=revealTimeout,proto3" json:"reveal_timeout,omitempty"` // in seconds
+	Move      Move                   `protobuf:"varint,1,opt,name=move,proto3,enum=facundomedica.rps.v1.Move" json:"move,omitempty"`
+	Salt      []byte                 `protobuf:"bytes,2,opt,name=salt,proto3" json:"salt,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
+func (x *MoveReveal) Reset() {
+	*x = MoveReveal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_facundomedica_rps_v1_types_proto_msgTypes[0]
+		mi := &file_facundomedica_rps_v1_types_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Params) String() string {
+func (x *MoveReveal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Params) ProtoMessage() {}
+func (*MoveReveal) ProtoMessage() {}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_facundomedica_rps_v1_types_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use MoveReveal.ProtoReflect.Descriptor instead.
+func (*MoveReveal) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_types_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Params) GetCommitTimeout() uint64 {
+func (x *MoveReveal) GetMove() Move {
 	if x != nil {
-		return x.CommitTimeout
+		return x.Move
+	}
+	return Move_MOVE_UNSPECIFIED
+}
+
+func (x *MoveReveal) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+func (x *MoveReveal) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// PlayerStats holds the track record of a player.
+type PlayerStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// no_shows is the number of games in which the player didn't reveal their
+	// move.
+	NoShows uint64 `protobuf:"varint,1,opt,name=no_shows,json=noShows,proto3" json:"no_shows,omitempty"`
+	// cooldown_until is the time until which the player can't join new games.
+	CooldownUntil *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=cooldown_until,json=cooldownUntil,proto3" json:"cooldown_until,omitempty"`
+	// wins is the number of games the player won with both moves revealed.
+	Wins uint64 `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	// win_streak is the number of games the player won in a row, it's reset
+	// by losses, draws and no-shows, and when the jackpot is paid out. Wins of
+	// games that didn't feed the jackpot or with a stake below
+	// Params.jackpot_min_stake don't count.
+	WinStreak uint64 `protobuf:"varint,4,opt,name=win_streak,json=winStreak,proto3" json:"win_streak,omitempty"`
+}
+
+func (x *PlayerStats) Reset() {
+	*x = PlayerStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_facundomedica_rps_v1_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStats) ProtoMessage() {}
+
+// Deprecated: Use PlayerStats.ProtoReflect.Descriptor instead.
+func (*PlayerStats) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PlayerStats) GetNoShows() uint64 {
+	if x != nil {
+		return x.NoShows
 	}
 	return 0
 }
 
-func (x *Params) GetRevealTimeout() uint64 {
+func (x *PlayerStats) GetCooldownUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RevealTimeout
+		return x.CooldownUntil
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetWins() uint64 {
+	if x != nil {
+		return x.Wins
 	}
 	return 0
 }
 
-type Game struct {
+func (x *PlayerStats) GetWinStreak() uint64 {
+	if x != nil {
+		return x.WinStreak
+	}
+	return 0
+}
+
+// QueueEntry is a player waiting in the matchmaking queue for an opponent.
+type QueueEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	EntryFee      *v1beta1.Coin          `protobuf:"bytes,2,opt,name=entry_fee,json=entryFee,proto3" json:"entry_fee,omitempty"`
-	CommitTimeout *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=commit_timeout,json=commitTimeout,proto3" json:"commit_timeout,omitempty"`
-	RevealTimeout *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=reveal_timeout,json=revealTimeout,proto3" json:"reveal_timeout,omitempty"`
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Player string `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	Commit []byte `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"` // see MoveCommit
+	// entry_fee is the escrowed stake, it's the maximum stake the player is
+	// willing to play for.
+	EntryFee  *v1beta1.Coin          `protobuf:"bytes,4,opt,name=entry_fee,json=entryFee,proto3" json:"entry_fee,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// min_entry_fee is the minimum stake the player is willing to play for.
+	MinEntryFee *v1beta1.Coin `protobuf:"bytes,6,opt,name=min_entry_fee,json=minEntryFee,proto3" json:"min_entry_fee,omitempty"`
+	// scheme is the scheme commit was computed with, stored with the commit
+	// once the player is matched.
+	Scheme CommitmentScheme `protobuf:"varint,7,opt,name=scheme,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"scheme,omitempty"`
 }
 
-func (x *Game) Reset() {
-	*x = Game{}
+func (x *QueueEntry) Reset() {
+	*x = QueueEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_facundomedica_rps_v1_types_proto_msgTypes[1]
+		mi := &file_facundomedica_rps_v1_types_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Game) String() string {
+func (x *QueueEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Game) ProtoMessage() {}
+func (*QueueEntry) ProtoMessage() {}
 
-// Deprecated: Use Game.ProtoReflect.Descriptor instead.
-func (*Game) Descriptor() ([]byte, []int) {
-	return file_facundomedica_rps_v1_types_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueueEntry.ProtoReflect.Descriptor instead.
+func (*QueueEntry) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_types_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Game) GetId() uint64 {
+func (x *QueueEntry) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Game) GetEntryFee() *v1beta1.Coin {
+func (x *QueueEntry) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *QueueEntry) GetCommit() []byte {
+	if x != nil {
+		return x.Commit
+	}
+	return nil
+}
+
+func (x *QueueEntry) GetEntryFee() *v1beta1.Coin {
 	if x != nil {
 		return x.EntryFee
 	}
 	return nil
 }
 
-func (x *Game) GetCommitTimeout() *timestamppb.Timestamp {
+func (x *QueueEntry) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CommitTimeout
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Game) GetRevealTimeout() *timestamppb.Timestamp {
+func (x *QueueEntry) GetMinEntryFee() *v1beta1.Coin {
 	if x != nil {
-		return x.RevealTimeout
+		return x.MinEntryFee
 	}
 	return nil
 }
 
-type MoveCommit struct {
+func (x *QueueEntry) GetScheme() CommitmentScheme {
+	if x != nil {
+		return x.Scheme
+	}
+	return CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED
+}
+
+// Bet is the bet of a spectator on the outcome of a game.
+type Bet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Commit    string                 `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"` // hex encoded sha256 of "salt:move"
+	Outcome BetOutcome `protobuf:"varint,1,opt,name=outcome,proto3,enum=facundomedica.rps.v1.BetOutcome" json:"outcome,omitempty"`
+	// amount is the escrowed amount of the bet, each denom is settled in its
+	// own pool.
+	Amount    []*v1beta1.Coin        `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount,omitempty"`
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *MoveCommit) Reset() {
-	*x = MoveCommit{}
+func (x *Bet) Reset() {
+	*x = Bet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_facundomedica_rps_v1_types_proto_msgTypes[2]
+		mi := &file_facundomedica_rps_v1_types_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MoveCommit) String() string {
+func (x *Bet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveCommit) ProtoMessage() {}
+func (*Bet) ProtoMessage() {}
 
-// Deprecated: Use MoveCommit.ProtoReflect.Descriptor instead.
-func (*MoveCommit) Descriptor() ([]byte, []int) {
-	return file_facundomedica_rps_v1_types_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Bet.ProtoReflect.Descriptor instead.
+func (*Bet) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_types_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *MoveCommit) GetCommit() string {
+func (x *Bet) GetOutcome() BetOutcome {
 	if x != nil {
-		return x.Commit
+		return x.Outcome
 	}
-	return ""
+	return BetOutcome_BET_OUTCOME_UNSPECIFIED
 }
 
-func (x *MoveCommit) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Bet) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Bet) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-type MoveReveal struct {
+// SettledGame holds the participants and rules of a settled game for a while,
+// so its players can offer a rematch.
+type SettledGame struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Move      string                 `protobuf:"bytes,1,opt,name=move,proto3" json:"move,omitempty"` // "rock", "paper" or "scissors"
-	Salt      string                 `protobuf:"bytes,2,opt,name=salt,proto3" json:"salt,omitempty"` // hex encoded 32 bytes salt
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator    string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Challenger string `protobuf:"bytes,3,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	// creator_stake and challenger_stake are what each player put into the
+	// game.
+	CreatorStake    []*v1beta1.Coin `protobuf:"bytes,4,rep,name=creator_stake,json=creatorStake,proto3" json:"creator_stake,omitempty"`
+	ChallengerStake []*v1beta1.Coin `protobuf:"bytes,5,rep,name=challenger_stake,json=challengerStake,proto3" json:"challenger_stake,omitempty"`
+	TimeoutMode     TimeoutMode     `protobuf:"varint,6,opt,name=timeout_mode,json=timeoutMode,proto3,enum=facundomedica.rps.v1.TimeoutMode" json:"timeout_mode,omitempty"`
+	CommitDuration  uint64          `protobuf:"varint,7,opt,name=commit_duration,json=commitDuration,proto3" json:"commit_duration,omitempty"`
+	RevealDuration  uint64          `protobuf:"varint,8,opt,name=reveal_duration,json=revealDuration,proto3" json:"reveal_duration,omitempty"`
+	// expires_at is the time after which a rematch can't be offered anymore.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
-func (x *MoveReveal) Reset() {
-	*x = MoveReveal{}
+func (x *SettledGame) Reset() {
+	*x = SettledGame{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_facundomedica_rps_v1_types_proto_msgTypes[3]
+		mi := &file_facundomedica_rps_v1_types_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MoveReveal) String() string {
+func (x *SettledGame) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveReveal) ProtoMessage() {}
+func (*SettledGame) ProtoMessage() {}
 
-// Deprecated: Use MoveReveal.ProtoReflect.Descriptor instead.
-func (*MoveReveal) Descriptor() ([]byte, []int) {
-	return file_facundomedica_rps_v1_types_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SettledGame.ProtoReflect.Descriptor instead.
+func (*SettledGame) Descriptor() ([]byte, []int) {
+	return file_facundomedica_rps_v1_types_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *MoveReveal) GetMove() string {
+func (x *SettledGame) GetId() uint64 {
 	if x != nil {
-		return x.Move
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SettledGame) GetCreator() string {
+	if x != nil {
+		return x.Creator
 	}
 	return ""
 }
 
-func (x *MoveReveal) GetSalt() string {
+func (x *SettledGame) GetChallenger() string {
 	if x != nil {
-		return x.Salt
+		return x.Challenger
 	}
 	return ""
 }
 
-func (x *MoveReveal) GetCreatedAt() *timestamppb.Timestamp {
+func (x *SettledGame) GetCreatorStake() []*v1beta1.Coin {
 	if x != nil {
-		return x.CreatedAt
+		return x.CreatorStake
+	}
+	return nil
+}
+
+func (x *SettledGame) GetChallengerStake() []*v1beta1.Coin {
+	if x != nil {
+		return x.ChallengerStake
+	}
+	return nil
+}
+
+func (x *SettledGame) GetTimeoutMode() TimeoutMode {
+	if x != nil {
+		return x.TimeoutMode
+	}
+	return TimeoutMode_TIMEOUT_MODE_UNSPECIFIED
+}
+
+func (x *SettledGame) GetCommitDuration() uint64 {
+	if x != nil {
+		return x.CommitDuration
+	}
+	return 0
+}
+
+func (x *SettledGame) GetRevealDuration() uint64 {
+	if x != nil {
+		return x.RevealDuration
+	}
+	return 0
+}
+
+func (x *SettledGame) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
 	}
 	return nil
 }
@@ -2396,59 +8784,323 @@ var file_facundomedica_rps_v1_types_proto_rawDesc = []byte{
 	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x75, 0x0a,
-	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x25,
-	0x0a, 0x0e, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x54, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x66, 0x61, 0x63, 0x75,
-	0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x22, 0xf8, 0x01, 0x0a, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3c, 0x0a,
-	0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f,
-	0x00, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x46, 0x65, 0x65, 0x12, 0x50, 0x0a, 0x0e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
-	0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x50, 0x0a,
-	0x0e, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x0d, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22,
-	0x6e, 0x0a, 0x0a, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x48, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x0c,
+	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x53, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x44, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64,
+	0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x72,
+	0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x72, 0x65, 0x76, 0x65,
+	0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d,
+	0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12,
+	0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x61, 0x78,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x59, 0x0a,
+	0x0f, 0x6e, 0x6f, 0x5f, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x6e, 0x6f, 0x53, 0x68, 0x6f,
+	0x77, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x6f, 0x5f, 0x73,
+	0x68, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x6e, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f,
+	0x77, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x52, 0x0a, 0x0b, 0x62, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x0a, 0x62, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x12, 0x52, 0x0a,
+	0x0b, 0x6a, 0x61, 0x63, 0x6b, 0x70, 0x6f, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x6a, 0x61, 0x63, 0x6b, 0x70, 0x6f, 0x74, 0x46, 0x65,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6a, 0x61, 0x63, 0x6b, 0x70, 0x6f, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6b, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6a, 0x61, 0x63, 0x6b, 0x70,
+	0x6f, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x8a, 0x01, 0x0a, 0x12, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x18,
+	0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f,
+	0x69, 0x6e, 0x73, 0x52, 0x10, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x4d, 0x61, 0x78, 0x45, 0x78, 0x70,
+	0x6f, 0x73, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72,
+	0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x55, 0x0a, 0x12,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e,
+	0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x52, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x47, 0x72, 0x61, 0x63, 0x65, 0x12,
+	0x37, 0x0a, 0x18, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x67,
+	0x72, 0x61, 0x63, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x15, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x47, 0x72, 0x61,
+	0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x11, 0x6a, 0x61, 0x63,
+	0x6b, 0x70, 0x6f, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x16,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x41, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69,
+	0x6e, 0x73, 0x52, 0x0f, 0x6a, 0x61, 0x63, 0x6b, 0x70, 0x6f, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x12, 0x93, 0x01, 0x0a, 0x17, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x18,
+	0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f,
+	0x69, 0x6e, 0x73, 0x52, 0x14, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x4d, 0x61, 0x78, 0x4f, 0x70, 0x65,
+	0x6e, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18,
+	0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70,
+	0x73, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xe6, 0x06, 0x0a, 0x04, 0x47, 0x61, 0x6d,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x7e, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69,
+	0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x46, 0x65,
+	0x65, 0x12, 0x50, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
 	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
-	0x7e, 0x0a, 0x0a, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x12, 0x12, 0x0a,
-	0x04, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x76,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x48, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x50, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f,
+	0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x44, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x66, 0x61,
+	0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x32, 0x0a, 0x15, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13,
+	0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x83, 0x01, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c,
+	0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x73,
+	0x65, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x53, 0x65, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x5f, 0x66, 0x6f, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x46, 0x6f,
+	0x72, 0x22, 0xdb, 0x02, 0x0a, 0x0a, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x12, 0x24, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x0c, 0xfa, 0xde, 0x1f, 0x08, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x06,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x48, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x72, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00,
+	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a,
+	0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
+	0x64, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f,
+	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x12,
+	0x3e, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x26, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e,
+	0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x22,
+	0xa8, 0x01, 0x0a, 0x0a, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x12, 0x2e,
+	0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x66,
+	0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x20,
+	0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0c, 0xfa, 0xde,
+	0x1f, 0x08, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74,
+	0x12, 0x48, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x0b, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f,
+	0x5f, 0x73, 0x68, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6e, 0x6f,
+	0x53, 0x68, 0x6f, 0x77, 0x73, 0x12, 0x50, 0x0a, 0x0e, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77,
+	0x6e, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90,
+	0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f,
+	0x77, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x77,
+	0x69, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x77, 0x69, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x22, 0x81, 0x03, 0x0a, 0x0a, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0c, 0xfa, 0xde, 0x1f,
+	0x08, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x3c, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x46, 0x65, 0x65, 0x12,
+	0x48, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x6d, 0x69, 0x6e,
+	0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x46, 0x65, 0x65, 0x12, 0x3e,
+	0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26,
+	0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72,
+	0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x22, 0x86,
+	0x02, 0x0a, 0x03, 0x42, 0x65, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64,
+	0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x65, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x12, 0x79, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8,
+	0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a,
+	0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x48, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8,
+	0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x85, 0x05, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x86, 0x01, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x8c,
+	0x01, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x44, 0x0a,
+	0x0c, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64,
+	0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
 	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42,
-	0xd5, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d,
-	0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79,
-	0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65,
-	0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x63,
-	0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f, 0x76,
-	0x31, 0x3b, 0x72, 0x70, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x52, 0x58, 0xaa, 0x02, 0x14,
-	0x46, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x52, 0x70,
-	0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x14, 0x46, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65,
-	0x64, 0x69, 0x63, 0x61, 0x5c, 0x52, 0x70, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x20, 0x46, 0x61,
-	0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x5c, 0x52, 0x70, 0x73, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x16, 0x46, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x3a, 0x3a,
-	0x52, 0x70, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x2a,
+	0x5b, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c,
+	0x0a, 0x18, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
+	0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x49, 0x4d,
+	0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x48, 0x45, 0x49, 0x47, 0x48, 0x54, 0x10, 0x02, 0x2a, 0x4e, 0x0a, 0x04,
+	0x4d, 0x6f, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f,
+	0x56, 0x45, 0x5f, 0x52, 0x4f, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x4f, 0x56,
+	0x45, 0x5f, 0x50, 0x41, 0x50, 0x45, 0x52, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x4f, 0x56,
+	0x45, 0x5f, 0x53, 0x43, 0x49, 0x53, 0x53, 0x4f, 0x52, 0x53, 0x10, 0x03, 0x2a, 0x74, 0x0a, 0x10,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
+	0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x4b, 0x45, 0x43, 0x43, 0x41, 0x4b, 0x32, 0x35, 0x36,
+	0x10, 0x02, 0x2a, 0x74, 0x0a, 0x0a, 0x42, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x17, 0x42, 0x45, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a,
+	0x13, 0x42, 0x45, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x43, 0x52, 0x45,
+	0x41, 0x54, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x45, 0x54, 0x5f, 0x4f, 0x55,
+	0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x52,
+	0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x45, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d,
+	0x45, 0x5f, 0x44, 0x52, 0x41, 0x57, 0x10, 0x03, 0x42, 0xd5, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d,
+	0x2e, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x72,
+	0x70, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2f, 0x72, 0x70,
+	0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64,
+	0x69, 0x63, 0x61, 0x2f, 0x72, 0x70, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x70, 0x73, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x46, 0x52, 0x58, 0xaa, 0x02, 0x14, 0x46, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f,
+	0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x2e, 0x52, 0x70, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x14,
+	0x46, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x5c, 0x52, 0x70,
+	0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x20, 0x46, 0x61, 0x63, 0x75, 0x6e, 0x64, 0x6f, 0x6d, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x5c, 0x52, 0x70, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x46, 0x61, 0x63, 0x75, 0x6e, 0x64,
+	0x6f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x3a, 0x3a, 0x52, 0x70, 0x73, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2463,26 +9115,57 @@ func file_facundomedica_rps_v1_types_proto_rawDescGZIP() []byte {
 	return file_facundomedica_rps_v1_types_proto_rawDescData
 }
 
-var file_facundomedica_rps_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_facundomedica_rps_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_facundomedica_rps_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_facundomedica_rps_v1_types_proto_goTypes = []interface{}{
-	(*Params)(nil),                // 0: facundomedica.rps.v1.Params
-	(*Game)(nil),                  // 1: facundomedica.rps.v1.Game
-	(*MoveCommit)(nil),            // 2: facundomedica.rps.v1.MoveCommit
-	(*MoveReveal)(nil),            // 3: facundomedica.rps.v1.MoveReveal
-	(*v1beta1.Coin)(nil),          // 4: cosmos.base.v1beta1.Coin
-	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(TimeoutMode)(0),              // 0: facundomedica.rps.v1.TimeoutMode
+	(Move)(0),                     // 1: facundomedica.rps.v1.Move
+	(CommitmentScheme)(0),         // 2: facundomedica.rps.v1.CommitmentScheme
+	(BetOutcome)(0),               // 3: facundomedica.rps.v1.BetOutcome
+	(*Params)(nil),                // 4: facundomedica.rps.v1.Params
+	(*Game)(nil),                  // 5: facundomedica.rps.v1.Game
+	(*MoveCommit)(nil),            // 6: facundomedica.rps.v1.MoveCommit
+	(*MoveReveal)(nil),            // 7: facundomedica.rps.v1.MoveReveal
+	(*PlayerStats)(nil),           // 8: facundomedica.rps.v1.PlayerStats
+	(*QueueEntry)(nil),            // 9: facundomedica.rps.v1.QueueEntry
+	(*Bet)(nil),                   // 10: facundomedica.rps.v1.Bet
+	(*SettledGame)(nil),           // 11: facundomedica.rps.v1.SettledGame
+	(*v1beta1.Coin)(nil),          // 12: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
 }
 var file_facundomedica_rps_v1_types_proto_depIdxs = []int32{
-	4, // 0: facundomedica.rps.v1.Game.entry_fee:type_name -> cosmos.base.v1beta1.Coin
-	5, // 1: facundomedica.rps.v1.Game.commit_timeout:type_name -> google.protobuf.Timestamp
-	5, // 2: facundomedica.rps.v1.Game.reveal_timeout:type_name -> google.protobuf.Timestamp
-	5, // 3: facundomedica.rps.v1.MoveCommit.created_at:type_name -> google.protobuf.Timestamp
-	5, // 4: facundomedica.rps.v1.MoveReveal.created_at:type_name -> google.protobuf.Timestamp
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	0,  // 0: facundomedica.rps.v1.Params.timeout_mode:type_name -> facundomedica.rps.v1.TimeoutMode
+	12, // 1: facundomedica.rps.v1.Params.house_max_exposure:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 2: facundomedica.rps.v1.Params.commitment_schemes:type_name -> facundomedica.rps.v1.CommitmentScheme
+	12, // 3: facundomedica.rps.v1.Params.jackpot_min_stake:type_name -> cosmos.base.v1beta1.Coin
+	12, // 4: facundomedica.rps.v1.Params.house_max_open_exposure:type_name -> cosmos.base.v1beta1.Coin
+	12, // 5: facundomedica.rps.v1.Game.entry_fee:type_name -> cosmos.base.v1beta1.Coin
+	13, // 6: facundomedica.rps.v1.Game.commit_timeout:type_name -> google.protobuf.Timestamp
+	13, // 7: facundomedica.rps.v1.Game.reveal_timeout:type_name -> google.protobuf.Timestamp
+	0,  // 8: facundomedica.rps.v1.Game.timeout_mode:type_name -> facundomedica.rps.v1.TimeoutMode
+	12, // 9: facundomedica.rps.v1.Game.challenger_fee:type_name -> cosmos.base.v1beta1.Coin
+	13, // 10: facundomedica.rps.v1.MoveCommit.created_at:type_name -> google.protobuf.Timestamp
+	12, // 11: facundomedica.rps.v1.MoveCommit.stake:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 12: facundomedica.rps.v1.MoveCommit.scheme:type_name -> facundomedica.rps.v1.CommitmentScheme
+	1,  // 13: facundomedica.rps.v1.MoveReveal.move:type_name -> facundomedica.rps.v1.Move
+	13, // 14: facundomedica.rps.v1.MoveReveal.created_at:type_name -> google.protobuf.Timestamp
+	13, // 15: facundomedica.rps.v1.PlayerStats.cooldown_until:type_name -> google.protobuf.Timestamp
+	12, // 16: facundomedica.rps.v1.QueueEntry.entry_fee:type_name -> cosmos.base.v1beta1.Coin
+	13, // 17: facundomedica.rps.v1.QueueEntry.created_at:type_name -> google.protobuf.Timestamp
+	12, // 18: facundomedica.rps.v1.QueueEntry.min_entry_fee:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 19: facundomedica.rps.v1.QueueEntry.scheme:type_name -> facundomedica.rps.v1.CommitmentScheme
+	3,  // 20: facundomedica.rps.v1.Bet.outcome:type_name -> facundomedica.rps.v1.BetOutcome
+	12, // 21: facundomedica.rps.v1.Bet.amount:type_name -> cosmos.base.v1beta1.Coin
+	13, // 22: facundomedica.rps.v1.Bet.created_at:type_name -> google.protobuf.Timestamp
+	12, // 23: facundomedica.rps.v1.SettledGame.creator_stake:type_name -> cosmos.base.v1beta1.Coin
+	12, // 24: facundomedica.rps.v1.SettledGame.challenger_stake:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 25: facundomedica.rps.v1.SettledGame.timeout_mode:type_name -> facundomedica.rps.v1.TimeoutMode
+	13, // 26: facundomedica.rps.v1.SettledGame.expires_at:type_name -> google.protobuf.Timestamp
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_facundomedica_rps_v1_types_proto_init() }
@@ -2539,19 +9222,68 @@ func file_facundomedica_rps_v1_types_proto_init() {
 				return nil
 			}
 		}
+		file_facundomedica_rps_v1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_facundomedica_rps_v1_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettledGame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_facundomedica_rps_v1_types_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      4,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_facundomedica_rps_v1_types_proto_goTypes,
 		DependencyIndexes: file_facundomedica_rps_v1_types_proto_depIdxs,
+		EnumInfos:         file_facundomedica_rps_v1_types_proto_enumTypes,
 		MessageInfos:      file_facundomedica_rps_v1_types_proto_msgTypes,
 	}.Build()
 	File_facundomedica_rps_v1_types_proto = out.File
@@ -0,0 +1,546 @@
+// Package rpsclient is a Go client of the rps module for applications and
+// bots. It creates the commitments of the moves and keeps their salts in a
+// salt store, builds, signs and broadcasts the transactions, and follows the
+// games until they are settled.
+package rpsclient
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/facundomedica/rps"
+	"github.com/facundomedica/rps/client/saltstore"
+	"github.com/facundomedica/rps/utils"
+)
+
+// TxInclusionTimeout is how long WaitForTx waits for a broadcast transaction
+// to be included in a block.
+const TxInclusionTimeout = 30 * time.Second
+
+// subscriber identifies the event subscriptions of the clients on the node.
+const subscriber = "rpsclient"
+
+// Client plays games on behalf of the account of its client context.
+//
+// Queries and transactions go through the client context, over gRPC when it
+// has a gRPC connection. The settlement of the games is read from the block
+// results, which need the CometBFT RPC client of the context.
+type Client struct {
+	clientCtx    client.Context
+	txf          tx.Factory
+	queryClient  rps.QueryClient
+	txClient     txtypes.ServiceClient
+	pollInterval time.Duration
+
+	// txMu serializes the transactions of the account, whose sequence is
+	// read from the node.
+	txMu sync.Mutex
+	// mu guards the salt store and seen.
+	mu    sync.Mutex
+	store *saltstore.Store
+	// seen is the last height each game was seen in progress at, its
+	// settlement is looked for in the blocks after it.
+	seen map[uint64]int64
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithPollInterval sets how often the client polls the node while waiting for
+// transactions and games, 2 seconds by default.
+func WithPollInterval(interval time.Duration) Option {
+	return func(c *Client) {
+		c.pollInterval = interval
+	}
+}
+
+// New returns a client signing with the key of clientCtx.FromName, using txf
+// for the chain ID, gas and fees of the transactions. The moves committed by
+// the client are kept in store.
+func New(clientCtx client.Context, txf tx.Factory, store *saltstore.Store, opts ...Option) *Client {
+	c := &Client{
+		clientCtx:    clientCtx,
+		txf:          txf,
+		queryClient:  rps.NewQueryClient(clientCtx),
+		txClient:     txtypes.NewServiceClient(clientCtx),
+		pollInterval: 2 * time.Second,
+		store:        store,
+		seen:         map[uint64]int64{},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Address returns the address of the account playing.
+func (c *Client) Address() string {
+	return c.clientCtx.GetFromAddress().String()
+}
+
+// CreateGame creates a game committing move, and returns its ID.
+func (c *Client) CreateGame(ctx context.Context, move rps.Move, entryFee sdk.Coins) (uint64, error) {
+	entry, commit, err := c.newEntry(move)
+	if err != nil {
+		return 0, err
+	}
+
+	// the game ID is only known once the transaction is included
+	entry.Pending = true
+	if err := c.saveEntry(entry); err != nil {
+		return 0, err
+	}
+
+	res, err := c.broadcast(ctx, &rps.MsgNewGame{Player: entry.Address, Commit: commit, EntryFee: entryFee})
+	if err != nil {
+		return 0, err
+	}
+
+	resp := &rps.MsgNewGameResponse{}
+	if err := MsgResponse(res, resp); err != nil {
+		return 0, err
+	}
+
+	entry.GameID, entry.Pending = resp.GameId, false
+	if err := c.saveEntry(entry); err != nil {
+		return 0, err
+	}
+
+	c.setSeen(entry.GameID, res.Height-1)
+	return entry.GameID, nil
+}
+
+// Join joins a game committing move.
+func (c *Client) Join(ctx context.Context, gameID uint64, move rps.Move) error {
+	entry, commit, err := c.newEntry(move)
+	if err != nil {
+		return err
+	}
+
+	entry.GameID = gameID
+	if err := c.saveEntry(entry); err != nil {
+		return err
+	}
+
+	res, err := c.broadcast(ctx, &rps.MsgCommitMove{Player: entry.Address, GameId: gameID, Commit: commit})
+	if err != nil {
+		return err
+	}
+
+	c.setSeen(gameID, res.Height-1)
+	return nil
+}
+
+// Reveal reveals the move committed to a game, which must be full.
+func (c *Client) Reveal(ctx context.Context, gameID uint64) error {
+	c.mu.Lock()
+	entry, found := c.store.Get(c.clientCtx.ChainID, gameID, c.Address())
+	c.mu.Unlock()
+	if !found {
+		return fmt.Errorf("no move for game %d in the salt store", gameID)
+	}
+
+	move, err := rps.ParseMove(entry.Move)
+	if err != nil {
+		return err
+	}
+
+	salt, err := rps.ParseHexBytes(entry.Salt)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.broadcast(ctx, &rps.MsgRevealMove{Player: entry.Address, GameId: gameID, Move: move, Salt: salt})
+	if err != nil {
+		return err
+	}
+
+	c.setSeen(gameID, res.Height-1)
+	return nil
+}
+
+// ListOpenGames returns the games waiting for an opponent that the account
+// can join before their commit deadline.
+func (c *Client) ListOpenGames(ctx context.Context) ([]rps.Game, error) {
+	node, err := c.clientCtx.Client.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.queryClient.Games(ctx, &rps.QueryGamesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	address := c.Address()
+	games := []rps.Game{}
+	for _, game := range res.Games {
+		if game.House || game.Creator == address || game.CommitTimedOut(node.SyncInfo.LatestBlockTime, node.SyncInfo.LatestBlockHeight) {
+			continue
+		}
+
+		if game.ReservedFor != "" && game.ReservedFor != address {
+			continue
+		}
+
+		players, err := c.queryClient.GamePlayers(ctx, &rps.QueryGamePlayersRequest{GameId: game.Id})
+		if err != nil {
+			// the game was settled since it was listed
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+
+			return nil, err
+		}
+
+		if len(players.Players) >= 2 {
+			continue
+		}
+
+		games = append(games, game)
+	}
+
+	return games, nil
+}
+
+// WaitForOpponent waits until a game is full, it returns false if the game
+// was over before anyone joined.
+func (c *Client) WaitForOpponent(ctx context.Context, gameID uint64) (bool, error) {
+	game, err := c.waitUntil(ctx, gameID, rps.Game.HasRevealTimeout)
+	if err != nil {
+		return false, err
+	}
+
+	return game != nil, nil
+}
+
+// WaitForOutcome waits until a game is settled and returns its
+// EventGameSettled. It subscribes to the event when the node allows it, and
+// polls the game otherwise or when the subscription is dropped.
+func (c *Client) WaitForOutcome(ctx context.Context, gameID uint64) (*rps.EventGameSettled, error) {
+	if settled, err := c.subscribeOutcome(ctx, gameID); settled != nil || err != nil {
+		return settled, err
+	}
+
+	if _, err := c.waitUntil(ctx, gameID, func(rps.Game) bool { return false }); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	seen, ok := c.seen[gameID]
+	c.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("game %d is not in progress", gameID)
+	}
+
+	status, err := c.clientCtx.Client.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the settlement is in one of the blocks since the game was last seen in
+	// progress
+	for height := seen + 1; height <= status.SyncInfo.LatestBlockHeight; height++ {
+		res, err := c.clientCtx.Client.BlockResults(ctx, &height)
+		if err != nil {
+			return nil, err
+		}
+
+		settled, err := findSettlement(res.FinalizeBlockEvents, gameID)
+		if err != nil || settled != nil {
+			return settled, err
+		}
+	}
+
+	return nil, fmt.Errorf("game %d is over but its settlement wasn't found", gameID)
+}
+
+// subscribeOutcome waits for the settlement of a game through the CometBFT
+// event stream. It returns nil if the node can't be subscribed to, if the
+// subscription is dropped or if the game is already over, in which case the
+// settlement is looked up in the block results.
+func (c *Client) subscribeOutcome(ctx context.Context, gameID uint64) (*rps.EventGameSettled, error) {
+	events, ok := c.clientCtx.Client.(rpcclient.EventsClient)
+	if !ok {
+		return nil, nil
+	}
+
+	query := fmt.Sprintf(`tm.event='NewBlockEvents' AND %s.game_id='"%d"'`, proto.MessageName(&rps.EventGameSettled{}), gameID)
+	out, err := events.Subscribe(ctx, subscriber, query)
+	if err != nil {
+		return nil, nil
+	}
+
+	defer events.Unsubscribe(context.Background(), subscriber, query) //nolint:errcheck
+
+	// the game may have been settled before the subscription
+	game, err := c.waitUntil(ctx, gameID, func(rps.Game) bool { return true })
+	if err != nil || game == nil {
+		return nil, err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case res, ok := <-out:
+			if !ok {
+				return nil, nil
+			}
+
+			data, ok := res.Data.(cmttypes.EventDataNewBlockEvents)
+			if !ok {
+				continue
+			}
+
+			settled, err := findSettlement(data.Events, gameID)
+			if err != nil || settled != nil {
+				return settled, err
+			}
+		}
+	}
+}
+
+// findSettlement returns the EventGameSettled of a game among the events of a
+// block, or nil if the game wasn't settled in it.
+func findSettlement(events []abci.Event, gameID uint64) (*rps.EventGameSettled, error) {
+	eventType := proto.MessageName(&rps.EventGameSettled{})
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+
+		// the node adds the mode the event was emitted in, which isn't a field
+		// of the event
+		attrs := event.Attributes[:0:0]
+		for _, attr := range event.Attributes {
+			if attr.Key != "mode" {
+				attrs = append(attrs, attr)
+			}
+		}
+
+		event.Attributes = attrs
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			return nil, err
+		}
+
+		if settled := msg.(*rps.EventGameSettled); settled.GameId == gameID {
+			return settled, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// waitUntil polls a game until done returns true for it, or until it's over in
+// which case nil is returned.
+func (c *Client) waitUntil(ctx context.Context, gameID uint64, done func(rps.Game) bool) (*rps.Game, error) {
+	for {
+		// the height is taken first, the game is known to be in progress at it
+		// when it's found
+		status, err := c.clientCtx.Client.Status(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := c.queryClient.Games(ctx, &rps.QueryGamesRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		var game *rps.Game
+		for i := range res.Games {
+			if res.Games[i].Id == gameID {
+				game = &res.Games[i]
+				c.setSeen(gameID, status.SyncInfo.LatestBlockHeight)
+				break
+			}
+		}
+
+		if game == nil || done(*game) {
+			return game, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(c.pollInterval):
+		}
+	}
+}
+
+// setSeen records that a game was in progress at a height. The games the
+// client sends a transaction to are in progress before its block, they may be
+// settled at the end of it.
+func (c *Client) setSeen(gameID uint64, height int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if height > c.seen[gameID] {
+		c.seen[gameID] = height
+	}
+}
+
+// newEntry returns the salt store entry of a move committed with a fresh salt,
+// and the commitment.
+func (c *Client) newEntry(move rps.Move) (saltstore.Entry, rps.HexBytes, error) {
+	if !move.IsValid() {
+		return saltstore.Entry{}, nil, errors.New("invalid move")
+	}
+
+	salt := make(rps.HexBytes, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return saltstore.Entry{}, nil, err
+	}
+
+	commit := rps.HexBytes(utils.CalculateCommitment(move.Name(), salt))
+	return saltstore.Entry{
+		ChainID:   c.clientCtx.ChainID,
+		Address:   c.Address(),
+		Move:      move.Name(),
+		Salt:      salt.String(),
+		Commit:    commit.String(),
+		CreatedAt: time.Now().UTC(),
+	}, commit, nil
+}
+
+// saveEntry puts an entry in the salt store and saves it, moves are saved
+// before being committed so they can always be revealed.
+func (c *Client) saveEntry(entry saltstore.Entry) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.store.Put(entry)
+	if err := c.store.Save(); err != nil {
+		return fmt.Errorf("failed to save the salt: %w", err)
+	}
+
+	return nil
+}
+
+// broadcast signs and broadcasts a transaction and waits for it to be
+// included in a block, returning its result.
+func (c *Client) broadcast(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	c.txMu.Lock()
+	defer c.txMu.Unlock()
+
+	txf, err := c.txf.Prepare(c.clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(c.clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+
+		txf = txf.WithGas(adjusted)
+	}
+
+	builder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Sign(ctx, txf, c.clientCtx.FromName, builder, true); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := c.clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.txClient.BroadcastTx(ctx, &txtypes.BroadcastTxRequest{TxBytes: txBytes, Mode: txtypes.BroadcastMode_BROADCAST_MODE_SYNC})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.TxResponse.Code != 0 {
+		return nil, TxError(res.TxResponse)
+	}
+
+	return WaitForTx(ctx, c.clientCtx, res.TxResponse.TxHash, c.pollInterval)
+}
+
+// WaitForTx waits until a transaction is included in a block and returns its
+// result, polling the node every pollInterval. It returns an error if the
+// transaction failed or took longer than TxInclusionTimeout.
+func WaitForTx(ctx context.Context, clientCtx client.Context, txHash string, pollInterval time.Duration) (*sdk.TxResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TxInclusionTimeout)
+	defer cancel()
+
+	txClient := txtypes.NewServiceClient(clientCtx)
+	for {
+		res, err := txClient.GetTx(ctx, &txtypes.GetTxRequest{Hash: txHash})
+		if err == nil {
+			if res.TxResponse.Code != 0 {
+				return nil, TxError(res.TxResponse)
+			}
+
+			return res.TxResponse, nil
+		}
+
+		// the transaction is not found until it's included
+		if s, ok := status.FromError(err); !ok || s.Code() != codes.NotFound {
+			if ctx.Err() == nil {
+				return nil, err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("transaction %s not included after %s", txHash, TxInclusionTimeout)
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// TxError returns the error of a failed transaction, which matches the
+// registered error of its code with errors.Is, e.g. rps.ErrMoveAlreadyRevealed.
+func TxError(res *sdk.TxResponse) error {
+	return errorsmod.Wrapf(errorsmod.ABCIError(res.Codespace, res.Code, res.RawLog), "transaction %s failed", res.TxHash)
+}
+
+// MsgResponse decodes the response of the first message of an included
+// transaction into resp.
+func MsgResponse(res *sdk.TxResponse, resp proto.Message) error {
+	data, err := hex.DecodeString(res.Data)
+	if err != nil {
+		return err
+	}
+
+	var msgData sdk.TxMsgData
+	if err := proto.Unmarshal(data, &msgData); err != nil {
+		return err
+	}
+
+	if len(msgData.MsgResponses) == 0 {
+		return errors.New("transaction has no message responses")
+	}
+
+	return proto.Unmarshal(msgData.MsgResponses[0].Value, resp)
+}
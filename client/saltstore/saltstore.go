@@ -0,0 +1,247 @@
+// Package saltstore keeps the moves and salts committed from the rps CLI in a
+// file encrypted with a passphrase, so they can be revealed later on without
+// the player having to copy them around.
+package saltstore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// FileName is the name of the store file, under the rps directory of the
+// client home.
+const FileName = "salts.json"
+
+// fileVersion is the version of the store file format.
+const fileVersion = 1
+
+// scrypt parameters used to derive the encryption key from the passphrase.
+const (
+	scryptN    = 1 << 15
+	scryptR    = 8
+	scryptP    = 1
+	keyLen     = 32
+	kdfSaltLen = 16
+)
+
+// Entry is a committed move. Entries are identified by chain ID, address and
+// commitment, an entry is pending until the ID of its game is known (e.g.
+// while waiting in the matchmaking queue).
+type Entry struct {
+	ChainID   string    `json:"chain_id"`
+	GameID    uint64    `json:"game_id"`
+	Pending   bool      `json:"pending,omitempty"`
+	Address   string    `json:"address"`
+	Move      string    `json:"move"`
+	Salt      string    `json:"salt"`
+	Commit    string    `json:"commit"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// file is the on-disk format of the store.
+type file struct {
+	Version    int    `json:"version"`
+	KDFSalt    []byte `json:"kdf_salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Store holds the decrypted entries of a store file.
+type Store struct {
+	path       string
+	passphrase []byte
+	kdfSalt    []byte
+	entries    []Entry
+}
+
+// DefaultPath returns the path of the store file under a client home.
+func DefaultPath(home string) string {
+	return filepath.Join(home, "rps", FileName)
+}
+
+// Open decrypts the store file at path with the passphrase, a missing file is
+// an empty store that is created on the first Save.
+func Open(path string, passphrase []byte) (*Store, error) {
+	if len(passphrase) == 0 {
+		return nil, errors.New("salt store passphrase can't be empty")
+	}
+
+	s := &Store{path: path, passphrase: passphrase}
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return s, nil
+		}
+
+		return nil, err
+	}
+
+	var f file
+	if err := json.Unmarshal(bz, &f); err != nil {
+		return nil, fmt.Errorf("invalid salt store file: %w", err)
+	}
+
+	if f.Version != fileVersion {
+		return nil, fmt.Errorf("unsupported salt store version %d", f.Version)
+	}
+
+	gcm, err := newGCM(passphrase, f.KDFSalt)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := gcm.Open(nil, f.Nonce, f.Ciphertext, nil)
+	if err != nil {
+		return nil, errors.New("can't decrypt the salt store, wrong passphrase?")
+	}
+
+	if err := json.Unmarshal(plaintext, &s.entries); err != nil {
+		return nil, fmt.Errorf("invalid salt store entries: %w", err)
+	}
+
+	s.kdfSalt = f.KDFSalt
+	return s, nil
+}
+
+// Save encrypts the entries and writes them to the store file, replacing it
+// atomically.
+func (s *Store) Save() error {
+	if s.kdfSalt == nil {
+		s.kdfSalt = make([]byte, kdfSaltLen)
+		if _, err := rand.Read(s.kdfSalt); err != nil {
+			return err
+		}
+	}
+
+	gcm, err := newGCM(s.passphrase, s.kdfSalt)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	plaintext, err := json.Marshal(s.entries)
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(file{
+		Version:    fileVersion,
+		KDFSalt:    s.kdfSalt,
+		Nonce:      nonce,
+		Ciphertext: gcm.Seal(nil, nonce, plaintext, nil),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, bz, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, s.path)
+}
+
+// Put adds an entry to the store, replacing the one with the same chain ID,
+// address and commitment if any.
+func (s *Store) Put(entry Entry) {
+	for i, e := range s.entries {
+		if e.ChainID == entry.ChainID && e.Address == entry.Address && e.Commit == entry.Commit {
+			s.entries[i] = entry
+			return
+		}
+	}
+
+	s.entries = append(s.entries, entry)
+}
+
+// Get returns the entry of an address in a game.
+func (s *Store) Get(chainID string, gameID uint64, address string) (Entry, bool) {
+	for _, e := range s.entries {
+		if e.ChainID == chainID && e.GameID == gameID && e.Address == address && !e.Pending {
+			return e, true
+		}
+	}
+
+	return Entry{}, false
+}
+
+// Resolve fills in the game ID of the pending entry of an address with the
+// given commitment, once the game it was committed to is known, and returns
+// the updated entry.
+func (s *Store) Resolve(chainID, address string, gameID uint64, commit string) (Entry, bool) {
+	for i, e := range s.entries {
+		if e.ChainID == chainID && e.Address == address && e.Pending && strings.EqualFold(e.Commit, commit) {
+			s.entries[i].GameID, s.entries[i].Pending = gameID, false
+			return s.entries[i], true
+		}
+	}
+
+	return Entry{}, false
+}
+
+// HasPending returns whether an address has pending entries.
+func (s *Store) HasPending(chainID, address string) bool {
+	for _, e := range s.entries {
+		if e.ChainID == chainID && e.Address == address && e.Pending {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Entries returns all the entries of the store.
+func (s *Store) Entries() []Entry {
+	return append([]Entry{}, s.entries...)
+}
+
+// Prune removes the entries for which remove returns true and returns how many
+// were removed.
+func (s *Store) Prune(remove func(Entry) bool) int {
+	kept := s.entries[:0]
+	for _, e := range s.entries {
+		if !remove(e) {
+			kept = append(kept, e)
+		}
+	}
+
+	removed := len(s.entries) - len(kept)
+	s.entries = kept
+	return removed
+}
+
+// newGCM derives the encryption key from the passphrase and returns an AES-GCM
+// cipher using it.
+func newGCM(passphrase, kdfSalt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key(passphrase, kdfSalt, scryptN, scryptR, scryptP, keyLen)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,79 @@
+package saltstore_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/facundomedica/rps/client/saltstore"
+)
+
+func TestStore(t *testing.T) {
+	require := require.New(t)
+	path := saltstore.DefaultPath(t.TempDir())
+
+	s, err := saltstore.Open(path, []byte("passphrase"))
+	require.NoError(err)
+	require.Empty(s.Entries())
+
+	pending := saltstore.Entry{ChainID: "rps-1", Pending: true, Address: "addr1", Move: "rock", Salt: "salt1", Commit: "commit1", CreatedAt: time.Unix(1000, 0).UTC()}
+	s.Put(pending)
+	s.Put(saltstore.Entry{ChainID: "rps-1", GameID: 2, Address: "addr1", Move: "paper", Salt: "salt2", Commit: "commit2"})
+	s.Put(saltstore.Entry{ChainID: "rps-2", GameID: 2, Address: "addr1", Move: "scissors", Salt: "salt3", Commit: "commit3"})
+	require.NoError(s.Save())
+
+	// the salts are not stored in the clear
+	bz, err := os.ReadFile(path)
+	require.NoError(err)
+	require.NotContains(string(bz), "salt1")
+
+	info, err := os.Stat(path)
+	require.NoError(err)
+	require.Equal(os.FileMode(0o600), info.Mode().Perm())
+
+	_, err = saltstore.Open(path, []byte("wrong"))
+	require.ErrorContains(err, "wrong passphrase")
+
+	s, err = saltstore.Open(path, []byte("passphrase"))
+	require.NoError(err)
+	require.Len(s.Entries(), 3)
+
+	// pending entries are not returned until their game ID is known
+	_, found := s.Get("rps-1", 0, "addr1")
+	require.False(found)
+
+	// pending entries are resolved by their commitment once the game is known
+	require.True(s.HasPending("rps-1", "addr1"))
+	require.False(s.HasPending("rps-2", "addr1"))
+
+	_, found = s.Resolve("rps-1", "addr1", 1, "commit2")
+	require.False(found)
+
+	entry, found := s.Resolve("rps-1", "addr1", 1, "commit1")
+	require.True(found)
+	pending.GameID, pending.Pending = 1, false
+	require.Equal(pending, entry)
+	require.False(s.HasPending("rps-1", "addr1"))
+
+	entry, found = s.Get("rps-1", 1, "addr1")
+	require.True(found)
+	require.Equal(pending, entry)
+
+	entry, found = s.Get("rps-2", 2, "addr1")
+	require.True(found)
+	require.Equal("salt3", entry.Salt)
+
+	require.Equal(1, s.Prune(func(e saltstore.Entry) bool { return e.ChainID == "rps-2" }))
+	require.NoError(s.Save())
+
+	s, err = saltstore.Open(path, []byte("passphrase"))
+	require.NoError(err)
+	require.Len(s.Entries(), 2)
+
+	files, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(err)
+	require.Len(files, 1)
+}
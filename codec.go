@@ -11,10 +11,21 @@ import (
 // RegisterLegacyAminoCodec registers the necessary interfaces and concrete types
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
-	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "rps/MsgUpdateParams")
-	legacy.RegisterAminoMsg(cdc, &MsgNewGame{}, "rps/MsgNewGame")
-	legacy.RegisterAminoMsg(cdc, &MsgCommitMove{}, "rps/MsgCommitMove")
-	legacy.RegisterAminoMsg(cdc, &MsgRevealMove{}, "rps/MsgRevealMove")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "facundomedica/rps/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgNewGame{}, "facundomedica/rps/MsgNewGame")
+	legacy.RegisterAminoMsg(cdc, &MsgCommitMove{}, "facundomedica/rps/MsgCommitMove")
+	legacy.RegisterAminoMsg(cdc, &MsgRevealMove{}, "facundomedica/rps/MsgRevealMove")
+	legacy.RegisterAminoMsg(cdc, &MsgResolveStuckGame{}, "facundomedica/rps/MsgResolveStuckGame")
+	legacy.RegisterAminoMsg(cdc, &MsgJoinQueue{}, "facundomedica/rps/MsgJoinQueue")
+	legacy.RegisterAminoMsg(cdc, &MsgLeaveQueue{}, "facundomedica/rps/MsgLeaveQueue")
+	legacy.RegisterAminoMsg(cdc, &MsgPlaceBet{}, "facundomedica/rps/MsgPlaceBet")
+	legacy.RegisterAminoMsg(cdc, &MsgPlayHouse{}, "facundomedica/rps/MsgPlayHouse")
+	legacy.RegisterAminoMsg(cdc, &MsgFundHouse{}, "facundomedica/rps/MsgFundHouse")
+	legacy.RegisterAminoMsg(cdc, &MsgOfferRematch{}, "facundomedica/rps/MsgOfferRematch")
+	legacy.RegisterAminoMsg(cdc, &MsgSetRevealAgent{}, "facundomedica/rps/MsgSetRevealAgent")
+	legacy.RegisterAminoMsg(cdc, &MsgEscrowReveal{}, "facundomedica/rps/MsgEscrowReveal")
+	legacy.RegisterAminoMsg(cdc, &MsgAgentRevealMove{}, "facundomedica/rps/MsgAgentRevealMove")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateAndJoin{}, "facundomedica/rps/MsgCreateAndJoin")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
@@ -24,6 +35,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgNewGame{},
 		&MsgCommitMove{},
 		&MsgRevealMove{},
+		&MsgResolveStuckGame{},
+		&MsgJoinQueue{},
+		&MsgLeaveQueue{},
+		&MsgPlaceBet{},
+		&MsgPlayHouse{},
+		&MsgFundHouse{},
+		&MsgOfferRematch{},
+		&MsgSetRevealAgent{},
+		&MsgEscrowReveal{},
+		&MsgAgentRevealMove{},
+		&MsgCreateAndJoin{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
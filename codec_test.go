@@ -0,0 +1,84 @@
+package rps_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/x/tx/signing/aminojson"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/facundomedica/rps"
+	rpsv1 "github.com/facundomedica/rps/api/v1"
+)
+
+func TestRevealMoveLegacyEncoding(t *testing.T) {
+	player := sdk.AccAddress("player______________").String()
+	salt := rps.HexBytes("salt of player 0")
+	expected := &rps.MsgRevealMove{Player: player, GameId: 1, Move: rps.Move_MOVE_ROCK, Salt: salt}
+
+	// amino JSON of a legacy client, with the move as a string
+	legacyAmino := codec.NewLegacyAmino()
+	sdk.RegisterLegacyAminoCodec(legacyAmino)
+	rps.RegisterLegacyAminoCodec(legacyAmino)
+	aminoDoc := `{"type":"facundomedica/rps/MsgRevealMove","value":{"player":"` + player + `","game_id":"1","move":"rock","salt":"` + salt.String() + `"}}`
+
+	var msg sdk.Msg
+	require.NoError(t, legacyAmino.UnmarshalJSON([]byte(aminoDoc), &msg))
+	require.Equal(t, expected, msg)
+
+	bz, err := legacyAmino.MarshalJSON(msg)
+	require.NoError(t, err)
+	require.JSONEq(t, aminoDoc, string(bz))
+
+	// protobuf JSON
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	jsonDoc := `{"player":"` + player + `","game_id":"1","move":"rock","salt":"` + salt.String() + `"}`
+
+	var fromJSON rps.MsgRevealMove
+	require.NoError(t, cdc.UnmarshalJSON([]byte(jsonDoc), &fromJSON))
+	require.Equal(t, *expected, fromJSON)
+
+	bz, err = cdc.MarshalJSON(&fromJSON)
+	require.NoError(t, err)
+	require.JSONEq(t, jsonDoc, string(bz))
+
+	// binary and amino JSON sign bytes of the message as sent by legacy clients
+	legacyMsg := &rpsv1.MsgRevealMove{Player: player, GameId: 1, Move: "rock", Salt: salt}
+	bz, err = proto.Marshal(legacyMsg)
+	require.NoError(t, err)
+
+	var fromBinary rps.MsgRevealMove
+	require.NoError(t, cdc.Unmarshal(bz, &fromBinary))
+	require.Equal(t, *expected, fromBinary)
+
+	gogoBz, err := cdc.Marshal(&fromBinary)
+	require.NoError(t, err)
+	require.Equal(t, bz, gogoBz)
+
+	signBytes, err := aminojson.NewEncoder(aminojson.EncoderOptions{}).Marshal(legacyMsg)
+	require.NoError(t, err)
+	require.Contains(t, string(signBytes), `"move":"rock"`)
+
+	// moves that aren't rock, paper or scissors are rejected when decoding
+	legacyMsg.Move = "lizard"
+	bz, err = proto.Marshal(legacyMsg)
+	require.NoError(t, err)
+	require.ErrorIs(t, cdc.Unmarshal(bz, &fromBinary), rps.ErrInvalidMove)
+
+	// names are case sensitive and numbers must be defined moves
+	legacyMsg.Move = "Rock"
+	bz, err = proto.Marshal(legacyMsg)
+	require.NoError(t, err)
+	require.ErrorIs(t, cdc.Unmarshal(bz, &fromBinary), rps.ErrInvalidMove)
+
+	jsonDoc = `{"player":"` + player + `","game_id":"1","move":1,"salt":"` + salt.String() + `"}`
+	require.NoError(t, cdc.UnmarshalJSON([]byte(jsonDoc), &fromJSON))
+	require.Equal(t, *expected, fromJSON)
+
+	jsonDoc = `{"player":"` + player + `","game_id":"1","move":7,"salt":"` + salt.String() + `"}`
+	require.ErrorIs(t, cdc.UnmarshalJSON([]byte(jsonDoc), &fromJSON), rps.ErrInvalidMove)
+}
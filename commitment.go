@@ -0,0 +1,108 @@
+package rps
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+
+	"golang.org/x/crypto/sha3"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/facundomedica/rps/utils"
+)
+
+const (
+	// CommitLength is the length of a commitment, a 256 bits hash.
+	CommitLength = sha256.Size
+	// MinSaltLength is the minimum length of the salt of a commitment, short
+	// salts would let the move be guessed from the commitment.
+	MinSaltLength = 16
+)
+
+// HexBytes are the bytes of commitments and salts. They are hex encoded in
+// JSON, like the commitments and salts were before being stored as bytes.
+type HexBytes []byte
+
+// ParseHexBytes decodes hex encoded bytes, in any case.
+func ParseHexBytes(s string) (HexBytes, error) {
+	bz, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex %q: %w", s, err)
+	}
+
+	return bz, nil
+}
+
+// String returns the lowercase hex encoding of the bytes.
+func (b HexBytes) String() string {
+	return hex.EncodeToString(b)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b HexBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *HexBytes) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	bz, err := ParseHexBytes(s)
+	if err != nil {
+		return err
+	}
+
+	*b = bz
+	return nil
+}
+
+// ValidateCommit returns an error if a commitment isn't CommitLength bytes
+// long.
+func ValidateCommit(commit []byte) error {
+	if len(commit) != CommitLength {
+		return errorsmod.Wrapf(ErrInvalidCommit, "commit must be %d bytes, got %d", CommitLength, len(commit))
+	}
+
+	return nil
+}
+
+// ValidateSalt returns an error if a salt is shorter than MinSaltLength.
+func ValidateSalt(salt []byte) error {
+	if len(salt) < MinSaltLength {
+		return errorsmod.Wrapf(ErrInvalidSalt, "salt must be at least %d bytes, got %d", MinSaltLength, len(salt))
+	}
+
+	return nil
+}
+
+// commitmentHashes are the hash functions of the supported commitment schemes.
+// New schemes must produce CommitLength bytes hashes.
+var commitmentHashes = map[CommitmentScheme]func() hash.Hash{
+	CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED: sha256.New,
+	CommitmentScheme_COMMITMENT_SCHEME_SHA256:      sha256.New,
+	CommitmentScheme_COMMITMENT_SCHEME_KECCAK256:   sha3.NewLegacyKeccak256,
+}
+
+// IsSupported returns whether the commitment scheme is known to the module,
+// it may still be disabled in the params.
+func (s CommitmentScheme) IsSupported() bool {
+	_, ok := commitmentHashes[s]
+	return ok
+}
+
+// Commitment returns the commitment to a move with a salt under the scheme, the
+// hash of "move:salt" like in utils.CalculateCommitment.
+func (s CommitmentScheme) Commitment(move Move, salt []byte) ([]byte, error) {
+	newHash, ok := commitmentHashes[s]
+	if !ok {
+		return nil, errorsmod.Wrapf(ErrInvalidCommitmentScheme, "unsupported commitment scheme %s", s)
+	}
+
+	return utils.HashCommitment(newHash(), move.Name(), salt), nil
+}
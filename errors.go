@@ -0,0 +1,28 @@
+package rps
+
+import errorsmod "cosmossdk.io/errors"
+
+// Errors of the module, returned by the stateless validation of the messages
+// and of the commitments, and by the keeper.
+var (
+	ErrInvalidAddress          = errorsmod.Register(ModuleName, 2, "invalid address")
+	ErrInvalidCommit           = errorsmod.Register(ModuleName, 3, "invalid commit")
+	ErrInvalidSalt             = errorsmod.Register(ModuleName, 4, "invalid salt")
+	ErrInvalidMove             = errorsmod.Register(ModuleName, 5, "invalid move")
+	ErrInvalidCommitmentScheme = errorsmod.Register(ModuleName, 6, "invalid commitment scheme")
+	ErrInvalidCoins            = errorsmod.Register(ModuleName, 7, "invalid coins")
+	ErrInvalidBetOutcome       = errorsmod.Register(ModuleName, 8, "invalid bet outcome")
+	ErrInvalidEncryptedReveal  = errorsmod.Register(ModuleName, 9, "invalid encrypted reveal")
+	ErrInvalidPlayers          = errorsmod.Register(ModuleName, 10, "invalid players")
+	ErrInvalidParams           = errorsmod.Register(ModuleName, 11, "invalid params")
+	ErrMoveAlreadyRevealed     = errorsmod.Register(ModuleName, 12, "move already revealed")
+	ErrNotFound                = errorsmod.Register(ModuleName, 13, "not found")
+	ErrDeadlinePassed          = errorsmod.Register(ModuleName, 14, "deadline passed")
+	ErrGameFull                = errorsmod.Register(ModuleName, 15, "game is full")
+	ErrGameNotReady            = errorsmod.Register(ModuleName, 16, "game not ready")
+	ErrNotPlayer               = errorsmod.Register(ModuleName, 17, "not a player of the game")
+	ErrAlreadyJoined           = errorsmod.Register(ModuleName, 18, "already joined")
+	ErrUnauthorized            = errorsmod.Register(ModuleName, 19, "unauthorized")
+	ErrNotAllowed              = errorsmod.Register(ModuleName, 20, "not allowed")
+	ErrMoveMismatch            = errorsmod.Register(ModuleName, 21, "move doesn't match commitment")
+)
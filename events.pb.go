@@ -0,0 +1,1239 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: facundomedica/rps/v1/events.proto
+
+package rps
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventJackpotFunded is emitted when a slice of the pot of a game goes into
+// the jackpot.
+type EventJackpotFunded struct {
+	GameId uint64                                   `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *EventJackpotFunded) Reset()         { *m = EventJackpotFunded{} }
+func (m *EventJackpotFunded) String() string { return proto.CompactTextString(m) }
+func (*EventJackpotFunded) ProtoMessage()    {}
+func (*EventJackpotFunded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87af62f05b215cdb, []int{0}
+}
+func (m *EventJackpotFunded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventJackpotFunded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventJackpotFunded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventJackpotFunded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventJackpotFunded.Merge(m, src)
+}
+func (m *EventJackpotFunded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventJackpotFunded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventJackpotFunded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventJackpotFunded proto.InternalMessageInfo
+
+func (m *EventJackpotFunded) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
+	}
+	return 0
+}
+
+func (m *EventJackpotFunded) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// EventJackpotWon is emitted when a player takes the jackpot.
+type EventJackpotWon struct {
+	GameId uint64 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Winner string `protobuf:"bytes,2,opt,name=winner,proto3" json:"winner,omitempty"`
+	// streak is the number of games the winner won in a row.
+	Streak uint64                                   `protobuf:"varint,3,opt,name=streak,proto3" json:"streak,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *EventJackpotWon) Reset()         { *m = EventJackpotWon{} }
+func (m *EventJackpotWon) String() string { return proto.CompactTextString(m) }
+func (*EventJackpotWon) ProtoMessage()    {}
+func (*EventJackpotWon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87af62f05b215cdb, []int{1}
+}
+func (m *EventJackpotWon) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventJackpotWon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventJackpotWon.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventJackpotWon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventJackpotWon.Merge(m, src)
+}
+func (m *EventJackpotWon) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventJackpotWon) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventJackpotWon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventJackpotWon proto.InternalMessageInfo
+
+func (m *EventJackpotWon) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
+	}
+	return 0
+}
+
+func (m *EventJackpotWon) GetWinner() string {
+	if m != nil {
+		return m.Winner
+	}
+	return ""
+}
+
+func (m *EventJackpotWon) GetStreak() uint64 {
+	if m != nil {
+		return m.Streak
+	}
+	return 0
+}
+
+func (m *EventJackpotWon) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// EventGameSettled is emitted when a game is over, with what each of its
+// players got back.
+type EventGameSettled struct {
+	GameId uint64 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// winners are the players that won the game, both of them on a draw. It's
+	// empty when nobody revealed or the game never started.
+	Winners []string       `protobuf:"bytes,2,rep,name=winners,proto3" json:"winners,omitempty"`
+	Results []PlayerResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results"`
+}
+
+func (m *EventGameSettled) Reset()         { *m = EventGameSettled{} }
+func (m *EventGameSettled) String() string { return proto.CompactTextString(m) }
+func (*EventGameSettled) ProtoMessage()    {}
+func (*EventGameSettled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87af62f05b215cdb, []int{2}
+}
+func (m *EventGameSettled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGameSettled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGameSettled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGameSettled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGameSettled.Merge(m, src)
+}
+func (m *EventGameSettled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGameSettled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGameSettled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGameSettled proto.InternalMessageInfo
+
+func (m *EventGameSettled) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
+	}
+	return 0
+}
+
+func (m *EventGameSettled) GetWinners() []string {
+	if m != nil {
+		return m.Winners
+	}
+	return nil
+}
+
+func (m *EventGameSettled) GetResults() []PlayerResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// PlayerResult is the result of a game for one of its players.
+type PlayerResult struct {
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// move is the move revealed by the player, unspecified if they didn't
+	// reveal.
+	Move Move `protobuf:"varint,2,opt,name=move,proto3,enum=facundomedica.rps.v1.Move" json:"move,omitempty"`
+	// payout is what the player got back from the game. A jackpot won along
+	// with it is reported by EventJackpotWon.
+	Payout github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=payout,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"payout"`
+}
+
+func (m *PlayerResult) Reset()         { *m = PlayerResult{} }
+func (m *PlayerResult) String() string { return proto.CompactTextString(m) }
+func (*PlayerResult) ProtoMessage()    {}
+func (*PlayerResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87af62f05b215cdb, []int{3}
+}
+func (m *PlayerResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlayerResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlayerResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlayerResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlayerResult.Merge(m, src)
+}
+func (m *PlayerResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlayerResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlayerResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlayerResult proto.InternalMessageInfo
+
+func (m *PlayerResult) GetPlayer() string {
+	if m != nil {
+		return m.Player
+	}
+	return ""
+}
+
+func (m *PlayerResult) GetMove() Move {
+	if m != nil {
+		return m.Move
+	}
+	return Move_MOVE_UNSPECIFIED
+}
+
+func (m *PlayerResult) GetPayout() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Payout
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventJackpotFunded)(nil), "facundomedica.rps.v1.EventJackpotFunded")
+	proto.RegisterType((*EventJackpotWon)(nil), "facundomedica.rps.v1.EventJackpotWon")
+	proto.RegisterType((*EventGameSettled)(nil), "facundomedica.rps.v1.EventGameSettled")
+	proto.RegisterType((*PlayerResult)(nil), "facundomedica.rps.v1.PlayerResult")
+}
+
+func init() { proto.RegisterFile("facundomedica/rps/v1/events.proto", fileDescriptor_87af62f05b215cdb) }
+
+var fileDescriptor_87af62f05b215cdb = []byte{
+	// 493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x3b, 0xdb, 0x92, 0xb2, 0xe3, 0xe2, 0x9f, 0x50, 0x34, 0x5b, 0x24, 0x5b, 0x7b, 0x2a,
+	0x0b, 0x3b, 0xb1, 0x15, 0xbc, 0x5b, 0x71, 0x17, 0x05, 0x41, 0xb2, 0x07, 0xc1, 0x4b, 0x99, 0x26,
+	0xaf, 0x31, 0xb4, 0x99, 0x09, 0x99, 0x49, 0x24, 0xdf, 0xc2, 0xb3, 0x9f, 0xa0, 0x78, 0xda, 0x83,
+	0x1f, 0x62, 0x8f, 0x8b, 0x27, 0x4f, 0x2a, 0xed, 0x61, 0x0f, 0x1e, 0xfc, 0x0a, 0x32, 0x7f, 0x16,
+	0x2a, 0x76, 0x7b, 0xdc, 0x4b, 0x3b, 0x6f, 0xde, 0xe7, 0xcd, 0xfb, 0x7b, 0x78, 0x32, 0xf8, 0xd1,
+	0x7b, 0x1a, 0x95, 0x2c, 0xe6, 0x19, 0xc4, 0x69, 0x44, 0x83, 0x22, 0x17, 0x41, 0x35, 0x0c, 0xa0,
+	0x02, 0x26, 0x05, 0xc9, 0x0b, 0x2e, 0xb9, 0xdb, 0xf9, 0x47, 0x42, 0x8a, 0x5c, 0x90, 0x6a, 0xd8,
+	0xbd, 0x47, 0xb3, 0x94, 0xf1, 0x40, 0xff, 0x1a, 0x61, 0xd7, 0x8f, 0xb8, 0xc8, 0xb8, 0x08, 0xa6,
+	0x54, 0x40, 0x50, 0x0d, 0xa7, 0x20, 0xe9, 0x30, 0x88, 0x78, 0xca, 0x6c, 0xbf, 0x93, 0xf0, 0x84,
+	0xeb, 0x63, 0xa0, 0x4e, 0xf6, 0xe9, 0xbe, 0x99, 0x9a, 0x98, 0x86, 0x29, 0x6c, 0xab, 0xb7, 0x11,
+	0x4e, 0xd6, 0x39, 0x58, 0x45, 0x7f, 0x81, 0xb0, 0xfb, 0x42, 0xc1, 0xbe, 0xa2, 0xd1, 0x2c, 0xe7,
+	0xf2, 0xb8, 0x64, 0x31, 0xc4, 0xee, 0x03, 0xdc, 0x4e, 0x68, 0x06, 0x93, 0x34, 0xf6, 0x50, 0x0f,
+	0x0d, 0x5a, 0xa1, 0xa3, 0xca, 0x97, 0xb1, 0x5b, 0x63, 0x87, 0x66, 0xbc, 0x64, 0xd2, 0xdb, 0xe9,
+	0x35, 0x07, 0xb7, 0x46, 0xfb, 0xc4, 0x2e, 0x54, 0xcc, 0xc4, 0x32, 0x93, 0xe7, 0x3c, 0x65, 0xe3,
+	0xe3, 0xf3, 0x1f, 0x07, 0x8d, 0x2f, 0x3f, 0x0f, 0x06, 0x49, 0x2a, 0x3f, 0x94, 0x53, 0x12, 0xf1,
+	0xcc, 0xd2, 0xd9, 0xbf, 0x23, 0x11, 0xcf, 0x2c, 0x8c, 0x1a, 0x10, 0x9f, 0x2f, 0xcf, 0x0e, 0xf7,
+	0xe6, 0x90, 0xd0, 0xa8, 0x9e, 0x28, 0xd7, 0x62, 0x71, 0x79, 0x76, 0x88, 0x42, 0xbb, 0xb0, 0xff,
+	0x07, 0xe1, 0x3b, 0xeb, 0xa8, 0x6f, 0x39, 0xbb, 0x9e, 0xf3, 0x31, 0x76, 0x3e, 0xa6, 0x8c, 0x41,
+	0xe1, 0xed, 0xf4, 0xd0, 0x60, 0x77, 0xec, 0x7d, 0xfb, 0x7a, 0xd4, 0xb1, 0xa8, 0xcf, 0xe2, 0xb8,
+	0x00, 0x21, 0x4e, 0x65, 0x91, 0xb2, 0x24, 0xb4, 0x3a, 0xf7, 0x3e, 0x76, 0x84, 0x2c, 0x80, 0xce,
+	0xbc, 0xa6, 0x79, 0x93, 0xa9, 0xd6, 0x1c, 0xb7, 0x6e, 0xda, 0xf1, 0x02, 0xe1, 0xbb, 0xda, 0xf1,
+	0x09, 0xcd, 0xe0, 0x14, 0xa4, 0x9c, 0x6f, 0x8b, 0x66, 0x84, 0xdb, 0xc6, 0x8a, 0xd0, 0xd9, 0x6c,
+	0xf3, 0x7c, 0x25, 0x74, 0x4f, 0x70, 0xbb, 0x00, 0x51, 0xce, 0xa5, 0xf0, 0x9a, 0xda, 0x5d, 0x9f,
+	0x6c, 0xfa, 0x58, 0xc9, 0x9b, 0x39, 0xad, 0xa1, 0x08, 0xb5, 0x74, 0xbc, 0xab, 0x6c, 0x1a, 0xd2,
+	0xab, 0xe9, 0xfe, 0x6f, 0x84, 0xf7, 0xd6, 0x45, 0x2a, 0x80, 0x5c, 0xd7, 0x9a, 0x72, 0x6b, 0x00,
+	0x46, 0xe7, 0x12, 0xdc, 0xca, 0x78, 0x05, 0x3a, 0xb0, 0xdb, 0xa3, 0xee, 0x66, 0x90, 0xd7, 0xbc,
+	0x82, 0x50, 0xeb, 0x54, 0x30, 0x39, 0xad, 0x79, 0x29, 0xbd, 0xe6, 0x8d, 0x05, 0x63, 0x16, 0x8e,
+	0x9f, 0x9e, 0x2f, 0x7d, 0x74, 0xb1, 0xf4, 0xd1, 0xaf, 0xa5, 0x8f, 0x3e, 0xad, 0xfc, 0xc6, 0xc5,
+	0xca, 0x6f, 0x7c, 0x5f, 0xf9, 0x8d, 0x77, 0x0f, 0xd7, 0x36, 0xfc, 0x77, 0xf9, 0xa6, 0x8e, 0xbe,
+	0x74, 0x4f, 0xfe, 0x0e, 0x00, 0x42, 0x31, 0x34, 0x28, 0x35, 0x04, 0x00, 0x00,
+}
+
+func (m *EventJackpotFunded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventJackpotFunded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventJackpotFunded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GameId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventJackpotWon) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventJackpotWon) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventJackpotWon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Streak != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Streak))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Winner) > 0 {
+		i -= len(m.Winner)
+		copy(dAtA[i:], m.Winner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Winner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GameId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGameSettled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGameSettled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGameSettled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Winners) > 0 {
+		for iNdEx := len(m.Winners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Winners[iNdEx])
+			copy(dAtA[i:], m.Winners[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Winners[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GameId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlayerResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlayerResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlayerResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payout) > 0 {
+		for iNdEx := len(m.Payout) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payout[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Move != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Move))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventJackpotFunded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		n += 1 + sovEvents(uint64(m.GameId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventJackpotWon) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		n += 1 + sovEvents(uint64(m.GameId))
+	}
+	l = len(m.Winner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Streak != 0 {
+		n += 1 + sovEvents(uint64(m.Streak))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventGameSettled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		n += 1 + sovEvents(uint64(m.GameId))
+	}
+	if len(m.Winners) > 0 {
+		for _, s := range m.Winners {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PlayerResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Move != 0 {
+		n += 1 + sovEvents(uint64(m.Move))
+	}
+	if len(m.Payout) > 0 {
+		for _, e := range m.Payout {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventJackpotFunded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventJackpotFunded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventJackpotFunded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventJackpotWon) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventJackpotWon: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventJackpotWon: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Winner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streak", wireType)
+			}
+			m.Streak = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Streak |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGameSettled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGameSettled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGameSettled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Winners = append(m.Winners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, PlayerResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlayerResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlayerResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlayerResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
+			}
+			m.Move = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Move |= Move(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payout = append(m.Payout, types.Coin{})
+			if err := m.Payout[len(m.Payout)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,66 @@
+package rps
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UsesHeightTimeouts returns whether the game deadlines are block heights
+// instead of timestamps.
+func (g Game) UsesHeightTimeouts() bool {
+	return g.TimeoutMode == TimeoutMode_TIMEOUT_MODE_HEIGHT
+}
+
+// CommitTimedOut returns whether the commit deadline of the game has passed.
+func (g Game) CommitTimedOut(blockTime time.Time, height int64) bool {
+	if g.UsesHeightTimeouts() {
+		return height > g.CommitTimeoutHeight
+	}
+
+	return blockTime.After(g.CommitTimeout)
+}
+
+// HasRevealTimeout returns whether the reveal deadline of the game has been
+// set, which happens on the first reveal.
+func (g Game) HasRevealTimeout() bool {
+	if g.UsesHeightTimeouts() {
+		return g.RevealTimeoutHeight != 0
+	}
+
+	return !g.RevealTimeout.IsZero()
+}
+
+// RevealTimedOut returns whether the reveal deadline of the game has passed.
+// It's always false if the reveal deadline hasn't been set yet.
+func (g Game) RevealTimedOut(blockTime time.Time, height int64) bool {
+	if !g.HasRevealTimeout() {
+		return false
+	}
+
+	if g.UsesHeightTimeouts() {
+		return height > g.RevealTimeoutHeight
+	}
+
+	return blockTime.After(g.RevealTimeout)
+}
+
+// ChallengerStake returns the stake the challenger has to put into the game.
+func (g Game) ChallengerStake() sdk.Coins {
+	if g.ChallengerFee.Empty() {
+		return g.EntryFee
+	}
+
+	return g.ChallengerFee
+}
+
+// PlayerStake returns the stake a player put into the game with their commit.
+// Commits that don't track the stake are from games where both players
+// paid the entry fee.
+func (g Game) PlayerStake(commit MoveCommit) sdk.Coins {
+	if commit.Stake.Empty() {
+		return g.EntryFee
+	}
+
+	return commit.Stake
+}
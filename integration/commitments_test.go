@@ -0,0 +1,78 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/facundomedica/rps"
+	"github.com/facundomedica/rps/module"
+)
+
+func TestCommitCmds(t *testing.T) {
+	// the commands take the move in place of the commitment, and the scheme
+	// it's computed with as a flag
+	txCmd := module.AppModule{}.GetTxCmd()
+	for use, name := range map[string]string{
+		"new-game [move] [entry_fee]":                                         "new-game",
+		"commit-move [game_id] [move]":                                        "commit-move",
+		"create-and-join [challenger] [challenger_commit] [move] [entry_fee]": "create-and-join",
+		"join-queue [move] [entry_fee]":                                       "join-queue",
+		"play-house [move] [entry_fee]":                                       "play-house",
+		"offer-rematch [game_id] [move]":                                      "offer-rematch",
+	} {
+		cmd, _, err := txCmd.Find([]string{name})
+		require.NoError(t, err)
+		require.Equal(t, use, cmd.Use)
+
+		schemeFlag := "commitment-scheme"
+		if name == "create-and-join" {
+			schemeFlag = "creator-commitment-scheme"
+		}
+		require.NotNil(t, cmd.Flags().Lookup(schemeFlag), name)
+	}
+
+	t.Setenv("RPS_SALT_PASSPHRASE", "passphrase")
+	n := newNetwork(t)
+	creator, challenger := n.Validators[0], n.Validators[1]
+
+	out := execTx(t, n, creator, "new-game", "rock", "10stake", "--challenger-fee", "5stake")
+	require.Contains(t, out, "Game ID: 0")
+
+	// keccak256 is enabled by the default params
+	execTx(t, n, challenger, "commit-move", "0", "paper", "--commitment-scheme", "keccak256")
+	require.NoError(t, n.WaitForNextBlock())
+
+	res, err := rps.NewQueryClient(creator.ClientCtx).Games(context.Background(), &rps.QueryGamesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Games, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), res.Games[0].EntryFee)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), res.Games[0].ChallengerFee)
+	require.True(t, res.Games[0].HasRevealTimeout())
+
+	// both moves are in the salt stores, so the players can reveal them
+	execTx(t, n, creator, "reveal-move", "0")
+	execTx(t, n, challenger, "reveal-move", "0")
+	require.NoError(t, n.WaitForNextBlock())
+	require.NoError(t, n.WaitForNextBlock())
+
+	res, err = rps.NewQueryClient(creator.ClientCtx).Games(context.Background(), &rps.QueryGamesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Games)
+
+	// the scheme of a queued player is stored with their commit once matched
+	execTx(t, n, creator, "join-queue", "scissors", "10stake", "--commitment-scheme", "keccak256")
+	require.NoError(t, n.WaitForNextBlock())
+	execTx(t, n, challenger, "join-queue", "rock", "10stake")
+	require.NoError(t, n.WaitForNextBlock())
+
+	commitRes, err := rps.NewQueryClient(creator.ClientCtx).MoveCommit(context.Background(), &rps.QueryMoveCommitRequest{GameId: 1, Player: creator.Address.String()})
+	require.NoError(t, err)
+	require.Equal(t, rps.CommitmentScheme_COMMITMENT_SCHEME_KECCAK256, commitRes.MoveCommit.Scheme)
+
+	execTx(t, n, creator, "reveal-move", "1")
+	require.NoError(t, n.WaitForNextBlock())
+}
@@ -10,6 +10,7 @@ require (
 	github.com/cosmos/cosmos-sdk v0.50.0-beta.0
 	github.com/facundomedica/rps v1.0.0
 	github.com/stretchr/testify v1.8.4
+	google.golang.org/grpc v1.57.0
 )
 
 require (
@@ -142,7 +143,6 @@ require (
 	google.golang.org/genproto v0.0.0-20230706204954-ccb25ca9f130 // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20230629202037-9506855d4529 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20230726155614-23370e0ffb3e // indirect
-	google.golang.org/grpc v1.57.0 // indirect
 	google.golang.org/protobuf v1.31.0 // indirect
 	gopkg.in/ini.v1 v1.67.0 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
 	_ "github.com/cosmos/cosmos-sdk/x/bank"
 	_ "github.com/cosmos/cosmos-sdk/x/consensus"
+	_ "github.com/cosmos/cosmos-sdk/x/distribution"
 	_ "github.com/cosmos/cosmos-sdk/x/genutil"
 	_ "github.com/cosmos/cosmos-sdk/x/mint"
 	_ "github.com/cosmos/cosmos-sdk/x/staking"
@@ -48,6 +49,7 @@ func TestIntegration(t *testing.T) {
 			configurator.StakingModule(),
 			configurator.TxModule(),
 			configurator.ConsensusModule(),
+			configurator.DistributionModule(),
 			configurator.GenutilModule(),
 			configurator.MintModule(),
 			ExampleModule(),
@@ -56,6 +58,7 @@ func TestIntegration(t *testing.T) {
 				"bank",
 				"staking",
 				"mint",
+				"distribution",
 				"genutil",
 				"consensus",
 				rps.ModuleName,
@@ -0,0 +1,129 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	authmodulev1 "cosmossdk.io/api/cosmos/auth/module/v1"
+	"cosmossdk.io/core/appconfig"
+	"cosmossdk.io/depinject"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/facundomedica/rps"
+	"github.com/facundomedica/rps/module"
+)
+
+// newNetwork starts an in-process network of two validators running the rps
+// module, each validator is a player.
+func newNetwork(t *testing.T) *network.Network {
+	t.Helper()
+
+	cfg, err := network.DefaultConfigWithAppConfig(depinject.Configs(configurator.NewAppConfig(
+		authModule(),
+		configurator.BankModule(),
+		configurator.StakingModule(),
+		configurator.TxModule(),
+		configurator.ConsensusModule(),
+		configurator.DistributionModule(),
+		configurator.GenutilModule(),
+		configurator.MintModule(),
+		ExampleModule(),
+		configurator.WithCustomInitGenesisOrder(
+			"auth",
+			"bank",
+			"staking",
+			"mint",
+			"distribution",
+			"genutil",
+			"consensus",
+			rps.ModuleName,
+		),
+		endBlocker(t),
+	)))
+	require.NoError(t, err)
+
+	var genesis map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(cfg.GenesisState[rps.ModuleName], &genesis))
+	params := rps.DefaultParams()
+	params.CommitTimeout, params.RevealTimeout = 600, 600
+	paramsJSON, err := cfg.Codec.MarshalJSON(&params)
+	require.NoError(t, err)
+	genesis["params"] = json.RawMessage(fmt.Sprintf(`[{"key":"item","value":%s}]`, paramsJSON))
+	cfg.GenesisState[rps.ModuleName], err = json.Marshal(genesis)
+	require.NoError(t, err)
+
+	cfg.NumValidators = 2
+	n, err := network.New(t, t.TempDir(), cfg)
+	require.NoError(t, err)
+	t.Cleanup(n.Cleanup)
+
+	require.NoError(t, n.WaitForNextBlock())
+	return n
+}
+
+// authModule is configurator.AuthModule with the rps module account, which
+// holds the stakes.
+func authModule() configurator.ModuleOption {
+	return func(config *configurator.Config) {
+		configurator.AuthModule()(config)
+
+		authConfig := &authmodulev1.Module{}
+		if err := config.ModuleConfigs["auth"].Config.UnmarshalTo(authConfig); err != nil {
+			panic(err)
+		}
+
+		authConfig.ModuleAccountPermissions = append(authConfig.ModuleAccountPermissions, &authmodulev1.ModuleAccountPermission{Account: rps.ModuleName})
+		config.ModuleConfigs["auth"].Config = appconfig.WrapAny(authConfig)
+	}
+}
+
+// endBlocker adds the rps module to the end blockers, settling the games. The
+// configurator options modify a config shared by all tests, so the end blockers
+// are restored once the test is done.
+func endBlocker(t *testing.T) configurator.ModuleOption {
+	return func(config *configurator.Config) {
+		endBlockers := config.EndBlockersOrder
+		config.EndBlockersOrder = append(endBlockers[:len(endBlockers):len(endBlockers)], rps.ModuleName)
+		t.Cleanup(func() { config.EndBlockersOrder = endBlockers })
+	}
+}
+
+// execTx runs an rps tx command as the given validator, through the node of
+// the first one which is the only one serving RPC.
+func execTx(t *testing.T, n *network.Network, val *network.Validator, args ...string) string {
+	t.Helper()
+
+	out, err := runTx(n, val, args...)
+	require.NoError(t, err)
+	return out
+}
+
+// runTx is execTx returning the error, for commands run in their own
+// goroutine.
+func runTx(n *network.Network, val *network.Validator, args ...string) (string, error) {
+	clientCtx := n.Validators[0].ClientCtx.
+		WithKeyring(val.ClientCtx.Keyring).
+		WithHomeDir(val.ClientCtx.HomeDir)
+
+	args = append(args,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))),
+	)
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, module.AppModule{}.GetTxCmd(), args)
+	if err != nil {
+		return "", err
+	}
+
+	return out.String(), nil
+}
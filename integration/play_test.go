@@ -0,0 +1,44 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/facundomedica/rps"
+)
+
+func TestPlay(t *testing.T) {
+	t.Setenv("RPS_SALT_PASSPHRASE", "passphrase")
+	n := newNetwork(t)
+	creator, challenger := n.Validators[0], n.Validators[1]
+
+	// the creator waits for an opponent in the background
+	type result struct {
+		out string
+		err error
+	}
+	done := make(chan result)
+	go func() {
+		out, err := runTx(n, creator, "play", "--move", "rock", "--entry-fee", "10stake", "--poll-interval", "500ms")
+		done <- result{out, err}
+	}()
+
+	queryClient := rps.NewQueryClient(creator.ClientCtx)
+	require.Eventually(t, func() bool {
+		res, err := queryClient.Games(context.Background(), &rps.QueryGamesRequest{})
+		return err == nil && len(res.Games) == 1
+	}, time.Minute, 500*time.Millisecond)
+
+	out := execTx(t, n, challenger, "play", "--move", "scissors", "--join", "0", "--poll-interval", "500ms")
+	require.Contains(t, out, "Joined game 0")
+	require.Contains(t, out, "Revealed scissors")
+	require.Contains(t, out, "You lost game 0, your opponent played rock. You got nothing")
+
+	res := <-done
+	require.NoError(t, res.err)
+	require.Contains(t, res.out, "Created game 0, waiting for an opponent")
+	require.Contains(t, res.out, "You won game 0! Your opponent played scissors. You got 20stake")
+}
@@ -0,0 +1,130 @@
+package integration_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/facundomedica/rps"
+	"github.com/facundomedica/rps/client/rpsclient"
+	"github.com/facundomedica/rps/client/saltstore"
+)
+
+// newClient returns a client playing as the given validator, through the
+// gRPC server and the node of the first one, signing in signMode.
+func newClient(t *testing.T, n *network.Network, val *network.Validator, signMode signingtypes.SignMode) *rpsclient.Client {
+	t.Helper()
+
+	clientCtx := n.Validators[0].ClientCtx
+	conn, err := grpc.Dial(
+		n.Validators[0].AppConfig.GRPC.Address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec())),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	record, err := val.ClientCtx.Keyring.KeyByAddress(val.Address)
+	require.NoError(t, err)
+
+	clientCtx = clientCtx.
+		WithGRPCClient(conn).
+		WithKeyring(val.ClientCtx.Keyring).
+		WithFromName(record.Name).
+		WithFromAddress(val.Address)
+
+	txf := tx.Factory{}.
+		WithChainID(clientCtx.ChainID).
+		WithKeybase(clientCtx.Keyring).
+		WithTxConfig(clientCtx.TxConfig).
+		WithAccountRetriever(authtypes.AccountRetriever{}).
+		WithSignMode(signMode).
+		WithGas(200000).
+		WithFees(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10).String())
+
+	store, err := saltstore.Open(filepath.Join(t.TempDir(), "salts.json"), []byte("passphrase"))
+	require.NoError(t, err)
+
+	return rpsclient.New(clientCtx, txf, store, rpsclient.WithPollInterval(500*time.Millisecond))
+}
+
+func TestClient(t *testing.T) {
+	n := newNetwork(t)
+	ctx := context.Background()
+	// the challenger signs with amino JSON like legacy clients do, which has to
+	// render the moves
+	creator := newClient(t, n, n.Validators[0], signingtypes.SignMode_SIGN_MODE_DIRECT)
+	challenger := newClient(t, n, n.Validators[1], signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+
+	games, err := challenger.ListOpenGames(ctx)
+	require.NoError(t, err)
+	require.Empty(t, games)
+
+	_, err = creator.CreateGame(ctx, rps.Move_MOVE_UNSPECIFIED, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	require.EqualError(t, err, "invalid move")
+
+	gameID, err := creator.CreateGame(ctx, rps.Move_MOVE_PAPER, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), gameID)
+
+	// the creator can't join its own game
+	games, err = creator.ListOpenGames(ctx)
+	require.NoError(t, err)
+	require.Empty(t, games)
+
+	games, err = challenger.ListOpenGames(ctx)
+	require.NoError(t, err)
+	require.Len(t, games, 1)
+	require.Equal(t, gameID, games[0].Id)
+	require.Equal(t, creator.Address(), games[0].Creator)
+
+	require.NoError(t, challenger.Join(ctx, gameID, rps.Move_MOVE_ROCK))
+
+	full, err := creator.WaitForOpponent(ctx, gameID)
+	require.NoError(t, err)
+	require.True(t, full)
+
+	require.ErrorContains(t, creator.Reveal(ctx, 1), "no move for game 1")
+	require.NoError(t, creator.Reveal(ctx, gameID))
+	require.NoError(t, challenger.Reveal(ctx, gameID))
+
+	settled, err := challenger.WaitForOutcome(ctx, gameID)
+	require.NoError(t, err)
+	require.Equal(t, gameID, settled.GameId)
+	require.Equal(t, []string{creator.Address()}, settled.Winners)
+
+	for _, result := range settled.Results {
+		switch result.Player {
+		case creator.Address():
+			require.Equal(t, rps.Move_MOVE_PAPER, result.Move)
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)), result.Payout)
+		case challenger.Address():
+			require.Equal(t, rps.Move_MOVE_ROCK, result.Move)
+			require.True(t, result.Payout.IsZero())
+		default:
+			t.Fatalf("unexpected player %s", result.Player)
+		}
+	}
+
+	// the creator's tracking of the game ends on the same settlement
+	settled, err = creator.WaitForOutcome(ctx, gameID)
+	require.NoError(t, err)
+	require.Equal(t, []string{creator.Address()}, settled.Winners)
+
+	games, err = challenger.ListOpenGames(ctx)
+	require.NoError(t, err)
+	require.Empty(t, games)
+}
@@ -0,0 +1,71 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+
+	"github.com/facundomedica/rps"
+	"github.com/facundomedica/rps/module"
+)
+
+func TestWatch(t *testing.T) {
+	t.Setenv("RPS_SALT_PASSPHRASE", "passphrase")
+	n := newNetwork(t)
+	creator, challenger := n.Validators[0], n.Validators[1]
+
+	// the game ID is filled in from the transaction result
+	out := execTx(t, n, creator, "new-game", "rock", "10stake")
+	require.Contains(t, out, "Game ID: 0")
+
+	execTx(t, n, challenger, "commit-move", "0", "paper")
+	require.NoError(t, n.WaitForNextBlock())
+
+	out = execTx(t, n, creator, "watch", "--once", "--dry-run")
+	require.Contains(t, out, "game 0: would reveal rock")
+
+	out = execTx(t, n, creator, "watch", "--once")
+	require.Contains(t, out, "game 0: revealed rock")
+
+	// a later run sees the move was already revealed
+	out = execTx(t, n, creator, "watch", "--once")
+	require.NotContains(t, out, "game 0")
+
+	// the challenger reveals by hand, using the salt store as well
+	execTx(t, n, challenger, "reveal-move", "0")
+	require.NoError(t, n.WaitForNextBlock())
+	require.NoError(t, n.WaitForNextBlock())
+
+	res, err := rps.NewQueryClient(creator.ClientCtx).Games(context.Background(), &rps.QueryGamesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Games)
+
+	// a player waiting in the queue doesn't know the game they're matched in,
+	// their pending salt is resolved by its commitment
+	out = execTx(t, n, creator, "join-queue", "scissors", "10stake")
+	require.Contains(t, out, "No game yet")
+
+	out = execTx(t, n, challenger, "join-queue", "paper", "10stake")
+	require.Contains(t, out, "Game ID: 1")
+
+	out = execTx(t, n, creator, "watch", "--once")
+	require.Contains(t, out, "game 1: revealed scissors")
+
+	execTx(t, n, challenger, "reveal-move", "1")
+	require.NoError(t, n.WaitForNextBlock())
+	require.NoError(t, n.WaitForNextBlock())
+
+	res, err = rps.NewQueryClient(creator.ClientCtx).Games(context.Background(), &rps.QueryGamesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Games)
+
+	// the settlements of both games are found in the block events, so their
+	// moves are pruned without asking
+	clientCtx := n.Validators[0].ClientCtx.WithHomeDir(creator.ClientCtx.HomeDir)
+	prune, err := clitestutil.ExecTestCLICmd(clientCtx, module.AppModule{}.GetTxCmd(), []string{"salts", "prune"})
+	require.NoError(t, err)
+	require.Contains(t, prune.String(), "Removed 2 moves from the salt store")
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// balanceOf returns the coins tracked by a map of amounts by denom, such as
+// the jackpot or the house bankroll. The funds are held by the module account.
+func balanceOf(ctx context.Context, balances collections.Map[string, math.Int]) (sdk.Coins, error) {
+	coins := sdk.NewCoins()
+	err := balances.Walk(ctx, nil, func(denom string, amount math.Int) (bool, error) {
+		coins = coins.Add(sdk.NewCoin(denom, amount))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return coins, nil
+}
+
+// addToBalance adds the coins to a map of amounts by denom.
+func addToBalance(ctx context.Context, balances collections.Map[string, math.Int], coins sdk.Coins) error {
+	for _, coin := range coins {
+		balance, err := balances.Get(ctx, coin.Denom)
+		if err != nil {
+			if !errors.Is(err, collections.ErrNotFound) {
+				return err
+			}
+
+			balance = math.ZeroInt()
+		}
+
+		if err := balances.Set(ctx, coin.Denom, balance.Add(coin.Amount)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// subFromBalance subtracts the coins from a map of amounts by denom, failing if
+// the balance can't cover them. Empty balances are removed.
+func subFromBalance(ctx context.Context, balances collections.Map[string, math.Int], coins sdk.Coins) error {
+	for _, coin := range coins {
+		balance, err := balances.Get(ctx, coin.Denom)
+		if err != nil {
+			if !errors.Is(err, collections.ErrNotFound) {
+				return err
+			}
+
+			balance = math.ZeroInt()
+		}
+
+		if balance.LT(coin.Amount) {
+			return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s%s < %s", balance, coin.Denom, coin)
+		}
+
+		if balance.Equal(coin.Amount) {
+			if err := balances.Remove(ctx, coin.Denom); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := balances.Set(ctx, coin.Denom, balance.Sub(coin.Amount)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/facundomedica/rps"
+)
+
+// betOutcomes are the outcomes spectators can bet on.
+var betOutcomes = []rps.BetOutcome{
+	rps.BetOutcome_BET_OUTCOME_CREATOR,
+	rps.BetOutcome_BET_OUTCOME_CHALLENGER,
+	rps.BetOutcome_BET_OUTCOME_DRAW,
+}
+
+// gameBet is a bet along with the address of the bettor.
+type gameBet struct {
+	bettor []byte
+	bet    rps.Bet
+}
+
+// gameBets returns the bets placed on a game, ordered by bettor.
+func (k Keeper) gameBets(ctx context.Context, gameID uint64) ([]gameBet, error) {
+	bets := []gameBet{}
+	err := k.Bets.Walk(
+		ctx,
+		collections.NewPrefixedPairRange[uint64, []byte](gameID),
+		func(key collections.Pair[uint64, []byte], bet rps.Bet) (bool, error) {
+			bets = append(bets, gameBet{bettor: key.K2(), bet: bet})
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return bets, nil
+}
+
+// betPools returns the sum of the bets on each outcome and the sum of all bets.
+func betPools(bets []gameBet) (map[rps.BetOutcome]sdk.Coins, sdk.Coins) {
+	pools := map[rps.BetOutcome]sdk.Coins{}
+	total := sdk.NewCoins()
+	for _, b := range bets {
+		pools[b.bet.Outcome] = pools[b.bet.Outcome].Add(b.bet.Amount...)
+		total = total.Add(b.bet.Amount...)
+	}
+
+	return pools, total
+}
+
+// bettingFee returns the betting fee, params stored before it existed have it nil.
+func bettingFee(params rps.Params) math.LegacyDec {
+	if params.BettingFee.IsNil() {
+		return math.LegacyZeroDec()
+	}
+
+	return params.BettingFee
+}
+
+// playerBetOutcome returns the outcome spectators bet on for a win of the player.
+func (k Keeper) playerBetOutcome(game rps.Game, player []byte) (rps.BetOutcome, error) {
+	// games created before the creator was tracked don't have bets
+	if game.Creator == "" {
+		return rps.BetOutcome_BET_OUTCOME_UNSPECIFIED, nil
+	}
+
+	creator, err := k.addressCodec.StringToBytes(game.Creator)
+	if err != nil {
+		return rps.BetOutcome_BET_OUTCOME_UNSPECIFIED, err
+	}
+
+	if bytes.Equal(creator, player) {
+		return rps.BetOutcome_BET_OUTCOME_CREATOR, nil
+	}
+
+	return rps.BetOutcome_BET_OUTCOME_CHALLENGER, nil
+}
+
+// settleBets pays the spectator bets of a game once its outcome is known. Each
+// denom is a separate parimutuel pool: the bets on the outcome share the whole
+// pool, minus the betting fee, proportionally to their amount. If the game has
+// no outcome or nobody bet on it, the bets in that denom are refunded.
+func (k Keeper) settleBets(ctx context.Context, params rps.Params, game rps.Game, outcome rps.BetOutcome) error {
+	bets, err := k.gameBets(ctx, game.Id)
+	if err != nil {
+		return err
+	}
+
+	if len(bets) == 0 {
+		return nil
+	}
+
+	pools, total := betPools(bets)
+	fee := bettingFee(params)
+
+	payouts := make([]sdk.Coins, len(bets))
+	protocolFees := sdk.NewCoins()
+	for _, pool := range total {
+		winning := pools[outcome].AmountOf(pool.Denom)
+		if outcome == rps.BetOutcome_BET_OUTCOME_UNSPECIFIED || winning.IsZero() {
+			for i, b := range bets {
+				if amount := b.bet.Amount.AmountOf(pool.Denom); amount.IsPositive() {
+					payouts[i] = payouts[i].Add(sdk.NewCoin(pool.Denom, amount))
+				}
+			}
+
+			continue
+		}
+
+		protocolFee := fee.MulInt(pool.Amount).TruncateInt()
+		distributable := pool.Amount.Sub(protocolFee)
+		paid := math.ZeroInt()
+		for i, b := range bets {
+			amount := b.bet.Amount.AmountOf(pool.Denom)
+			if b.bet.Outcome != outcome || !amount.IsPositive() {
+				continue
+			}
+
+			payout := amount.Mul(distributable).Quo(winning)
+			if payout.IsPositive() {
+				payouts[i] = payouts[i].Add(sdk.NewCoin(pool.Denom, payout))
+				paid = paid.Add(payout)
+			}
+		}
+
+		// the rounding dust goes along with the protocol fee
+		if remaining := protocolFee.Add(distributable.Sub(paid)); remaining.IsPositive() {
+			protocolFees = protocolFees.Add(sdk.NewCoin(pool.Denom, remaining))
+		}
+	}
+
+	for i, b := range bets {
+		if payouts[i].IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, rps.ModuleName, b.bettor, payouts[i]); err != nil {
+			return err
+		}
+	}
+
+	if protocolFees.IsZero() {
+		return nil
+	}
+
+	return k.distrKeeper.FundCommunityPool(ctx, protocolFees, authtypes.NewModuleAddress(rps.ModuleName))
+}
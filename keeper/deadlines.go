@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/facundomedica/rps"
+	v5 "github.com/facundomedica/rps/migrations/v5"
+)
+
+// dueNowTime and dueNowHeight are the deadline of the games whose moves are
+// both revealed, they sort before any other so the games are settled in the
+// next EndBlocker.
+var (
+	dueNowTime         = time.Unix(0, 0).UTC()
+	dueNowHeight int64 = 0
+)
+
+// scheduleGame indexes a game by the deadline at which the EndBlocker has to
+// settle it, replacing its previous entry: the commit deadline until the game
+// is full, then the reveal deadline, and right away once both moves are
+// revealed. Full games whose reveal window hasn't started yet aren't indexed,
+// the first reveal starts it.
+func (k Keeper) scheduleGame(ctx context.Context, game rps.Game) error {
+	if err := k.unscheduleGame(ctx, game); err != nil {
+		return err
+	}
+
+	players, err := k.committedPlayers(ctx, game.Id)
+	if err != nil {
+		return err
+	}
+
+	if len(players) < 2 {
+		return k.setGameDeadline(ctx, game, game.CommitTimeout, game.CommitTimeoutHeight)
+	}
+
+	if !game.HasRevealTimeout() {
+		return nil
+	}
+
+	reveals := 0
+	err = k.MoveReveals.Walk(ctx, collections.NewPrefixedPairRange[uint64, []byte](game.Id), func(_ collections.Pair[uint64, []byte], _ rps.MoveReveal) (bool, error) {
+		reveals++
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if reveals >= 2 {
+		return k.setGameDeadline(ctx, game, dueNowTime, dueNowHeight)
+	}
+
+	return k.setGameDeadline(ctx, game, game.RevealTimeout, game.RevealTimeoutHeight)
+}
+
+// rebuildDeadlineIndex drops the deadline index and indexes every open game
+// again, like the migration to version 5 does.
+func (k Keeper) rebuildDeadlineIndex(ctx context.Context) error {
+	if err := k.GamesByTimeDeadline.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	if err := k.GamesByHeightDeadline.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	return v5.Migrate(ctx, k.Games, k.scheduleGame)
+}
+
+// setGameDeadline adds a game to the deadline index of its timeout mode.
+func (k Keeper) setGameDeadline(ctx context.Context, game rps.Game, deadline time.Time, height int64) error {
+	if game.UsesHeightTimeouts() {
+		return k.GamesByHeightDeadline.Set(ctx, collections.Join(height, game.Id))
+	}
+
+	return k.GamesByTimeDeadline.Set(ctx, collections.Join(deadline, game.Id))
+}
+
+// unscheduleGame removes a game from the deadline index, whichever of its
+// deadlines it's indexed by.
+func (k Keeper) unscheduleGame(ctx context.Context, game rps.Game) error {
+	if game.UsesHeightTimeouts() {
+		for _, height := range []int64{game.CommitTimeoutHeight, game.RevealTimeoutHeight, dueNowHeight} {
+			if err := k.GamesByHeightDeadline.Remove(ctx, collections.Join(height, game.Id)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	for _, deadline := range []time.Time{game.CommitTimeout, game.RevealTimeout, dueNowTime} {
+		if err := k.GamesByTimeDeadline.Remove(ctx, collections.Join(deadline, game.Id)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dueGames returns the games whose deadline has passed, up to limit games. Zero
+// means no limit. Games are due once the block is past their deadline, like in
+// Game.CommitTimedOut and Game.RevealTimedOut. Each index is taken earliest
+// deadline first, but heights and times can't be compared with each other, so
+// the two indexes take turns. Entries of games that no longer exist are dropped
+// from the index.
+func (k Keeper) dueGames(ctx context.Context, limit uint64) ([]rps.Game, error) {
+	heightKeys, timeKeys, err := k.dueKeys(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	games := make([]rps.Game, 0, len(heightKeys)+len(timeKeys))
+	for i := 0; i < len(heightKeys) || i < len(timeKeys); i++ {
+		if i < len(timeKeys) && (limit == 0 || uint64(len(games)) < limit) {
+			game, found, err := k.indexedGame(ctx, timeKeys[i].K2())
+			if err != nil {
+				return nil, err
+			}
+
+			if found {
+				games = append(games, game)
+			} else if err := k.GamesByTimeDeadline.Remove(ctx, timeKeys[i]); err != nil {
+				return nil, err
+			}
+		}
+
+		if i < len(heightKeys) && (limit == 0 || uint64(len(games)) < limit) {
+			game, found, err := k.indexedGame(ctx, heightKeys[i].K2())
+			if err != nil {
+				return nil, err
+			}
+
+			if found {
+				games = append(games, game)
+			} else if err := k.GamesByHeightDeadline.Remove(ctx, heightKeys[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return games, nil
+}
+
+// dueKeys returns the entries of the height and time deadline indexes whose
+// deadline has passed, earliest first, up to limit entries of each index. Zero
+// means no limit.
+func (k Keeper) dueKeys(ctx context.Context, limit uint64) ([]collections.Pair[int64, uint64], []collections.Pair[time.Time, uint64], error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now, height := sdkCtx.BlockTime(), sdkCtx.BlockHeight()
+
+	heightKeys := []collections.Pair[int64, uint64]{}
+	err := k.GamesByHeightDeadline.Walk(ctx, nil, func(key collections.Pair[int64, uint64]) (bool, error) {
+		if (limit != 0 && uint64(len(heightKeys)) >= limit) || key.K1() >= height {
+			return true, nil
+		}
+
+		heightKeys = append(heightKeys, key)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	timeKeys := []collections.Pair[time.Time, uint64]{}
+	err = k.GamesByTimeDeadline.Walk(ctx, nil, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		if (limit != 0 && uint64(len(timeKeys)) >= limit) || !now.After(key.K1()) {
+			return true, nil
+		}
+
+		timeKeys = append(timeKeys, key)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return heightKeys, timeKeys, nil
+}
+
+// indexedGame returns a game from the deadline index, and false if it doesn't
+// exist anymore.
+func (k Keeper) indexedGame(ctx context.Context, gameID uint64) (rps.Game, bool, error) {
+	game, err := k.Games.Get(ctx, gameID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return rps.Game{}, false, nil
+		}
+
+		return rps.Game{}, false, err
+	}
+
+	game.Id = gameID
+	return game, true, nil
+}
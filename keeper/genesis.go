@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+)
+
+// genesisHandler imports and exports the module state through the collections
+// of the keeper's schema. The deadline index is derived from the games, so it's
+// rebuilt on import instead of trusting the one in the genesis file.
+type genesisHandler struct {
+	collections.Schema
+
+	k Keeper
+}
+
+// InitGenesis implements appmodule.HasGenesis.
+func (g genesisHandler) InitGenesis(ctx context.Context, source appmodule.GenesisSource) error {
+	if err := g.Schema.InitGenesis(ctx, source); err != nil {
+		return err
+	}
+
+	return g.k.rebuildDeadlineIndex(ctx)
+}
@@ -22,7 +22,7 @@ func TestDefaultGenesis(t *testing.T) {
 	err = json.Compact(buf, result)
 	require.NoError(t, err)
 
-	require.Equal(t, `{"game_id":[],"games":[],"move_commits":[],"move_reveals":[],"params":[]}`, buf.String())
+	require.Equal(t, `{"bets":[],"game_id":[],"games":[],"games_by_height_deadline":[],"games_by_time_deadline":[],"house_bankroll":[],"house_exposure":[],"house_games_to_seed":[],"jackpot":[],"move_commits":[],"move_reveals":[],"params":[],"player_stats":[],"queue":[],"queue_by_player":[],"queue_by_stake":[],"queue_entry_id":[],"reveal_agents":[],"settled_games":[],"settled_games_by_expiry":[],"stuck_games":[]}`, buf.String())
 }
 
 // func TestExportGenesis(t *testing.T) {
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/facundomedica/rps"
+)
+
+// houseAddress returns the address the house commits and reveals its moves
+// with, which is the module account.
+func houseAddress() []byte {
+	return authtypes.NewModuleAddress(rps.ModuleName)
+}
+
+// payStake sends coins held by the module to a player of a game. The house
+// can't receive coins from its own account, its share goes back to the
+// bankroll instead.
+func (k Keeper) payStake(ctx context.Context, player []byte, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	if bytes.Equal(player, houseAddress()) {
+		return addToBalance(ctx, k.HouseBankroll, amount)
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, rps.ModuleName, player, amount)
+}
+
+// reserveHouseStake takes the stake of the house in a new game from the
+// bankroll, as long as the total stake of the house in the games in progress
+// stays within the max open exposure.
+func (k Keeper) reserveHouseStake(ctx context.Context, params rps.Params, stake sdk.Coins) error {
+	if !params.HouseMaxOpenExposure.Empty() {
+		exposure, err := balanceOf(ctx, k.HouseExposure)
+		if err != nil {
+			return err
+		}
+
+		if !exposure.Add(stake...).IsAllLTE(params.HouseMaxOpenExposure) {
+			return errorsmod.Wrapf(rps.ErrInvalidCoins, "house open exposure %s plus %s exceeds the max open exposure %s", exposure, stake, params.HouseMaxOpenExposure)
+		}
+	}
+
+	if err := subFromBalance(ctx, k.HouseBankroll, stake); err != nil {
+		return errorsmod.Wrap(err, "house bankroll can't cover the entry fee")
+	}
+
+	return addToBalance(ctx, k.HouseExposure, stake)
+}
+
+// releaseHouseStake removes the stake of the house in a game that is over from
+// its open exposure. What goes back to the bankroll is paid with payStake.
+func (k Keeper) releaseHouseStake(ctx context.Context, stake sdk.Coins) error {
+	return subFromBalance(ctx, k.HouseExposure, stake)
+}
+
+// seedHouseGames sets the seed of the house games created in previous blocks
+// to the hash of the current block. The hash of the block a game is created in
+// can be chosen by its proposer, who may be the player, while the next one
+// isn't known when the player commits. Players can't reveal until then.
+func (k Keeper) seedHouseGames(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	seed := sdkCtx.HeaderHash()
+	if len(seed) == 0 {
+		return nil
+	}
+
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).EndExclusive(collections.Join(sdkCtx.BlockHeight(), uint64(0)))
+	keys, err := k.HouseGamesToSeed.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	toSeed, err := keys.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range toSeed {
+		if err := k.HouseGamesToSeed.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		// the game may have been moved to the stuck games in the meantime
+		game, err := k.Games.Get(ctx, key.K2())
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+
+			return err
+		}
+
+		game.HouseSeed = seed
+		if err := k.Games.Set(ctx, key.K2(), game); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// settleHouseGame settles a game against the house once the player revealed
+// or the reveal timeout passed. The house move is revealed along with the
+// player's, a player that doesn't reveal forfeits their stake to the house.
+func (k Keeper) settleHouseGame(ctx context.Context, params rps.Params, game rps.Game, players [][]byte, stakes []sdk.Coins) error {
+	house := houseAddress()
+	playerIdx := 0
+	if bytes.Equal(players[0], house) {
+		playerIdx = 1
+	}
+
+	player, playerStake := players[playerIdx], stakes[playerIdx]
+	houseStake := stakes[1-playerIdx]
+	prize := playerStake.Add(houseStake...)
+
+	if err := k.releaseHouseStake(ctx, houseStake); err != nil {
+		return err
+	}
+
+	playerReveal, err := k.MoveReveals.Get(ctx, collections.Join(game.Id, player))
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		// the player didn't reveal, the house takes their whole stake
+		if err := k.recordNoShow(ctx, params, player); err != nil {
+			return err
+		}
+
+		if err := k.payStake(ctx, house, prize); err != nil {
+			return err
+		}
+
+		payouts := make([]sdk.Coins, len(players))
+		payouts[1-playerIdx] = prize
+		if err := k.emitGameSettled(ctx, game.Id, players, payouts, [][]byte{house}); err != nil {
+			return err
+		}
+
+		return k.removeGame(ctx, game.Id)
+	}
+
+	houseReveal, err := k.MoveReveals.Get(ctx, collections.Join(game.Id, house))
+	if err != nil {
+		return fmt.Errorf("house move not revealed: %w", err)
+	}
+
+	payouts := make([]sdk.Coins, len(players))
+	winners := decideWinner(player, house, playerReveal.Move, houseReveal.Move)
+	if len(winners) == 1 {
+		if err := k.payStake(ctx, winners[0], prize); err != nil {
+			return err
+		}
+
+		payouts[playerIndex(players, winners[0])] = prize
+	} else {
+		// draw, each side gets its own stake back
+		if err := k.payStake(ctx, player, playerStake); err != nil {
+			return err
+		}
+
+		if err := k.payStake(ctx, house, houseStake); err != nil {
+			return err
+		}
+
+		copy(payouts, stakes)
+	}
+
+	if err := k.emitGameSettled(ctx, game.Id, players, payouts, winners); err != nil {
+		return err
+	}
+
+	return k.removeGame(ctx, game.Id)
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/facundomedica/rps"
+)
+
+// fundJackpot moves the jackpot fee of the pot of a game into the jackpot and
+// returns what's left of the pot. The funds stay in the module account.
+func (k Keeper) fundJackpot(ctx context.Context, params rps.Params, gameID uint64, pot sdk.Coins) (sdk.Coins, error) {
+	if params.JackpotFee.IsNil() || !params.JackpotFee.IsPositive() {
+		return pot, nil
+	}
+
+	contribution := sdk.NewCoins()
+	for _, coin := range pot {
+		contribution = contribution.Add(sdk.NewCoin(coin.Denom, params.JackpotFee.MulInt(coin.Amount).TruncateInt()))
+	}
+
+	if contribution.IsZero() {
+		return pot, nil
+	}
+
+	if err := addToBalance(ctx, k.Jackpot, contribution); err != nil {
+		return nil, err
+	}
+
+	err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&rps.EventJackpotFunded{GameId: gameID, Amount: contribution})
+	if err != nil {
+		return nil, err
+	}
+
+	return pot.Sub(contribution...), nil
+}
+
+// countsForJackpot returns whether winning a game counts toward the jackpot
+// streak: the game must have fed the jackpot and the stakes of both players
+// must reach Params.JackpotMinStake, so the jackpot can't be farmed by playing
+// dust games against a second account.
+func countsForJackpot(params rps.Params, contribution sdk.Coins, stakes []sdk.Coins) bool {
+	if contribution.IsZero() {
+		return false
+	}
+
+	if params.JackpotMinStake.IsZero() {
+		return true
+	}
+
+	for _, stake := range stakes {
+		if !stake.IsAnyGTE(params.JackpotMinStake) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// recordResult updates the win streaks of the players of a game decided by
+// their moves. The loser's streak is reset and the winner's grows if the game
+// counts toward the jackpot, a nil winner means a draw and resets both. A
+// winner reaching Params.JackpotStreak takes the jackpot and starts over.
+func (k Keeper) recordResult(ctx context.Context, params rps.Params, gameID uint64, players [][]byte, winner []byte, counts bool) error {
+	for _, player := range players {
+		stats, err := k.PlayerStats.Get(ctx, player)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		if !bytes.Equal(player, winner) {
+			stats.WinStreak = 0
+			if err := k.PlayerStats.Set(ctx, player, stats); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		stats.Wins++
+		if counts {
+			stats.WinStreak++
+		}
+
+		if counts && params.JackpotStreak != 0 && stats.WinStreak >= params.JackpotStreak {
+			if err := k.payJackpot(ctx, gameID, player, stats.WinStreak); err != nil {
+				return err
+			}
+
+			stats.WinStreak = 0
+		}
+
+		if err := k.PlayerStats.Set(ctx, player, stats); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// payJackpot sends the whole jackpot to the winner and empties it.
+func (k Keeper) payJackpot(ctx context.Context, gameID uint64, winner []byte, streak uint64) error {
+	jackpot, err := balanceOf(ctx, k.Jackpot)
+	if err != nil {
+		return err
+	}
+
+	if jackpot.IsZero() {
+		return nil
+	}
+
+	if err := k.Jackpot.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, rps.ModuleName, winner, jackpot); err != nil {
+		return err
+	}
+
+	winnerAddr, err := k.addressCodec.BytesToString(winner)
+	if err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&rps.EventJackpotWon{
+		GameId: gameID,
+		Winner: winnerAddr,
+		Streak: streak,
+		Amount: jackpot,
+	})
+}
@@ -1,16 +1,22 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	storetypes "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/facundomedica/rps"
 	expectedkeepers "github.com/facundomedica/rps/expected_keepers"
@@ -19,6 +25,7 @@ import (
 type Keeper struct {
 	cdc          codec.BinaryCodec
 	addressCodec address.Codec
+	storeService storetypes.KVStoreService
 
 	// authority is the address capable of executing a MsgUpdateParams and other authority-gated message.
 	// typically, this should be the x/gov module account.
@@ -31,27 +38,73 @@ type Keeper struct {
 	Games       collections.Map[uint64, rps.Game]
 	MoveCommits collections.Map[collections.Pair[uint64, []byte], rps.MoveCommit]
 	MoveReveals collections.Map[collections.Pair[uint64, []byte], rps.MoveReveal]
+	// StuckGames holds the games that couldn't be settled by the EndBlocker,
+	// they are kept apart until the authority resolves them.
+	StuckGames  collections.Map[uint64, rps.Game]
+	PlayerStats collections.Map[[]byte, rps.PlayerStats]
+	// Queue holds the players waiting for an opponent in the matchmaking queue.
+	Queue        *collections.IndexedMap[uint64, rps.QueueEntry, QueueIndexes]
+	QueueEntryID collections.Sequence
+	// Bets holds the spectator bets of the games, by game and bettor.
+	Bets collections.Map[collections.Pair[uint64, []byte], rps.Bet]
+	// Jackpot holds the balance of the progressive jackpot, by denom.
+	Jackpot collections.Map[string, math.Int]
+	// HouseBankroll holds the funds the house can put into new games, by denom.
+	HouseBankroll collections.Map[string, math.Int]
+	// HouseExposure holds the stakes the house has in the games in progress, by
+	// denom.
+	HouseExposure collections.Map[string, math.Int]
+	// HouseGamesToSeed holds the house games waiting for their seed, by the
+	// height they were created at.
+	HouseGamesToSeed collections.KeySet[collections.Pair[int64, uint64]]
+	// SettledGames holds the settled games that can still be rematched, and
+	// SettledGamesByExpiry the same games by the time they expire.
+	SettledGames         collections.Map[uint64, rps.SettledGame]
+	SettledGamesByExpiry collections.KeySet[collections.Pair[time.Time, uint64]]
+	// RevealAgents holds the address allowed to reveal moves on behalf of each
+	// player.
+	RevealAgents collections.Map[[]byte, []byte]
+	// GamesByTimeDeadline and GamesByHeightDeadline hold the open games by the
+	// deadline at which they have to be settled, for time and height timeouts.
+	GamesByTimeDeadline   collections.KeySet[collections.Pair[time.Time, uint64]]
+	GamesByHeightDeadline collections.KeySet[collections.Pair[int64, uint64]]
 
 	// other keepers
-	bankKeeper expectedkeepers.BankKeeper
+	bankKeeper  expectedkeepers.BankKeeper
+	distrKeeper expectedkeepers.DistributionKeeper
 }
 
 // NewKeeper creates a new Keeper instance
-func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService storetypes.KVStoreService, bk expectedkeepers.BankKeeper, authority string) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService storetypes.KVStoreService, bk expectedkeepers.BankKeeper, dk expectedkeepers.DistributionKeeper, authority string) Keeper {
 	if _, err := addressCodec.StringToBytes(authority); err != nil {
 		panic(fmt.Errorf("invalid authority address: %w", err))
 	}
 
 	sb := collections.NewSchemaBuilder(storeService)
 	k := Keeper{
-		cdc:          cdc,
-		addressCodec: addressCodec,
-		authority:    authority,
-		Params:       collections.NewItem(sb, rps.ParamsKey, "params", codec.CollValue[rps.Params](cdc)),
-		GameID:       collections.NewSequence(sb, rps.GameIDKey, "game_id"),
-		Games:        collections.NewMap(sb, rps.GamesKey, "games", collections.Uint64Key, codec.CollValue[rps.Game](cdc)),
-		MoveCommits:  collections.NewMap(sb, rps.MoveCommitKey, "move_commits", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), codec.CollValue[rps.MoveCommit](cdc)),
-		MoveReveals:  collections.NewMap(sb, rps.MoveRevealKey, "move_reveals", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), codec.CollValue[rps.MoveReveal](cdc)),
+		cdc:                   cdc,
+		addressCodec:          addressCodec,
+		storeService:          storeService,
+		authority:             authority,
+		Params:                collections.NewItem(sb, rps.ParamsKey, "params", codec.CollValue[rps.Params](cdc)),
+		GameID:                collections.NewSequence(sb, rps.GameIDKey, "game_id"),
+		Games:                 collections.NewMap(sb, rps.GamesKey, "games", collections.Uint64Key, codec.CollValue[rps.Game](cdc)),
+		MoveCommits:           collections.NewMap(sb, rps.MoveCommitKey, "move_commits", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), codec.CollValue[rps.MoveCommit](cdc)),
+		MoveReveals:           collections.NewMap(sb, rps.MoveRevealKey, "move_reveals", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), codec.CollValue[rps.MoveReveal](cdc)),
+		StuckGames:            collections.NewMap(sb, rps.StuckGamesKey, "stuck_games", collections.Uint64Key, codec.CollValue[rps.Game](cdc)),
+		PlayerStats:           collections.NewMap(sb, rps.PlayerStatsKey, "player_stats", collections.BytesKey, codec.CollValue[rps.PlayerStats](cdc)),
+		Queue:                 collections.NewIndexedMap(sb, rps.QueueKey, "queue", collections.Uint64Key, codec.CollValue[rps.QueueEntry](cdc), newQueueIndexes(sb, addressCodec)),
+		QueueEntryID:          collections.NewSequence(sb, rps.QueueEntryIDKey, "queue_entry_id"),
+		Bets:                  collections.NewMap(sb, rps.BetsKey, "bets", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), codec.CollValue[rps.Bet](cdc)),
+		Jackpot:               collections.NewMap(sb, rps.JackpotKey, "jackpot", collections.StringKey, sdk.IntValue),
+		HouseBankroll:         collections.NewMap(sb, rps.HouseBankrollKey, "house_bankroll", collections.StringKey, sdk.IntValue),
+		HouseExposure:         collections.NewMap(sb, rps.HouseExposureKey, "house_exposure", collections.StringKey, sdk.IntValue),
+		HouseGamesToSeed:      collections.NewKeySet(sb, rps.HouseGamesToSeedKey, "house_games_to_seed", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		SettledGames:          collections.NewMap(sb, rps.SettledGamesKey, "settled_games", collections.Uint64Key, codec.CollValue[rps.SettledGame](cdc)),
+		SettledGamesByExpiry:  collections.NewKeySet(sb, rps.SettledGamesByExpiryKey, "settled_games_by_expiry", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		RevealAgents:          collections.NewMap(sb, rps.RevealAgentsKey, "reveal_agents", collections.BytesKey, collections.BytesValue),
+		GamesByTimeDeadline:   collections.NewKeySet(sb, rps.GamesByTimeDeadlineKey, "games_by_time_deadline", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		GamesByHeightDeadline: collections.NewKeySet(sb, rps.GamesByHeightDeadlineKey, "games_by_height_deadline", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
 	}
 
 	schema, err := sb.Build()
@@ -61,6 +114,7 @@ func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService s
 
 	k.Schema = schema
 	k.bankKeeper = bk
+	k.distrKeeper = dk
 
 	return k
 }
@@ -70,8 +124,10 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// GenesisHandler returns the genesis handler of the module, the collections of
+// the schema with the derived state rebuilt on import.
 func (k Keeper) GenesisHandler() appmodule.HasGenesis {
-	return k.Schema
+	return genesisHandler{Schema: k.Schema, k: k}
 }
 
 func (k Keeper) EndBlocker(ctx context.Context) error {
@@ -81,124 +137,569 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 	- if the reveal timeout has passed, delete the game and pay the only player that revealed
 	*/
 
-	gamesToDelete := []uint64{}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the house games of the previous blocks are seeded before settling, so
+	// their players can reveal from the next block on
+	if err := k.seedHouseGames(ctx); err != nil {
+		return err
+	}
+
+	// collect the due games first, settling them modifies the games and the
+	// deadline index. Games are taken by deadline so the oldest ones are settled
+	// first (see dueGames), once the budget for this block is used the rest are
+	// left for the next blocks.
+	games, err := k.dueGames(ctx, params.MaxSettlementsPerBlock)
+	if err != nil {
+		return err
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	fmt.Println("ACACACA")
-	err := k.Games.Walk(ctx, nil, func(id uint64, game rps.Game) (bool, error) {
-		now := sdkCtx.BlockTime()
-
-		// players that committed
-		playersCommited := [][]byte{}
-		commits := []rps.MoveCommit{}
-		err := k.MoveCommits.Walk(
-			ctx,
-			collections.NewPrefixedPairRange[uint64, []byte](id),
-			func(key collections.Pair[uint64, []byte], commit rps.MoveCommit) (bool, error) {
-				playersCommited = append(playersCommited, key.K2())
-				commits = append(commits, commit)
-				return false, nil
-			},
-		)
+	for _, game := range games {
+		// each game is settled in its own cached context, so a game that fails to
+		// settle doesn't leave partial state behind nor prevents the rest from settling
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.safeSettleGame(cacheCtx, params, game); err != nil {
+			sdkCtx.Logger().Error("failed to settle game, moving it to stuck games", "module", "x/"+rps.ModuleName, "game_id", game.Id, "err", err)
+			if err := k.quarantineGame(ctx, game); err != nil {
+				return err
+			}
+			continue
+		}
+
+		write()
+
+		// a game that isn't over yet stays, it's indexed again by its current
+		// deadline so it doesn't take the budget of the next blocks
+		open, err := k.Games.Has(ctx, game.Id)
 		if err != nil {
-			return false, err
-		}
-		fmt.Println("ACACACA 22222")
-		// if the game has less than 2 players and the commit timeout has passed, delete the game and refund the entry fee
-		if len(playersCommited) < 2 && now.After(game.CommitTimeout) {
-			gamesToDelete = append(gamesToDelete, id)
-			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, rps.ModuleName, playersCommited[0], sdk.NewCoins(game.EntryFee)); err != nil {
-				return false, err
+			return err
+		}
+
+		if open {
+			if err := k.scheduleGame(ctx, game); err != nil {
+				return err
 			}
-			return false, nil
 		}
+	}
 
-		// no player revealed so there's no reveal timeout set yet
-		if game.RevealTimeout.IsZero() {
-			return false, nil
+	return k.pruneSettledGames(ctx, params.MaxSettlementsPerBlock)
+}
+
+// safeSettleGame calls settleGame recovering from any panic, a malformed game
+// (e.g. imported through genesis) must never halt the chain.
+func (k Keeper) safeSettleGame(ctx context.Context, params rps.Params, game rps.Game) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while settling game: %v", r)
 		}
+	}()
+
+	return k.settleGame(ctx, params, game)
+}
+
+// settleGame checks the state of a game and, if it's over, pays the winner (or
+// refunds the players) and deletes it.
+func (k Keeper) settleGame(ctx context.Context, params rps.Params, game rps.Game) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now, height := sdkCtx.BlockTime(), sdkCtx.BlockHeight()
+
+	if err := game.EntryFee.Validate(); err != nil {
+		return fmt.Errorf("invalid entry fee: %w", err)
+	}
+
+	if game.EntryFee.IsZero() {
+		return fmt.Errorf("game %d has no entry fee", game.Id)
+	}
+
+	if err := game.ChallengerFee.Validate(); err != nil {
+		return fmt.Errorf("invalid challenger fee: %w", err)
+	}
+
+	// players that committed
+	playersCommited, err := k.committedPlayers(ctx, game.Id)
+	if err != nil {
+		return err
+	}
 
-		fmt.Println("ACACACA 33333")
-		// now let's check for reveals
-		playersRevealed := [][]byte{}
-		reveals := []rps.MoveReveal{}
-		err = k.MoveReveals.Walk(
-			ctx,
-			collections.NewPrefixedPairRange[uint64, []byte](id),
-			func(key collections.Pair[uint64, []byte], reveal rps.MoveReveal) (bool, error) {
-				playersRevealed = append(playersRevealed, key.K2())
-				reveals = append(reveals, reveal)
-				return false, nil
-			},
-		)
+	// what each player put into the game
+	stakes := make([]sdk.Coins, len(playersCommited))
+	for i, player := range playersCommited {
+		stakes[i], err = k.playerStake(ctx, game, player)
 		if err != nil {
-			return false, err
+			return err
 		}
+	}
 
-		fmt.Println("ACACACA 4444")
+	if len(playersCommited) > 2 {
+		return fmt.Errorf("game has %d players, expected at most 2", len(playersCommited))
+	}
 
-		// revealTimedOut := now.After(game.RevealTimeout)
-		// if the reveal timeout hasn't passed and less than 2 players revealed, let's wait
-		if len(playersRevealed) < 2 && now.Before(game.RevealTimeout) {
-			return false, nil
+	// if the game has less than 2 players and the commit timeout has passed, delete the game and refund the entry fee
+	if len(playersCommited) < 2 {
+		if !game.CommitTimedOut(now, height) {
+			return nil
+		}
+
+		// a game without any commit can only come from genesis, there's nothing to refund
+		for i, player := range playersCommited {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, rps.ModuleName, player, stakes[i]); err != nil {
+				return err
+			}
 		}
 
-		// this game is over, let's delete it
-		gamesToDelete = append(gamesToDelete, id)
+		// the game never started, bets are refunded
+		if err := k.settleBets(ctx, params, game, rps.BetOutcome_BET_OUTCOME_UNSPECIFIED); err != nil {
+			return err
+		}
+
+		if err := k.emitGameSettled(ctx, game.Id, playersCommited, stakes, nil); err != nil {
+			return err
+		}
 
-		// given that 2 players committed, the prize is the entry fee times 2
-		prize := sdk.NewCoins(sdk.NewCoin(game.EntryFee.Denom, game.EntryFee.Amount.MulRaw(2)))
+		return k.removeGame(ctx, game.Id)
+	}
 
-		fmt.Println("ACACACA 5555")
+	// the reveal timeout is set once the game is full, games created before
+	// that only get it on the first reveal
+	if !game.HasRevealTimeout() {
+		return nil
+	}
 
-		// now either 2 players revealed or the reveal timeout has passed
-		// if a single player revealed, they win by default
-		if len(playersRevealed) == 1 {
-			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, rps.ModuleName, playersRevealed[0], prize); err != nil {
-				return false, err
-			}
+	// now let's check for reveals
+	playersRevealed := [][]byte{}
+	reveals := []rps.MoveReveal{}
+	err = k.MoveReveals.Walk(
+		ctx,
+		collections.NewPrefixedPairRange[uint64, []byte](game.Id),
+		func(key collections.Pair[uint64, []byte], reveal rps.MoveReveal) (bool, error) {
+			playersRevealed = append(playersRevealed, key.K2())
+			reveals = append(reveals, reveal)
 			return false, nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	// if the reveal timeout hasn't passed and less than 2 players revealed, let's wait
+	if len(playersRevealed) < 2 && !game.RevealTimedOut(now, height) {
+		return nil
+	}
+
+	// games against the house don't have bets, jackpot nor streaks
+	if game.House {
+		return k.settleHouseGame(ctx, params, game, playersCommited, stakes)
+	}
+
+	// given that 2 players committed, the prize is the sum of both stakes
+	prize := stakes[0].Add(stakes[1]...)
+
+	// the outcome spectators bet on, it's left unspecified if nobody revealed
+	// so bets are refunded
+	betOutcome := rps.BetOutcome_BET_OUTCOME_UNSPECIFIED
+
+	// what each player gets back and who won, for EventGameSettled
+	payouts := make([]sdk.Coins, len(playersCommited))
+	var winners [][]byte
+
+	switch len(playersRevealed) {
+	case 0:
+		// nobody revealed, both players are penalized and get the rest of their stake back
+		for i, player := range playersCommited {
+			refund, err := k.penalizeNoShow(ctx, params, player, stakes[i])
+			if err != nil {
+				return err
+			}
+
+			payouts[i] = refund
+
+			if !refund.IsZero() {
+				if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, rps.ModuleName, player, refund); err != nil {
+					return err
+				}
+			}
+		}
+	case 1:
+		// if a single player revealed, they win by default and take the whole
+		// stake of the other player, who is put on cooldown but not penalized
+		// as their stake is already forfeited
+		for _, player := range playersCommited {
+			if bytes.Equal(player, playersRevealed[0]) {
+				continue
+			}
+
+			if err := k.recordNoShow(ctx, params, player); err != nil {
+				return err
+			}
+		}
+
+		prize, err = k.fundJackpot(ctx, params, game.Id, prize)
+		if err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, rps.ModuleName, playersRevealed[0], prize); err != nil {
+			return err
 		}
 
+		winners = playersRevealed
+		payouts[playerIndex(playersCommited, playersRevealed[0])] = prize
+
+		betOutcome, err = k.playerBetOutcome(game, playersRevealed[0])
+		if err != nil {
+			return err
+		}
+	case 2:
 		// if both players revealed, let's decide the winner (or winners in case of a draw)
-		winners := decideWinner(playersRevealed[0], playersRevealed[1], reveals[0].Move, reveals[1].Move)
+		winners = decideWinner(playersRevealed[0], playersRevealed[1], reveals[0].Move, reveals[1].Move)
 		if len(winners) == 1 {
-			// a single winner takes all
+			// a single winner takes all, minus the slice that goes into the jackpot
+			pot := prize
+			prize, err = k.fundJackpot(ctx, params, game.Id, pot)
+			if err != nil {
+				return err
+			}
+
 			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, rps.ModuleName, winners[0], prize); err != nil {
-				return false, err
+				return err
 			}
-		} else if len(winners) == 2 {
-			// draw, refund both players
-			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, rps.ModuleName, winners[0], sdk.NewCoins(game.EntryFee)); err != nil {
-				return false, err
+
+			payouts[playerIndex(playersCommited, winners[0])] = prize
+
+			counts := countsForJackpot(params, pot.Sub(prize...), stakes)
+			if err := k.recordResult(ctx, params, game.Id, playersRevealed, winners[0], counts); err != nil {
+				return err
 			}
 
-			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, rps.ModuleName, winners[1], sdk.NewCoins(game.EntryFee)); err != nil {
-				return false, err
+			betOutcome, err = k.playerBetOutcome(game, winners[0])
+			if err != nil {
+				return err
 			}
+		} else {
+			// draw, refund each player their own stake
+			for i, player := range playersCommited {
+				if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, rps.ModuleName, player, stakes[i]); err != nil {
+					return err
+				}
+			}
+
+			copy(payouts, stakes)
+
+			if err := k.recordResult(ctx, params, game.Id, playersRevealed, nil, false); err != nil {
+				return err
+			}
+
+			betOutcome = rps.BetOutcome_BET_OUTCOME_DRAW
+		}
+	default:
+		return fmt.Errorf("game has %d reveals, expected at most 2", len(playersRevealed))
+	}
+
+	if err := k.settleBets(ctx, params, game, betOutcome); err != nil {
+		return err
+	}
+
+	if err := k.emitGameSettled(ctx, game.Id, playersCommited, payouts, winners); err != nil {
+		return err
+	}
+
+	// the players can offer each other a rematch for a while
+	if err := k.retainSettledGame(ctx, params, game, playersCommited, stakes); err != nil {
+		return err
+	}
+
+	// this game is over, let's delete it
+	return k.removeGame(ctx, game.Id)
+}
+
+// penalizeNoShow applies the no-show penalty to the stake of a player that
+// didn't reveal their move, sending it to the community pool, and puts the
+// player on cooldown. It returns what's left of the stake.
+func (k Keeper) penalizeNoShow(ctx context.Context, params rps.Params, player []byte, stake sdk.Coins) (sdk.Coins, error) {
+	if err := k.recordNoShow(ctx, params, player); err != nil {
+		return nil, err
+	}
+
+	if params.NoShowPenalty.IsNil() || !params.NoShowPenalty.IsPositive() {
+		return stake, nil
+	}
+
+	// the penalty is applied to each denom of the stake, zero amounts are dropped
+	penalty := sdk.NewCoins()
+	for _, coin := range stake {
+		penalty = penalty.Add(sdk.NewCoin(coin.Denom, params.NoShowPenalty.MulInt(coin.Amount).TruncateInt()))
+	}
+
+	if penalty.IsZero() {
+		return stake, nil
+	}
+
+	if err := k.distrKeeper.FundCommunityPool(ctx, penalty, authtypes.NewModuleAddress(rps.ModuleName)); err != nil {
+		return nil, err
+	}
+
+	return stake.Sub(penalty...), nil
+}
+
+// recordNoShow counts a game the player didn't reveal their move in and puts
+// them on cooldown.
+func (k Keeper) recordNoShow(ctx context.Context, params rps.Params, player []byte) error {
+	stats, err := k.PlayerStats.Get(ctx, player)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	// a no-show also breaks the win streak
+	stats.NoShows++
+	stats.WinStreak = 0
+	if params.NoShowCooldown > 0 {
+		stats.CooldownUntil = sdk.UnwrapSDKContext(ctx).BlockTime().Add(time.Second * time.Duration(params.NoShowCooldown))
+	}
+
+	return k.PlayerStats.Set(ctx, player, stats)
+}
+
+// checkNoShowCooldown returns an error if the player is still on cooldown for
+// not revealing their move in a previous game.
+func (k Keeper) checkNoShowCooldown(ctx context.Context, player []byte) error {
+	stats, err := k.PlayerStats.Get(ctx, player)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
 		}
 
-		return false, nil
+		return err
+	}
+
+	if sdk.UnwrapSDKContext(ctx).BlockTime().Before(stats.CooldownUntil) {
+		return errorsmod.Wrapf(rps.ErrNotAllowed, "player didn't reveal a move in a previous game and can't join new games until %s", stats.CooldownUntil)
+	}
+
+	return nil
+}
+
+// createGame creates and stores a new game with the given entry fee. An empty
+// challenger fee means the challenger matches the entry fee. Zero timeouts use
+// the module's defaults.
+func (k Keeper) createGame(ctx context.Context, creator []byte, entryFee, challengerFee sdk.Coins, commitTimeout, revealTimeout uint64) (rps.Game, error) {
+	creatorAddr, err := k.addressCodec.BytesToString(creator)
+	if err != nil {
+		return rps.Game{}, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return rps.Game{}, err
+	}
+
+	commitDuration, revealDuration, err := params.GameDurations(commitTimeout, revealTimeout)
+	if err != nil {
+		return rps.Game{}, err
+	}
+
+	return k.storeNewGame(ctx, rps.Game{
+		EntryFee:       entryFee,
+		ChallengerFee:  challengerFee,
+		Creator:        creatorAddr,
+		TimeoutMode:    params.TimeoutMode,
+		CommitDuration: commitDuration,
+		RevealDuration: revealDuration,
 	})
+}
+
+// storeNewGame assigns an ID to a new game, sets its commit deadline from its
+// timeout mode and commit duration, and stores it.
+func (k Keeper) storeNewGame(ctx context.Context, game rps.Game) (rps.Game, error) {
+	gid, err := k.GameID.Next(ctx)
+	if err != nil {
+		return rps.Game{}, err
+	}
+
+	game.Id = gid
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if game.UsesHeightTimeouts() {
+		game.CommitTimeoutHeight = sdkCtx.BlockHeight() + int64(game.CommitDuration)
+	} else {
+		game.CommitTimeout = sdkCtx.BlockTime().Add(time.Second * time.Duration(game.CommitDuration))
+	}
+
+	if err := k.Games.Set(ctx, gid, game); err != nil {
+		return rps.Game{}, err
+	}
+
+	if err := k.scheduleGame(ctx, game); err != nil {
+		return rps.Game{}, err
+	}
+
+	return game, nil
+}
+
+// startRevealWindow sets the reveal timeout of a game, counting from the
+// current block, and returns the updated game.
+func (k Keeper) startRevealWindow(ctx context.Context, game rps.Game) (rps.Game, error) {
+	// games created before the reveal duration was stored use the module's timeout
+	revealDuration := game.RevealDuration
+	if revealDuration == 0 {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return rps.Game{}, err
+		}
+
+		revealDuration = params.RevealTimeout
+		if game.UsesHeightTimeouts() {
+			revealDuration = params.RevealTimeoutBlocks
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if game.UsesHeightTimeouts() {
+		game.RevealTimeoutHeight = sdkCtx.BlockHeight() + int64(revealDuration)
+	} else {
+		game.RevealTimeout = sdkCtx.BlockTime().Add(time.Second * time.Duration(revealDuration))
+	}
+
+	if err := k.Games.Set(ctx, game.Id, game); err != nil {
+		return rps.Game{}, err
+	}
+
+	if err := k.scheduleGame(ctx, game); err != nil {
+		return rps.Game{}, err
+	}
+
+	return game, nil
+}
+
+// committedPlayers returns the addresses of the players that committed a move to the game.
+func (k Keeper) committedPlayers(ctx context.Context, gameID uint64) ([][]byte, error) {
+	players := [][]byte{}
+	err := k.MoveCommits.Walk(
+		ctx,
+		collections.NewPrefixedPairRange[uint64, []byte](gameID),
+		func(key collections.Pair[uint64, []byte], _ rps.MoveCommit) (bool, error) {
+			players = append(players, key.K2())
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return players, nil
+}
+
+// playerStake returns the stake a player put into a game.
+func (k Keeper) playerStake(ctx context.Context, game rps.Game, player []byte) (sdk.Coins, error) {
+	commit, err := k.MoveCommits.Get(ctx, collections.Join(game.Id, player))
 	if err != nil {
+		return nil, err
+	}
+
+	stake := game.PlayerStake(commit)
+	if err := stake.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid stake: %w", err)
+	}
+
+	return stake, nil
+}
+
+// removeGame deletes a game along with its commits, reveals, bets and its
+// entry in the deadline index.
+func (k Keeper) removeGame(ctx context.Context, gameID uint64) error {
+	game, err := k.Games.Get(ctx, gameID)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
 		return err
 	}
 
-	// delete completed games
-	for _, id := range gamesToDelete {
-		if err := k.Games.Remove(ctx, id); err != nil {
+	if err == nil {
+		game.Id = gameID
+		if err := k.unscheduleGame(ctx, game); err != nil {
 			return err
 		}
 	}
 
-	return nil
+	if err := k.Games.Remove(ctx, gameID); err != nil {
+		return err
+	}
+
+	if err := k.MoveCommits.Clear(ctx, collections.NewPrefixedPairRange[uint64, []byte](gameID)); err != nil {
+		return err
+	}
+
+	if err := k.MoveReveals.Clear(ctx, collections.NewPrefixedPairRange[uint64, []byte](gameID)); err != nil {
+		return err
+	}
+
+	return k.Bets.Clear(ctx, collections.NewPrefixedPairRange[uint64, []byte](gameID))
+}
+
+// quarantineGame moves a game to the stuck games, so it's no longer processed
+// by the EndBlocker. Its commits and reveals are kept so the authority can
+// resolve it later on.
+func (k Keeper) quarantineGame(ctx context.Context, game rps.Game) error {
+	if err := k.StuckGames.Set(ctx, game.Id, game); err != nil {
+		return err
+	}
+
+	if err := k.unscheduleGame(ctx, game); err != nil {
+		return err
+	}
+
+	return k.Games.Remove(ctx, game.Id)
+}
+
+// emitGameSettled emits EventGameSettled for a game that is over, payouts are
+// what each of the players got back, in the same order.
+func (k Keeper) emitGameSettled(ctx context.Context, gameID uint64, players [][]byte, payouts []sdk.Coins, winners [][]byte) error {
+	event := &rps.EventGameSettled{GameId: gameID, Results: []rps.PlayerResult{}}
+	for _, winner := range winners {
+		addr, err := k.addressCodec.BytesToString(winner)
+		if err != nil {
+			return err
+		}
+
+		event.Winners = append(event.Winners, addr)
+	}
+
+	for i, player := range players {
+		addr, err := k.addressCodec.BytesToString(player)
+		if err != nil {
+			return err
+		}
+
+		// the reveals are still there, they're deleted along with the game
+		reveal, err := k.MoveReveals.Get(ctx, collections.Join(gameID, player))
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		event.Results = append(event.Results, rps.PlayerResult{Player: addr, Move: reveal.Move, Payout: payouts[i]})
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event)
+}
+
+// playerIndex returns the index of a player in players, or -1 if it's not there.
+func playerIndex(players [][]byte, player []byte) int {
+	for i, p := range players {
+		if bytes.Equal(p, player) {
+			return i
+		}
+	}
+
+	return -1
 }
 
-func decideWinner(p1, p2 []byte, player1Move, player2Move string) [][]byte {
+// decideWinner returns the winner of a game between two players, or both of
+// them in a draw.
+func decideWinner(p1, p2 []byte, player1Move, player2Move rps.Move) [][]byte {
 	if player1Move == player2Move {
 		return [][]byte{p1, p2} // draw
-	} else if (player1Move == "rock" && player2Move == "scissors") ||
-		(player1Move == "scissors" && player2Move == "paper") ||
-		(player1Move == "paper" && player2Move == "rock") {
+	} else if player1Move.Beats(player2Move) {
 		return [][]byte{p1}
 	}
 
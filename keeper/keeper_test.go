@@ -1,10 +1,17 @@
 package keeper_test
 
 import (
+	"context"
+	"fmt"
 	"testing"
+	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/genesis"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/stretchr/testify/require"
 
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
@@ -13,18 +20,23 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/facundomedica/rps"
 	"github.com/facundomedica/rps/keeper"
+	"github.com/facundomedica/rps/utils"
 )
 
 type testFixture struct {
-	ctx         sdk.Context
-	k           keeper.Keeper
-	msgServer   rps.MsgServer
-	queryServer rps.QueryServer
+	ctx          sdk.Context
+	storeService store.KVStoreService
+	k            keeper.Keeper
+	msgServer    rps.MsgServer
+	queryServer  rps.QueryServer
 
-	addrs []sdk.AccAddress
+	bankKeeper *mockBankKeeper
+	addrs      []sdk.AccAddress
 }
 
 func initFixture(t *testing.T) *testFixture {
@@ -34,19 +46,952 @@ func initFixture(t *testing.T) *testFixture {
 	storeService := runtime.NewKVStoreService(key)
 	addrs := simtestutil.CreateIncrementalAccounts(3)
 
-	k := keeper.NewKeeper(encCfg.Codec, addresscodec.NewBech32Codec("cosmos"), storeService, nil, addrs[0].String())
+	bk := newMockBankKeeper()
+	for _, addr := range addrs {
+		bk.balances[addr.String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	}
+
+	k := keeper.NewKeeper(encCfg.Codec, addresscodec.NewBech32Codec("cosmos"), storeService, bk, mockDistributionKeeper{bk}, addrs[0].String())
 
-	source, err := genesis.SourceFromRawJSON([]byte(`{"game_id":[],"games":[],"move_commits":[],"move_reveals":[],"params":[{"key":"item","value":{"commit_timeout":"60","reveal_timeout":"60"}}]}`))
+	source, err := genesis.SourceFromRawJSON([]byte(`{"bets":[],"game_id":[],"games":[],"games_by_height_deadline":[],"games_by_time_deadline":[],"house_bankroll":[],"house_exposure":[],"house_games_to_seed":[],"jackpot":[],"move_commits":[],"move_reveals":[],"params":[{"key":"item","value":{"commit_timeout":"60","reveal_timeout":"60"}}],"player_stats":[],"queue":[],"queue_by_player":[],"queue_by_stake":[],"queue_entry_id":[],"reveal_agents":[],"settled_games":[],"settled_games_by_expiry":[],"stuck_games":[]}`))
 	require.NoError(t, err)
 
-	err = k.Schema.InitGenesis(testCtx.Ctx, source)
+	err = k.GenesisHandler().InitGenesis(testCtx.Ctx, source)
 	require.NoError(t, err)
 
 	return &testFixture{
-		ctx:         testCtx.Ctx,
-		k:           k,
-		msgServer:   keeper.NewMsgServerImpl(k),
-		queryServer: keeper.NewQueryServerImpl(k),
-		addrs:       addrs,
+		ctx:          testCtx.Ctx,
+		storeService: storeService,
+		k:            k,
+		msgServer:    keeper.NewMsgServerImpl(k),
+		queryServer:  keeper.NewQueryServerImpl(k),
+		bankKeeper:   bk,
+		addrs:        addrs,
+	}
+}
+
+const communityPool = "community_pool"
+
+// salts of the players in the tests, rps.MinSaltLength bytes long.
+var (
+	salt0 = []byte("salt of player 0")
+	salt1 = []byte("salt of player 1")
+	salt2 = []byte("salt of player 2")
+)
+
+// mockBankKeeper is an in-memory bank keeper that keeps track of the balances
+// of accounts and modules, indexed by address.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{balances: map[string]sdk.Coins{}}
+}
+
+func (bk *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return bk.send(senderAddr.String(), authtypes.NewModuleAddress(recipientModule).String(), amt)
+}
+
+func (bk *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return bk.send(authtypes.NewModuleAddress(senderModule).String(), recipientAddr.String(), amt)
+}
+
+// mockDistributionKeeper funds and spends the community pool using the balances of the mockBankKeeper.
+type mockDistributionKeeper struct {
+	bk *mockBankKeeper
+}
+
+func (dk mockDistributionKeeper) FundCommunityPool(_ context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return dk.bk.send(sender.String(), communityPool, amount)
+}
+
+func (dk mockDistributionKeeper) DistributeFromFeePool(_ context.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error {
+	return dk.bk.send(communityPool, receiveAddr.String(), amount)
+}
+
+func (bk *mockBankKeeper) send(from, to string, amt sdk.Coins) error {
+	balance, hasNeg := bk.balances[from].SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient funds: %s < %s", bk.balances[from], amt)
 	}
+
+	bk.balances[from] = balance
+	bk.balances[to] = bk.balances[to].Add(amt...)
+	return nil
+}
+
+func TestEndBlockerMalformedGames(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	// the malformed games are imported through genesis, without entries in
+	// the deadline index: a game without commits and a game with a malformed
+	// entry fee
+	exported := initFixture(t)
+	require.NoError(exported.k.Games.Set(exported.ctx, 100, rps.Game{Id: 100, EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}))
+	require.NoError(exported.k.Games.Set(exported.ctx, 101, rps.Game{Id: 101}))
+	require.NoError(exported.k.MoveCommits.Set(exported.ctx, collections.Join(uint64(101), f.addrs[2].Bytes()), rps.MoveCommit{}))
+
+	target := &genesis.RawJSONTarget{}
+	require.NoError(exported.k.Schema.ExportGenesis(exported.ctx, target.Target()))
+	genesisJSON, err := target.JSON()
+	require.NoError(err)
+	require.Contains(string(genesisJSON), `"games_by_time_deadline": []`)
+
+	source, err := genesis.SourceFromRawJSON(genesisJSON)
+	require.NoError(err)
+	require.NoError(f.k.GenesisHandler().InitGenesis(ctx, source))
+
+	// a valid game that already timed out waiting for an opponent
+	res, err := f.msgServer.NewGame(ctx, &rps.MsgNewGame{
+		Player:   f.addrs[1].String(),
+		Commit:   utils.CalculateCommitment("rock", salt0),
+		EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+	require.NoError(err)
+
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	require.NoError(f.k.EndBlocker(ctx))
+
+	// the valid game was refunded
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), f.bankKeeper.balances[f.addrs[1].String()])
+	has, err := f.k.Games.Has(ctx, res.GameId)
+	require.NoError(err)
+	require.False(has)
+
+	// the game without commits was removed
+	has, err = f.k.Games.Has(ctx, 100)
+	require.NoError(err)
+	require.False(has)
+
+	// the malformed game was quarantined
+	has, err = f.k.Games.Has(ctx, 101)
+	require.NoError(err)
+	require.False(has)
+
+	stuck, err := f.queryServer.StuckGames(ctx, &rps.QueryStuckGamesRequest{})
+	require.NoError(err)
+	require.Len(stuck.Games, 1)
+	require.Equal(uint64(101), stuck.Games[0].Id)
+}
+
+func TestEndBlockerSettlement(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	res, err := f.msgServer.NewGame(ctx, &rps.MsgNewGame{
+		Player:   f.addrs[1].String(),
+		Commit:   utils.CalculateCommitment("rock", salt1),
+		EntryFee: fee,
+	})
+	require.NoError(err)
+
+	_, err = f.msgServer.CommitMove(ctx, &rps.MsgCommitMove{
+		Player: f.addrs[2].String(),
+		GameId: res.GameId,
+		Commit: utils.CalculateCommitment("scissors", salt2),
+	})
+	require.NoError(err)
+
+	_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[1].String(), GameId: res.GameId, Move: rps.Move_MOVE_ROCK, Salt: salt1})
+	require.NoError(err)
+	_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[2].String(), GameId: res.GameId, Move: rps.Move_MOVE_SCISSORS, Salt: salt2})
+	require.NoError(err)
+
+	require.NoError(f.k.EndBlocker(ctx))
+
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1010)), f.bankKeeper.balances[f.addrs[1].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 990)), f.bankKeeper.balances[f.addrs[2].String()])
+
+	// the game, its commits and reveals are gone
+	has, err := f.k.Games.Has(ctx, res.GameId)
+	require.NoError(err)
+	require.False(has)
+
+	has, err = f.k.MoveCommits.Has(ctx, collections.Join(res.GameId, f.addrs[1].Bytes()))
+	require.NoError(err)
+	require.False(has)
+
+	has, err = f.k.MoveReveals.Has(ctx, collections.Join(res.GameId, f.addrs[1].Bytes()))
+	require.NoError(err)
+	require.False(has)
+}
+
+func TestEndBlockerSettlementBudget(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	require.NoError(f.k.Params.Set(f.ctx, rps.Params{CommitTimeout: 60, RevealTimeout: 60, MaxSettlementsPerBlock: 1}))
+
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0))
+	ids := []uint64{}
+	for _, addr := range f.addrs[1:] {
+		res, err := f.msgServer.NewGame(ctx, &rps.MsgNewGame{
+			Player:   addr.String(),
+			Commit:   utils.CalculateCommitment("rock", salt0),
+			EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		})
+		require.NoError(err)
+		ids = append(ids, res.GameId)
+	}
+
+	backlog, err := f.queryServer.SettlementBacklog(ctx, &rps.QuerySettlementBacklogRequest{})
+	require.NoError(err)
+	require.Equal(uint64(0), backlog.Count)
+
+	// both games time out, only the oldest one is settled in this block
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	backlog, err = f.queryServer.SettlementBacklog(ctx, &rps.QuerySettlementBacklogRequest{})
+	require.NoError(err)
+	require.Equal(uint64(2), backlog.Count)
+
+	require.NoError(f.k.EndBlocker(ctx))
+
+	has, err := f.k.Games.Has(ctx, ids[0])
+	require.NoError(err)
+	require.False(has)
+
+	has, err = f.k.Games.Has(ctx, ids[1])
+	require.NoError(err)
+	require.True(has)
+
+	backlog, err = f.queryServer.SettlementBacklog(ctx, &rps.QuerySettlementBacklogRequest{})
+	require.NoError(err)
+	require.Equal(uint64(1), backlog.Count)
+
+	// the remaining game is settled in the next block
+	require.NoError(f.k.EndBlocker(ctx))
+
+	backlog, err = f.queryServer.SettlementBacklog(ctx, &rps.QuerySettlementBacklogRequest{})
+	require.NoError(err)
+	require.Equal(uint64(0), backlog.Count)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), f.bankKeeper.balances[f.addrs[2].String()])
+
+	// the backlog is only counted up to a bound
+	for id := uint64(100); id < 1101; id++ {
+		require.NoError(f.k.GamesByTimeDeadline.Set(ctx, collections.Join(time.Unix(1500, 0), id)))
+	}
+
+	backlog, err = f.queryServer.SettlementBacklog(ctx, &rps.QuerySettlementBacklogRequest{})
+	require.NoError(err)
+	require.Equal(&rps.QuerySettlementBacklogResponse{Count: 1000, Truncated: true}, backlog)
+}
+
+func TestEndBlockerDeadlineOrder(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	require.NoError(f.k.Params.Set(f.ctx, rps.Params{CommitTimeout: 60, RevealTimeout: 60, MaxSettlementsPerBlock: 1}))
+
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	// the oldest game waits for an opponent until its commit deadline
+	waiting, err := f.msgServer.NewGame(ctx, &rps.MsgNewGame{
+		Player:   f.addrs[1].String(),
+		Commit:   utils.CalculateCommitment("rock", salt1),
+		EntryFee: fee,
+	})
+	require.NoError(err)
+
+	// a newer game where both moves are revealed
+	ctx = ctx.WithBlockTime(time.Unix(1030, 0))
+	revealed, err := f.msgServer.NewGame(ctx, &rps.MsgNewGame{
+		Player:   f.addrs[2].String(),
+		Commit:   utils.CalculateCommitment("scissors", salt2),
+		EntryFee: fee,
+	})
+	require.NoError(err)
+
+	_, err = f.msgServer.CommitMove(ctx, &rps.MsgCommitMove{Player: f.addrs[0].String(), GameId: revealed.GameId, Commit: utils.CalculateCommitment("paper", salt0)})
+	require.NoError(err)
+
+	has, err := f.k.GamesByTimeDeadline.Has(ctx, collections.Join(time.Unix(1090, 0).UTC(), revealed.GameId))
+	require.NoError(err)
+	require.True(has, "full games are indexed by their reveal deadline")
+
+	_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[2].String(), GameId: revealed.GameId, Move: rps.Move_MOVE_SCISSORS, Salt: salt2})
+	require.NoError(err)
+	_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[0].String(), GameId: revealed.GameId, Move: rps.Move_MOVE_PAPER, Salt: salt0})
+	require.NoError(err)
+
+	// both games are due, the revealed one comes first
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	require.NoError(f.k.EndBlocker(ctx))
+
+	has, err = f.k.Games.Has(ctx, revealed.GameId)
+	require.NoError(err)
+	require.False(has)
+
+	has, err = f.k.Games.Has(ctx, waiting.GameId)
+	require.NoError(err)
+	require.True(has)
+
+	// the game is still indexed by its commit deadline for the next block
+	has, err = f.k.GamesByTimeDeadline.Has(ctx, collections.Join(time.Unix(1060, 0).UTC(), waiting.GameId))
+	require.NoError(err)
+	require.True(has)
+
+	require.NoError(f.k.EndBlocker(ctx))
+
+	has, err = f.k.Games.Has(ctx, waiting.GameId)
+	require.NoError(err)
+	require.False(has)
+
+	// settled games leave the index
+	iter, err := f.k.GamesByTimeDeadline.Iterate(ctx, nil)
+	require.NoError(err)
+	keys, err := iter.Keys()
+	require.NoError(err)
+	require.Empty(keys)
+}
+
+func TestEndBlockerDeadlineOrderAcrossTimeoutModes(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	params := rps.Params{CommitTimeout: 60, RevealTimeout: 60, CommitTimeoutBlocks: 5, RevealTimeoutBlocks: 5, MaxSettlementsPerBlock: 2}
+	require.NoError(f.k.Params.Set(f.ctx, params))
+
+	ctx := f.ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	newGame := func(player sdk.AccAddress) uint64 {
+		res, err := f.msgServer.NewGame(ctx, &rps.MsgNewGame{
+			Player:   player.String(),
+			Commit:   utils.CalculateCommitment("rock", salt0),
+			EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		})
+		require.NoError(err)
+		return res.GameId
+	}
+
+	// a game with a time deadline, then two with height deadlines
+	timeGame := newGame(f.addrs[0])
+	params.TimeoutMode = rps.TimeoutMode_TIMEOUT_MODE_HEIGHT
+	require.NoError(f.k.Params.Set(ctx, params))
+	heightGames := []uint64{newGame(f.addrs[1]), newGame(f.addrs[2])}
+
+	// the indexes take turns, the time game isn't left behind the height games
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(time.Unix(2000, 0))
+	require.NoError(f.k.EndBlocker(ctx))
+
+	for id, open := range map[uint64]bool{timeGame: false, heightGames[0]: false, heightGames[1]: true} {
+		has, err := f.k.Games.Has(ctx, id)
+		require.NoError(err)
+		require.Equal(open, has, "game %d", id)
+	}
+}
+
+func TestEndBlockerHeightTimeouts(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	require.NoError(f.k.Params.Set(f.ctx, rps.Params{
+		TimeoutMode:         rps.TimeoutMode_TIMEOUT_MODE_HEIGHT,
+		CommitTimeoutBlocks: 5,
+		RevealTimeoutBlocks: 5,
+	}))
+
+	ctx := f.ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	res, err := f.msgServer.NewGame(ctx, &rps.MsgNewGame{
+		Player:   f.addrs[1].String(),
+		Commit:   utils.CalculateCommitment("rock", salt0),
+		EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+	require.NoError(err)
+
+	game, err := f.k.Games.Get(ctx, res.GameId)
+	require.NoError(err)
+	require.Equal(int64(15), game.CommitTimeoutHeight)
+	require.True(game.CommitTimeout.IsZero())
+
+	// a big jump in block time (e.g. after a chain halt) doesn't time out the game
+	ctx = ctx.WithBlockHeight(15).WithBlockTime(time.Unix(1000000, 0))
+	require.NoError(f.k.EndBlocker(ctx))
+
+	has, err := f.k.Games.Has(ctx, res.GameId)
+	require.NoError(err)
+	require.True(has)
+
+	ctx = ctx.WithBlockHeight(16)
+	_, err = f.msgServer.CommitMove(ctx, &rps.MsgCommitMove{
+		Player: f.addrs[2].String(),
+		GameId: res.GameId,
+		Commit: utils.CalculateCommitment("paper", salt0),
+	})
+	require.ErrorContains(err, "commit timeout has passed")
+
+	require.NoError(f.k.EndBlocker(ctx))
+
+	has, err = f.k.Games.Has(ctx, res.GameId)
+	require.NoError(err)
+	require.False(has)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), f.bankKeeper.balances[f.addrs[1].String()])
+}
+
+func TestEndBlockerNoShows(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	require.NoError(f.k.Params.Set(f.ctx, rps.Params{
+		CommitTimeout:  60,
+		RevealTimeout:  60,
+		NoShowPenalty:  math.LegacyNewDecWithPrec(1, 1),
+		NoShowCooldown: 3600,
+	}))
+
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0))
+	newFullGame := func() uint64 {
+		res, err := f.msgServer.NewGame(ctx, &rps.MsgNewGame{
+			Player:   f.addrs[1].String(),
+			Commit:   utils.CalculateCommitment("rock", salt1),
+			EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		})
+		require.NoError(err)
+
+		_, err = f.msgServer.CommitMove(ctx, &rps.MsgCommitMove{
+			Player: f.addrs[2].String(),
+			GameId: res.GameId,
+			Commit: utils.CalculateCommitment("paper", salt2),
+		})
+		require.NoError(err)
+		return res.GameId
+	}
+
+	// nobody reveals, both players are penalized
+	newFullGame()
+	ctx = ctx.WithBlockTime(time.Unix(1061, 0))
+	require.NoError(f.k.EndBlocker(ctx))
+
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 990)), f.bankKeeper.balances[f.addrs[1].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 990)), f.bankKeeper.balances[f.addrs[2].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), f.bankKeeper.balances[communityPool])
+
+	stats, err := f.queryServer.PlayerStats(ctx, &rps.QueryPlayerStatsRequest{Address: f.addrs[1].String()})
+	require.NoError(err)
+	require.Equal(uint64(1), stats.Stats.NoShows)
+
+	// both players are on cooldown
+	_, err = f.msgServer.NewGame(ctx, &rps.MsgNewGame{
+		Player:   f.addrs[1].String(),
+		Commit:   utils.CalculateCommitment("rock", salt1),
+		EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	})
+	require.ErrorContains(err, "can't join new games")
+
+	// once the cooldown is over, only the second player reveals and takes the
+	// whole stake of the first one, which isn't penalized
+	ctx = ctx.WithBlockTime(time.Unix(1061+3600, 0))
+	gameID := newFullGame()
+	_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[2].String(), GameId: gameID, Move: rps.Move_MOVE_PAPER, Salt: salt2})
+	require.NoError(err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(61 * time.Second))
+	require.NoError(f.k.EndBlocker(ctx))
+
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 890)), f.bankKeeper.balances[f.addrs[1].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1090)), f.bankKeeper.balances[f.addrs[2].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), f.bankKeeper.balances[communityPool])
+
+	stats, err = f.queryServer.PlayerStats(ctx, &rps.QueryPlayerStatsRequest{Address: f.addrs[1].String()})
+	require.NoError(err)
+	require.Equal(uint64(2), stats.Stats.NoShows)
+
+	stats, err = f.queryServer.PlayerStats(ctx, &rps.QueryPlayerStatsRequest{Address: f.addrs[2].String()})
+	require.NoError(err)
+	require.Equal(uint64(1), stats.Stats.NoShows)
+
+	// the last settlement reports the revealer as the winner
+	var settled *rps.EventGameSettled
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "facundomedica.rps.v1.EventGameSettled" {
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(err)
+			settled = msg.(*rps.EventGameSettled)
+		}
+	}
+
+	require.NotNil(settled)
+	require.Equal(gameID, settled.GameId)
+	require.Equal([]string{f.addrs[2].String()}, settled.Winners)
+	require.ElementsMatch([]rps.PlayerResult{
+		{Player: f.addrs[1].String(), Payout: sdk.Coins{}},
+		{Player: f.addrs[2].String(), Move: rps.Move_MOVE_PAPER, Payout: sdk.NewCoins(sdk.NewInt64Coin("stake", 200))},
+	}, settled.Results)
+}
+
+func TestEndBlockerMultiCoinSettlement(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	require.NoError(f.k.Params.Set(f.ctx, rps.Params{
+		CommitTimeout: 60,
+		RevealTimeout: 60,
+		NoShowPenalty: math.LegacyNewDecWithPrec(5, 1),
+	}))
+
+	for _, addr := range f.addrs {
+		f.bankKeeper.balances[addr.String()] = sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 1000))
+	}
+
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 10))
+
+	res, err := f.msgServer.NewGame(ctx, &rps.MsgNewGame{
+		Player:   f.addrs[1].String(),
+		Commit:   utils.CalculateCommitment("rock", salt1),
+		EntryFee: fee,
+	})
+	require.NoError(err)
+
+	_, err = f.msgServer.CommitMove(ctx, &rps.MsgCommitMove{
+		Player: f.addrs[2].String(),
+		GameId: res.GameId,
+		Commit: utils.CalculateCommitment("paper", salt2),
+	})
+	require.NoError(err)
+
+	// nobody reveals
+	require.NoError(f.k.EndBlocker(ctx.WithBlockTime(time.Unix(1200, 0))))
+
+	// the penalty is computed per denom: 2atom (truncated) and 5stake for each player
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 4), sdk.NewInt64Coin("stake", 10)), f.bankKeeper.balances[communityPool])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 998), sdk.NewInt64Coin("stake", 995)), f.bankKeeper.balances[f.addrs[1].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 998), sdk.NewInt64Coin("stake", 995)), f.bankKeeper.balances[f.addrs[2].String()])
+	require.True(f.bankKeeper.balances[authtypes.NewModuleAddress(rps.ModuleName).String()].IsZero())
+}
+
+func TestEndBlockerOdds(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	// the creator stakes 30 against 10
+	newGame := func(creatorMove, challengerMove rps.Move) uint64 {
+		res, err := f.msgServer.NewGame(ctx, &rps.MsgNewGame{
+			Player:        f.addrs[1].String(),
+			Commit:        utils.CalculateCommitment(creatorMove.Name(), salt1),
+			EntryFee:      sdk.NewCoins(sdk.NewInt64Coin("stake", 30)),
+			ChallengerFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		})
+		require.NoError(err)
+
+		_, err = f.msgServer.CommitMove(ctx, &rps.MsgCommitMove{
+			Player: f.addrs[2].String(),
+			GameId: res.GameId,
+			Commit: utils.CalculateCommitment(challengerMove.Name(), salt2),
+		})
+		require.NoError(err)
+
+		commit, err := f.k.MoveCommits.Get(ctx, collections.Join(res.GameId, f.addrs[2].Bytes()))
+		require.NoError(err)
+		require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), commit.Stake)
+
+		_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[1].String(), GameId: res.GameId, Move: creatorMove, Salt: salt1})
+		require.NoError(err)
+		_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[2].String(), GameId: res.GameId, Move: challengerMove, Salt: salt2})
+		require.NoError(err)
+
+		return res.GameId
+	}
+
+	// the challenger wins the whole pot
+	newGame(rps.Move_MOVE_ROCK, rps.Move_MOVE_PAPER)
+	require.NoError(f.k.EndBlocker(ctx))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 970)), f.bankKeeper.balances[f.addrs[1].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1030)), f.bankKeeper.balances[f.addrs[2].String()])
+
+	// a draw refunds each side's own stake
+	newGame(rps.Move_MOVE_ROCK, rps.Move_MOVE_ROCK)
+	require.NoError(f.k.EndBlocker(ctx))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 970)), f.bankKeeper.balances[f.addrs[1].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1030)), f.bankKeeper.balances[f.addrs[2].String()])
+
+	// the creator wins the whole pot
+	newGame(rps.Move_MOVE_SCISSORS, rps.Move_MOVE_PAPER)
+	require.NoError(f.k.EndBlocker(ctx))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 980)), f.bankKeeper.balances[f.addrs[1].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1020)), f.bankKeeper.balances[f.addrs[2].String()])
+	require.True(f.bankKeeper.balances[authtypes.NewModuleAddress(rps.ModuleName).String()].IsZero())
+}
+
+func TestEndBlockerSpectatorBets(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	require.NoError(f.k.Params.Set(f.ctx, rps.Params{CommitTimeout: 60, RevealTimeout: 60, BettingFee: math.LegacyNewDecWithPrec(1, 1)}))
+
+	bettors := simtestutil.CreateIncrementalAccounts(7)[3:]
+	for _, addr := range bettors {
+		f.bankKeeper.balances[addr.String()] = sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 1000))
+	}
+
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0))
+	res, err := f.msgServer.NewGame(ctx, &rps.MsgNewGame{
+		Player:   f.addrs[1].String(),
+		Commit:   utils.CalculateCommitment("rock", salt1),
+		EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+	require.NoError(err)
+
+	for i, bet := range []struct {
+		outcome rps.BetOutcome
+		amount  sdk.Coin
+	}{
+		{rps.BetOutcome_BET_OUTCOME_CREATOR, sdk.NewInt64Coin("stake", 100)},
+		{rps.BetOutcome_BET_OUTCOME_CREATOR, sdk.NewInt64Coin("stake", 300)},
+		{rps.BetOutcome_BET_OUTCOME_CHALLENGER, sdk.NewInt64Coin("stake", 200)},
+		{rps.BetOutcome_BET_OUTCOME_DRAW, sdk.NewInt64Coin("atom", 50)},
+	} {
+		_, err = f.msgServer.PlaceBet(ctx, &rps.MsgPlaceBet{
+			Bettor:  bettors[i].String(),
+			GameId:  res.GameId,
+			Outcome: bet.outcome,
+			Amount:  sdk.NewCoins(bet.amount),
+		})
+		require.NoError(err)
+	}
+
+	pools, err := f.queryServer.BetPools(ctx, &rps.QueryBetPoolsRequest{GameId: res.GameId})
+	require.NoError(err)
+	require.Len(pools.Pools, 3)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 400)), pools.Pools[0].Amount)
+	require.Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("1.35"))), pools.Pools[0].Odds)
+	require.Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("2.7"))), pools.Pools[1].Odds)
+	require.Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("0.9"))), pools.Pools[2].Odds)
+
+	_, err = f.msgServer.CommitMove(ctx, &rps.MsgCommitMove{
+		Player: f.addrs[2].String(),
+		GameId: res.GameId,
+		Commit: utils.CalculateCommitment("scissors", salt2),
+	})
+	require.NoError(err)
+
+	_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[1].String(), GameId: res.GameId, Move: rps.Move_MOVE_ROCK, Salt: salt1})
+	require.NoError(err)
+	_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[2].String(), GameId: res.GameId, Move: rps.Move_MOVE_SCISSORS, Salt: salt2})
+	require.NoError(err)
+
+	require.NoError(f.k.EndBlocker(ctx))
+
+	// the creator won: 600stake minus the 10% fee is shared by the bets on the
+	// creator, nobody bet on the draw with atom so those bets are refunded
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 1035)), f.bankKeeper.balances[bettors[0].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 1105)), f.bankKeeper.balances[bettors[1].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 800)), f.bankKeeper.balances[bettors[2].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 1000)), f.bankKeeper.balances[bettors[3].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), f.bankKeeper.balances[communityPool])
+	require.True(f.bankKeeper.balances[authtypes.NewModuleAddress(rps.ModuleName).String()].IsZero())
+
+	has, err := f.k.Bets.Has(ctx, collections.Join(res.GameId, bettors[0].Bytes()))
+	require.NoError(err)
+	require.False(has)
+
+	// bets on a game that never started are refunded
+	res, err = f.msgServer.NewGame(ctx, &rps.MsgNewGame{
+		Player:   f.addrs[1].String(),
+		Commit:   utils.CalculateCommitment("rock", salt1),
+		EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+	require.NoError(err)
+
+	_, err = f.msgServer.PlaceBet(ctx, &rps.MsgPlaceBet{
+		Bettor:  bettors[2].String(),
+		GameId:  res.GameId,
+		Outcome: rps.BetOutcome_BET_OUTCOME_CHALLENGER,
+		Amount:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	})
+	require.NoError(err)
+
+	require.NoError(f.k.EndBlocker(ctx.WithBlockTime(time.Unix(1100, 0))))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 800)), f.bankKeeper.balances[bettors[2].String()])
+}
+
+func TestEndBlockerJackpot(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	require.NoError(f.k.Params.Set(f.ctx, rps.Params{
+		CommitTimeout: 60,
+		RevealTimeout: 60,
+		JackpotFee:    math.LegacyNewDecWithPrec(1, 1),
+		JackpotStreak: 2,
+	}))
+
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0)).WithEventManager(sdk.NewEventManager())
+	play := func(move1, move2 rps.Move) {
+		res, err := f.msgServer.NewGame(ctx, &rps.MsgNewGame{
+			Player:   f.addrs[1].String(),
+			Commit:   utils.CalculateCommitment(move1.Name(), salt1),
+			EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		})
+		require.NoError(err)
+
+		_, err = f.msgServer.CommitMove(ctx, &rps.MsgCommitMove{
+			Player: f.addrs[2].String(),
+			GameId: res.GameId,
+			Commit: utils.CalculateCommitment(move2.Name(), salt2),
+		})
+		require.NoError(err)
+
+		_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[1].String(), GameId: res.GameId, Move: move1, Salt: salt1})
+		require.NoError(err)
+		_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[2].String(), GameId: res.GameId, Move: move2, Salt: salt2})
+		require.NoError(err)
+
+		require.NoError(f.k.EndBlocker(ctx))
+	}
+
+	// a win feeds the jackpot with 10% of the pot and starts a streak
+	play(rps.Move_MOVE_ROCK, rps.Move_MOVE_SCISSORS)
+	jackpot, err := f.queryServer.Jackpot(ctx, &rps.QueryJackpotRequest{})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), jackpot.Jackpot)
+	require.Equal(uint64(2), jackpot.Streak)
+
+	stats, err := f.k.PlayerStats.Get(ctx, f.addrs[1])
+	require.NoError(err)
+	require.Equal(rps.PlayerStats{Wins: 1, WinStreak: 1}, stats)
+
+	// a draw breaks the streak
+	play(rps.Move_MOVE_ROCK, rps.Move_MOVE_ROCK)
+	stats, err = f.k.PlayerStats.Get(ctx, f.addrs[1])
+	require.NoError(err)
+	require.Equal(uint64(0), stats.WinStreak)
+
+	// two wins in a row take the jackpot
+	play(rps.Move_MOVE_PAPER, rps.Move_MOVE_ROCK)
+	play(rps.Move_MOVE_SCISSORS, rps.Move_MOVE_PAPER)
+
+	jackpot, err = f.queryServer.Jackpot(ctx, &rps.QueryJackpotRequest{})
+	require.NoError(err)
+	require.True(jackpot.Jackpot.IsZero())
+
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1300)), f.bankKeeper.balances[f.addrs[1].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 700)), f.bankKeeper.balances[f.addrs[2].String()])
+	require.True(f.bankKeeper.balances[authtypes.NewModuleAddress(rps.ModuleName).String()].IsZero())
+
+	stats, err = f.k.PlayerStats.Get(ctx, f.addrs[1])
+	require.NoError(err)
+	require.Equal(rps.PlayerStats{Wins: 3, WinStreak: 0}, stats)
+
+	won := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "facundomedica.rps.v1.EventJackpotWon" {
+			won++
+		}
+	}
+	require.Equal(1, won)
+}
+
+func TestEndBlockerJackpotDustGames(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	params := rps.Params{
+		CommitTimeout:   60,
+		RevealTimeout:   60,
+		JackpotFee:      math.LegacyNewDecWithPrec(1, 1),
+		JackpotStreak:   2,
+		JackpotMinStake: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+	}
+	require.NoError(f.k.Params.Set(f.ctx, params))
+
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0))
+	play := func(stake int64) {
+		res, err := f.msgServer.NewGame(ctx, &rps.MsgNewGame{
+			Player:   f.addrs[1].String(),
+			Commit:   utils.CalculateCommitment("rock", salt1),
+			EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", stake)),
+		})
+		require.NoError(err)
+
+		_, err = f.msgServer.CommitMove(ctx, &rps.MsgCommitMove{
+			Player: f.addrs[2].String(),
+			GameId: res.GameId,
+			Commit: utils.CalculateCommitment("scissors", salt2),
+		})
+		require.NoError(err)
+
+		_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[1].String(), GameId: res.GameId, Move: rps.Move_MOVE_ROCK, Salt: salt1})
+		require.NoError(err)
+		_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[2].String(), GameId: res.GameId, Move: rps.Move_MOVE_SCISSORS, Salt: salt2})
+		require.NoError(err)
+
+		require.NoError(f.k.EndBlocker(ctx))
+	}
+
+	// a game for 100 feeds the jackpot
+	play(100)
+	stats, err := f.k.PlayerStats.Get(ctx, f.addrs[1])
+	require.NoError(err)
+	require.Equal(rps.PlayerStats{Wins: 1, WinStreak: 1}, stats)
+
+	// the same accounts winning games below the min stake don't grow the streak
+	for i := 0; i < 3; i++ {
+		play(20)
+	}
+
+	// without a min stake, games too small to feed the jackpot don't either
+	params.JackpotMinStake = nil
+	require.NoError(f.k.Params.Set(f.ctx, params))
+	for i := 0; i < 3; i++ {
+		play(1)
+	}
+
+	stats, err = f.k.PlayerStats.Get(ctx, f.addrs[1])
+	require.NoError(err)
+	require.Equal(rps.PlayerStats{Wins: 7, WinStreak: 1}, stats)
+
+	jackpot, err := f.queryServer.Jackpot(ctx, &rps.QueryJackpotRequest{})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 32)), jackpot.Jackpot)
+}
+
+func TestEndBlockerHouseGames(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	require.NoError(f.k.Params.Set(f.ctx, rps.Params{
+		CommitTimeout:    60,
+		RevealTimeout:    60,
+		HouseMaxExposure: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}))
+
+	// games are created in ctx and seeded by the EndBlocker of next
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0)).WithBlockHeight(1).WithHeaderHash([]byte("block hash"))
+	next := ctx.WithBlockHeight(2).WithHeaderHash([]byte("next block hash"))
+
+	// the bankroll is funded from the community pool by the authority
+	f.bankKeeper.balances[communityPool] = sdk.NewCoins(sdk.NewInt64Coin("stake", 500))
+	_, err := f.msgServer.FundHouse(ctx, &rps.MsgFundHouse{Authority: f.addrs[1].String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 120))})
+	require.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = f.msgServer.FundHouse(ctx, &rps.MsgFundHouse{Authority: f.addrs[0].String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 120))})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 380)), f.bankKeeper.balances[communityPool])
+
+	beats := map[rps.Move]rps.Move{rps.Move_MOVE_ROCK: rps.Move_MOVE_PAPER, rps.Move_MOVE_PAPER: rps.Move_MOVE_SCISSORS, rps.Move_MOVE_SCISSORS: rps.Move_MOVE_ROCK}
+	loses := map[rps.Move]rps.Move{rps.Move_MOVE_ROCK: rps.Move_MOVE_SCISSORS, rps.Move_MOVE_PAPER: rps.Move_MOVE_ROCK, rps.Move_MOVE_SCISSORS: rps.Move_MOVE_PAPER}
+
+	// play returns the game ID after committing a move picked against the house
+	play := func(pick func(houseMove rps.Move) rps.Move, reveal bool) uint64 {
+		houseMove, err := rps.ParseMove(utils.HouseMove([]byte("next block hash"), salt1))
+		require.NoError(err)
+		move := pick(houseMove)
+
+		res, err := f.msgServer.PlayHouse(ctx, &rps.MsgPlayHouse{
+			Player:   f.addrs[1].String(),
+			Commit:   utils.CalculateCommitment(move.Name(), salt1),
+			EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+		})
+		require.NoError(err)
+
+		if reveal {
+			// the house move isn't drawn until the next block
+			_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[1].String(), GameId: res.GameId, Move: move, Salt: salt1})
+			require.ErrorContains(err, "the house hasn't drawn its move yet")
+
+			require.NoError(f.k.EndBlocker(next))
+			_, err = f.msgServer.RevealMove(next, &rps.MsgRevealMove{Player: f.addrs[1].String(), GameId: res.GameId, Move: move, Salt: salt1})
+			require.NoError(err)
+
+			reveal, err := f.k.MoveReveals.Get(ctx, collections.Join(res.GameId, authtypes.NewModuleAddress(rps.ModuleName).Bytes()))
+			require.NoError(err)
+			require.Equal(houseMove, reveal.Move)
+		}
+
+		return res.GameId
+	}
+
+	bankroll := func() sdk.Coins {
+		res, err := f.queryServer.HouseBankroll(ctx, &rps.QueryHouseBankrollRequest{})
+		require.NoError(err)
+		return res.Bankroll
+	}
+
+	exposure := func() sdk.Coins {
+		res, err := f.queryServer.HouseBankroll(ctx, &rps.QueryHouseBankrollRequest{})
+		require.NoError(err)
+		return res.Exposure
+	}
+
+	// the house is a player, nobody else can join
+	gameID := play(func(m rps.Move) rps.Move { return beats[m] }, true)
+	_, err = f.msgServer.CommitMove(ctx, &rps.MsgCommitMove{Player: f.addrs[2].String(), GameId: gameID, Commit: utils.CalculateCommitment("rock", salt2)})
+	require.ErrorContains(err, "game is full")
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 70)), bankroll())
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), exposure())
+
+	// the player wins the house stake
+	require.NoError(f.k.EndBlocker(next))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1050)), f.bankKeeper.balances[f.addrs[1].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 70)), bankroll())
+	require.Empty(exposure())
+
+	// a draw gives each side its stake back
+	play(func(m rps.Move) rps.Move { return m }, true)
+	require.NoError(f.k.EndBlocker(next))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1050)), f.bankKeeper.balances[f.addrs[1].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 70)), bankroll())
+
+	// the house wins the player stake
+	play(func(m rps.Move) rps.Move { return loses[m] }, true)
+	require.NoError(f.k.EndBlocker(next))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), f.bankKeeper.balances[f.addrs[1].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 120)), bankroll())
+
+	// a player that doesn't reveal forfeits to the house
+	play(func(m rps.Move) rps.Move { return m }, false)
+	require.NoError(f.k.EndBlocker(next.WithBlockTime(time.Unix(1100, 0))))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 950)), f.bankKeeper.balances[f.addrs[1].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 170)), bankroll())
+	require.Empty(exposure())
+
+	// what the module holds is the bankroll
+	require.Equal(bankroll(), f.bankKeeper.balances[authtypes.NewModuleAddress(rps.ModuleName).String()])
+
+	// the exposure per game, the bankroll and the open exposure are bounded
+	_, err = f.msgServer.PlayHouse(ctx, &rps.MsgPlayHouse{
+		Player:   f.addrs[1].String(),
+		Commit:   utils.CalculateCommitment("rock", salt1),
+		EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 101)),
+	})
+	require.ErrorContains(err, "exceeds the house max exposure")
+
+	play(func(m rps.Move) rps.Move { return m }, false)
+	play(func(m rps.Move) rps.Move { return m }, false)
+	play(func(m rps.Move) rps.Move { return m }, false)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), bankroll())
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 150)), exposure())
+
+	_, err = f.msgServer.PlayHouse(ctx, &rps.MsgPlayHouse{
+		Player:   f.addrs[1].String(),
+		Commit:   utils.CalculateCommitment("rock", salt1),
+		EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+	})
+	require.ErrorContains(err, "house bankroll can't cover the entry fee")
+
+	params, err := f.k.Params.Get(ctx)
+	require.NoError(err)
+	params.HouseMaxOpenExposure = sdk.NewCoins(sdk.NewInt64Coin("stake", 160))
+	require.NoError(f.k.Params.Set(ctx, params))
+
+	_, err = f.msgServer.PlayHouse(ctx, &rps.MsgPlayHouse{
+		Player:   f.addrs[1].String(),
+		Commit:   utils.CalculateCommitment("rock", salt1),
+		EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 20)),
+	})
+	require.ErrorContains(err, "exceeds the max open exposure")
+
+	// the stakes of the house are released once the games are over
+	require.NoError(f.k.EndBlocker(next.WithBlockTime(time.Unix(1100, 0))))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 320)), bankroll())
+	require.Empty(exposure())
 }
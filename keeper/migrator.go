@@ -1,5 +1,19 @@
 package keeper
 
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/facundomedica/rps"
+	v2 "github.com/facundomedica/rps/migrations/v2"
+	v3 "github.com/facundomedica/rps/migrations/v3"
+	v4 "github.com/facundomedica/rps/migrations/v4"
+	v5 "github.com/facundomedica/rps/migrations/v5"
+	v6 "github.com/facundomedica/rps/migrations/v6"
+	v7 "github.com/facundomedica/rps/migrations/v7"
+)
+
 // Migrator is a struct for handling in-place state migrations.
 type Migrator struct {
 	keeper Keeper
@@ -13,6 +27,40 @@ func NewMigrator(k Keeper) Migrator {
 }
 
 // Migrate1to2 migrates the module state from version 1 to version 2.
-// func (m Migrator) Migrate1to2(ctx context.Context) error {
-// 	return v2.Migrate(/* migrations arguments */)
-// }
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.Migrate(ctx, m.keeper.Games, m.keeper.StuckGames)
+}
+
+// Migrate2to3 migrates the module state from version 2 to version 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.Migrate(ctx, m.keeper.storeService)
+}
+
+// Migrate3to4 migrates the module state from version 3 to version 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.Migrate(ctx, m.keeper.storeService)
+}
+
+// Migrate4to5 migrates the module state from version 4 to version 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.Migrate(ctx, m.keeper.Games, m.keeper.scheduleGame)
+}
+
+// Migrate5to6 migrates the module state from version 5 to version 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.Migrate(ctx, m.keeper.storeService, m.keeper.addressCodec, m.keeper.Queue, func(ctx context.Context, entry rps.QueueEntry) error {
+		player, err := m.keeper.addressCodec.StringToBytes(entry.Player)
+		if err != nil {
+			return err
+		}
+
+		return m.keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, rps.ModuleName, player, sdk.NewCoins(entry.EntryFee))
+	})
+}
+
+// Migrate6to7 migrates the module state from version 6 to version 7.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.Migrate(ctx, m.keeper.Games, m.keeper.StuckGames, func(ctx context.Context, game rps.Game) (sdk.Coins, error) {
+		return m.keeper.playerStake(ctx, game, houseAddress())
+	}, m.keeper.HouseExposure)
+}
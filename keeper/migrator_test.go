@@ -0,0 +1,280 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/facundomedica/rps"
+	"github.com/facundomedica/rps/keeper"
+	"github.com/facundomedica/rps/utils"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	// version 1 games hold a single coin, which decodes as a one element sdk.Coins
+	require.NoError(f.k.Games.Set(f.ctx, 1, rps.Game{Id: 1, EntryFee: sdk.Coins{sdk.NewInt64Coin("stake", 10)}}))
+	require.NoError(f.k.Games.Set(f.ctx, 2, rps.Game{Id: 2, EntryFee: sdk.Coins{sdk.NewCoin("stake", math.ZeroInt())}}))
+	require.NoError(f.k.StuckGames.Set(f.ctx, 3, rps.Game{Id: 3, EntryFee: sdk.Coins{{Denom: "", Amount: math.NewInt(10)}}}))
+
+	require.NoError(keeper.NewMigrator(f.k).Migrate1to2(f.ctx))
+
+	game, err := f.k.Games.Get(f.ctx, 1)
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), game.EntryFee)
+
+	game, err = f.k.Games.Get(f.ctx, 2)
+	require.NoError(err)
+	require.True(game.EntryFee.IsZero())
+
+	game, err = f.k.StuckGames.Get(f.ctx, 3)
+	require.NoError(err)
+	require.True(game.EntryFee.IsZero())
+}
+
+func TestMigrate2to3(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	createdAt := time.Unix(1000, 0).UTC()
+	timestamp, err := gogotypes.StdTimeMarshal(createdAt)
+	require.NoError(err)
+
+	// version 2 reveals hold the name of the move in the same field
+	v2Reveal := func(move, salt string) []byte {
+		bz := protowire.AppendTag(nil, 1, protowire.BytesType)
+		bz = protowire.AppendString(bz, move)
+		if salt != "" {
+			bz = protowire.AppendTag(bz, 2, protowire.BytesType)
+			bz = protowire.AppendString(bz, salt)
+		}
+
+		bz = protowire.AppendTag(bz, 3, protowire.BytesType)
+		return protowire.AppendBytes(bz, timestamp)
+	}
+
+	sb := collections.NewSchemaBuilder(f.storeService)
+	reveals := collections.NewMap(sb, rps.MoveRevealKey, "move_reveals", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.BytesValue)
+	_, err = sb.Build()
+	require.NoError(err)
+
+	require.NoError(reveals.Set(f.ctx, collections.Join(uint64(1), f.addrs[0].Bytes()), v2Reveal("rock", "salt0")))
+	require.NoError(reveals.Set(f.ctx, collections.Join(uint64(1), f.addrs[1].Bytes()), v2Reveal("scissors", "salt1")))
+	// house reveals have no salt
+	require.NoError(reveals.Set(f.ctx, collections.Join(uint64(2), f.addrs[2].Bytes()), v2Reveal("paper", "")))
+
+	require.NoError(keeper.NewMigrator(f.k).Migrate2to3(f.ctx))
+
+	for _, tc := range []struct {
+		gameID uint64
+		player sdk.AccAddress
+		reveal rps.MoveReveal
+	}{
+		{1, f.addrs[0], rps.MoveReveal{Move: rps.Move_MOVE_ROCK, Salt: []byte("salt0"), CreatedAt: createdAt}},
+		{1, f.addrs[1], rps.MoveReveal{Move: rps.Move_MOVE_SCISSORS, Salt: []byte("salt1"), CreatedAt: createdAt}},
+		{2, f.addrs[2], rps.MoveReveal{Move: rps.Move_MOVE_PAPER, CreatedAt: createdAt}},
+	} {
+		reveal, err := f.k.MoveReveals.Get(f.ctx, collections.Join(tc.gameID, tc.player.Bytes()))
+		require.NoError(err)
+		require.Equal(tc.reveal, reveal)
+	}
+
+	// migrated reveals are left as they are
+	require.NoError(keeper.NewMigrator(f.k).Migrate2to3(f.ctx))
+	reveal, err := f.k.MoveReveals.Get(f.ctx, collections.Join(uint64(1), f.addrs[0].Bytes()))
+	require.NoError(err)
+	require.Equal(rps.Move_MOVE_ROCK, reveal.Move)
+
+	require.NoError(reveals.Set(f.ctx, collections.Join(uint64(3), f.addrs[0].Bytes()), v2Reveal("lizard", "salt0")))
+	require.ErrorContains(keeper.NewMigrator(f.k).Migrate2to3(f.ctx), "invalid move")
+}
+
+func TestMigrate3to4(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	createdAt := time.Unix(1000, 0).UTC()
+	commit := utils.CalculateCommitment("rock", salt0)
+	fee := sdk.NewInt64Coin("stake", 10)
+
+	// version 3 values hold the hex strings in the same fields, strings and
+	// bytes are encoded alike
+	hexOf := func(bz []byte) []byte { return []byte(rps.HexBytes(bz).String()) }
+	require.NoError(f.k.MoveCommits.Set(f.ctx, collections.Join(uint64(1), f.addrs[0].Bytes()), rps.MoveCommit{Commit: hexOf(commit), CreatedAt: createdAt, Stake: sdk.NewCoins(fee)}))
+	require.NoError(f.k.MoveCommits.Set(f.ctx, collections.Join(uint64(1), f.addrs[1].Bytes()), rps.MoveCommit{Commit: []byte("not hex"), CreatedAt: createdAt}))
+	require.NoError(f.k.MoveReveals.Set(f.ctx, collections.Join(uint64(1), f.addrs[0].Bytes()), rps.MoveReveal{Move: rps.Move_MOVE_ROCK, Salt: hexOf(salt0), CreatedAt: createdAt}))
+	require.NoError(f.k.MoveReveals.Set(f.ctx, collections.Join(uint64(1), f.addrs[1].Bytes()), rps.MoveReveal{Move: rps.Move_MOVE_PAPER, Salt: []byte("salt1"), CreatedAt: createdAt}))
+	require.NoError(f.k.MoveReveals.Set(f.ctx, collections.Join(uint64(2), f.addrs[2].Bytes()), rps.MoveReveal{Move: rps.Move_MOVE_PAPER, CreatedAt: createdAt}))
+	require.NoError(f.k.Queue.Set(f.ctx, 0, rps.QueueEntry{Id: 0, Player: f.addrs[0].String(), Commit: []byte(strings.ToUpper(rps.HexBytes(commit).String())), EntryFee: fee, CreatedAt: createdAt}))
+
+	require.NoError(keeper.NewMigrator(f.k).Migrate3to4(f.ctx))
+
+	moveCommit, err := f.k.MoveCommits.Get(f.ctx, collections.Join(uint64(1), f.addrs[0].Bytes()))
+	require.NoError(err)
+	require.Equal(rps.MoveCommit{Commit: commit, CreatedAt: createdAt, Stake: sdk.NewCoins(fee)}, moveCommit)
+
+	// invalid commitments are dropped, they could never be revealed
+	moveCommit, err = f.k.MoveCommits.Get(f.ctx, collections.Join(uint64(1), f.addrs[1].Bytes()))
+	require.NoError(err)
+	require.Empty(moveCommit.Commit)
+
+	for _, tc := range []struct {
+		gameID uint64
+		player sdk.AccAddress
+		reveal rps.MoveReveal
+	}{
+		{1, f.addrs[0], rps.MoveReveal{Move: rps.Move_MOVE_ROCK, Salt: salt0, CreatedAt: createdAt}},
+		// salts that aren't hex are kept
+		{1, f.addrs[1], rps.MoveReveal{Move: rps.Move_MOVE_PAPER, Salt: []byte("salt1"), CreatedAt: createdAt}},
+		{2, f.addrs[2], rps.MoveReveal{Move: rps.Move_MOVE_PAPER, CreatedAt: createdAt}},
+	} {
+		reveal, err := f.k.MoveReveals.Get(f.ctx, collections.Join(tc.gameID, tc.player.Bytes()))
+		require.NoError(err)
+		require.Equal(tc.reveal, reveal)
+	}
+
+	entry, err := f.k.Queue.Get(f.ctx, 0)
+	require.NoError(err)
+	require.Equal(rps.HexBytes(commit), entry.Commit)
+	require.Equal(fee, entry.EntryFee)
+
+	// the queue indexes are kept
+	ids, err := f.k.Queue.Indexes.Stake.MatchExact(f.ctx, collections.Join("stake", fee.Amount))
+	require.NoError(err)
+	keys, err := ids.PrimaryKeys()
+	require.NoError(err)
+	require.Equal([]uint64{0}, keys)
+}
+
+func TestMigrate4to5(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	commitTimeout := time.Unix(1060, 0).UTC()
+	revealTimeout := time.Unix(1120, 0).UTC()
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	// version 4 games aren't in the deadline index: one waiting for an
+	// opponent, one in its reveal window and one with height timeouts
+	require.NoError(f.k.Games.Set(f.ctx, 1, rps.Game{Id: 1, EntryFee: fee, CommitTimeout: commitTimeout}))
+	require.NoError(f.k.MoveCommits.Set(f.ctx, collections.Join(uint64(1), f.addrs[0].Bytes()), rps.MoveCommit{}))
+
+	require.NoError(f.k.Games.Set(f.ctx, 2, rps.Game{Id: 2, EntryFee: fee, CommitTimeout: commitTimeout, RevealTimeout: revealTimeout}))
+	require.NoError(f.k.MoveCommits.Set(f.ctx, collections.Join(uint64(2), f.addrs[0].Bytes()), rps.MoveCommit{}))
+	require.NoError(f.k.MoveCommits.Set(f.ctx, collections.Join(uint64(2), f.addrs[1].Bytes()), rps.MoveCommit{}))
+
+	require.NoError(f.k.Games.Set(f.ctx, 3, rps.Game{Id: 3, EntryFee: fee, TimeoutMode: rps.TimeoutMode_TIMEOUT_MODE_HEIGHT, CommitTimeoutHeight: 20}))
+
+	require.NoError(keeper.NewMigrator(f.k).Migrate4to5(f.ctx))
+
+	iter, err := f.k.GamesByTimeDeadline.Iterate(f.ctx, nil)
+	require.NoError(err)
+	timeKeys, err := iter.Keys()
+	require.NoError(err)
+	require.Equal([]collections.Pair[time.Time, uint64]{
+		collections.Join(commitTimeout, uint64(1)),
+		collections.Join(revealTimeout, uint64(2)),
+	}, timeKeys)
+
+	heightIter, err := f.k.GamesByHeightDeadline.Iterate(f.ctx, nil)
+	require.NoError(err)
+	heightKeys, err := heightIter.Keys()
+	require.NoError(err)
+	require.Equal([]collections.Pair[int64, uint64]{collections.Join(int64(20), uint64(3))}, heightKeys)
+}
+
+func TestMigrate5to6(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	// version 5 queue entries are indexed by denom, and a player can wait
+	// several times for the same denom
+	sb := collections.NewSchemaBuilder(f.storeService)
+	entries := collections.NewMap(sb, rps.QueueKey, "queue", collections.Uint64Key, collections.BytesValue)
+	byDenom := collections.NewKeySet(sb, rps.QueueByStakeKey, "queue_by_denom", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key))
+	_, err := sb.Build()
+	require.NoError(err)
+
+	for _, entry := range []rps.QueueEntry{
+		{Id: 0, Player: f.addrs[0].String(), EntryFee: sdk.NewInt64Coin("stake", 10), MinEntryFee: sdk.NewInt64Coin("stake", 10)},
+		{Id: 1, Player: f.addrs[0].String(), EntryFee: sdk.NewInt64Coin("stake", 20), MinEntryFee: sdk.NewInt64Coin("stake", 5)},
+		{Id: 2, Player: f.addrs[0].String(), EntryFee: sdk.NewInt64Coin("atom", 5)},
+		{Id: 3, Player: f.addrs[1].String(), EntryFee: sdk.NewInt64Coin("stake", 30), MinEntryFee: sdk.NewInt64Coin("stake", 25)},
+	} {
+		bz, err := entry.Marshal()
+		require.NoError(err)
+		require.NoError(entries.Set(f.ctx, entry.Id, bz))
+		require.NoError(byDenom.Set(f.ctx, collections.Join(entry.EntryFee.Denom, entry.Id)))
+	}
+
+	f.bankKeeper.balances[authtypes.NewModuleAddress(rps.ModuleName).String()] = sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 60))
+
+	require.NoError(keeper.NewMigrator(f.k).Migrate5to6(f.ctx))
+
+	// the newer entry of the first player for stake is refunded
+	has, err := f.k.Queue.Has(f.ctx, 1)
+	require.NoError(err)
+	require.False(has)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1020)), f.bankKeeper.balances[f.addrs[0].String()])
+
+	iter, err := f.k.Queue.Indexes.Stake.Iterate(f.ctx, nil)
+	require.NoError(err)
+	stakeKeys, err := iter.FullKeys()
+	require.NoError(err)
+	require.Equal([]collections.Pair[collections.Pair[string, math.Int], uint64]{
+		collections.Join(collections.Join("atom", math.NewInt(5)), uint64(2)),
+		collections.Join(collections.Join("stake", math.NewInt(10)), uint64(0)),
+		collections.Join(collections.Join("stake", math.NewInt(25)), uint64(3)),
+	}, stakeKeys)
+
+	for _, tc := range []struct {
+		denom  string
+		player sdk.AccAddress
+		id     uint64
+	}{
+		{"atom", f.addrs[0], 2},
+		{"stake", f.addrs[0], 0},
+		{"stake", f.addrs[1], 3},
+	} {
+		id, err := f.k.Queue.Indexes.Player.MatchExact(f.ctx, collections.Join(tc.denom, tc.player.Bytes()))
+		require.NoError(err)
+		require.Equal(tc.id, id)
+	}
+}
+
+func TestMigrate6to7(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	house := authtypes.NewModuleAddress(rps.ModuleName).Bytes()
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	// version 6 house games don't count towards the open exposure of the
+	// house: one in progress, one stuck and a game between two players
+	require.NoError(f.k.Games.Set(f.ctx, 1, rps.Game{Id: 1, EntryFee: fee, House: true}))
+	require.NoError(f.k.MoveCommits.Set(f.ctx, collections.Join(uint64(1), f.addrs[0].Bytes()), rps.MoveCommit{Stake: fee}))
+	require.NoError(f.k.MoveCommits.Set(f.ctx, collections.Join(uint64(1), house), rps.MoveCommit{Stake: fee}))
+
+	require.NoError(f.k.StuckGames.Set(f.ctx, 2, rps.Game{Id: 2, EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 25)), House: true}))
+	require.NoError(f.k.MoveCommits.Set(f.ctx, collections.Join(uint64(2), house), rps.MoveCommit{}))
+
+	require.NoError(f.k.Games.Set(f.ctx, 3, rps.Game{Id: 3, EntryFee: fee}))
+	require.NoError(f.k.MoveCommits.Set(f.ctx, collections.Join(uint64(3), f.addrs[0].Bytes()), rps.MoveCommit{Stake: fee}))
+
+	require.NoError(keeper.NewMigrator(f.k).Migrate6to7(f.ctx))
+
+	res, err := f.queryServer.HouseBankroll(f.ctx, &rps.QueryHouseBankrollRequest{})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 35)), res.Exposure)
+}
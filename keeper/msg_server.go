@@ -1,17 +1,16 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"errors"
-	"fmt"
-	"strings"
-	"time"
 
 	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"github.com/facundomedica/rps"
-	"github.com/facundomedica/rps/utils"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type msgServer struct {
@@ -27,39 +26,45 @@ func NewMsgServerImpl(keeper Keeper) rps.MsgServer {
 
 // NewGame implements rps.MsgServer.
 func (ms msgServer) NewGame(ctx context.Context, msg *rps.MsgNewGame) (*rps.MsgNewGameResponse, error) {
-	if msg.EntryFee.IsZero() {
-		return nil, fmt.Errorf("entry fee must be positive")
+	if err := rps.ValidateCommit(msg.Commit); err != nil {
+		return nil, err
 	}
 
-	playerAddr, err := ms.k.addressCodec.StringToBytes(msg.Player)
+	params, err := ms.k.Params.Get(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("invalid player address: %w", err)
+		return nil, err
 	}
 
-	err = ms.k.bankKeeper.SendCoinsFromAccountToModule(ctx, playerAddr, rps.ModuleName, sdk.NewCoins(msg.EntryFee))
-	if err != nil {
+	if err := params.ValidateCommitmentScheme(msg.CommitmentScheme); err != nil {
 		return nil, err
 	}
 
-	// create game
-	gid, err := ms.k.GameID.Next(ctx)
-	if err != nil {
+	if err := params.ValidateEntryFee(msg.EntryFee); err != nil {
 		return nil, err
 	}
 
-	params, err := ms.k.Params.Get(ctx)
+	// a challenger fee means the creator is offering odds
+	if !msg.ChallengerFee.Empty() {
+		if err := params.ValidateEntryFee(msg.ChallengerFee); err != nil {
+			return nil, errorsmod.Wrap(err, "invalid challenger fee")
+		}
+	}
+
+	playerAddr, err := ms.k.addressCodec.StringToBytes(msg.Player)
 	if err != nil {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidAddress, "invalid player address: %s", err)
+	}
+
+	if err := ms.k.checkNoShowCooldown(ctx, playerAddr); err != nil {
 		return nil, err
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	game := rps.Game{
-		Id:            gid,
-		EntryFee:      msg.EntryFee,
-		CommitTimeout: sdkCtx.BlockTime().Add(time.Second * time.Duration(params.CommitTimeout)),
+	err = ms.k.bankKeeper.SendCoinsFromAccountToModule(ctx, playerAddr, rps.ModuleName, msg.EntryFee)
+	if err != nil {
+		return nil, err
 	}
 
-	err = ms.k.Games.Set(ctx, gid, game)
+	game, err := ms.k.createGame(ctx, playerAddr, msg.EntryFee, msg.ChallengerFee, msg.CommitTimeout, msg.RevealTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -67,22 +72,37 @@ func (ms msgServer) NewGame(ctx context.Context, msg *rps.MsgNewGame) (*rps.MsgN
 	// store move commit
 	commit := rps.MoveCommit{
 		Commit:    msg.Commit,
-		CreatedAt: sdkCtx.BlockTime(),
+		CreatedAt: sdk.UnwrapSDKContext(ctx).BlockTime(),
+		Stake:     msg.EntryFee,
+		Scheme:    msg.CommitmentScheme,
 	}
 
-	err = ms.k.MoveCommits.Set(ctx, collections.Join(gid, playerAddr), commit)
+	err = ms.k.MoveCommits.Set(ctx, collections.Join(game.Id, playerAddr), commit)
 	if err != nil {
 		return nil, err
 	}
 
-	return &rps.MsgNewGameResponse{GameId: gid}, nil
+	return &rps.MsgNewGameResponse{GameId: game.Id}, nil
 }
 
 // CommitMove implements rps.MsgServer.
 func (ms msgServer) CommitMove(ctx context.Context, msg *rps.MsgCommitMove) (*rps.MsgCommitMoveResponse, error) {
+	if err := rps.ValidateCommit(msg.Commit); err != nil {
+		return nil, err
+	}
+
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := params.ValidateCommitmentScheme(msg.CommitmentScheme); err != nil {
+		return nil, err
+	}
+
 	playerAddr, err := ms.k.addressCodec.StringToBytes(msg.Player)
 	if err != nil {
-		return nil, fmt.Errorf("invalid sender address: %w", err)
+		return nil, errorsmod.Wrapf(rps.ErrInvalidAddress, "invalid sender address: %s", err)
 	}
 
 	// check if the game exists, check if the commit timeout hasn't passed and if the player is already in the game
@@ -91,8 +111,9 @@ func (ms msgServer) CommitMove(ctx context.Context, msg *rps.MsgCommitMove) (*rp
 		return nil, err
 	}
 
-	if !game.CommitTimeout.IsZero() && sdk.UnwrapSDKContext(ctx).BlockTime().After(game.CommitTimeout) {
-		return nil, errors.New("commit timeout has passed")
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if game.CommitTimedOut(sdkCtx.BlockTime(), sdkCtx.BlockHeight()) {
+		return nil, errorsmod.Wrap(rps.ErrDeadlinePassed, "commit timeout has passed")
 	}
 
 	alreadyInGame, err := ms.k.MoveCommits.Has(ctx, collections.Join(msg.GameId, playerAddr))
@@ -101,14 +122,34 @@ func (ms msgServer) CommitMove(ctx context.Context, msg *rps.MsgCommitMove) (*rp
 	}
 
 	if alreadyInGame {
-		return nil, errors.New("player already in game")
+		return nil, errorsmod.Wrap(rps.ErrAlreadyJoined, "player already in game")
+	}
+
+	// rematches can only be accepted by the previous opponent
+	if game.ReservedFor != "" {
+		reservedFor, err := ms.k.addressCodec.StringToBytes(game.ReservedFor)
+		if err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(reservedFor, playerAddr) {
+			return nil, errorsmod.Wrapf(rps.ErrUnauthorized, "game %d is reserved for %s", msg.GameId, game.ReservedFor)
+		}
 	}
 
-	err = ms.k.bankKeeper.SendCoinsFromAccountToModule(ctx, playerAddr, rps.ModuleName, sdk.NewCoins(game.EntryFee))
+	hasBet, err := ms.k.Bets.Has(ctx, collections.Join(msg.GameId, playerAddr))
 	if err != nil {
 		return nil, err
 	}
 
+	if hasBet {
+		return nil, errorsmod.Wrap(rps.ErrNotAllowed, "player has a bet on this game")
+	}
+
+	if err := ms.k.checkNoShowCooldown(ctx, playerAddr); err != nil {
+		return nil, err
+	}
+
 	// check if the game is full
 	players := 0
 	rng := collections.NewPrefixedPairRange[uint64, []byte](msg.GameId)
@@ -121,64 +162,243 @@ func (ms msgServer) CommitMove(ctx context.Context, msg *rps.MsgCommitMove) (*rp
 	}
 
 	if players == 2 {
-		return nil, errors.New("game is full, sorry")
+		return nil, rps.ErrGameFull
+	}
+
+	stake := game.ChallengerStake()
+	err = ms.k.bankKeeper.SendCoinsFromAccountToModule(ctx, playerAddr, rps.ModuleName, stake)
+	if err != nil {
+		return nil, err
 	}
 
 	// now that we've checked everything, we can store the move commitment
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	commit := rps.MoveCommit{
 		Commit:    msg.Commit,
 		CreatedAt: sdkCtx.BlockTime(),
+		Stake:     stake,
+		Scheme:    msg.CommitmentScheme,
 	}
 
 	if err := ms.k.MoveCommits.Set(ctx, collections.Join(msg.GameId, playerAddr), commit); err != nil {
 		return nil, err
 	}
 
+	// the game is now full, the reveal window starts
+	if _, err := ms.k.startRevealWindow(ctx, game); err != nil {
+		return nil, err
+	}
+
 	return &rps.MsgCommitMoveResponse{}, nil
 }
 
+// CreateAndJoin implements rps.MsgServer.
+func (ms msgServer) CreateAndJoin(ctx context.Context, msg *rps.MsgCreateAndJoin) (*rps.MsgCreateAndJoinResponse, error) {
+	if err := rps.ValidateCommit(msg.CreatorCommit); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator commit")
+	}
+
+	if err := rps.ValidateCommit(msg.ChallengerCommit); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid challenger commit")
+	}
+
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := params.ValidateCommitmentScheme(msg.CreatorCommitmentScheme); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator commitment scheme")
+	}
+
+	if err := params.ValidateCommitmentScheme(msg.ChallengerCommitmentScheme); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid challenger commitment scheme")
+	}
+
+	if err := params.ValidateEntryFee(msg.EntryFee); err != nil {
+		return nil, err
+	}
+
+	if !msg.ChallengerFee.Empty() {
+		if err := params.ValidateEntryFee(msg.ChallengerFee); err != nil {
+			return nil, errorsmod.Wrap(err, "invalid challenger fee")
+		}
+	}
+
+	creatorAddr, err := ms.k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	challengerAddr, err := ms.k.addressCodec.StringToBytes(msg.Challenger)
+	if err != nil {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidAddress, "invalid challenger address: %s", err)
+	}
+
+	if bytes.Equal(creatorAddr, challengerAddr) {
+		return nil, errorsmod.Wrap(rps.ErrInvalidPlayers, "creator and challenger must be different players")
+	}
+
+	for _, player := range [][]byte{creatorAddr, challengerAddr} {
+		if err := ms.k.checkNoShowCooldown(ctx, player); err != nil {
+			return nil, err
+		}
+	}
+
+	game, err := ms.k.createGame(ctx, creatorAddr, msg.EntryFee, msg.ChallengerFee, 0, msg.RevealTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	commits := []struct {
+		player []byte
+		commit rps.HexBytes
+		scheme rps.CommitmentScheme
+		stake  sdk.Coins
+	}{
+		{creatorAddr, msg.CreatorCommit, msg.CreatorCommitmentScheme, msg.EntryFee},
+		{challengerAddr, msg.ChallengerCommit, msg.ChallengerCommitmentScheme, game.ChallengerStake()},
+	}
+
+	for _, c := range commits {
+		if err := ms.k.bankKeeper.SendCoinsFromAccountToModule(ctx, c.player, rps.ModuleName, c.stake); err != nil {
+			return nil, err
+		}
+
+		commit := rps.MoveCommit{
+			Commit:    c.commit,
+			CreatedAt: sdkCtx.BlockTime(),
+			Stake:     c.stake,
+			Scheme:    c.scheme,
+		}
+
+		if err := ms.k.MoveCommits.Set(ctx, collections.Join(game.Id, c.player), commit); err != nil {
+			return nil, err
+		}
+	}
+
+	// both players are in, the reveal window starts right away
+	if _, err := ms.k.startRevealWindow(ctx, game); err != nil {
+		return nil, err
+	}
+
+	return &rps.MsgCreateAndJoinResponse{GameId: game.Id}, nil
+}
+
 // RevealMove implements rps.MsgServer.
 func (ms msgServer) RevealMove(ctx context.Context, msg *rps.MsgRevealMove) (*rps.MsgRevealMoveResponse, error) {
 	playerAddr, err := ms.k.addressCodec.StringToBytes(msg.Player)
 	if err != nil {
-		return nil, fmt.Errorf("invalid sender address: %w", err)
+		return nil, errorsmod.Wrapf(rps.ErrInvalidAddress, "invalid sender address: %s", err)
 	}
 
-	if !utils.MoveIsValid(msg.Move) {
-		return nil, errors.New("invalid move")
+	if err := ms.k.revealMove(ctx, playerAddr, msg.GameId, msg.Move, msg.Salt); err != nil {
+		return nil, err
 	}
 
-	// check if the game exists and that the reveal timeout hasn't passed
-	game, err := ms.k.Games.Get(ctx, msg.GameId)
+	return &rps.MsgRevealMoveResponse{}, nil
+}
+
+// SetRevealAgent implements rps.MsgServer.
+func (ms msgServer) SetRevealAgent(ctx context.Context, msg *rps.MsgSetRevealAgent) (*rps.MsgSetRevealAgentResponse, error) {
+	playerAddr, err := ms.k.addressCodec.StringToBytes(msg.Player)
+	if err != nil {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidAddress, "invalid player address: %s", err)
+	}
+
+	if msg.Agent == "" {
+		if err := ms.k.RevealAgents.Remove(ctx, playerAddr); err != nil {
+			return nil, err
+		}
+
+		return &rps.MsgSetRevealAgentResponse{}, nil
+	}
+
+	agentAddr, err := ms.k.addressCodec.StringToBytes(msg.Agent)
 	if err != nil {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidAddress, "invalid agent address: %s", err)
+	}
+
+	if bytes.Equal(playerAddr, agentAddr) {
+		return nil, errorsmod.Wrap(rps.ErrInvalidPlayers, "player can't be their own reveal agent")
+	}
+
+	if err := ms.k.RevealAgents.Set(ctx, playerAddr, agentAddr); err != nil {
 		return nil, err
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	if !game.RevealTimeout.IsZero() && sdkCtx.BlockTime().After(game.RevealTimeout) {
-		return nil, errors.New("reveal timeout has passed")
+	return &rps.MsgSetRevealAgentResponse{}, nil
+}
+
+// EscrowReveal implements rps.MsgServer.
+func (ms msgServer) EscrowReveal(ctx context.Context, msg *rps.MsgEscrowReveal) (*rps.MsgEscrowRevealResponse, error) {
+	playerAddr, err := ms.k.addressCodec.StringToBytes(msg.Player)
+	if err != nil {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidAddress, "invalid player address: %s", err)
+	}
+
+	if len(msg.EncryptedReveal) == 0 {
+		return nil, errorsmod.Wrap(rps.ErrInvalidEncryptedReveal, "encrypted reveal can't be empty")
 	}
 
-	// check if the player is part of the game
-	moveCommit, err := ms.k.MoveCommits.Get(ctx, collections.Join(msg.GameId, playerAddr))
+	if len(msg.EncryptedReveal) > rps.MaxEncryptedRevealSize {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidEncryptedReveal, "encrypted reveal is too large, max %d bytes", rps.MaxEncryptedRevealSize)
+	}
+
+	// the player must be in the game and not have revealed yet
+	commit, err := ms.k.MoveCommits.Get(ctx, collections.Join(msg.GameId, playerAddr))
 	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(rps.ErrNotPlayer, "player is not in game %d", msg.GameId)
+		}
+
 		return nil, err
 	}
 
-	// check if the game is full, if it is, we allow the player to reveal their move
-	players := 0
-	rng := collections.NewPrefixedPairRange[uint64, []byte](msg.GameId)
-	err = ms.k.MoveCommits.Walk(ctx, rng, func(key collections.Pair[uint64, []byte], value rps.MoveCommit) (stop bool, err error) {
-		players++
-		return false, nil
-	})
+	revealed, err := ms.k.MoveReveals.Has(ctx, collections.Join(msg.GameId, playerAddr))
+	if err != nil {
+		return nil, err
+	}
+
+	if revealed {
+		return nil, rps.ErrMoveAlreadyRevealed
+	}
+
+	commit.EncryptedReveal = msg.EncryptedReveal
+	if err := ms.k.MoveCommits.Set(ctx, collections.Join(msg.GameId, playerAddr), commit); err != nil {
+		return nil, err
+	}
+
+	return &rps.MsgEscrowRevealResponse{}, nil
+}
+
+// AgentRevealMove implements rps.MsgServer.
+func (ms msgServer) AgentRevealMove(ctx context.Context, msg *rps.MsgAgentRevealMove) (*rps.MsgAgentRevealMoveResponse, error) {
+	agentAddr, err := ms.k.addressCodec.StringToBytes(msg.Agent)
+	if err != nil {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidAddress, "invalid agent address: %s", err)
+	}
+
+	playerAddr, err := ms.k.addressCodec.StringToBytes(msg.Player)
 	if err != nil {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidAddress, "invalid player address: %s", err)
+	}
+
+	if err := ms.k.checkRevealAgent(ctx, playerAddr, agentAddr); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.revealMove(ctx, playerAddr, msg.GameId, msg.Move, msg.Salt); err != nil {
 		return nil, err
 	}
 
-	if players != 2 {
-		return nil, errors.New("please wait until the game is full")
+	return &rps.MsgAgentRevealMoveResponse{}, nil
+}
+
+// JoinQueue implements rps.MsgServer.
+func (ms msgServer) JoinQueue(ctx context.Context, msg *rps.MsgJoinQueue) (*rps.MsgJoinQueueResponse, error) {
+	if err := rps.ValidateCommit(msg.Commit); err != nil {
+		return nil, err
 	}
 
 	params, err := ms.k.Params.Get(ctx)
@@ -186,63 +406,564 @@ func (ms msgServer) RevealMove(ctx context.Context, msg *rps.MsgRevealMove) (*rp
 		return nil, err
 	}
 
-	// store reveal timeout if it's the first reveal
-	if game.RevealTimeout.IsZero() {
-		game.RevealTimeout = sdkCtx.BlockTime().Add(time.Second * time.Duration(params.RevealTimeout))
-		if err := ms.k.Games.Set(ctx, msg.GameId, game); err != nil {
+	if err := params.ValidateCommitmentScheme(msg.CommitmentScheme); err != nil {
+		return nil, err
+	}
+
+	// the queue matches on a single denom, games created from it have single coin entry fees
+	if err := params.ValidateEntryFee(sdk.Coins{msg.EntryFee}); err != nil {
+		return nil, err
+	}
+
+	// without a minimum, the player only accepts games for exactly the entry fee
+	minFee := msg.MinEntryFee
+	if minFee.Amount.IsNil() || minFee.IsZero() {
+		minFee = msg.EntryFee
+	}
+
+	if minFee.Denom != msg.EntryFee.Denom {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidCoins, "min entry fee denom %s doesn't match entry fee denom %s", minFee.Denom, msg.EntryFee.Denom)
+	}
+
+	if msg.EntryFee.IsLT(minFee) {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidCoins, "min entry fee %s is greater than entry fee %s", minFee, msg.EntryFee)
+	}
+
+	playerAddr, err := ms.k.addressCodec.StringToBytes(msg.Player)
+	if err != nil {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidAddress, "invalid player address: %s", err)
+	}
+
+	if err := ms.k.checkNoShowCooldown(ctx, playerAddr); err != nil {
+		return nil, err
+	}
+
+	// a player waits in the queue once for each denom, which keeps the stake
+	// ranges of the entries from overlapping (see findQueueMatch)
+	if _, err := ms.k.Queue.Indexes.Player.MatchExact(ctx, collections.Join(msg.EntryFee.Denom, playerAddr)); err == nil {
+		return nil, errorsmod.Wrapf(rps.ErrAlreadyJoined, "player is already waiting in the queue for %s", msg.EntryFee.Denom)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	// the maximum entry fee is escrowed both if the player is matched or enqueued
+	err = ms.k.bankKeeper.SendCoinsFromAccountToModule(ctx, playerAddr, rps.ModuleName, sdk.NewCoins(msg.EntryFee))
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	opponent, stake, found, err := ms.k.findQueueMatch(ctx, minFee, msg.EntryFee)
+	if err != nil {
+		return nil, err
+	}
+
+	// nobody is waiting for a game within this stake range, let's wait in the queue
+	if !found {
+		id, err := ms.k.QueueEntryID.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		entry := rps.QueueEntry{
+			Id:          id,
+			Player:      msg.Player,
+			Commit:      msg.Commit,
+			EntryFee:    msg.EntryFee,
+			MinEntryFee: minFee,
+			CreatedAt:   sdkCtx.BlockTime(),
+			Scheme:      msg.CommitmentScheme,
+		}
+
+		if err := ms.k.Queue.Set(ctx, id, entry); err != nil {
 			return nil, err
 		}
+
+		return &rps.MsgJoinQueueResponse{EntryId: id}, nil
 	}
 
-	// check if the move has already been revealed
-	revealed, err := ms.k.MoveReveals.Has(ctx, collections.Join(msg.GameId, playerAddr))
+	// we have a match, the opponent leaves the queue and the game starts with both players
+	if err := ms.k.Queue.Remove(ctx, opponent.Id); err != nil {
+		return nil, err
+	}
+
+	opponentAddr, err := ms.k.addressCodec.StringToBytes(opponent.Player)
 	if err != nil {
 		return nil, err
 	}
 
-	if revealed {
-		return nil, errors.New("move already revealed")
+	// refund what both players escrowed over the stake of the game
+	for _, p := range []struct {
+		addr     []byte
+		escrowed sdk.Coin
+	}{{playerAddr, msg.EntryFee}, {opponentAddr, opponent.EntryFee}} {
+		if excess := p.escrowed.Sub(stake); excess.IsPositive() {
+			if err := ms.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, rps.ModuleName, p.addr, sdk.NewCoins(excess)); err != nil {
+				return nil, err
+			}
+		}
 	}
 
-	// all good, let's reveal the move
-	// calculate the move's commitment, must match the one stored
-	commit := utils.CalculateCommitment(msg.Move, msg.Salt)
-	if commit != moveCommit.Commit {
-		return nil, errors.New("move doesn't match commitment, are you a cheater?")
+	game, err := ms.k.createGame(ctx, opponentAddr, sdk.NewCoins(stake), nil, 0, 0)
+	if err != nil {
+		return nil, err
 	}
 
-	// store the reveal
-	reveal := rps.MoveReveal{
-		Move:      msg.Move,
-		Salt:      msg.Salt,
-		CreatedAt: sdkCtx.BlockTime(),
+	err = ms.k.MoveCommits.Set(ctx, collections.Join(game.Id, opponentAddr), rps.MoveCommit{Commit: opponent.Commit, CreatedAt: opponent.CreatedAt, Stake: game.EntryFee, Scheme: opponent.Scheme})
+	if err != nil {
+		return nil, err
 	}
 
-	err = ms.k.MoveReveals.Set(ctx, collections.Join(msg.GameId, playerAddr), reveal)
+	err = ms.k.MoveCommits.Set(ctx, collections.Join(game.Id, playerAddr), rps.MoveCommit{Commit: msg.Commit, CreatedAt: sdkCtx.BlockTime(), Stake: game.EntryFee, Scheme: msg.CommitmentScheme})
 	if err != nil {
 		return nil, err
 	}
 
-	return &rps.MsgRevealMoveResponse{}, nil
+	// the opponent may not be online when they're matched, they get a grace
+	// period on top of the reveal window to notice the game
+	if game.UsesHeightTimeouts() {
+		game.RevealDuration += params.QueueMatchGraceBlocks
+	} else {
+		game.RevealDuration += params.QueueMatchGrace
+	}
+
+	if _, err := ms.k.startRevealWindow(ctx, game); err != nil {
+		return nil, err
+	}
+
+	return &rps.MsgJoinQueueResponse{Matched: true, GameId: game.Id}, nil
 }
 
-// UpdateParams params is defining the handler for the MsgUpdateParams message.
-func (ms msgServer) UpdateParams(ctx context.Context, msg *rps.MsgUpdateParams) (*rps.MsgUpdateParamsResponse, error) {
-	if _, err := ms.k.addressCodec.StringToBytes(msg.Authority); err != nil {
-		return nil, fmt.Errorf("invalid authority address: %w", err)
+// LeaveQueue implements rps.MsgServer.
+func (ms msgServer) LeaveQueue(ctx context.Context, msg *rps.MsgLeaveQueue) (*rps.MsgLeaveQueueResponse, error) {
+	playerAddr, err := ms.k.addressCodec.StringToBytes(msg.Player)
+	if err != nil {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidAddress, "invalid player address: %s", err)
 	}
 
-	if authority := ms.k.GetAuthority(); !strings.EqualFold(msg.Authority, authority) {
-		return nil, fmt.Errorf("unauthorized, authority does not match the module's authority: got %s, want %s", msg.Authority, authority)
+	entry, err := ms.k.Queue.Get(ctx, msg.EntryId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "queue entry %d not found", msg.EntryId)
 	}
 
-	if err := msg.Params.Validate(); err != nil {
+	entryAddr, err := ms.k.addressCodec.StringToBytes(entry.Player)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(entryAddr, playerAddr) {
+		return nil, errorsmod.Wrap(rps.ErrUnauthorized, "queue entry belongs to another player")
+	}
+
+	if err := ms.k.Queue.Remove(ctx, msg.EntryId); err != nil {
 		return nil, err
 	}
 
+	if err := ms.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, rps.ModuleName, playerAddr, sdk.NewCoins(entry.EntryFee)); err != nil {
+		return nil, err
+	}
+
+	return &rps.MsgLeaveQueueResponse{}, nil
+}
+
+// PlaceBet implements rps.MsgServer.
+func (ms msgServer) PlaceBet(ctx context.Context, msg *rps.MsgPlaceBet) (*rps.MsgPlaceBetResponse, error) {
+	bettorAddr, err := ms.k.addressCodec.StringToBytes(msg.Bettor)
+	if err != nil {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidAddress, "invalid bettor address: %s", err)
+	}
+
+	if msg.Outcome == rps.BetOutcome_BET_OUTCOME_UNSPECIFIED {
+		return nil, errorsmod.Wrap(rps.ErrInvalidBetOutcome, "bet outcome must be specified")
+	}
+
+	if _, ok := rps.BetOutcome_name[int32(msg.Outcome)]; !ok {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidBetOutcome, "unknown bet outcome %d", msg.Outcome)
+	}
+
+	if err := msg.Amount.Validate(); err != nil {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidCoins, "invalid bet amount: %s", err)
+	}
+
+	if msg.Amount.IsZero() {
+		return nil, errorsmod.Wrap(rps.ErrInvalidCoins, "bet amount must be positive")
+	}
+
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, coin := range msg.Amount {
+		if !params.IsDenomAllowed(coin.Denom) {
+			return nil, errorsmod.Wrapf(rps.ErrInvalidCoins, "denom %s is not allowed in bets", coin.Denom)
+		}
+	}
+
+	game, err := ms.k.Games.Get(ctx, msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	if game.Creator == "" {
+		return nil, errorsmod.Wrapf(rps.ErrNotAllowed, "game %d doesn't accept bets", msg.GameId)
+	}
+
+	// bets are accepted until the commit phase closes, either because the
+	// game is full or because the commit timeout passed
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	players, err := ms.k.committedPlayers(ctx, msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(players) >= 2 || game.CommitTimedOut(sdkCtx.BlockTime(), sdkCtx.BlockHeight()) {
+		return nil, errorsmod.Wrapf(rps.ErrDeadlinePassed, "betting is closed for game %d", msg.GameId)
+	}
+
+	for _, player := range players {
+		if bytes.Equal(player, bettorAddr) {
+			return nil, errorsmod.Wrap(rps.ErrInvalidPlayers, "players can't bet on their own game")
+		}
+	}
+
+	bet, err := ms.k.Bets.Get(ctx, collections.Join(msg.GameId, bettorAddr))
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		bet = rps.Bet{Outcome: msg.Outcome, CreatedAt: sdkCtx.BlockTime()}
+	case err != nil:
+		return nil, err
+	case bet.Outcome != msg.Outcome:
+		return nil, errorsmod.Wrapf(rps.ErrNotAllowed, "bettor already bet on %s in this game", bet.Outcome)
+	}
+
+	if err := ms.k.bankKeeper.SendCoinsFromAccountToModule(ctx, bettorAddr, rps.ModuleName, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	bet.Amount = bet.Amount.Add(msg.Amount...)
+	if err := ms.k.Bets.Set(ctx, collections.Join(msg.GameId, bettorAddr), bet); err != nil {
+		return nil, err
+	}
+
+	return &rps.MsgPlaceBetResponse{}, nil
+}
+
+// PlayHouse implements rps.MsgServer.
+func (ms msgServer) PlayHouse(ctx context.Context, msg *rps.MsgPlayHouse) (*rps.MsgPlayHouseResponse, error) {
+	if err := rps.ValidateCommit(msg.Commit); err != nil {
+		return nil, err
+	}
+
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := params.ValidateCommitmentScheme(msg.CommitmentScheme); err != nil {
+		return nil, err
+	}
+
+	if err := params.ValidateEntryFee(msg.EntryFee); err != nil {
+		return nil, err
+	}
+
+	if params.HouseMaxExposure.Empty() {
+		return nil, errorsmod.Wrap(rps.ErrNotAllowed, "house games are disabled")
+	}
+
+	if !msg.EntryFee.IsAllLTE(params.HouseMaxExposure) {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidCoins, "entry fee %s exceeds the house max exposure %s", msg.EntryFee, params.HouseMaxExposure)
+	}
+
+	playerAddr, err := ms.k.addressCodec.StringToBytes(msg.Player)
+	if err != nil {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidAddress, "invalid player address: %s", err)
+	}
+
+	if err := ms.k.checkNoShowCooldown(ctx, playerAddr); err != nil {
+		return nil, err
+	}
+
+	// the house matches the entry fee with funds from the bankroll
+	if err := ms.k.reserveHouseStake(ctx, params, msg.EntryFee); err != nil {
+		return nil, err
+	}
+
+	err = ms.k.bankKeeper.SendCoinsFromAccountToModule(ctx, playerAddr, rps.ModuleName, msg.EntryFee)
+	if err != nil {
+		return nil, err
+	}
+
+	game, err := ms.k.createGame(ctx, playerAddr, msg.EntryFee, nil, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	game.House = true
+
+	// the house move is derived from the hash of the next block, which the
+	// player can't know when signing the commitment, see seedHouseGames
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := ms.k.HouseGamesToSeed.Set(ctx, collections.Join(sdkCtx.BlockHeight(), game.Id)); err != nil {
+		return nil, err
+	}
+
+	err = ms.k.MoveCommits.Set(ctx, collections.Join(game.Id, playerAddr), rps.MoveCommit{Commit: msg.Commit, CreatedAt: sdkCtx.BlockTime(), Stake: msg.EntryFee, Scheme: msg.CommitmentScheme})
+	if err != nil {
+		return nil, err
+	}
+
+	// the house takes the second seat, so the game is full right away
+	err = ms.k.MoveCommits.Set(ctx, collections.Join(game.Id, houseAddress()), rps.MoveCommit{CreatedAt: sdkCtx.BlockTime(), Stake: msg.EntryFee})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := ms.k.startRevealWindow(ctx, game); err != nil {
+		return nil, err
+	}
+
+	return &rps.MsgPlayHouseResponse{GameId: game.Id}, nil
+}
+
+// FundHouse implements rps.MsgServer.
+func (ms msgServer) FundHouse(ctx context.Context, msg *rps.MsgFundHouse) (*rps.MsgFundHouseResponse, error) {
+	if err := ms.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Amount.Validate(); err != nil {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidCoins, "invalid amount: %s", err)
+	}
+
+	if msg.Amount.IsZero() {
+		return nil, errorsmod.Wrap(rps.ErrInvalidCoins, "amount must be positive")
+	}
+
+	// the bankroll is funded by the community pool, the app must allow the
+	// module account to receive funds
+	if err := ms.k.distrKeeper.DistributeFromFeePool(ctx, msg.Amount, houseAddress()); err != nil {
+		return nil, err
+	}
+
+	if err := addToBalance(ctx, ms.k.HouseBankroll, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &rps.MsgFundHouseResponse{}, nil
+}
+
+// OfferRematch implements rps.MsgServer.
+func (ms msgServer) OfferRematch(ctx context.Context, msg *rps.MsgOfferRematch) (*rps.MsgOfferRematchResponse, error) {
+	if err := rps.ValidateCommit(msg.Commit); err != nil {
+		return nil, err
+	}
+
+	playerAddr, err := ms.k.addressCodec.StringToBytes(msg.Player)
+	if err != nil {
+		return nil, errorsmod.Wrapf(rps.ErrInvalidAddress, "invalid player address: %s", err)
+	}
+
+	settled, err := ms.k.SettledGames.Get(ctx, msg.GameId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(rps.ErrNotFound, "game %d can't be rematched", msg.GameId)
+		}
+
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !sdkCtx.BlockTime().Before(settled.ExpiresAt) {
+		return nil, errorsmod.Wrapf(rps.ErrDeadlinePassed, "rematch window of game %d is over", msg.GameId)
+	}
+
+	// the offerer keeps their own stake and the opponent is reserved a seat
+	// with theirs
+	creatorAddr, err := ms.k.addressCodec.StringToBytes(settled.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	challengerAddr, err := ms.k.addressCodec.StringToBytes(settled.Challenger)
+	if err != nil {
+		return nil, err
+	}
+
+	var stake, opponentStake sdk.Coins
+	var player, opponent string
+	switch {
+	case bytes.Equal(playerAddr, creatorAddr):
+		stake, opponentStake = settled.CreatorStake, settled.ChallengerStake
+		player, opponent = settled.Creator, settled.Challenger
+	case bytes.Equal(playerAddr, challengerAddr):
+		stake, opponentStake = settled.ChallengerStake, settled.CreatorStake
+		player, opponent = settled.Challenger, settled.Creator
+	default:
+		return nil, errorsmod.Wrapf(rps.ErrNotPlayer, "player didn't play game %d", msg.GameId)
+	}
+
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := params.ValidateCommitmentScheme(msg.CommitmentScheme); err != nil {
+		return nil, err
+	}
+
+	// the allowed denoms may have changed since the game was played
+	if err := params.ValidateEntryFee(stake); err != nil {
+		return nil, err
+	}
+
+	if err := params.ValidateEntryFee(opponentStake); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid opponent stake")
+	}
+
+	if err := ms.k.checkNoShowCooldown(ctx, playerAddr); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.bankKeeper.SendCoinsFromAccountToModule(ctx, playerAddr, rps.ModuleName, stake); err != nil {
+		return nil, err
+	}
+
+	// a settled game can only be rematched once
+	if err := ms.k.removeSettledGame(ctx, settled); err != nil {
+		return nil, err
+	}
+
+	game, err := ms.k.storeNewGame(ctx, rps.Game{
+		EntryFee:       stake,
+		ChallengerFee:  opponentStake,
+		Creator:        player,
+		TimeoutMode:    settled.TimeoutMode,
+		CommitDuration: settled.CommitDuration,
+		RevealDuration: settled.RevealDuration,
+		ReservedFor:    opponent,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	commit := rps.MoveCommit{
+		Commit:    msg.Commit,
+		CreatedAt: sdkCtx.BlockTime(),
+		Stake:     stake,
+		Scheme:    msg.CommitmentScheme,
+	}
+
+	if err := ms.k.MoveCommits.Set(ctx, collections.Join(game.Id, playerAddr), commit); err != nil {
+		return nil, err
+	}
+
+	return &rps.MsgOfferRematchResponse{GameId: game.Id}, nil
+}
+
+// UpdateParams params is defining the handler for the MsgUpdateParams message.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *rps.MsgUpdateParams) (*rps.MsgUpdateParamsResponse, error) {
+	if err := ms.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(rps.ErrInvalidParams, err.Error())
+	}
+
 	if err := ms.k.Params.Set(ctx, msg.Params); err != nil {
 		return nil, err
 	}
 
 	return &rps.MsgUpdateParamsResponse{}, nil
 }
+
+// ResolveStuckGame defines the handler for the MsgResolveStuckGame message.
+func (ms msgServer) ResolveStuckGame(ctx context.Context, msg *rps.MsgResolveStuckGame) (*rps.MsgResolveStuckGameResponse, error) {
+	if err := ms.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	game, err := ms.k.StuckGames.Get(ctx, msg.GameId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "stuck game %d not found", msg.GameId)
+	}
+
+	if msg.Refund {
+		if err := game.EntryFee.Validate(); err != nil {
+			return nil, errorsmod.Wrap(err, "cannot refund game with invalid entry fee")
+		}
+
+		players, err := ms.k.committedPlayers(ctx, msg.GameId)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, player := range players {
+			stake, err := ms.k.playerStake(ctx, game, player)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "cannot refund game")
+			}
+
+			// the stake of the house goes back to the bankroll
+			if err := ms.k.payStake(ctx, player, stake); err != nil {
+				return nil, err
+			}
+		}
+
+		params, err := ms.k.Params.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// without an outcome the spectator bets are refunded
+		if err := ms.k.settleBets(ctx, params, game, rps.BetOutcome_BET_OUTCOME_UNSPECIFIED); err != nil {
+			return nil, errorsmod.Wrap(err, "cannot refund bets")
+		}
+	}
+
+	// either way the house no longer has a stake in the game
+	if game.House {
+		stake, err := ms.k.playerStake(ctx, game, houseAddress())
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "cannot release the house stake")
+		}
+
+		if err := ms.k.releaseHouseStake(ctx, stake); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ms.k.StuckGames.Remove(ctx, msg.GameId); err != nil {
+		return nil, err
+	}
+
+	// the game is no longer in the games map, removing it again is a no-op
+	if err := ms.k.removeGame(ctx, msg.GameId); err != nil {
+		return nil, err
+	}
+
+	return &rps.MsgResolveStuckGameResponse{}, nil
+}
+
+// checkAuthority returns an error if the signer of a message isn't the
+// authority of the module, comparing the decoded addresses.
+func (ms msgServer) checkAuthority(signer string) error {
+	signerAddr, err := ms.k.addressCodec.StringToBytes(signer)
+	if err != nil {
+		return errorsmod.Wrapf(rps.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	authority := ms.k.GetAuthority()
+	authorityAddr, err := ms.k.addressCodec.StringToBytes(authority)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(signerAddr, authorityAddr) {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", authority, signer)
+	}
+
+	return nil
+}
@@ -2,10 +2,19 @@ package keeper_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	"cosmossdk.io/collections"
 	"github.com/facundomedica/rps"
+	"github.com/facundomedica/rps/utils"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func TestUpdateParams(t *testing.T) {
@@ -29,7 +38,23 @@ func TestUpdateParams(t *testing.T) {
 			request: &rps.MsgUpdateParams{
 				Authority: f.addrs[1].String(),
 			},
-			expectErrMsg: fmt.Sprintf("unauthorized, authority does not match the module's authority: got %s, want %s", f.addrs[1].String(), f.k.GetAuthority()),
+			expectErrMsg: fmt.Sprintf("invalid authority; expected %s, got %s", f.k.GetAuthority(), f.addrs[1].String()),
+		},
+		{
+			name: "set invalid params (height timeouts without blocks)",
+			request: &rps.MsgUpdateParams{
+				Authority: f.k.GetAuthority(),
+				Params:    rps.Params{TimeoutMode: rps.TimeoutMode_TIMEOUT_MODE_HEIGHT},
+			},
+			expectErrMsg: "commit and reveal timeouts in blocks must be positive",
+		},
+		{
+			name: "set invalid params (unsupported commitment scheme)",
+			request: &rps.MsgUpdateParams{
+				Authority: f.k.GetAuthority(),
+				Params:    rps.Params{CommitmentSchemes: []rps.CommitmentScheme{rps.CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED}},
+			},
+			expectErrMsg: "invalid commitment scheme: COMMITMENT_SCHEME_UNSPECIFIED",
 		},
 		{
 			name: "set valid params",
@@ -55,6 +80,916 @@ func TestUpdateParams(t *testing.T) {
 	}
 }
 
+func TestResolveStuckGame(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	fee := sdk.NewInt64Coin("stake", 10)
+	f.bankKeeper.balances[authtypes.NewModuleAddress(rps.ModuleName).String()] = sdk.NewCoins(fee)
+	f.bankKeeper.balances[f.addrs[1].String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 990))
+	require.NoError(f.k.StuckGames.Set(f.ctx, 1, rps.Game{Id: 1, EntryFee: sdk.NewCoins(fee)}))
+	require.NoError(f.k.MoveCommits.Set(f.ctx, collections.Join(uint64(1), f.addrs[1].Bytes()), rps.MoveCommit{}))
+
+	testCases := []struct {
+		name         string
+		request      *rps.MsgResolveStuckGame
+		expectErrMsg string
+	}{
+		{
+			name: "set invalid authority (not defined authority)",
+			request: &rps.MsgResolveStuckGame{
+				Authority: f.addrs[1].String(),
+				GameId:    1,
+			},
+			expectErrMsg: "invalid authority; expected",
+		},
+		{
+			name: "game not stuck",
+			request: &rps.MsgResolveStuckGame{
+				Authority: f.k.GetAuthority(),
+				GameId:    2,
+			},
+			expectErrMsg: "stuck game 2 not found",
+		},
+		{
+			name: "refund stuck game",
+			request: &rps.MsgResolveStuckGame{
+				Authority: f.k.GetAuthority(),
+				GameId:    1,
+				Refund:    true,
+			},
+			expectErrMsg: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := f.msgServer.ResolveStuckGame(f.ctx, tc.request)
+			if tc.expectErrMsg != "" {
+				require.Error(err)
+				require.ErrorContains(err, tc.expectErrMsg)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), f.bankKeeper.balances[f.addrs[1].String()])
+
+	has, err := f.k.StuckGames.Has(f.ctx, 1)
+	require.NoError(err)
+	require.False(has)
+
+	has, err = f.k.MoveCommits.Has(f.ctx, collections.Join(uint64(1), f.addrs[1].Bytes()))
+	require.NoError(err)
+	require.False(has)
+}
+
+func TestNewGameTimeoutOverrides(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	require.NoError(f.k.Params.Set(f.ctx, rps.Params{CommitTimeout: 60, RevealTimeout: 60, MinTimeout: 30, MaxTimeout: 86400}))
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	testCases := []struct {
+		name           string
+		commitTimeout  uint64
+		revealTimeout  uint64
+		expectErrMsg   string
+		expectedCommit uint64
+		expectedReveal uint64
+	}{
+		{
+			name:           "module defaults",
+			expectedCommit: 60,
+			expectedReveal: 60,
+		},
+		{
+			name:           "override reveal timeout",
+			revealTimeout:  86400,
+			expectedCommit: 60,
+			expectedReveal: 86400,
+		},
+		{
+			name:          "timeout below min",
+			commitTimeout: 10,
+			expectErrMsg:  "timeout 10 out of bounds",
+		},
+		{
+			name:          "timeout above max",
+			revealTimeout: 86401,
+			expectErrMsg:  "timeout 86401 out of bounds",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := f.msgServer.NewGame(ctx, &rps.MsgNewGame{
+				Player:        f.addrs[1].String(),
+				Commit:        utils.CalculateCommitment("rock", salt0),
+				EntryFee:      sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+				CommitTimeout: tc.commitTimeout,
+				RevealTimeout: tc.revealTimeout,
+			})
+			if tc.expectErrMsg != "" {
+				require.Error(err)
+				require.ErrorContains(err, tc.expectErrMsg)
+				return
+			}
+
+			require.NoError(err)
+			game, err := f.k.Games.Get(ctx, res.GameId)
+			require.NoError(err)
+			require.Equal(tc.expectedCommit, game.CommitDuration)
+			require.Equal(tc.expectedReveal, game.RevealDuration)
+			require.Equal(time.Unix(1000+int64(tc.expectedCommit), 0).UTC(), game.CommitTimeout.UTC())
+		})
+	}
+}
+
+func TestMatchmakingQueue(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	fee := sdk.NewInt64Coin("stake", 10)
+
+	// the first player waits in the queue
+	res, err := f.msgServer.JoinQueue(f.ctx, &rps.MsgJoinQueue{
+		Player:   f.addrs[1].String(),
+		Commit:   utils.CalculateCommitment("rock", salt1),
+		EntryFee: fee,
+	})
+	require.NoError(err)
+	require.False(res.Matched)
+	firstEntry := res.EntryId
+
+	// the same player can only wait once for each denom
+	_, err = f.msgServer.JoinQueue(f.ctx, &rps.MsgJoinQueue{
+		Player:   f.addrs[1].String(),
+		Commit:   utils.CalculateCommitment("paper", salt1),
+		EntryFee: sdk.NewInt64Coin("stake", 20),
+	})
+	require.ErrorContains(err, "player is already waiting in the queue for stake")
+
+	// a different fee doesn't match
+	res, err = f.msgServer.JoinQueue(f.ctx, &rps.MsgJoinQueue{
+		Player:   f.addrs[0].String(),
+		Commit:   utils.CalculateCommitment("paper", salt0),
+		EntryFee: sdk.NewInt64Coin("stake", 20),
+	})
+	require.NoError(err)
+	require.False(res.Matched)
+	otherFeeEntry := res.EntryId
+
+	// a player with the same fee is paired with the waiting entry, the reveal
+	// window includes the grace period of the waiting player
+	require.NoError(f.k.Params.Set(f.ctx, rps.Params{CommitTimeout: 60, RevealTimeout: 60, QueueMatchGrace: 300}))
+	res, err = f.msgServer.JoinQueue(f.ctx, &rps.MsgJoinQueue{
+		Player:   f.addrs[2].String(),
+		Commit:   utils.CalculateCommitment("scissors", salt2),
+		EntryFee: fee,
+	})
+	require.NoError(err)
+	require.True(res.Matched)
+
+	game, err := f.k.Games.Get(f.ctx, res.GameId)
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(fee), game.EntryFee)
+	require.Equal(f.ctx.BlockTime().Add(360*time.Second), game.RevealTimeout)
+
+	commit, err := f.k.MoveCommits.Get(f.ctx, collections.Join(res.GameId, f.addrs[1].Bytes()))
+	require.NoError(err)
+	require.Equal(rps.HexBytes(utils.CalculateCommitment("rock", salt1)), commit.Commit)
+
+	has, err := f.k.Queue.Has(f.ctx, firstEntry)
+	require.NoError(err)
+	require.False(has)
+
+	// only the owner can leave the queue with an entry
+	_, err = f.msgServer.LeaveQueue(f.ctx, &rps.MsgLeaveQueue{Player: f.addrs[2].String(), EntryId: otherFeeEntry})
+	require.ErrorContains(err, "queue entry belongs to another player")
+
+	_, err = f.msgServer.LeaveQueue(f.ctx, &rps.MsgLeaveQueue{Player: f.addrs[0].String(), EntryId: otherFeeEntry})
+	require.NoError(err)
+
+	queue, err := f.queryServer.Queue(f.ctx, &rps.QueryQueueRequest{})
+	require.NoError(err)
+	require.Empty(queue.Entries)
+
+	// only the entry fees of the game remain escrowed
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), f.bankKeeper.balances[f.addrs[0].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 990)), f.bankKeeper.balances[f.addrs[1].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 990)), f.bankKeeper.balances[f.addrs[2].String()])
+}
+
+func TestMatchmakingQueueStakeRange(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	// the first player plays for 10 to 50
+	res, err := f.msgServer.JoinQueue(f.ctx, &rps.MsgJoinQueue{
+		Player:      f.addrs[1].String(),
+		Commit:      utils.CalculateCommitment("rock", salt1),
+		EntryFee:    sdk.NewInt64Coin("stake", 50),
+		MinEntryFee: sdk.NewInt64Coin("stake", 10),
+	})
+	require.NoError(err)
+	require.False(res.Matched)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 950)), f.bankKeeper.balances[f.addrs[1].String()])
+
+	// a range that doesn't overlap isn't matched
+	res, err = f.msgServer.JoinQueue(f.ctx, &rps.MsgJoinQueue{
+		Player:      f.addrs[0].String(),
+		Commit:      utils.CalculateCommitment("paper", salt0),
+		EntryFee:    sdk.NewInt64Coin("stake", 100),
+		MinEntryFee: sdk.NewInt64Coin("stake", 60),
+	})
+	require.NoError(err)
+	require.False(res.Matched)
+	highEntry := res.EntryId
+
+	// the second player plays for 20 to 40, the game is played for 40
+	res, err = f.msgServer.JoinQueue(f.ctx, &rps.MsgJoinQueue{
+		Player:      f.addrs[2].String(),
+		Commit:      utils.CalculateCommitment("paper", salt2),
+		EntryFee:    sdk.NewInt64Coin("stake", 40),
+		MinEntryFee: sdk.NewInt64Coin("stake", 20),
+	})
+	require.NoError(err)
+	require.True(res.Matched)
+
+	game, err := f.k.Games.Get(f.ctx, res.GameId)
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), game.EntryFee)
+
+	// the excess escrow of the first player is refunded
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 960)), f.bankKeeper.balances[f.addrs[1].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 960)), f.bankKeeper.balances[f.addrs[2].String()])
+
+	// with several entries in range, the one with the highest stake is matched
+	res, err = f.msgServer.JoinQueue(f.ctx, &rps.MsgJoinQueue{
+		Player:      f.addrs[1].String(),
+		Commit:      utils.CalculateCommitment("rock", salt1),
+		EntryFee:    sdk.NewInt64Coin("stake", 20),
+		MinEntryFee: sdk.NewInt64Coin("stake", 10),
+	})
+	require.NoError(err)
+	require.False(res.Matched)
+
+	res, err = f.msgServer.JoinQueue(f.ctx, &rps.MsgJoinQueue{
+		Player:      f.addrs[2].String(),
+		Commit:      utils.CalculateCommitment("paper", salt2),
+		EntryFee:    sdk.NewInt64Coin("stake", 80),
+		MinEntryFee: sdk.NewInt64Coin("stake", 15),
+	})
+	require.NoError(err)
+	require.True(res.Matched)
+
+	has, err := f.k.Queue.Has(f.ctx, highEntry)
+	require.NoError(err)
+	require.False(has)
+
+	game, err = f.k.Games.Get(f.ctx, res.GameId)
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 80)), game.EntryFee)
+
+	// invalid ranges are rejected
+	_, err = f.msgServer.JoinQueue(f.ctx, &rps.MsgJoinQueue{
+		Player:      f.addrs[1].String(),
+		Commit:      utils.CalculateCommitment("rock", salt1),
+		EntryFee:    sdk.NewInt64Coin("stake", 10),
+		MinEntryFee: sdk.NewInt64Coin("stake", 20),
+	})
+	require.ErrorContains(err, "min entry fee 20stake is greater than entry fee 10stake")
+}
+
+func TestAllowedDenoms(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	require.NoError(f.k.Params.Set(f.ctx, rps.Params{CommitTimeout: 60, RevealTimeout: 60, AllowedDenoms: []string{"stake"}}))
+
+	testCases := []struct {
+		name         string
+		entryFee     sdk.Coins
+		expectErrMsg string
+	}{
+		{
+			name:     "allowed denom",
+			entryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		},
+		{
+			name:         "denom not allowed",
+			entryFee:     sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("atom", 10)),
+			expectErrMsg: "denom atom is not allowed in entry fees",
+		},
+		{
+			name:         "empty entry fee",
+			entryFee:     sdk.NewCoins(),
+			expectErrMsg: "entry fee must be positive",
+		},
+		{
+			name:         "unsorted entry fee",
+			entryFee:     sdk.Coins{sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("atom", 10)},
+			expectErrMsg: "invalid entry fee",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := f.msgServer.NewGame(f.ctx, &rps.MsgNewGame{
+				Player:   f.addrs[1].String(),
+				Commit:   utils.CalculateCommitment("rock", salt0),
+				EntryFee: tc.entryFee,
+			})
+			if tc.expectErrMsg != "" {
+				require.Error(err)
+				require.ErrorContains(err, tc.expectErrMsg)
+				return
+			}
+
+			require.NoError(err)
+		})
+	}
+
+	_, err := f.msgServer.JoinQueue(f.ctx, &rps.MsgJoinQueue{
+		Player:   f.addrs[1].String(),
+		Commit:   utils.CalculateCommitment("rock", salt0),
+		EntryFee: sdk.NewInt64Coin("atom", 10),
+	})
+	require.ErrorContains(err, "denom atom is not allowed in entry fees")
+}
+
+func TestPlaceBet(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	res, err := f.msgServer.NewGame(f.ctx, &rps.MsgNewGame{
+		Player:   f.addrs[1].String(),
+		Commit:   utils.CalculateCommitment("rock", salt0),
+		EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+	require.NoError(err)
+
+	_, err = f.msgServer.PlaceBet(f.ctx, &rps.MsgPlaceBet{
+		Bettor:  f.addrs[0].String(),
+		GameId:  res.GameId,
+		Outcome: rps.BetOutcome_BET_OUTCOME_DRAW,
+		Amount:  sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+	require.NoError(err)
+
+	testCases := []struct {
+		name         string
+		input        *rps.MsgPlaceBet
+		expectErrMsg string
+	}{
+		{
+			name: "unspecified outcome",
+			input: &rps.MsgPlaceBet{
+				Bettor: f.addrs[0].String(),
+				GameId: res.GameId,
+				Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			},
+			expectErrMsg: "bet outcome must be specified",
+		},
+		{
+			name: "empty amount",
+			input: &rps.MsgPlaceBet{
+				Bettor:  f.addrs[0].String(),
+				GameId:  res.GameId,
+				Outcome: rps.BetOutcome_BET_OUTCOME_DRAW,
+			},
+			expectErrMsg: "bet amount must be positive",
+		},
+		{
+			name: "player betting on their own game",
+			input: &rps.MsgPlaceBet{
+				Bettor:  f.addrs[1].String(),
+				GameId:  res.GameId,
+				Outcome: rps.BetOutcome_BET_OUTCOME_CHALLENGER,
+				Amount:  sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			},
+			expectErrMsg: "players can't bet on their own game",
+		},
+		{
+			name: "different outcome than the previous bet",
+			input: &rps.MsgPlaceBet{
+				Bettor:  f.addrs[0].String(),
+				GameId:  res.GameId,
+				Outcome: rps.BetOutcome_BET_OUTCOME_CREATOR,
+				Amount:  sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			},
+			expectErrMsg: "bettor already bet on BET_OUTCOME_DRAW in this game",
+		},
+		{
+			name: "same outcome adds to the bet",
+			input: &rps.MsgPlaceBet{
+				Bettor:  f.addrs[0].String(),
+				GameId:  res.GameId,
+				Outcome: rps.BetOutcome_BET_OUTCOME_DRAW,
+				Amount:  sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+			},
+		},
+		{
+			name: "game not found",
+			input: &rps.MsgPlaceBet{
+				Bettor:  f.addrs[0].String(),
+				GameId:  100,
+				Outcome: rps.BetOutcome_BET_OUTCOME_DRAW,
+				Amount:  sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			},
+			expectErrMsg: "not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := f.msgServer.PlaceBet(f.ctx, tc.input)
+			if tc.expectErrMsg != "" {
+				require.Error(err)
+				require.ErrorContains(err, tc.expectErrMsg)
+				return
+			}
+
+			require.NoError(err)
+		})
+	}
+
+	bet, err := f.k.Bets.Get(f.ctx, collections.Join(res.GameId, f.addrs[0].Bytes()))
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 15)), bet.Amount)
+
+	// bettors can't join the game they bet on
+	_, err = f.msgServer.CommitMove(f.ctx, &rps.MsgCommitMove{
+		Player: f.addrs[0].String(),
+		GameId: res.GameId,
+		Commit: utils.CalculateCommitment("paper", salt0),
+	})
+	require.ErrorContains(err, "player has a bet on this game")
+
+	// once the game is full betting is closed
+	_, err = f.msgServer.CommitMove(f.ctx, &rps.MsgCommitMove{
+		Player: f.addrs[2].String(),
+		GameId: res.GameId,
+		Commit: utils.CalculateCommitment("paper", salt0),
+	})
+	require.NoError(err)
+
+	_, err = f.msgServer.PlaceBet(f.ctx, &rps.MsgPlaceBet{
+		Bettor:  f.addrs[0].String(),
+		GameId:  res.GameId,
+		Outcome: rps.BetOutcome_BET_OUTCOME_DRAW,
+		Amount:  sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+	require.ErrorContains(err, "betting is closed")
+}
+
+func TestOfferRematch(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	require.NoError(f.k.Params.Set(f.ctx, rps.Params{CommitTimeout: 60, RevealTimeout: 60, RematchWindow: 100}))
+
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	// play creates a game between addrs[1] and addrs[2], where the creator
+	// offers odds, and settles it
+	play := func() uint64 {
+		res, err := f.msgServer.NewGame(ctx, &rps.MsgNewGame{
+			Player:        f.addrs[1].String(),
+			Commit:        utils.CalculateCommitment("rock", salt1),
+			EntryFee:      sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			ChallengerFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 20)),
+		})
+		require.NoError(err)
+
+		_, err = f.msgServer.CommitMove(ctx, &rps.MsgCommitMove{Player: f.addrs[2].String(), GameId: res.GameId, Commit: utils.CalculateCommitment("rock", salt2)})
+		require.NoError(err)
+
+		_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[1].String(), GameId: res.GameId, Move: rps.Move_MOVE_ROCK, Salt: salt1})
+		require.NoError(err)
+
+		_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[2].String(), GameId: res.GameId, Move: rps.Move_MOVE_ROCK, Salt: salt2})
+		require.NoError(err)
+
+		require.NoError(f.k.EndBlocker(ctx))
+		return res.GameId
+	}
+
+	gameID := play()
+	settled, err := f.queryServer.SettledGame(ctx, &rps.QuerySettledGameRequest{GameId: gameID})
+	require.NoError(err)
+	require.Equal(f.addrs[1].String(), settled.Game.Creator)
+	require.Equal(f.addrs[2].String(), settled.Game.Challenger)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), settled.Game.CreatorStake)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), settled.Game.ChallengerStake)
+	require.Equal(time.Unix(1100, 0).UTC(), settled.Game.ExpiresAt.UTC())
+
+	_, err = f.msgServer.OfferRematch(ctx, &rps.MsgOfferRematch{Player: f.addrs[0].String(), GameId: gameID, Commit: utils.CalculateCommitment("rock", salt0)})
+	require.ErrorContains(err, "player didn't play game")
+
+	// the challenger offers the rematch, keeping their stake. Addresses are
+	// compared decoded, so the uppercase form of the address works as well
+	res, err := f.msgServer.OfferRematch(ctx, &rps.MsgOfferRematch{Player: strings.ToUpper(f.addrs[2].String()), GameId: gameID, Commit: utils.CalculateCommitment("paper", salt2)})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 980)), f.bankKeeper.balances[f.addrs[2].String()])
+
+	game, err := f.k.Games.Get(ctx, res.GameId)
+	require.NoError(err)
+	require.Equal(f.addrs[2].String(), game.Creator)
+	require.Equal(f.addrs[1].String(), game.ReservedFor)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), game.EntryFee)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), game.ChallengerFee)
+	require.Equal(uint64(60), game.CommitDuration)
+
+	// a settled game is rematched once
+	_, err = f.msgServer.OfferRematch(ctx, &rps.MsgOfferRematch{Player: f.addrs[1].String(), GameId: gameID, Commit: utils.CalculateCommitment("rock", salt1)})
+	require.ErrorContains(err, "can't be rematched")
+
+	// only the previous opponent can take the seat
+	_, err = f.msgServer.CommitMove(ctx, &rps.MsgCommitMove{Player: f.addrs[0].String(), GameId: res.GameId, Commit: utils.CalculateCommitment("rock", salt0)})
+	require.ErrorContains(err, "is reserved for")
+
+	_, err = f.msgServer.CommitMove(ctx, &rps.MsgCommitMove{Player: strings.ToUpper(f.addrs[1].String()), GameId: res.GameId, Commit: utils.CalculateCommitment("rock", salt1)})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 990)), f.bankKeeper.balances[f.addrs[1].String()])
+
+	// the rematch window runs out, the expired games are pruned up to the
+	// settlement limit of each block
+	gameID = play()
+	otherGameID := play()
+	require.NoError(f.k.Params.Set(ctx, rps.Params{CommitTimeout: 60, RevealTimeout: 60, RematchWindow: 100, MaxSettlementsPerBlock: 1}))
+	ctx = ctx.WithBlockTime(time.Unix(1100, 0))
+	require.NoError(f.k.EndBlocker(ctx))
+
+	_, err = f.queryServer.SettledGame(ctx, &rps.QuerySettledGameRequest{GameId: gameID})
+	require.ErrorContains(err, "not found")
+
+	_, err = f.msgServer.OfferRematch(ctx, &rps.MsgOfferRematch{Player: f.addrs[1].String(), GameId: gameID, Commit: utils.CalculateCommitment("rock", salt1)})
+	require.ErrorContains(err, "can't be rematched")
+
+	_, err = f.msgServer.OfferRematch(ctx, &rps.MsgOfferRematch{Player: f.addrs[1].String(), GameId: otherGameID, Commit: utils.CalculateCommitment("rock", salt1)})
+	require.ErrorContains(err, "rematch window of game")
+
+	require.NoError(f.k.EndBlocker(ctx))
+	_, err = f.queryServer.SettledGame(ctx, &rps.QuerySettledGameRequest{GameId: otherGameID})
+	require.ErrorContains(err, "not found")
+}
+
+func TestRevealAgents(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	player, opponent, agent := f.addrs[1].String(), f.addrs[2].String(), f.addrs[0].String()
+
+	res, err := f.msgServer.NewGame(f.ctx, &rps.MsgNewGame{
+		Player:   player,
+		Commit:   utils.CalculateCommitment("rock", salt1),
+		EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+	require.NoError(err)
+
+	reveal := &rps.MsgAgentRevealMove{Agent: agent, Player: player, GameId: res.GameId, Move: rps.Move_MOVE_ROCK, Salt: salt1}
+	_, err = f.msgServer.AgentRevealMove(f.ctx, reveal)
+	require.ErrorContains(err, "player has no reveal agent")
+
+	_, err = f.msgServer.SetRevealAgent(f.ctx, &rps.MsgSetRevealAgent{Player: player, Agent: player})
+	require.ErrorContains(err, "can't be their own reveal agent")
+
+	_, err = f.msgServer.SetRevealAgent(f.ctx, &rps.MsgSetRevealAgent{Player: player, Agent: agent})
+	require.NoError(err)
+
+	// the reveal is escrowed for the agent, only the players of the game can do it
+	_, err = f.msgServer.EscrowReveal(f.ctx, &rps.MsgEscrowReveal{Player: opponent, GameId: res.GameId, EncryptedReveal: []byte("secret")})
+	require.ErrorContains(err, "player is not in game")
+
+	_, err = f.msgServer.EscrowReveal(f.ctx, &rps.MsgEscrowReveal{Player: player, GameId: res.GameId, EncryptedReveal: make([]byte, 1025)})
+	require.ErrorContains(err, "encrypted reveal is too large")
+
+	_, err = f.msgServer.EscrowReveal(f.ctx, &rps.MsgEscrowReveal{Player: player, GameId: res.GameId, EncryptedReveal: []byte("secret")})
+	require.NoError(err)
+
+	escrowed, err := f.queryServer.EscrowedReveal(f.ctx, &rps.QueryEscrowedRevealRequest{GameId: res.GameId, Player: player})
+	require.NoError(err)
+	require.Equal(agent, escrowed.Agent)
+	require.Equal([]byte("secret"), escrowed.EncryptedReveal)
+
+	_, err = f.msgServer.CommitMove(f.ctx, &rps.MsgCommitMove{Player: opponent, GameId: res.GameId, Commit: utils.CalculateCommitment("paper", salt2)})
+	require.NoError(err)
+
+	// only the agent can reveal for the player, and the reveal is checked
+	// against the player's commitment
+	_, err = f.msgServer.AgentRevealMove(f.ctx, &rps.MsgAgentRevealMove{Agent: opponent, Player: player, GameId: res.GameId, Move: rps.Move_MOVE_ROCK, Salt: salt1})
+	require.ErrorContains(err, "signer is not the reveal agent")
+
+	_, err = f.msgServer.AgentRevealMove(f.ctx, &rps.MsgAgentRevealMove{Agent: agent, Player: player, GameId: res.GameId, Move: rps.Move_MOVE_PAPER, Salt: salt1})
+	require.ErrorContains(err, "move doesn't match commitment")
+
+	_, err = f.msgServer.AgentRevealMove(f.ctx, reveal)
+	require.NoError(err)
+
+	moveReveal, err := f.k.MoveReveals.Get(f.ctx, collections.Join(res.GameId, f.addrs[1].Bytes()))
+	require.NoError(err)
+	require.Equal(rps.Move_MOVE_ROCK, moveReveal.Move)
+
+	_, err = f.msgServer.AgentRevealMove(f.ctx, reveal)
+	require.ErrorIs(err, rps.ErrMoveAlreadyRevealed)
+
+	// removing the agent
+	_, err = f.msgServer.SetRevealAgent(f.ctx, &rps.MsgSetRevealAgent{Player: player})
+	require.NoError(err)
+
+	agentRes, err := f.queryServer.RevealAgent(f.ctx, &rps.QueryRevealAgentRequest{Address: player})
+	require.NoError(err)
+	require.Empty(agentRes.Agent)
+}
+
+func TestCreateAndJoin(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0))
+	msg := &rps.MsgCreateAndJoin{
+		Creator:          f.addrs[1].String(),
+		CreatorCommit:    utils.CalculateCommitment("rock", salt1),
+		Challenger:       f.addrs[2].String(),
+		ChallengerCommit: utils.CalculateCommitment("paper", salt2),
+		EntryFee:         sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		ChallengerFee:    sdk.NewCoins(sdk.NewInt64Coin("stake", 30)),
+	}
+
+	// both players have to sign
+	signers, _, err := moduletestutil.MakeTestEncodingConfig().Codec.GetMsgV1Signers(msg)
+	require.NoError(err)
+	require.Equal([][]byte{f.addrs[1], f.addrs[2]}, signers)
+
+	_, err = f.msgServer.CreateAndJoin(ctx, &rps.MsgCreateAndJoin{
+		Creator:          f.addrs[1].String(),
+		CreatorCommit:    msg.CreatorCommit,
+		Challenger:       f.addrs[1].String(),
+		ChallengerCommit: msg.ChallengerCommit,
+		EntryFee:         sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+	require.ErrorContains(err, "must be different players")
+
+	// the commitments are sha256 hashes
+	_, err = f.msgServer.CreateAndJoin(ctx, &rps.MsgCreateAndJoin{
+		Creator:          f.addrs[1].String(),
+		CreatorCommit:    msg.CreatorCommit,
+		Challenger:       f.addrs[2].String(),
+		ChallengerCommit: []byte(rps.HexBytes(msg.ChallengerCommit).String()),
+		EntryFee:         sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+	require.ErrorIs(err, rps.ErrInvalidCommit)
+	require.EqualError(err, "invalid challenger commit: commit must be 32 bytes, got 64: invalid commit")
+
+	res, err := f.msgServer.CreateAndJoin(ctx, msg)
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 990)), f.bankKeeper.balances[f.addrs[1].String()])
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 970)), f.bankKeeper.balances[f.addrs[2].String()])
+
+	// the game is full and the reveal window is open
+	game, err := f.k.Games.Get(ctx, res.GameId)
+	require.NoError(err)
+	require.Equal(time.Unix(1060, 0).UTC(), game.RevealTimeout.UTC())
+
+	_, err = f.msgServer.CommitMove(ctx, &rps.MsgCommitMove{Player: f.addrs[0].String(), GameId: res.GameId, Commit: utils.CalculateCommitment("rock", salt0)})
+	require.ErrorContains(err, "game is full")
+
+	// short salts are rejected before being checked against the commitment
+	_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[1].String(), GameId: res.GameId, Move: rps.Move_MOVE_ROCK, Salt: salt1[:8]})
+	require.ErrorContains(err, "salt must be at least 16 bytes, got 8")
+
+	_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[1].String(), GameId: res.GameId, Move: rps.Move_MOVE_ROCK, Salt: salt1})
+	require.NoError(err)
+
+	_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[2].String(), GameId: res.GameId, Move: rps.Move_MOVE_PAPER, Salt: salt2})
+	require.NoError(err)
+
+	require.NoError(f.k.EndBlocker(ctx))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1010)), f.bankKeeper.balances[f.addrs[2].String()])
+}
+
+func TestCommitmentSchemes(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	keccak := rps.CommitmentScheme_COMMITMENT_SCHEME_KECCAK256
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0))
+	newGame := &rps.MsgNewGame{
+		Player:           f.addrs[1].String(),
+		Commit:           utils.HashCommitment(sha3.NewLegacyKeccak256(), "rock", salt1),
+		EntryFee:         sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		CommitmentScheme: keccak,
+	}
+
+	// schemes other than sha256 have to be enabled
+	_, err := f.msgServer.NewGame(ctx, newGame)
+	require.ErrorIs(err, rps.ErrInvalidCommitmentScheme)
+	require.EqualError(err, "commitment scheme COMMITMENT_SCHEME_KECCAK256 is not enabled: invalid commitment scheme")
+
+	params, err := f.k.Params.Get(ctx)
+	require.NoError(err)
+	params.CommitmentSchemes = []rps.CommitmentScheme{keccak}
+	require.NoError(f.k.Params.Set(ctx, params))
+
+	res, err := f.msgServer.NewGame(ctx, newGame)
+	require.NoError(err)
+
+	_, err = f.msgServer.CommitMove(ctx, &rps.MsgCommitMove{Player: f.addrs[2].String(), GameId: res.GameId, Commit: utils.CalculateCommitment("paper", salt2), CommitmentScheme: rps.CommitmentScheme(42)})
+	require.EqualError(err, "unsupported commitment scheme 42: invalid commitment scheme")
+
+	// each player reveals under the scheme they committed with
+	_, err = f.msgServer.CommitMove(ctx, &rps.MsgCommitMove{Player: f.addrs[2].String(), GameId: res.GameId, Commit: utils.CalculateCommitment("paper", salt2)})
+	require.NoError(err)
+
+	commit, err := f.k.MoveCommits.Get(ctx, collections.Join(res.GameId, f.addrs[1].Bytes()))
+	require.NoError(err)
+	require.Equal(keccak, commit.Scheme)
+
+	_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[1].String(), GameId: res.GameId, Move: rps.Move_MOVE_ROCK, Salt: salt1})
+	require.NoError(err)
+
+	_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[2].String(), GameId: res.GameId, Move: rps.Move_MOVE_PAPER, Salt: salt2})
+	require.NoError(err)
+
+	require.NoError(f.k.EndBlocker(ctx))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1010)), f.bankKeeper.balances[f.addrs[2].String()])
+}
+
+func TestCommitmentSchemesOfAllCommits(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	keccak := rps.CommitmentScheme_COMMITMENT_SCHEME_KECCAK256
+	keccakCommit := func(move string, salt []byte) []byte {
+		return utils.HashCommitment(sha3.NewLegacyKeccak256(), move, salt)
+	}
+
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0)).WithHeaderHash([]byte("block hash"))
+	joinQueue := &rps.MsgJoinQueue{Player: f.addrs[1].String(), Commit: keccakCommit("rock", salt1), EntryFee: sdk.NewInt64Coin("stake", 10), CommitmentScheme: keccak}
+
+	// schemes other than sha256 have to be enabled
+	_, err := f.msgServer.JoinQueue(ctx, joinQueue)
+	require.ErrorIs(err, rps.ErrInvalidCommitmentScheme)
+
+	require.NoError(f.k.Params.Set(ctx, rps.Params{
+		CommitTimeout:     60,
+		RevealTimeout:     60,
+		RematchWindow:     100,
+		HouseMaxExposure:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		CommitmentSchemes: []rps.CommitmentScheme{keccak},
+	}))
+
+	scheme := func(gameID uint64, player sdk.AccAddress) rps.CommitmentScheme {
+		commit, err := f.k.MoveCommits.Get(ctx, collections.Join(gameID, player.Bytes()))
+		require.NoError(err)
+		return commit.Scheme
+	}
+
+	// the scheme of a queued player is kept in the queue entry until matched
+	res, err := f.msgServer.JoinQueue(ctx, joinQueue)
+	require.NoError(err)
+	require.False(res.Matched)
+
+	entry, err := f.k.Queue.Get(ctx, res.EntryId)
+	require.NoError(err)
+	require.Equal(keccak, entry.Scheme)
+
+	res, err = f.msgServer.JoinQueue(ctx, &rps.MsgJoinQueue{Player: f.addrs[2].String(), Commit: utils.CalculateCommitment("paper", salt2), EntryFee: sdk.NewInt64Coin("stake", 10)})
+	require.NoError(err)
+	require.True(res.Matched)
+	require.Equal(keccak, scheme(res.GameId, f.addrs[1]))
+	require.Equal(rps.CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED, scheme(res.GameId, f.addrs[2]))
+
+	_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[1].String(), GameId: res.GameId, Move: rps.Move_MOVE_ROCK, Salt: salt1})
+	require.NoError(err)
+
+	_, err = f.msgServer.RevealMove(ctx, &rps.MsgRevealMove{Player: f.addrs[2].String(), GameId: res.GameId, Move: rps.Move_MOVE_PAPER, Salt: salt2})
+	require.NoError(err)
+
+	require.NoError(f.k.EndBlocker(ctx))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1010)), f.bankKeeper.balances[f.addrs[2].String()])
+
+	rematch, err := f.msgServer.OfferRematch(ctx, &rps.MsgOfferRematch{Player: f.addrs[2].String(), GameId: res.GameId, Commit: keccakCommit("rock", salt2), CommitmentScheme: keccak})
+	require.NoError(err)
+	require.Equal(keccak, scheme(rematch.GameId, f.addrs[2]))
+
+	createAndJoin, err := f.msgServer.CreateAndJoin(ctx, &rps.MsgCreateAndJoin{
+		Creator:                    f.addrs[0].String(),
+		CreatorCommit:              utils.CalculateCommitment("rock", salt0),
+		Challenger:                 f.addrs[1].String(),
+		ChallengerCommit:           keccakCommit("paper", salt1),
+		EntryFee:                   sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		ChallengerCommitmentScheme: keccak,
+	})
+	require.NoError(err)
+	require.Equal(rps.CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED, scheme(createAndJoin.GameId, f.addrs[0]))
+	require.Equal(keccak, scheme(createAndJoin.GameId, f.addrs[1]))
+
+	f.bankKeeper.balances[communityPool] = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	_, err = f.msgServer.FundHouse(ctx, &rps.MsgFundHouse{Authority: f.addrs[0].String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))})
+	require.NoError(err)
+
+	house, err := f.msgServer.PlayHouse(ctx, &rps.MsgPlayHouse{Player: f.addrs[2].String(), Commit: keccakCommit("scissors", salt2), EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), CommitmentScheme: keccak})
+	require.NoError(err)
+	require.Equal(keccak, scheme(house.GameId, f.addrs[2]))
+}
+
+func TestMsgServerRegisteredErrors(t *testing.T) {
+	f := initFixture(t)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	commit := utils.CalculateCommitment("rock", salt1)
+
+	// addresses are decoded with the address codec of the keeper, so addresses
+	// with another prefix are rejected
+	otherPrefix := sdk.MustBech32ifyAddressBytes("osmo", f.addrs[1])
+
+	testCases := []struct {
+		name      string
+		run       func() error
+		expectErr error
+	}{
+		{
+			name: "address with another prefix",
+			run: func() error {
+				_, err := f.msgServer.NewGame(f.ctx, &rps.MsgNewGame{Player: otherPrefix, Commit: commit, EntryFee: fee})
+				return err
+			},
+			expectErr: rps.ErrInvalidAddress,
+		},
+		{
+			name: "entry fee without amount",
+			run: func() error {
+				_, err := f.msgServer.PlayHouse(f.ctx, &rps.MsgPlayHouse{Player: f.addrs[1].String(), Commit: commit})
+				return err
+			},
+			expectErr: rps.ErrInvalidCoins,
+		},
+		{
+			name: "same creator and challenger",
+			run: func() error {
+				_, err := f.msgServer.CreateAndJoin(f.ctx, &rps.MsgCreateAndJoin{Creator: f.addrs[1].String(), CreatorCommit: commit, Challenger: f.addrs[1].String(), ChallengerCommit: commit, EntryFee: fee})
+				return err
+			},
+			expectErr: rps.ErrInvalidPlayers,
+		},
+		{
+			name: "bet without outcome",
+			run: func() error {
+				_, err := f.msgServer.PlaceBet(f.ctx, &rps.MsgPlaceBet{Bettor: f.addrs[1].String(), Amount: fee})
+				return err
+			},
+			expectErr: rps.ErrInvalidBetOutcome,
+		},
+		{
+			name: "empty encrypted reveal",
+			run: func() error {
+				_, err := f.msgServer.EscrowReveal(f.ctx, &rps.MsgEscrowReveal{Player: f.addrs[1].String()})
+				return err
+			},
+			expectErr: rps.ErrInvalidEncryptedReveal,
+		},
+		{
+			name: "invalid move",
+			run: func() error {
+				_, err := f.msgServer.RevealMove(f.ctx, &rps.MsgRevealMove{Player: f.addrs[1].String(), Salt: salt1})
+				return err
+			},
+			expectErr: rps.ErrInvalidMove,
+		},
+		{
+			name: "short salt",
+			run: func() error {
+				_, err := f.msgServer.RevealMove(f.ctx, &rps.MsgRevealMove{Player: f.addrs[1].String(), Move: rps.Move_MOVE_ROCK, Salt: salt1[:8]})
+				return err
+			},
+			expectErr: rps.ErrInvalidSalt,
+		},
+		{
+			name: "invalid params",
+			run: func() error {
+				_, err := f.msgServer.UpdateParams(f.ctx, &rps.MsgUpdateParams{Authority: f.k.GetAuthority(), Params: rps.Params{TimeoutMode: rps.TimeoutMode_TIMEOUT_MODE_HEIGHT}})
+				return err
+			},
+			expectErr: rps.ErrInvalidParams,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.ErrorIs(t, tc.run(), tc.expectErr)
+		})
+	}
+}
+
 // func TestIncrementCounter(t *testing.T) {
 // 	f := initFixture(t)
 // 	require := require.New(t)
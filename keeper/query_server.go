@@ -5,9 +5,12 @@ import (
 	"errors"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/facundomedica/rps"
 )
 
@@ -48,6 +51,294 @@ func (qs queryServer) Games(ctx context.Context, _ *rps.QueryGamesRequest) (*rps
 	return res, nil
 }
 
+// StuckGames implements rps.QueryServer.
+func (qs queryServer) StuckGames(ctx context.Context, _ *rps.QueryStuckGamesRequest) (*rps.QueryStuckGamesResponse, error) {
+	res := &rps.QueryStuckGamesResponse{Games: []rps.Game{}}
+
+	err := qs.k.StuckGames.Walk(ctx, nil, func(key uint64, game rps.Game) (bool, error) {
+		game.Id = key
+		res.Games = append(res.Games, game)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// maxSettlementBacklog is the number of due games SettlementBacklog counts up
+// to, so the query doesn't walk the whole deadline index.
+const maxSettlementBacklog = 1000
+
+// SettlementBacklog implements rps.QueryServer.
+func (qs queryServer) SettlementBacklog(ctx context.Context, _ *rps.QuerySettlementBacklogRequest) (*rps.QuerySettlementBacklogResponse, error) {
+	// one entry over the bound tells whether there are more
+	heightKeys, timeKeys, err := qs.k.dueKeys(ctx, maxSettlementBacklog+1)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	count := uint64(len(heightKeys) + len(timeKeys))
+	if count > maxSettlementBacklog {
+		return &rps.QuerySettlementBacklogResponse{Count: maxSettlementBacklog, Truncated: true}, nil
+	}
+
+	return &rps.QuerySettlementBacklogResponse{Count: count}, nil
+}
+
+// PlayerStats implements rps.QueryServer.
+func (qs queryServer) PlayerStats(ctx context.Context, req *rps.QueryPlayerStatsRequest) (*rps.QueryPlayerStatsResponse, error) {
+	addr, err := qs.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	stats, err := qs.k.PlayerStats.Get(ctx, addr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &rps.QueryPlayerStatsResponse{Stats: stats}, nil
+}
+
+// Queue implements rps.QueryServer.
+func (qs queryServer) Queue(ctx context.Context, _ *rps.QueryQueueRequest) (*rps.QueryQueueResponse, error) {
+	res := &rps.QueryQueueResponse{Entries: []rps.QueueEntry{}}
+
+	err := qs.k.Queue.Walk(ctx, nil, func(_ uint64, entry rps.QueueEntry) (bool, error) {
+		res.Entries = append(res.Entries, entry)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// BetPools implements rps.QueryServer.
+func (qs queryServer) BetPools(ctx context.Context, req *rps.QueryBetPoolsRequest) (*rps.QueryBetPoolsResponse, error) {
+	if _, err := qs.k.Games.Get(ctx, req.GameId); err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "game %d not found", req.GameId)
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	params, err := qs.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	bets, err := qs.k.gameBets(ctx, req.GameId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pools, total := betPools(bets)
+	payoutRatio := math.LegacyOneDec().Sub(bettingFee(params))
+
+	res := &rps.QueryBetPoolsResponse{Pools: []rps.BetPool{}}
+	for _, outcome := range betOutcomes {
+		pool := rps.BetPool{Outcome: outcome, Amount: pools[outcome], Odds: sdk.NewDecCoins()}
+
+		// the odds are what the whole pool, minus the fee, pays for each unit bet on the outcome
+		for _, coin := range pool.Amount {
+			odds := payoutRatio.MulInt(total.AmountOf(coin.Denom)).QuoInt(coin.Amount)
+			pool.Odds = pool.Odds.Add(sdk.NewDecCoinFromDec(coin.Denom, odds))
+		}
+
+		res.Pools = append(res.Pools, pool)
+	}
+
+	return res, nil
+}
+
+// Jackpot implements rps.QueryServer.
+func (qs queryServer) Jackpot(ctx context.Context, _ *rps.QueryJackpotRequest) (*rps.QueryJackpotResponse, error) {
+	params, err := qs.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	jackpot, err := balanceOf(ctx, qs.k.Jackpot)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &rps.QueryJackpotResponse{Jackpot: jackpot, Streak: params.JackpotStreak}, nil
+}
+
+// HouseBankroll implements rps.QueryServer.
+func (qs queryServer) HouseBankroll(ctx context.Context, _ *rps.QueryHouseBankrollRequest) (*rps.QueryHouseBankrollResponse, error) {
+	params, err := qs.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	bankroll, err := balanceOf(ctx, qs.k.HouseBankroll)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	exposure, err := balanceOf(ctx, qs.k.HouseExposure)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &rps.QueryHouseBankrollResponse{
+		Bankroll:        bankroll,
+		MaxExposure:     params.HouseMaxExposure,
+		Exposure:        exposure,
+		MaxOpenExposure: params.HouseMaxOpenExposure,
+	}, nil
+}
+
+// SettledGame implements rps.QueryServer.
+func (qs queryServer) SettledGame(ctx context.Context, req *rps.QuerySettledGameRequest) (*rps.QuerySettledGameResponse, error) {
+	game, err := qs.k.SettledGames.Get(ctx, req.GameId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "settled game %d not found", req.GameId)
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &rps.QuerySettledGameResponse{Game: game}, nil
+}
+
+// RevealAgent implements rps.QueryServer.
+func (qs queryServer) RevealAgent(ctx context.Context, req *rps.QueryRevealAgentRequest) (*rps.QueryRevealAgentResponse, error) {
+	addr, err := qs.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	agent, err := qs.k.revealAgent(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &rps.QueryRevealAgentResponse{Agent: agent}, nil
+}
+
+// EscrowedReveal implements rps.QueryServer.
+func (qs queryServer) EscrowedReveal(ctx context.Context, req *rps.QueryEscrowedRevealRequest) (*rps.QueryEscrowedRevealResponse, error) {
+	addr, err := qs.k.addressCodec.StringToBytes(req.Player)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid player address: %s", err)
+	}
+
+	commit, err := qs.k.MoveCommits.Get(ctx, collections.Join(req.GameId, addr))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "player is not in game %d", req.GameId)
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	agent, err := qs.k.revealAgent(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &rps.QueryEscrowedRevealResponse{Agent: agent, EncryptedReveal: commit.EncryptedReveal}, nil
+}
+
+// MoveCommit implements rps.QueryServer.
+func (qs queryServer) MoveCommit(ctx context.Context, req *rps.QueryMoveCommitRequest) (*rps.QueryMoveCommitResponse, error) {
+	addr, err := qs.k.addressCodec.StringToBytes(req.Player)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid player address: %s", err)
+	}
+
+	commit, err := qs.k.MoveCommits.Get(ctx, collections.Join(req.GameId, addr))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "player is not in game %d", req.GameId)
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &rps.QueryMoveCommitResponse{MoveCommit: commit}, nil
+}
+
+// GamePlayers implements rps.QueryServer.
+func (qs queryServer) GamePlayers(ctx context.Context, req *rps.QueryGamePlayersRequest) (*rps.QueryGamePlayersResponse, error) {
+	found, err := qs.k.Games.Has(ctx, req.GameId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "game %d not found", req.GameId)
+	}
+
+	players, err := qs.k.committedPlayers(ctx, req.GameId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &rps.QueryGamePlayersResponse{Players: make([]string, len(players))}
+	for i, player := range players {
+		res.Players[i], err = qs.k.addressCodec.BytesToString(player)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return res, nil
+}
+
+// ComputeCommitment defines the handler for the Query/ComputeCommitment RPC method.
+func (qs queryServer) ComputeCommitment(ctx context.Context, req *rps.QueryComputeCommitmentRequest) (*rps.QueryComputeCommitmentResponse, error) {
+	if !req.Move.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid move")
+	}
+
+	if err := rps.ValidateSalt(req.Salt); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	params, err := qs.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// commitments under a disabled scheme would be rejected by the messages
+	if err := params.ValidateCommitmentScheme(req.Scheme); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	commit, err := req.Scheme.Commitment(req.Move, req.Salt)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &rps.QueryComputeCommitmentResponse{Commit: commit}, nil
+}
+
+// VerifyReveal defines the handler for the Query/VerifyReveal RPC method.
+func (qs queryServer) VerifyReveal(ctx context.Context, req *rps.QueryVerifyRevealRequest) (*rps.QueryVerifyRevealResponse, error) {
+	addr, err := qs.k.addressCodec.StringToBytes(req.Player)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid player address: %s", err)
+	}
+
+	// the reveal is checked like RevealMove does, the reason it would be
+	// rejected is the error the transaction would fail with
+	if _, err := qs.k.checkReveal(ctx, addr, req.GameId, req.Move, req.Salt); err != nil {
+		return &rps.QueryVerifyRevealResponse{Reason: err.Error()}, nil
+	}
+
+	return &rps.QueryVerifyRevealResponse{Valid: true}, nil
+}
+
 // Params defines the handler for the Query/Params RPC method.
 func (qs queryServer) Params(ctx context.Context, req *rps.QueryParamsRequest) (*rps.QueryParamsResponse, error) {
 	params, err := qs.k.Params.Get(ctx)
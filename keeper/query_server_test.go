@@ -3,8 +3,13 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/facundomedica/rps"
+	"github.com/facundomedica/rps/utils"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestQueryParams(t *testing.T) {
@@ -13,7 +18,7 @@ func TestQueryParams(t *testing.T) {
 
 	resp, err := f.queryServer.Params(f.ctx, &rps.QueryParamsRequest{})
 	require.NoError(err)
-	require.Equal(rps.Params{CommitTimeout: 60, RevealTimeout: 60}, resp.Params)
+	require.Equal(rps.Params{CommitTimeout: 60, RevealTimeout: 60, NoShowPenalty: math.LegacyZeroDec(), BettingFee: math.LegacyZeroDec(), JackpotFee: math.LegacyZeroDec()}, resp.Params)
 }
 
 // func TestQueryCounter(t *testing.T) {
@@ -31,3 +36,100 @@ func TestQueryParams(t *testing.T) {
 // 	require.NoError(err)
 // 	require.Equal(uint64(1), resp.Counter)
 // }
+
+func TestQueryComputeCommitment(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	resp, err := f.queryServer.ComputeCommitment(f.ctx, &rps.QueryComputeCommitmentRequest{Move: rps.Move_MOVE_ROCK, Salt: salt0})
+	require.NoError(err)
+	require.Equal(rps.HexBytes(utils.CalculateCommitment("rock", salt0)), resp.Commit)
+
+	// schemes other than sha256 have to be enabled
+	keccak := &rps.QueryComputeCommitmentRequest{Move: rps.Move_MOVE_ROCK, Salt: salt0, Scheme: rps.CommitmentScheme_COMMITMENT_SCHEME_KECCAK256}
+	_, err = f.queryServer.ComputeCommitment(f.ctx, keccak)
+	require.ErrorContains(err, "commitment scheme COMMITMENT_SCHEME_KECCAK256 is not enabled")
+
+	params, err := f.k.Params.Get(f.ctx)
+	require.NoError(err)
+	params.CommitmentSchemes = []rps.CommitmentScheme{rps.CommitmentScheme_COMMITMENT_SCHEME_KECCAK256}
+	require.NoError(f.k.Params.Set(f.ctx, params))
+
+	resp, err = f.queryServer.ComputeCommitment(f.ctx, keccak)
+	require.NoError(err)
+	require.Equal(rps.HexBytes(utils.HashCommitment(sha3.NewLegacyKeccak256(), "rock", salt0)), resp.Commit)
+
+	_, err = f.queryServer.ComputeCommitment(f.ctx, &rps.QueryComputeCommitmentRequest{Move: rps.Move_MOVE_ROCK, Salt: salt0, Scheme: rps.CommitmentScheme(42)})
+	require.ErrorContains(err, "unsupported commitment scheme 42")
+
+	_, err = f.queryServer.ComputeCommitment(f.ctx, &rps.QueryComputeCommitmentRequest{Move: rps.Move_MOVE_UNSPECIFIED, Salt: salt0})
+	require.ErrorContains(err, "invalid move")
+}
+
+func TestQueryMoveCommit(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	commit := utils.CalculateCommitment("rock", salt1)
+	res, err := f.msgServer.NewGame(f.ctx, &rps.MsgNewGame{Player: f.addrs[1].String(), Commit: commit, EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))})
+	require.NoError(err)
+
+	resp, err := f.queryServer.MoveCommit(f.ctx, &rps.QueryMoveCommitRequest{GameId: res.GameId, Player: f.addrs[1].String()})
+	require.NoError(err)
+	require.Equal(commit, []byte(resp.MoveCommit.Commit))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), resp.MoveCommit.Stake)
+
+	_, err = f.queryServer.MoveCommit(f.ctx, &rps.QueryMoveCommitRequest{GameId: res.GameId, Player: f.addrs[2].String()})
+	require.ErrorContains(err, "player is not in game 0")
+
+	_, err = f.queryServer.MoveCommit(f.ctx, &rps.QueryMoveCommitRequest{GameId: res.GameId, Player: "foo"})
+	require.ErrorContains(err, "invalid player address")
+}
+
+func TestQueryGamePlayers(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	res, err := f.msgServer.NewGame(f.ctx, &rps.MsgNewGame{Player: f.addrs[1].String(), Commit: utils.CalculateCommitment("rock", salt1), EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))})
+	require.NoError(err)
+
+	resp, err := f.queryServer.GamePlayers(f.ctx, &rps.QueryGamePlayersRequest{GameId: res.GameId})
+	require.NoError(err)
+	require.Equal([]string{f.addrs[1].String()}, resp.Players)
+
+	_, err = f.queryServer.GamePlayers(f.ctx, &rps.QueryGamePlayersRequest{GameId: res.GameId + 1})
+	require.ErrorContains(err, "game 1 not found")
+}
+
+func TestQueryVerifyReveal(t *testing.T) {
+	f := initFixture(t)
+	require := require.New(t)
+
+	verify := func(player sdk.AccAddress, gameID uint64, move rps.Move, salt []byte) *rps.QueryVerifyRevealResponse {
+		resp, err := f.queryServer.VerifyReveal(f.ctx, &rps.QueryVerifyRevealRequest{GameId: gameID, Player: player.String(), Move: move, Salt: salt})
+		require.NoError(err)
+		return resp
+	}
+
+	res, err := f.msgServer.NewGame(f.ctx, &rps.MsgNewGame{Player: f.addrs[0].String(), Commit: utils.CalculateCommitment("rock", salt0), EntryFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))})
+	require.NoError(err)
+
+	require.Equal(&rps.QueryVerifyRevealResponse{Reason: "please wait until the game is full: game not ready"}, verify(f.addrs[0], res.GameId, rps.Move_MOVE_ROCK, salt0))
+	require.Equal(&rps.QueryVerifyRevealResponse{Reason: "game 5 not found: not found"}, verify(f.addrs[0], 5, rps.Move_MOVE_ROCK, salt0))
+
+	_, err = f.msgServer.CommitMove(f.ctx, &rps.MsgCommitMove{Player: f.addrs[1].String(), GameId: res.GameId, Commit: utils.CalculateCommitment("paper", salt1)})
+	require.NoError(err)
+
+	require.Equal(&rps.QueryVerifyRevealResponse{Valid: true}, verify(f.addrs[0], res.GameId, rps.Move_MOVE_ROCK, salt0))
+	require.Equal(&rps.QueryVerifyRevealResponse{Reason: "move doesn't match commitment"}, verify(f.addrs[0], res.GameId, rps.Move_MOVE_ROCK, salt1))
+	require.Equal(&rps.QueryVerifyRevealResponse{Reason: "invalid move"}, verify(f.addrs[0], res.GameId, rps.Move_MOVE_UNSPECIFIED, salt0))
+	require.Equal(&rps.QueryVerifyRevealResponse{Reason: "player is not in game 0: not a player of the game"}, verify(f.addrs[2], res.GameId, rps.Move_MOVE_ROCK, salt0))
+
+	_, err = f.msgServer.RevealMove(f.ctx, &rps.MsgRevealMove{Player: f.addrs[0].String(), GameId: res.GameId, Move: rps.Move_MOVE_ROCK, Salt: salt0})
+	require.NoError(err)
+	require.Equal(&rps.QueryVerifyRevealResponse{Reason: "move already revealed"}, verify(f.addrs[0], res.GameId, rps.Move_MOVE_ROCK, salt0))
+	require.Equal(&rps.QueryVerifyRevealResponse{Valid: true}, verify(f.addrs[1], res.GameId, rps.Move_MOVE_PAPER, salt1))
+
+	_, err = f.queryServer.VerifyReveal(f.ctx, &rps.QueryVerifyRevealRequest{GameId: res.GameId, Player: "invalid", Move: rps.Move_MOVE_ROCK, Salt: salt0})
+	require.ErrorContains(err, "invalid player address")
+}
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	stdmath "math"
+	"math/big"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/facundomedica/rps"
+)
+
+// QueueIndexes defines the indexes of the matchmaking queue.
+type QueueIndexes struct {
+	// Stake indexes the queue entries by the denom and minimum amount of their
+	// stake range.
+	Stake *indexes.Multi[collections.Pair[string, math.Int], uint64, rps.QueueEntry]
+	// Player indexes the queue entries by denom and player, a player can only
+	// wait in the queue once for each denom.
+	Player *indexes.Unique[collections.Pair[string, []byte], uint64, rps.QueueEntry]
+}
+
+func newQueueIndexes(sb *collections.SchemaBuilder, addressCodec address.Codec) QueueIndexes {
+	return QueueIndexes{
+		Stake: indexes.NewMulti(sb, rps.QueueByStakeKey, "queue_by_stake", collections.PairKeyCodec(collections.StringKey, intKey), collections.Uint64Key, func(_ uint64, entry rps.QueueEntry) (collections.Pair[string, math.Int], error) {
+			return collections.Join(entry.EntryFee.Denom, queueEntryMinFee(entry).Amount), nil
+		}),
+		Player: indexes.NewUnique(sb, rps.QueueByPlayerKey, "queue_by_player", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), collections.Uint64Key, func(_ uint64, entry rps.QueueEntry) (collections.Pair[string, []byte], error) {
+			player, err := addressCodec.StringToBytes(entry.Player)
+			return collections.Join(entry.EntryFee.Denom, player), err
+		}),
+	}
+}
+
+// IndexesList implements collections.Indexes.
+func (i QueueIndexes) IndexesList() []collections.Index[uint64, rps.QueueEntry] {
+	return []collections.Index[uint64, rps.QueueEntry]{i.Stake, i.Player}
+}
+
+// queueEntryMinFee returns the minimum stake of a queue entry, which is its
+// entry fee if it has no range.
+func queueEntryMinFee(entry rps.QueueEntry) sdk.Coin {
+	if entry.MinEntryFee.Amount.IsNil() || entry.MinEntryFee.IsZero() {
+		return entry.EntryFee
+	}
+
+	return entry.MinEntryFee
+}
+
+// findQueueMatch returns the queue entry whose stake range overlaps with
+// [minFee, maxFee] at the highest stake, which is the highest one acceptable
+// for both players.
+//
+// The stake ranges of the entries of a denom don't overlap, as they'd have been
+// matched otherwise and a player can only wait once for each denom, so the
+// entry with the highest minimum stake not above maxFee is the only candidate.
+func (k Keeper) findQueueMatch(ctx context.Context, minFee, maxFee sdk.Coin) (entry rps.QueueEntry, stake sdk.Coin, found bool, err error) {
+	rng := new(collections.Range[collections.Pair[collections.Pair[string, math.Int], uint64]]).
+		StartInclusive(collections.Join(collections.Join(maxFee.Denom, math.ZeroInt()), uint64(0))).
+		EndInclusive(collections.Join(collections.Join(maxFee.Denom, maxFee.Amount), uint64(stdmath.MaxUint64))).
+		Descending()
+
+	err = k.Queue.Indexes.Stake.Walk(ctx, rng, func(_ collections.Pair[string, math.Int], id uint64) (bool, error) {
+		candidate, err := k.Queue.Get(ctx, id)
+		if err != nil {
+			return true, err
+		}
+
+		if candidate.EntryFee.Amount.LT(minFee.Amount) {
+			return true, nil
+		}
+
+		entry, stake, found = candidate, sdk.NewCoin(maxFee.Denom, math.MinInt(maxFee.Amount, candidate.EntryFee.Amount)), true
+		return true, nil
+	})
+
+	return entry, stake, found, err
+}
+
+// intKey is the key codec of non-negative amounts, encoded as their big-endian
+// bytes prefixed with their length so they're sorted by value.
+var intKey collcodec.KeyCodec[math.Int] = intKeyCodec{}
+
+type intKeyCodec struct{}
+
+func (intKeyCodec) Encode(buffer []byte, key math.Int) (int, error) {
+	if key.IsNil() || key.IsNegative() {
+		return 0, fmt.Errorf("invalid amount key: %s", key)
+	}
+
+	bz := key.BigInt().Bytes()
+	buffer[0] = byte(len(bz))
+	return 1 + copy(buffer[1:], bz), nil
+}
+
+func (intKeyCodec) Decode(buffer []byte) (int, math.Int, error) {
+	if len(buffer) == 0 || len(buffer) < 1+int(buffer[0]) {
+		return 0, math.Int{}, fmt.Errorf("invalid amount key length: %d", len(buffer))
+	}
+
+	size := 1 + int(buffer[0])
+	return size, math.NewIntFromBigInt(new(big.Int).SetBytes(buffer[1:size])), nil
+}
+
+func (intKeyCodec) Size(key math.Int) int {
+	if key.IsNil() {
+		return 1
+	}
+
+	return 1 + len(key.BigInt().Bytes())
+}
+
+func (intKeyCodec) EncodeJSON(key math.Int) ([]byte, error) {
+	return collections.StringKey.EncodeJSON(key.String())
+}
+
+func (intKeyCodec) DecodeJSON(b []byte) (math.Int, error) {
+	s, err := collections.StringKey.DecodeJSON(b)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	key, ok := math.NewIntFromString(s)
+	if !ok {
+		return math.Int{}, fmt.Errorf("invalid amount key: %s", s)
+	}
+
+	return key, nil
+}
+
+func (intKeyCodec) Stringify(key math.Int) string { return key.String() }
+
+func (intKeyCodec) KeyType() string { return "math.Int" }
+
+// the encoding is length prefixed, so it's the same in any position of a key
+func (c intKeyCodec) EncodeNonTerminal(buffer []byte, key math.Int) (int, error) {
+	return c.Encode(buffer, key)
+}
+
+func (c intKeyCodec) DecodeNonTerminal(buffer []byte) (int, math.Int, error) {
+	return c.Decode(buffer)
+}
+
+func (c intKeyCodec) SizeNonTerminal(key math.Int) int { return c.Size(key) }
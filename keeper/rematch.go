@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/facundomedica/rps"
+)
+
+// retainSettledGame keeps the participants, stakes and rules of a settled game
+// for Params.RematchWindow seconds, so either player can offer a rematch.
+func (k Keeper) retainSettledGame(ctx context.Context, params rps.Params, game rps.Game, players [][]byte, stakes []sdk.Coins) error {
+	if params.RematchWindow == 0 {
+		return nil
+	}
+
+	// games created before the creator was tracked take the first player as creator
+	creatorIdx := 0
+	if game.Creator != "" {
+		creator, err := k.addressCodec.StringToBytes(game.Creator)
+		if err != nil {
+			return err
+		}
+
+		if bytes.Equal(players[1], creator) {
+			creatorIdx = 1
+		}
+	}
+
+	creatorAddr, err := k.addressCodec.BytesToString(players[creatorIdx])
+	if err != nil {
+		return err
+	}
+
+	challengerAddr, err := k.addressCodec.BytesToString(players[1-creatorIdx])
+	if err != nil {
+		return err
+	}
+
+	expiresAt := sdk.UnwrapSDKContext(ctx).BlockTime().Add(time.Second * time.Duration(params.RematchWindow))
+	settled := rps.SettledGame{
+		Id:              game.Id,
+		Creator:         creatorAddr,
+		Challenger:      challengerAddr,
+		CreatorStake:    stakes[creatorIdx],
+		ChallengerStake: stakes[1-creatorIdx],
+		TimeoutMode:     game.TimeoutMode,
+		CommitDuration:  game.CommitDuration,
+		RevealDuration:  game.RevealDuration,
+		ExpiresAt:       expiresAt,
+	}
+
+	if err := k.SettledGames.Set(ctx, game.Id, settled); err != nil {
+		return err
+	}
+
+	return k.SettledGamesByExpiry.Set(ctx, collections.Join(expiresAt, game.Id))
+}
+
+// removeSettledGame deletes a settled game along with its expiry index entry.
+func (k Keeper) removeSettledGame(ctx context.Context, settled rps.SettledGame) error {
+	if err := k.SettledGames.Remove(ctx, settled.Id); err != nil {
+		return err
+	}
+
+	return k.SettledGamesByExpiry.Remove(ctx, collections.Join(settled.ExpiresAt, settled.Id))
+}
+
+// pruneSettledGames deletes the settled games whose rematch window is over,
+// up to limit games, earliest expiry first. Zero means no limit. The rest are
+// left for the next blocks, they can't be rematched in the meantime.
+func (k Keeper) pruneSettledGames(ctx context.Context, limit uint64) error {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	// collect the expired games first, removing them modifies the index
+	expired := []collections.Pair[time.Time, uint64]{}
+	err := k.SettledGamesByExpiry.Walk(ctx, nil, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		if (limit != 0 && uint64(len(expired)) >= limit) || key.K1().After(now) {
+			return true, nil
+		}
+
+		expired = append(expired, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := k.SettledGames.Remove(ctx, key.K2()); err != nil {
+			return err
+		}
+
+		if err := k.SettledGamesByExpiry.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/facundomedica/rps"
+	"github.com/facundomedica/rps/utils"
+)
+
+// revealMove reveals the move a player committed to a game, checking it
+// against their commitment. It's shared by the player and their reveal agent.
+func (k Keeper) revealMove(ctx context.Context, playerAddr []byte, gameID uint64, move rps.Move, salt []byte) error {
+	game, err := k.checkReveal(ctx, playerAddr, gameID, move, salt)
+	if err != nil {
+		return err
+	}
+
+	// games that became full before the reveal timeout was set when the second
+	// player joined get it on the first reveal
+	if !game.HasRevealTimeout() {
+		game, err = k.startRevealWindow(ctx, game)
+		if err != nil {
+			return err
+		}
+	}
+
+	// store the reveal
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reveal := rps.MoveReveal{
+		Move:      move,
+		Salt:      salt,
+		CreatedAt: sdkCtx.BlockTime(),
+	}
+
+	if err := k.MoveReveals.Set(ctx, collections.Join(gameID, playerAddr), reveal); err != nil {
+		return err
+	}
+
+	// in house games the house move is revealed along with the player's, it
+	// couldn't be known before as it depends on the salt
+	if game.House {
+		houseMove, err := rps.ParseMove(utils.HouseMove(game.HouseSeed, salt))
+		if err != nil {
+			return err
+		}
+
+		houseReveal := rps.MoveReveal{
+			Move:      houseMove,
+			CreatedAt: sdkCtx.BlockTime(),
+		}
+
+		if err := k.MoveReveals.Set(ctx, collections.Join(gameID, houseAddress()), houseReveal); err != nil {
+			return err
+		}
+	}
+
+	// once both moves are revealed the game is settled in the next EndBlocker
+	return k.scheduleGame(ctx, game)
+}
+
+// checkReveal returns the game if a player can reveal a move in it, or the
+// reason they can't. It doesn't modify the state, Query/VerifyReveal uses it
+// as well.
+func (k Keeper) checkReveal(ctx context.Context, playerAddr []byte, gameID uint64, move rps.Move, salt []byte) (rps.Game, error) {
+	if !move.IsValid() {
+		return rps.Game{}, rps.ErrInvalidMove
+	}
+
+	if err := rps.ValidateSalt(salt); err != nil {
+		return rps.Game{}, err
+	}
+
+	// check if the game exists and that the reveal timeout hasn't passed
+	game, err := k.Games.Get(ctx, gameID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return rps.Game{}, errorsmod.Wrapf(rps.ErrNotFound, "game %d not found", gameID)
+		}
+
+		return rps.Game{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if game.RevealTimedOut(sdkCtx.BlockTime(), sdkCtx.BlockHeight()) {
+		return rps.Game{}, errorsmod.Wrap(rps.ErrDeadlinePassed, "reveal timeout has passed")
+	}
+
+	// check if the player is part of the game
+	moveCommit, err := k.MoveCommits.Get(ctx, collections.Join(gameID, playerAddr))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return rps.Game{}, errorsmod.Wrapf(rps.ErrNotPlayer, "player is not in game %d", gameID)
+		}
+
+		return rps.Game{}, err
+	}
+
+	// check if the game is full, if it is, we allow the player to reveal their move
+	players, err := k.committedPlayers(ctx, gameID)
+	if err != nil {
+		return rps.Game{}, err
+	}
+
+	if len(players) != 2 {
+		return rps.Game{}, errorsmod.Wrap(rps.ErrGameNotReady, "please wait until the game is full")
+	}
+
+	// the house move depends on the hash of the block after the one the game
+	// was created in
+	if game.House && len(game.HouseSeed) == 0 {
+		return rps.Game{}, errorsmod.Wrap(rps.ErrGameNotReady, "the house hasn't drawn its move yet, reveal in a later block")
+	}
+
+	// check if the move has already been revealed
+	revealed, err := k.MoveReveals.Has(ctx, collections.Join(gameID, playerAddr))
+	if err != nil {
+		return rps.Game{}, err
+	}
+
+	if revealed {
+		return rps.Game{}, rps.ErrMoveAlreadyRevealed
+	}
+
+	// calculate the move's commitment with the scheme it was committed with,
+	// it must match the one stored
+	commit, err := moveCommit.Scheme.Commitment(move, salt)
+	if err != nil {
+		return rps.Game{}, err
+	}
+
+	if !bytes.Equal(commit, moveCommit.Commit) {
+		return rps.Game{}, rps.ErrMoveMismatch
+	}
+
+	return game, nil
+}
+
+// revealAgent returns the address of the reveal agent of a player, or an empty
+// string if they have none.
+func (k Keeper) revealAgent(ctx context.Context, player []byte) (string, error) {
+	agent, err := k.RevealAgents.Get(ctx, player)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return k.addressCodec.BytesToString(agent)
+}
+
+// checkRevealAgent returns an error if the agent isn't the reveal agent of the player.
+func (k Keeper) checkRevealAgent(ctx context.Context, player, agent []byte) error {
+	registered, err := k.RevealAgents.Get(ctx, player)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrap(rps.ErrUnauthorized, "player has no reveal agent")
+		}
+
+		return err
+	}
+
+	if !bytes.Equal(registered, agent) {
+		return errorsmod.Wrap(rps.ErrUnauthorized, "signer is not the reveal agent of the player")
+	}
+
+	return nil
+}
@@ -5,9 +5,25 @@ import "cosmossdk.io/collections"
 const ModuleName = "rps"
 
 var (
-	ParamsKey     = collections.NewPrefix(0)
-	GameIDKey     = collections.NewPrefix(1)
-	GamesKey      = collections.NewPrefix(2)
-	MoveCommitKey = collections.NewPrefix(3)
-	MoveRevealKey = collections.NewPrefix(4)
+	ParamsKey                = collections.NewPrefix(0)
+	GameIDKey                = collections.NewPrefix(1)
+	GamesKey                 = collections.NewPrefix(2)
+	MoveCommitKey            = collections.NewPrefix(3)
+	MoveRevealKey            = collections.NewPrefix(4)
+	StuckGamesKey            = collections.NewPrefix(5)
+	PlayerStatsKey           = collections.NewPrefix(6)
+	QueueKey                 = collections.NewPrefix(7)
+	QueueByStakeKey          = collections.NewPrefix(8)
+	QueueEntryIDKey          = collections.NewPrefix(9)
+	BetsKey                  = collections.NewPrefix(10)
+	JackpotKey               = collections.NewPrefix(11)
+	HouseBankrollKey         = collections.NewPrefix(12)
+	SettledGamesKey          = collections.NewPrefix(13)
+	SettledGamesByExpiryKey  = collections.NewPrefix(14)
+	RevealAgentsKey          = collections.NewPrefix(15)
+	GamesByTimeDeadlineKey   = collections.NewPrefix(16)
+	GamesByHeightDeadlineKey = collections.NewPrefix(17)
+	QueueByPlayerKey         = collections.NewPrefix(18)
+	HouseGamesToSeedKey      = collections.NewPrefix(19)
+	HouseExposureKey         = collections.NewPrefix(20)
 )
@@ -0,0 +1,64 @@
+package v2
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/facundomedica/rps"
+)
+
+// Migrate converts the entry fees of existing games to sdk.Coins.
+//
+// Version 1 stored a single sdk.Coin as entry fee. A repeated field is wire
+// compatible with a singular one, so the stored games already decode as a one
+// element sdk.Coins. This normalizes them, dropping empty or invalid coins so
+// settlement doesn't have to deal with them.
+func Migrate(ctx context.Context, games, stuckGames collections.Map[uint64, rps.Game]) error {
+	for _, m := range []collections.Map[uint64, rps.Game]{games, stuckGames} {
+		if err := migrateGames(ctx, m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func migrateGames(ctx context.Context, games collections.Map[uint64, rps.Game]) error {
+	iter, err := games.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range kvs {
+		game := kv.Value
+		game.EntryFee = normalizeCoins(game.EntryFee)
+
+		if err := games.Set(ctx, kv.Key, game); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// normalizeCoins drops invalid and zero coins and returns the rest sorted.
+func normalizeCoins(coins sdk.Coins) sdk.Coins {
+	var valid []sdk.Coin
+	for _, coin := range coins {
+		if coin.Validate() != nil || coin.IsZero() {
+			continue
+		}
+
+		valid = append(valid, coin)
+	}
+
+	return sdk.NewCoins(valid...)
+}
@@ -0,0 +1,89 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+
+	"github.com/facundomedica/rps"
+)
+
+// moveFieldNumber is the field number of MoveReveal.move.
+const moveFieldNumber = 1
+
+// Migrate converts the moves of the stored reveals to the Move enum.
+//
+// Version 2 stored MoveReveal.move as a string, "rock", "paper" or
+// "scissors", which doesn't decode as the enum now in the same field. The
+// reveals are read as raw bytes and the field is rewritten with the enum value,
+// the rest of the fields are kept as they are.
+func Migrate(ctx context.Context, storeService storetypes.KVStoreService) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	reveals := collections.NewMap(sb, rps.MoveRevealKey, "move_reveals", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.BytesValue)
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+
+	iter, err := reveals.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range kvs {
+		bz, err := migrateMoveReveal(kv.Value)
+		if err != nil {
+			return fmt.Errorf("failed to migrate the reveal of game %d: %w", kv.Key.K1(), err)
+		}
+
+		if err := reveals.Set(ctx, kv.Key, bz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migrateMoveReveal rewrites the move of an encoded MoveReveal from its name to
+// the enum value. Reveals already holding the enum are returned unchanged.
+func migrateMoveReveal(bz []byte) ([]byte, error) {
+	out := make([]byte, 0, len(bz))
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		if m < 0 {
+			return nil, protowire.ParseError(m)
+		}
+
+		field := bz[:n+m]
+		bz = bz[n+m:]
+
+		if num != moveFieldNumber || typ != protowire.BytesType {
+			out = append(out, field...)
+			continue
+		}
+
+		name, _ := protowire.ConsumeBytes(field[n:])
+		move, err := rps.ParseMove(string(name))
+		if err != nil {
+			return nil, err
+		}
+
+		out = protowire.AppendTag(out, moveFieldNumber, protowire.VarintType)
+		out = protowire.AppendVarint(out, uint64(move))
+	}
+
+	return out, nil
+}
@@ -0,0 +1,132 @@
+package v4
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+
+	"github.com/facundomedica/rps"
+)
+
+const (
+	// commitFieldNumber is the field number of MoveCommit.commit.
+	commitFieldNumber = 1
+	// saltFieldNumber is the field number of MoveReveal.salt.
+	saltFieldNumber = 2
+	// queueCommitFieldNumber is the field number of QueueEntry.commit.
+	queueCommitFieldNumber = 3
+)
+
+// Migrate converts the stored commitments and salts from hex strings to bytes.
+//
+// Version 3 stored MoveCommit.commit, QueueEntry.commit and MoveReveal.salt as
+// hex strings, which decode as the bytes now in the same fields but hold the
+// hex characters instead of the bytes they encode. The values are read as raw
+// bytes and the fields are replaced with the decoded bytes, the rest of the
+// fields are kept as they are.
+//
+// Commitments that aren't valid hex could never be matched by a reveal, they
+// are emptied so they keep not matching. Salts that aren't valid hex belong to
+// reveals already accepted, they are kept as they were.
+func Migrate(ctx context.Context, storeService storetypes.KVStoreService) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	commits := collections.NewMap(sb, rps.MoveCommitKey, "move_commits", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.BytesValue)
+	reveals := collections.NewMap(sb, rps.MoveRevealKey, "move_reveals", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.BytesValue)
+	queue := collections.NewMap(sb, rps.QueueKey, "queue", collections.Uint64Key, collections.BytesValue)
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+
+	if err := migrateValues(ctx, commits, func(bz []byte) ([]byte, error) {
+		return decodeHexField(bz, commitFieldNumber, false)
+	}); err != nil {
+		return fmt.Errorf("failed to migrate the commitments: %w", err)
+	}
+
+	if err := migrateValues(ctx, reveals, func(bz []byte) ([]byte, error) {
+		return decodeHexField(bz, saltFieldNumber, true)
+	}); err != nil {
+		return fmt.Errorf("failed to migrate the reveals: %w", err)
+	}
+
+	if err := migrateValues(ctx, queue, func(bz []byte) ([]byte, error) {
+		return decodeHexField(bz, queueCommitFieldNumber, false)
+	}); err != nil {
+		return fmt.Errorf("failed to migrate the queue: %w", err)
+	}
+
+	return nil
+}
+
+// migrateValues rewrites every value of the map with migrate.
+func migrateValues[K any](ctx context.Context, m collections.Map[K, []byte], migrate func([]byte) ([]byte, error)) error {
+	iter, err := m.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range kvs {
+		bz, err := migrate(kv.Value)
+		if err != nil {
+			return err
+		}
+
+		if err := m.Set(ctx, kv.Key, bz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeHexField replaces the hex string in a field of an encoded message with
+// the bytes it encodes. If the string isn't valid hex the field is kept as it
+// is when keepInvalid is set, or emptied otherwise.
+func decodeHexField(bz []byte, fieldNumber protowire.Number, keepInvalid bool) ([]byte, error) {
+	out := make([]byte, 0, len(bz))
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		if m < 0 {
+			return nil, protowire.ParseError(m)
+		}
+
+		field := bz[:n+m]
+		bz = bz[n+m:]
+
+		if num != fieldNumber || typ != protowire.BytesType {
+			out = append(out, field...)
+			continue
+		}
+
+		s, _ := protowire.ConsumeBytes(field[n:])
+		decoded, err := hex.DecodeString(string(s))
+		if err != nil {
+			if keepInvalid {
+				out = append(out, field...)
+			}
+			continue
+		}
+
+		if len(decoded) > 0 {
+			out = protowire.AppendTag(out, fieldNumber, protowire.BytesType)
+			out = protowire.AppendBytes(out, decoded)
+		}
+	}
+
+	return out, nil
+}
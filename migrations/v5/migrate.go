@@ -0,0 +1,37 @@
+package v5
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/facundomedica/rps"
+)
+
+// Migrate indexes the open games by their settlement deadline.
+//
+// Version 4 found the games to settle by walking all of them on every block,
+// version 5 keeps them in a deadline index instead. schedule adds a game to
+// the index, it's the same function the keeper uses for new games. Stuck games
+// aren't indexed, they're left to the authority.
+func Migrate(ctx context.Context, games collections.Map[uint64, rps.Game], schedule func(context.Context, rps.Game) error) error {
+	iter, err := games.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range kvs {
+		game := kv.Value
+		game.Id = kv.Key
+		if err := schedule(ctx, game); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package v6
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	storetypes "cosmossdk.io/core/store"
+
+	"github.com/facundomedica/rps"
+)
+
+// queueByDenomKey is the prefix of the version 5 queue index by denom, which
+// is now the prefix of the index by stake.
+var queueByDenomKey = collections.NewPrefix(8)
+
+// Migrate indexes the matchmaking queue by stake and player.
+//
+// Version 5 indexed the queue entries by denom and let a player wait several
+// times for the same denom, version 6 indexes them by the minimum of their
+// stake range and lets a player wait once for each denom. The denom index is
+// removed and the entries are indexed again by queue, oldest first. The newer
+// entries of a player already waiting for the same denom are removed and
+// passed to refund.
+func Migrate[I collections.Indexes[uint64, rps.QueueEntry]](ctx context.Context, storeService storetypes.KVStoreService, addressCodec address.Codec, queue *collections.IndexedMap[uint64, rps.QueueEntry, I], refund func(context.Context, rps.QueueEntry) error) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	entries := collections.NewMap(sb, rps.QueueKey, "queue", collections.Uint64Key, collections.BytesValue)
+	byDenom := collections.NewKeySet(sb, queueByDenomKey, "queue_by_denom", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key))
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+
+	iter, err := byDenom.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := byDenom.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	queueIter, err := queue.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	kvs, err := queueIter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	waiting := make(map[string]bool)
+	for _, kv := range kvs {
+		player, err := addressCodec.StringToBytes(kv.Value.Player)
+		if err != nil {
+			return err
+		}
+
+		key := kv.Value.EntryFee.Denom + "/" + string(player)
+		if !waiting[key] {
+			waiting[key] = true
+			if err := queue.Set(ctx, kv.Key, kv.Value); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		// the entry is removed without going through the indexes, the player
+		// index references the entry that is kept
+		if err := entries.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+
+		if err := refund(ctx, kv.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package v7
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/facundomedica/rps"
+)
+
+// Migrate tracks the stakes of the house in the games in progress.
+//
+// Version 6 took the stake of the house from the bankroll without keeping
+// count of it, version 7 adds it to the open exposure of the house and removes
+// it once the game is over. houseStake returns the stake of the house in a
+// game. Stuck games are counted as well, they're released when the authority
+// resolves them.
+func Migrate(ctx context.Context, games, stuckGames collections.Map[uint64, rps.Game], houseStake func(context.Context, rps.Game) (sdk.Coins, error), exposure collections.Map[string, math.Int]) error {
+	total := sdk.NewCoins()
+	for _, m := range []collections.Map[uint64, rps.Game]{games, stuckGames} {
+		err := m.Walk(ctx, nil, func(id uint64, game rps.Game) (bool, error) {
+			if !game.House {
+				return false, nil
+			}
+
+			game.Id = id
+			stake, err := houseStake(ctx, game)
+			if err != nil {
+				return true, err
+			}
+
+			total = total.Add(stake...)
+			return false, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, coin := range total {
+		amount, err := exposure.Get(ctx, coin.Denom)
+		if err != nil {
+			if !errors.Is(err, collections.ErrNotFound) {
+				return err
+			}
+
+			amount = math.ZeroInt()
+		}
+
+		if err := exposure.Set(ctx, coin.Denom, amount.Add(coin.Amount)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
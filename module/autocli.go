@@ -21,6 +21,88 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "count",
 					Short:     "Get total games count",
 				},
+				{
+					RpcMethod: "StuckGames",
+					Use:       "stuck-games",
+					Short:     "Get the games that couldn't be settled",
+				},
+				{
+					RpcMethod: "SettlementBacklog",
+					Use:       "settlement-backlog",
+					Short:     "Get the number of games waiting to be settled",
+				},
+				{
+					RpcMethod:      "PlayerStats",
+					Use:            "player-stats [address]",
+					Short:          "Get the track record of a player",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "Queue",
+					Use:       "queue",
+					Short:     "Get the players waiting in the matchmaking queue",
+				},
+				{
+					RpcMethod:      "BetPools",
+					Use:            "bet-pools [game_id]",
+					Short:          "Get the spectator betting pools of a game and their implied odds",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "game_id"}},
+				},
+				{
+					RpcMethod: "Jackpot",
+					Use:       "jackpot",
+					Short:     "Get the progressive jackpot and the win streak needed to take it",
+				},
+				{
+					RpcMethod: "HouseBankroll",
+					Use:       "house-bankroll",
+					Short:     "Get the funds available to the house and its max exposure per game",
+				},
+				{
+					RpcMethod:      "SettledGame",
+					Use:            "settled-game [game_id]",
+					Short:          "Get a recently settled game that can still be rematched",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "game_id"}},
+				},
+				{
+					RpcMethod:      "RevealAgent",
+					Use:            "reveal-agent [address]",
+					Short:          "Get the reveal agent of a player",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "EscrowedReveal",
+					Use:            "escrowed-reveal [game_id] [player]",
+					Short:          "Get the encrypted reveal a player escrowed for their reveal agent",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "game_id"}, {ProtoField: "player"}},
+				},
+				{
+					RpcMethod:      "MoveCommit",
+					Use:            "move-commit [game_id] [player]",
+					Short:          "Get the move commitment of a player in a game",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "game_id"}, {ProtoField: "player"}},
+				},
+				{
+					RpcMethod:      "GamePlayers",
+					Use:            "game-players [game_id]",
+					Short:          "Get the players that committed a move to a game",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "game_id"}},
+				},
+				{
+					RpcMethod:      "ComputeCommitment",
+					Use:            "compute-commitment [move] [salt]",
+					Short:          "Get the commitment of a move with a salt",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "move"}, {ProtoField: "salt"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"scheme": {Usage: "Hash function of the commitment, sha256 or keccak256 (defaults to sha256)"},
+					},
+				},
+				{
+					RpcMethod:      "VerifyReveal",
+					Use:            "verify-reveal [game_id] [player] [move] [salt]",
+					Short:          "Check whether a player's reveal would be accepted, and why not otherwise",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "game_id"}, {ProtoField: "player"}, {ProtoField: "move"}, {ProtoField: "salt"}},
+				},
 				{
 					RpcMethod: "Params",
 					Use:       "params",
@@ -30,6 +112,62 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
 			Service: rpsv1.Msg_ServiceDesc.ServiceName,
+			// the commands of the messages committing and revealing a move, which
+			// use the salt store, are hand-written in GetTxCmd
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "SetRevealAgent",
+					Use:            "set-reveal-agent [agent]",
+					Short:          "Allow an address to reveal your moves on your behalf, an empty agent removes it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "agent"}},
+				},
+				{
+					RpcMethod:      "EscrowReveal",
+					Use:            "escrow-reveal [game_id] [encrypted_reveal]",
+					Short:          "Escrow your reveal encrypted for your reveal agent",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "game_id"}, {ProtoField: "encrypted_reveal"}},
+				},
+				{
+					RpcMethod: "AgentRevealMove",
+					Use:       "agent-reveal-move [player] [game_id] [move] [salt]",
+					Short:     "Reveal the move of a player you are the reveal agent of",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "player"},
+						{ProtoField: "game_id"},
+						{ProtoField: "move"},
+						{ProtoField: "salt"},
+					},
+				},
+				{
+					RpcMethod:      "LeaveQueue",
+					Use:            "leave-queue [entry_id]",
+					Short:          "Leave the matchmaking queue and get the entry fee back",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "entry_id"}},
+				},
+				{
+					RpcMethod: "PlaceBet",
+					Use:       "place-bet [game_id] [creator|challenger|draw] [amount]",
+					Short:     "Bet on the outcome of a game that is waiting for an opponent",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "game_id"},
+						{ProtoField: "outcome"},
+						{ProtoField: "amount", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "ResolveStuckGame",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "FundHouse",
+					Skip:      true, // skipped because authority gated
+				},
+			},
 		},
 	}
 }
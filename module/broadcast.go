@@ -0,0 +1,113 @@
+package module
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/facundomedica/rps/client/rpsclient"
+)
+
+// broadcastTx signs and broadcasts a transaction like tx.BroadcastTx does, but
+// returns the response of the node instead of only printing it. A nil
+// response means the transaction wasn't broadcast (simulation or canceled).
+func broadcastTx(clientCtx client.Context, flagSet *pflag.FlagSet, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	txf, err := tx.NewFactoryCLI(clientCtx, flagSet)
+	if err != nil {
+		return nil, err
+	}
+
+	txf, err = txf.Prepare(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if txf.SimulateAndExecute() || clientCtx.Simulate {
+		if clientCtx.Offline {
+			return nil, errors.New("cannot estimate gas in offline mode")
+		}
+
+		_, adjusted, err := tx.CalculateGas(clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+
+		txf = txf.WithGas(adjusted)
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n", tx.GasEstimateResponse{GasEstimate: txf.Gas()})
+	}
+
+	if clientCtx.Simulate {
+		return nil, nil
+	}
+
+	unsignedTx, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !clientCtx.SkipConfirm {
+		txBytes, err := clientCtx.TxConfig.TxJSONEncoder()(unsignedTx.GetTx())
+		if err != nil {
+			return nil, err
+		}
+
+		if err := clientCtx.PrintRaw(json.RawMessage(txBytes)); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "error: %v\n%s\n", err, txBytes)
+		}
+
+		ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", bufio.NewReader(os.Stdin), os.Stderr)
+		if err != nil || !ok {
+			_, _ = fmt.Fprintln(os.Stderr, "canceled transaction")
+			return nil, err
+		}
+	}
+
+	if err := tx.Sign(clientCtx.CmdContext, txf, clientCtx.GetFromName(), unsignedTx, true); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(unsignedTx.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, clientCtx.PrintProto(res)
+}
+
+// broadcastAndWait broadcasts a transaction like broadcastTx and waits for it
+// to be included in a block, returning its result. A nil result means the
+// transaction wasn't broadcast.
+func broadcastAndWait(clientCtx client.Context, flagSet *pflag.FlagSet, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	res, err := broadcastTx(clientCtx, flagSet, msgs...)
+	if err != nil || res == nil {
+		return nil, err
+	}
+
+	if res.Code != 0 {
+		return nil, rpsclient.TxError(res)
+	}
+
+	ctx := clientCtx.CmdContext
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return rpsclient.WaitForTx(ctx, clientCtx, res.TxHash, time.Second)
+}
@@ -0,0 +1,382 @@
+package module
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/facundomedica/rps"
+	"github.com/facundomedica/rps/client/saltstore"
+)
+
+const (
+	flagCommitTimeout              = "commit-timeout"
+	flagRevealTimeout              = "reveal-timeout"
+	flagChallengerFee              = "challenger-fee"
+	flagMinEntryFee                = "min-entry-fee"
+	flagCommitmentScheme           = "commitment-scheme"
+	flagCreatorCommitmentScheme    = "creator-commitment-scheme"
+	flagChallengerCommitmentScheme = "challenger-commitment-scheme"
+
+	commitmentSchemeUsage = "Hash function of the commitment, sha256 or keccak256 if enabled in the module params (defaults to sha256)"
+	timeoutUsage          = "timeout for this game, in seconds or blocks depending on the module params (0 uses the module default)"
+	challengerFeeUsage    = "Stake the challenger has to put into the game to offer odds (defaults to the entry fee)"
+)
+
+// The commands of the messages committing a move take the move in plaintext:
+// the commitment is computed with a fresh salt, kept in the salt store.
+
+func newGameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "new-game [move] [entry_fee]",
+		Short: "Create a new game",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			entry, commit, scheme, err := newCommit(cmd, args[0], flagCommitmentScheme)
+			if err != nil {
+				return err
+			}
+
+			fee, err := sdk.ParseCoinsNormalized(strings.Join(args[1:], ","))
+			if err != nil {
+				return err
+			}
+
+			commitTimeout, err := cmd.Flags().GetUint64(flagCommitTimeout)
+			if err != nil {
+				return err
+			}
+
+			revealTimeout, err := cmd.Flags().GetUint64(flagRevealTimeout)
+			if err != nil {
+				return err
+			}
+
+			challengerFee, err := coinsFlag(cmd, flagChallengerFee)
+			if err != nil {
+				return err
+			}
+
+			msg := &rps.MsgNewGame{
+				Player:           clientCtx.GetFromAddress().String(),
+				Commit:           commit,
+				EntryFee:         fee,
+				CommitTimeout:    commitTimeout,
+				RevealTimeout:    revealTimeout,
+				ChallengerFee:    challengerFee,
+				CommitmentScheme: scheme,
+			}
+
+			return broadcastCommit(cmd, clientCtx, msg, entry, &rps.MsgNewGameResponse{})
+		},
+	}
+
+	cmd.Flags().Uint64(flagCommitTimeout, 0, "Commit "+timeoutUsage)
+	cmd.Flags().Uint64(flagRevealTimeout, 0, "Reveal "+timeoutUsage)
+	cmd.Flags().String(flagChallengerFee, "", challengerFeeUsage)
+	cmd.Flags().String(flagCommitmentScheme, "", commitmentSchemeUsage)
+	addSaltStoreFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func commitMoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-move [game_id] [move]",
+		Short: "Enter a game",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			gameID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			entry, commit, scheme, err := newCommit(cmd, args[1], flagCommitmentScheme)
+			if err != nil {
+				return err
+			}
+
+			msg := &rps.MsgCommitMove{
+				Player:           clientCtx.GetFromAddress().String(),
+				GameId:           gameID,
+				Commit:           commit,
+				CommitmentScheme: scheme,
+			}
+
+			entry.GameID, entry.Pending = gameID, false
+			return broadcastCommit(cmd, clientCtx, msg, entry, nil)
+		},
+	}
+
+	cmd.Flags().String(flagCommitmentScheme, "", commitmentSchemeUsage)
+	addSaltStoreFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func createAndJoinCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-and-join [challenger] [challenger_commit] [move] [entry_fee]",
+		Short: "Create a game with a challenger that already agreed to play",
+		Long: `Create a game with a challenger that already agreed to play, committing both moves at once.
+The challenger computes their commitment with their own salt, and the transaction must be
+signed by both players: generate it with --generate-only and have each player sign it.`,
+		Args: cobra.MinimumNArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			challengerCommit, err := rps.ParseHexBytes(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid challenger commit: %w", err)
+			}
+
+			entry, commit, scheme, err := newCommit(cmd, args[2], flagCreatorCommitmentScheme)
+			if err != nil {
+				return err
+			}
+
+			fee, err := sdk.ParseCoinsNormalized(strings.Join(args[3:], ","))
+			if err != nil {
+				return err
+			}
+
+			revealTimeout, err := cmd.Flags().GetUint64(flagRevealTimeout)
+			if err != nil {
+				return err
+			}
+
+			challengerFee, err := coinsFlag(cmd, flagChallengerFee)
+			if err != nil {
+				return err
+			}
+
+			challengerScheme, err := schemeFlag(cmd, flagChallengerCommitmentScheme)
+			if err != nil {
+				return err
+			}
+
+			msg := &rps.MsgCreateAndJoin{
+				Creator:                    clientCtx.GetFromAddress().String(),
+				CreatorCommit:              commit,
+				Challenger:                 args[0],
+				ChallengerCommit:           challengerCommit,
+				EntryFee:                   fee,
+				ChallengerFee:              challengerFee,
+				RevealTimeout:              revealTimeout,
+				CreatorCommitmentScheme:    scheme,
+				ChallengerCommitmentScheme: challengerScheme,
+			}
+
+			return broadcastCommit(cmd, clientCtx, msg, entry, &rps.MsgCreateAndJoinResponse{})
+		},
+	}
+
+	cmd.Flags().Uint64(flagRevealTimeout, 0, "Reveal "+timeoutUsage)
+	cmd.Flags().String(flagChallengerFee, "", challengerFeeUsage)
+	cmd.Flags().String(flagCreatorCommitmentScheme, "", "Hash function of your commitment, sha256 or keccak256 if enabled in the module params (defaults to sha256)")
+	cmd.Flags().String(flagChallengerCommitmentScheme, "", "Hash function the challenger computed their commitment with (defaults to sha256)")
+	addSaltStoreFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func joinQueueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "join-queue [move] [entry_fee]",
+		Short: "Join the matchmaking queue, playing against the player waiting with an overlapping stake range for the highest stake",
+		Long:  "Join the matchmaking queue. The entry fee is the maximum stake, use --min-entry-fee to accept games for less.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			entry, commit, scheme, err := newCommit(cmd, args[0], flagCommitmentScheme)
+			if err != nil {
+				return err
+			}
+
+			fee, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &rps.MsgJoinQueue{
+				Player:           clientCtx.GetFromAddress().String(),
+				Commit:           commit,
+				EntryFee:         fee,
+				CommitmentScheme: scheme,
+			}
+
+			if minFee, _ := cmd.Flags().GetString(flagMinEntryFee); minFee != "" {
+				msg.MinEntryFee, err = sdk.ParseCoinNormalized(minFee)
+				if err != nil {
+					return err
+				}
+			}
+
+			return broadcastCommit(cmd, clientCtx, msg, entry, &rps.MsgJoinQueueResponse{})
+		},
+	}
+
+	cmd.Flags().String(flagMinEntryFee, "", "Minimum stake to play for (defaults to the entry fee)")
+	cmd.Flags().String(flagCommitmentScheme, "", commitmentSchemeUsage)
+	addSaltStoreFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func playHouseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "play-house [move] [entry_fee]",
+		Short: "Play a game against the house",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			entry, commit, scheme, err := newCommit(cmd, args[0], flagCommitmentScheme)
+			if err != nil {
+				return err
+			}
+
+			fee, err := sdk.ParseCoinsNormalized(strings.Join(args[1:], ","))
+			if err != nil {
+				return err
+			}
+
+			msg := &rps.MsgPlayHouse{
+				Player:           clientCtx.GetFromAddress().String(),
+				Commit:           commit,
+				EntryFee:         fee,
+				CommitmentScheme: scheme,
+			}
+
+			return broadcastCommit(cmd, clientCtx, msg, entry, &rps.MsgPlayHouseResponse{})
+		},
+	}
+
+	cmd.Flags().String(flagCommitmentScheme, "", commitmentSchemeUsage)
+	addSaltStoreFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func offerRematchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offer-rematch [game_id] [move]",
+		Short: "Offer a rematch of a settled game to the previous opponent",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			gameID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			entry, commit, scheme, err := newCommit(cmd, args[1], flagCommitmentScheme)
+			if err != nil {
+				return err
+			}
+
+			msg := &rps.MsgOfferRematch{
+				Player:           clientCtx.GetFromAddress().String(),
+				GameId:           gameID,
+				Commit:           commit,
+				CommitmentScheme: scheme,
+			}
+
+			// the rematch is a new game, its ID is in the response
+			return broadcastCommit(cmd, clientCtx, msg, entry, &rps.MsgOfferRematchResponse{})
+		},
+	}
+
+	cmd.Flags().String(flagCommitmentScheme, "", commitmentSchemeUsage)
+	addSaltStoreFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// newCommit commits to a move with a fresh salt, under the commitment scheme of
+// the given flag. It returns the salt store entry of the move, pending until
+// the game ID is known.
+func newCommit(cmd *cobra.Command, moveArg, flag string) (saltstore.Entry, rps.HexBytes, rps.CommitmentScheme, error) {
+	move, err := rps.ParseMove(strings.ToLower(moveArg))
+	if err != nil {
+		return saltstore.Entry{}, nil, 0, err
+	}
+
+	scheme, err := schemeFlag(cmd, flag)
+	if err != nil {
+		return saltstore.Entry{}, nil, 0, err
+	}
+
+	salt, err := salt(32)
+	if err != nil {
+		return saltstore.Entry{}, nil, 0, err
+	}
+
+	commit, err := scheme.Commitment(move, salt)
+	if err != nil {
+		return saltstore.Entry{}, nil, 0, err
+	}
+
+	// the move is kept under the name the commitment is computed over
+	entry := saltstore.Entry{Pending: true, Move: move.Name(), Salt: salt.String(), Commit: rps.HexBytes(commit).String()}
+	return entry, commit, scheme, nil
+}
+
+// schemeFlag parses a commitment scheme flag, given by its name with or without
+// the COMMITMENT_SCHEME_ prefix, in any case.
+func schemeFlag(cmd *cobra.Command, flag string) (rps.CommitmentScheme, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return rps.CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED, err
+	}
+
+	name := strings.ToUpper(value)
+	for _, candidate := range []string{name, "COMMITMENT_SCHEME_" + name} {
+		if scheme, ok := rps.CommitmentScheme_value[candidate]; ok {
+			return rps.CommitmentScheme(scheme), nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid --%s: unknown commitment scheme %q", flag, value)
+}
+
+// coinsFlag parses a coins flag, empty when not set.
+func coinsFlag(cmd *cobra.Command, flag string) (sdk.Coins, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return nil, err
+	}
+
+	return sdk.ParseCoinsNormalized(value)
+}
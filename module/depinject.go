@@ -36,6 +36,7 @@ type ModuleInputs struct {
 	StoreService store.KVStoreService
 	AddressCodec address.Codec
 	BankKeeper   expectedkeepers.BankKeeper
+	DistrKeeper  expectedkeepers.DistributionKeeper
 
 	Config *modulev1.Module
 }
@@ -54,7 +55,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
 	}
 
-	k := keeper.NewKeeper(in.Cdc, in.AddressCodec, in.StoreService, in.BankKeeper, authority.String())
+	k := keeper.NewKeeper(in.Cdc, in.AddressCodec, in.StoreService, in.BankKeeper, in.DistrKeeper, authority.String())
 	m := NewAppModule(k)
 
 	return ModuleOutputs{Module: m, Keeper: k}
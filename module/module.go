@@ -3,9 +3,9 @@ package module
 import (
 	"context"
 	"crypto/rand"
-	"encoding/hex"
-	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"cosmossdk.io/core/appmodule"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -19,14 +19,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 
 	"github.com/facundomedica/rps"
+	"github.com/facundomedica/rps/client/saltstore"
 	"github.com/facundomedica/rps/keeper"
-	"github.com/facundomedica/rps/utils"
-
-	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ConsensusVersion defines the current module consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 7
 
 type AppModule struct {
 	appmodule.HasGenesis
@@ -39,7 +37,7 @@ type AppModule struct {
 func NewAppModule(keeper keeper.Keeper) AppModule {
 	return AppModule{
 		// using the keeper's schema as the genesis schema, this means all state must use collections
-		HasGenesis: keeper.Schema,
+		HasGenesis: keeper.GenesisHandler(),
 		keeper:     keeper,
 	}
 }
@@ -77,83 +75,71 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	rps.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
 
 	// Register in place module state migration migrations
-	// m := keeper.NewMigrator(am.keeper)
-	// if err := cfg.RegisterMigration(rps.ModuleName, 1, m.Migrate1to2); err != nil {
-	// 	panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", rps.ModuleName, err))
-	// }
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(rps.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", rps.ModuleName, err))
+	}
+
+	if err := cfg.RegisterMigration(rps.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", rps.ModuleName, err))
+	}
+
+	if err := cfg.RegisterMigration(rps.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", rps.ModuleName, err))
+	}
+
+	if err := cfg.RegisterMigration(rps.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", rps.ModuleName, err))
+	}
+
+	if err := cfg.RegisterMigration(rps.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6: %v", rps.ModuleName, err))
+	}
+
+	if err := cfg.RegisterMigration(rps.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 6 to 7: %v", rps.ModuleName, err))
+	}
 }
 
 func (am AppModule) EndBlock(ctx context.Context) error {
 	return am.keeper.EndBlocker(ctx)
 }
 
-func (AppModule) GetTxCmd() *cobra.Command {
+func (am AppModule) GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  rps.ModuleName,
 		Args: cobra.ExactArgs(1),
 		RunE: client.ValidateCmd,
 	}
 
+	// the rest of the commands are generated by autocli
 	cmd.AddCommand(
 		newGameCmd(),
 		commitMoveCmd(),
+		createAndJoinCmd(),
+		joinQueueCmd(),
+		playHouseCmd(),
+		offerRematchCmd(),
 		revealMoveCmd(),
+		saltsCmd(),
+		watchCmd(),
+		playCmd(),
 	)
 	return cmd
 }
 
-func newGameCmd() *cobra.Command {
+func revealMoveCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "new-game [move] [entry_fee]",
-		Short: "Create a new game",
-		Args:  cobra.ExactArgs(2),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientTxContext(cmd)
-			if err != nil {
-				return err
-			}
-
-			playerAddr := clientCtx.GetFromAddress()
-
-			move := args[0]
-
-			if !utils.MoveIsValid(move) {
-				return errors.New("invalid move")
-			}
-
-			salt, err := salt(32)
-			if err != nil {
-				return err
-			}
-
-			commit := utils.CalculateCommitment(move, salt)
-
-			fee, err := sdk.ParseCoinNormalized(args[1])
-			if err != nil {
-				return err
-			}
-
-			msg := &rps.MsgNewGame{
-				Player:   playerAddr.String(),
-				Commit:   commit,
-				EntryFee: fee,
+		Use:   "reveal-move [game_id] [[move] [salt]]",
+		Short: "Reveal your move",
+		Long:  "Reveal your move, the move and salt are looked up in the salt store when only the game ID is given.",
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 && len(args) != 3 {
+				return fmt.Errorf("accepts 1 or 3 arg(s), received %d", len(args))
 			}
 
-			cmd.Println("Copy your salt for the reveal stage:", salt)
-
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return nil
 		},
-	}
-
-	flags.AddTxFlagsToCmd(cmd)
-	return cmd
-}
-
-func commitMoveCmd() *cobra.Command {
-	cmd := &cobra.Command{
-		Use:   "commit-move [game_id] [move]",
-		Short: "Enter a game",
-		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -167,67 +153,35 @@ func commitMoveCmd() *cobra.Command {
 				return err
 			}
 
-			move := args[1]
-
-			if !utils.MoveIsValid(move) {
-				return errors.New("invalid move")
+			entry := saltstore.Entry{}
+			if len(args) == 3 {
+				entry.Move, entry.Salt = strings.ToLower(args[1]), args[2]
+			} else {
+				store, err := openSaltStore(cmd, clientCtx)
+				if err != nil {
+					return err
+				}
+
+				var found bool
+				entry, found, err = lookupSalt(cmd.Context(), store, clientCtx.ChainID, gameID, playerAddr.String(), queryMoveCommit(rps.NewQueryClient(clientCtx), playerAddr.String()))
+				if err != nil {
+					return err
+				}
+
+				if !found {
+					return fmt.Errorf("no move for game %d in the salt store", gameID)
+				}
 			}
 
-			salt, err := salt(32)
+			msg, err := revealMsg(playerAddr.String(), gameID, entry)
 			if err != nil {
 				return err
 			}
 
-			commit := utils.CalculateCommitment(move, salt)
-
-			msg := &rps.MsgCommitMove{
-				Player: playerAddr.String(),
-				GameId: gameID,
-				Commit: commit,
-			}
-
-			cmd.Println("Copy your salt for the reveal stage:", salt)
-
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
-		},
-	}
-	flags.AddTxFlagsToCmd(cmd)
-	return cmd
-}
-
-func revealMoveCmd() *cobra.Command {
-	cmd := &cobra.Command{
-		Use:   "reveal-move [game_id] [move] [salt]",
-		Short: "Reveal your move",
-		Args:  cobra.ExactArgs(3),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientTxContext(cmd)
-			if err != nil {
-				return err
-			}
-
-			playerAddr := clientCtx.GetFromAddress()
-
-			gameID, err := strconv.ParseUint(args[0], 10, 64)
-			if err != nil {
+			if err := rps.ValidateSalt(msg.Salt); err != nil {
 				return err
 			}
 
-			move := args[1]
-
-			if !utils.MoveIsValid(move) {
-				return errors.New("invalid move")
-			}
-
-			salt := args[2]
-
-			msg := &rps.MsgRevealMove{
-				Player: playerAddr.String(),
-				GameId: gameID,
-				Move:   move,
-				Salt:   salt,
-			}
-
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -235,10 +189,11 @@ func revealMoveCmd() *cobra.Command {
 	return cmd
 }
 
-func salt(n int) (string, error) {
+// salt returns a random salt of n bytes.
+func salt(n int) (rps.HexBytes, error) {
 	bytes := make([]byte, n)
 	if _, err := rand.Read(bytes); err != nil {
-		return "", err
+		return nil, err
 	}
-	return hex.EncodeToString(bytes), nil
+	return bytes, nil
 }
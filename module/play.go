@@ -0,0 +1,196 @@
+package module
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/facundomedica/rps"
+	"github.com/facundomedica/rps/client/rpsclient"
+)
+
+const (
+	flagMove     = "move"
+	flagJoin     = "join"
+	flagEntryFee = "entry-fee"
+)
+
+// describeSettlement returns the outcome of a settled game for a player.
+func describeSettlement(settled *rps.EventGameSettled, player string) string {
+	var own, opponent rps.PlayerResult
+	for _, result := range settled.Results {
+		if result.Player == player {
+			own = result
+		} else {
+			opponent = result
+		}
+	}
+
+	payout := "nothing"
+	if !own.Payout.IsZero() {
+		payout = own.Payout.String()
+	}
+
+	if opponent.Player == "" {
+		return fmt.Sprintf("Nobody joined game %d, you got %s back", settled.GameId, payout)
+	}
+
+	opponentMove := "didn't reveal"
+	if opponent.Move != rps.Move_MOVE_UNSPECIFIED {
+		opponentMove = "played " + opponent.Move.Name()
+	}
+
+	switch {
+	case len(settled.Winners) == 0:
+		return fmt.Sprintf("Nobody revealed in game %d, you got %s back", settled.GameId, payout)
+	case len(settled.Winners) == 2:
+		return fmt.Sprintf("Game %d is a draw, your opponent %s too. You got %s back", settled.GameId, opponentMove, payout)
+	case settled.Winners[0] == player:
+		return fmt.Sprintf("You won game %d! Your opponent %s. You got %s", settled.GameId, opponentMove, payout)
+	default:
+		return fmt.Sprintf("You lost game %d, your opponent %s. You got %s", settled.GameId, opponentMove, payout)
+	}
+}
+
+func playCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "play",
+		Short: "Play a whole game: commit a move, wait for an opponent, reveal and get the outcome",
+		Long: `Create a game, or join one with --join, and follow it until it's over. The move is kept in
+the salt store and revealed as soon as the game is full, then the outcome and payout are
+printed once the game is settled. The move, the game to join and the entry fee are
+prompted for unless given with flags, and transactions are broadcast without further
+confirmation.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.GenerateOnly || clientCtx.Simulate {
+				return errors.New("play broadcasts its transactions, it can't generate nor simulate them")
+			}
+
+			interval, err := cmd.Flags().GetDuration(flagPollInterval)
+			if err != nil {
+				return err
+			}
+
+			buf := bufio.NewReader(cmd.InOrStdin())
+			prompt := func(flagName, text string) (string, error) {
+				if cmd.Flags().Changed(flagName) {
+					return cmd.Flags().GetString(flagName)
+				}
+
+				value, err := input.GetString(text, buf)
+				return strings.TrimSpace(value), err
+			}
+
+			moveName, err := prompt(flagMove, "Your move (rock, paper or scissors):")
+			if err != nil {
+				return err
+			}
+
+			move, err := rps.ParseMove(strings.ToLower(moveName))
+			if err != nil {
+				return err
+			}
+
+			join := ""
+			if !cmd.Flags().Changed(flagEntryFee) {
+				join, err = prompt(flagJoin, "Game ID to join, leave empty to create a new game:")
+				if err != nil {
+					return err
+				}
+			}
+
+			var fee sdk.Coins
+			if join == "" {
+				feeStr, err := prompt(flagEntryFee, "Entry fee:")
+				if err != nil {
+					return err
+				}
+
+				fee, err = sdk.ParseCoinsNormalized(feeStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			store, err := openSaltStore(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			c := rpsclient.New(clientCtx, txf, store, rpsclient.WithPollInterval(interval))
+
+			var gameID uint64
+			if join != "" {
+				gameID, err = strconv.ParseUint(join, 10, 64)
+				if err != nil {
+					return err
+				}
+
+				if err := c.Join(cmd.Context(), gameID, move); err != nil {
+					return err
+				}
+
+				cmd.Printf("Joined game %d\n", gameID)
+			} else {
+				gameID, err = c.CreateGame(cmd.Context(), move, fee)
+				if err != nil {
+					return err
+				}
+
+				cmd.Printf("Created game %d, waiting for an opponent\n", gameID)
+			}
+
+			full, err := c.WaitForOpponent(cmd.Context(), gameID)
+			if err != nil {
+				return err
+			}
+
+			if full {
+				if err := c.Reveal(cmd.Context(), gameID); err != nil {
+					return err
+				}
+
+				cmd.Printf("Revealed %s, waiting for the game to be settled\n", move.Name())
+			}
+
+			settled, err := c.WaitForOutcome(cmd.Context(), gameID)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(describeSettlement(settled, clientCtx.GetFromAddress().String()))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagMove, "", "Move to play, prompted for if not set")
+	cmd.Flags().String(flagJoin, "", "ID of the game to join, a new game is created if not set")
+	cmd.Flags().String(flagEntryFee, "", "Entry fee of the new game, prompted for if not set")
+	cmd.Flags().Duration(flagPollInterval, 2*time.Second, "How often to poll the node for the game")
+	cmd.MarkFlagsMutuallyExclusive(flagJoin, flagEntryFee)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,372 @@
+package module
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/facundomedica/rps"
+	"github.com/facundomedica/rps/client/rpsclient"
+	"github.com/facundomedica/rps/client/saltstore"
+)
+
+const (
+	flagNoSaltStore = "no-salt-store"
+	flagPending     = "pending"
+
+	// envSaltPassphrase is the environment variable holding the passphrase of
+	// the salt store, it's prompted for when not set.
+	envSaltPassphrase = "RPS_SALT_PASSPHRASE"
+)
+
+// gameIDResponse is a message response carrying the ID of a game.
+type gameIDResponse interface {
+	proto.Message
+	GetGameId() uint64
+}
+
+// openSaltStore opens the salt store under the client home.
+func openSaltStore(cmd *cobra.Command, clientCtx client.Context) (*saltstore.Store, error) {
+	passphrase := os.Getenv(envSaltPassphrase)
+	if passphrase == "" {
+		var err error
+		passphrase, err = input.GetPassword("Enter the salt store passphrase:", bufio.NewReader(cmd.InOrStdin()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return saltstore.Open(saltstore.DefaultPath(clientCtx.HomeDir), []byte(passphrase))
+}
+
+// addSaltStoreFlags adds the flags of the commands committing a move.
+func addSaltStoreFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(flagNoSaltStore, false, "Don't keep the move and salt in the salt store, print the salt instead")
+}
+
+// broadcastCommit broadcasts a message committing a move, keeping the move and
+// salt in the salt store before anything is sent. When the game doesn't exist
+// yet the entry is pending, and its game ID is filled in from resp once the
+// transaction is included.
+func broadcastCommit(cmd *cobra.Command, clientCtx client.Context, msg sdk.Msg, entry saltstore.Entry, resp gameIDResponse) error {
+	if noStore, _ := cmd.Flags().GetBool(flagNoSaltStore); noStore {
+		cmd.Println("Copy your salt for the reveal stage:", entry.Salt)
+		return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+	}
+
+	store, err := openSaltStore(cmd, clientCtx)
+	if err != nil {
+		return err
+	}
+
+	entry.ChainID = clientCtx.ChainID
+	entry.Address = clientCtx.GetFromAddress().String()
+	entry.CreatedAt = time.Now().UTC()
+	store.Put(entry)
+	if err := store.Save(); err != nil {
+		return fmt.Errorf("failed to save the salt, not broadcasting: %w", err)
+	}
+
+	if !entry.Pending || resp == nil || clientCtx.GenerateOnly || clientCtx.IsAux {
+		return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+	}
+
+	included, err := broadcastAndWait(clientCtx, cmd.Flags(), msg)
+	if err != nil {
+		return fmt.Errorf("the salt is kept as pending in the salt store: %w", err)
+	}
+
+	if included == nil {
+		return nil
+	}
+
+	if err := rpsclient.MsgResponse(included, resp); err != nil {
+		return err
+	}
+
+	// a player waiting in the matchmaking queue doesn't have a game yet
+	if res, ok := resp.(*rps.MsgJoinQueueResponse); ok && !res.Matched {
+		cmd.Println("No game yet, the salt is kept as pending in the salt store")
+		return nil
+	}
+
+	entry.GameID, entry.Pending = resp.GetGameId(), false
+	store.Put(entry)
+	if err := store.Save(); err != nil {
+		return err
+	}
+
+	cmd.Println("Game ID:", entry.GameID)
+	return nil
+}
+
+// lookupSalt returns the salt store entry of a player in a game. Pending
+// entries, like the one of a player that waited in the matchmaking queue until
+// someone else joined it, are resolved by comparing their commitment with the
+// player's commitment in the game, and saved with the game ID.
+func lookupSalt(ctx context.Context, store *saltstore.Store, chainID string, gameID uint64, player string, moveCommit moveCommitFunc) (saltstore.Entry, bool, error) {
+	if entry, found := store.Get(chainID, gameID, player); found {
+		return entry, true, nil
+	}
+
+	if !store.HasPending(chainID, player) {
+		return saltstore.Entry{}, false, nil
+	}
+
+	commit, err := moveCommit(ctx, gameID)
+	if err != nil || commit == nil {
+		return saltstore.Entry{}, false, err
+	}
+
+	entry, found := store.Resolve(chainID, player, gameID, rps.HexBytes(commit).String())
+	if !found {
+		return saltstore.Entry{}, false, nil
+	}
+
+	if err := store.Save(); err != nil {
+		return saltstore.Entry{}, false, err
+	}
+
+	return entry, true, nil
+}
+
+// moveCommitFunc returns the commitment of a player in a game, or nil if they
+// aren't in it.
+type moveCommitFunc func(ctx context.Context, gameID uint64) ([]byte, error)
+
+// queryMoveCommit returns a moveCommitFunc querying the commitments of player.
+func queryMoveCommit(queryClient rps.QueryClient, player string) moveCommitFunc {
+	return func(ctx context.Context, gameID uint64) ([]byte, error) {
+		res, err := queryClient.MoveCommit(ctx, &rps.QueryMoveCommitRequest{GameId: gameID, Player: player})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, nil
+			}
+
+			return nil, err
+		}
+
+		return res.MoveCommit.Commit, nil
+	}
+}
+
+func saltsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "salts",
+		Short: "Manage the moves and salts kept in the salt store",
+		Long: fmt.Sprintf(`Manage the moves and salts kept in the encrypted salt store under the client home.
+The passphrase of the store is read from %s, or prompted for.`, envSaltPassphrase),
+		Args: cobra.ExactArgs(1),
+		RunE: client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		saltsListCmd(),
+		saltsExportCmd(),
+		saltsPruneCmd(),
+	)
+	return cmd
+}
+
+func saltsListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the moves in the salt store",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			store, err := openSaltStore(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "CHAIN ID\tGAME ID\tADDRESS\tMOVE\tCREATED AT")
+			for _, e := range store.Entries() {
+				gameID := fmt.Sprint(e.GameID)
+				if e.Pending {
+					gameID = "pending"
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", e.ChainID, gameID, e.Address, e.Move, e.CreatedAt.Format(time.RFC3339))
+			}
+
+			return w.Flush()
+		},
+	}
+}
+
+func saltsExportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export",
+		Short: "Print the moves and salts in the salt store as unencrypted JSON",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			store, err := openSaltStore(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(store.Entries(), "", "  ")
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+}
+
+func saltsPruneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Remove the moves of the games of this chain that are over",
+		Long: `Remove the moves of the games of this chain that are over. A game is known to be
+over when it's in its rematch window or its settlement is found in the block
+events indexed by the node. The moves of the other games that aren't in
+progress are only removed after confirmation, as they may be of games the node
+doesn't know about.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// the games that aren't over are the open and the stuck ones
+			queryClient := rps.NewQueryClient(clientCtx)
+			games, err := queryClient.Games(cmd.Context(), &rps.QueryGamesRequest{})
+			if err != nil {
+				return err
+			}
+
+			stuck, err := queryClient.StuckGames(cmd.Context(), &rps.QueryStuckGamesRequest{})
+			if err != nil {
+				return err
+			}
+
+			live := map[uint64]bool{}
+			for _, g := range append(games.Games, stuck.Games...) {
+				live[g.Id] = true
+			}
+
+			pending, err := cmd.Flags().GetBool(flagPending)
+			if err != nil {
+				return err
+			}
+
+			skipConfirmation, err := cmd.Flags().GetBool(flags.FlagSkipConfirmation)
+			if err != nil {
+				return err
+			}
+
+			store, err := openSaltStore(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			over, unknown := map[uint64]bool{}, map[uint64]bool{}
+			for _, e := range store.Entries() {
+				if e.ChainID != clientCtx.ChainID || e.Pending || live[e.GameID] || over[e.GameID] || unknown[e.GameID] {
+					continue
+				}
+
+				settled, err := gameSettled(cmd.Context(), clientCtx, queryClient, e.GameID)
+				if err != nil {
+					return err
+				}
+
+				if settled {
+					over[e.GameID] = true
+				} else {
+					unknown[e.GameID] = true
+				}
+			}
+
+			if len(unknown) > 0 && !skipConfirmation {
+				cmd.Printf("%d games with moves in the salt store aren't in progress, but their settlement wasn't found\n", len(unknown))
+				ok, err := input.GetConfirmation("Remove their moves as well?", bufio.NewReader(cmd.InOrStdin()), cmd.ErrOrStderr())
+				if err != nil {
+					return err
+				}
+
+				if !ok {
+					unknown = map[uint64]bool{}
+				}
+			}
+
+			removed := store.Prune(func(e saltstore.Entry) bool {
+				if e.ChainID != clientCtx.ChainID {
+					return false
+				}
+
+				if e.Pending {
+					return pending
+				}
+
+				return over[e.GameID] || unknown[e.GameID]
+			})
+
+			if err := store.Save(); err != nil {
+				return err
+			}
+
+			cmd.Printf("Removed %d moves from the salt store\n", removed)
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagPending, false, "Also remove the moves whose game ID is unknown")
+	cmd.Flags().BoolP(flags.FlagSkipConfirmation, "y", false, "Remove the moves of the games whose settlement wasn't found without asking")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// gameSettled returns whether a game that isn't in progress is known to be
+// over: it's in its rematch window, or its EventGameSettled is in the block
+// events indexed by the node.
+func gameSettled(ctx context.Context, clientCtx client.Context, queryClient rps.QueryClient, gameID uint64) (bool, error) {
+	_, err := queryClient.SettledGame(ctx, &rps.QuerySettledGameRequest{GameId: gameID})
+	if err == nil {
+		return true, nil
+	}
+
+	if status.Code(err) != codes.NotFound {
+		return false, err
+	}
+
+	// the attributes of typed events are JSON encoded, uint64 as strings
+	query := fmt.Sprintf(`%s.game_id='"%d"'`, proto.MessageName(&rps.EventGameSettled{}), gameID)
+	res, err := clientCtx.Client.BlockSearch(ctx, query, nil, nil, "")
+	if err != nil {
+		return false, err
+	}
+
+	return res.TotalCount > 0, nil
+}
@@ -0,0 +1,218 @@
+package module
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/facundomedica/rps"
+	"github.com/facundomedica/rps/client/saltstore"
+)
+
+const (
+	flagPollInterval = "poll-interval"
+	flagMaxRetries   = "max-retries"
+	flagOnce         = "once"
+)
+
+// watcher reveals the moves of a player, kept in the salt store, in the games
+// that became full.
+type watcher struct {
+	chainID    string
+	player     string
+	store      *saltstore.Store
+	dryRun     bool
+	maxRetries int
+	out        io.Writer
+
+	// games returns the games in progress.
+	games func(ctx context.Context) ([]rps.Game, error)
+	// moveCommit returns the commitment of the player in a game.
+	moveCommit moveCommitFunc
+	// reveal broadcasts a reveal and waits for it to be included.
+	reveal func(ctx context.Context, msg *rps.MsgRevealMove) error
+
+	attempts map[uint64]int
+	done     map[uint64]bool
+}
+
+// poll goes once through the games in progress, revealing the player's move in
+// the ones that are full. Failed reveals are retried on the next polls until
+// maxRetries is reached.
+func (w *watcher) poll(ctx context.Context) error {
+	games, err := w.games(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, game := range games {
+		// the reveal timeout is set once the game is full
+		if w.done[game.Id] || !game.HasRevealTimeout() {
+			continue
+		}
+
+		entry, found, err := lookupSalt(ctx, w.store, w.chainID, game.Id, w.player, w.moveCommit)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			continue
+		}
+
+		if !game.UsesHeightTimeouts() && time.Now().After(game.RevealTimeout) {
+			fmt.Fprintf(w.out, "game %d: reveal timeout has passed\n", game.Id)
+			w.done[game.Id] = true
+			continue
+		}
+
+		msg, err := revealMsg(w.player, game.Id, entry)
+		if err != nil {
+			fmt.Fprintf(w.out, "game %d: %s\n", game.Id, err)
+			w.done[game.Id] = true
+			continue
+		}
+
+		if w.dryRun {
+			fmt.Fprintf(w.out, "game %d: would reveal %s\n", game.Id, entry.Move)
+			w.done[game.Id] = true
+			continue
+		}
+
+		if err := w.reveal(ctx, msg); err != nil {
+			// e.g. revealed by hand or by a previous run
+			if errors.Is(err, rps.ErrMoveAlreadyRevealed) {
+				w.done[game.Id] = true
+				continue
+			}
+
+			w.attempts[game.Id]++
+			if w.attempts[game.Id] >= w.maxRetries {
+				fmt.Fprintf(w.out, "game %d: giving up after %d attempts: %s\n", game.Id, w.attempts[game.Id], err)
+				w.done[game.Id] = true
+				continue
+			}
+
+			fmt.Fprintf(w.out, "game %d: reveal failed, retrying: %s\n", game.Id, err)
+			continue
+		}
+
+		fmt.Fprintf(w.out, "game %d: revealed %s\n", game.Id, entry.Move)
+		w.done[game.Id] = true
+	}
+
+	return nil
+}
+
+func watchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Reveal your moves automatically once your games are full",
+		Long: `Poll the node for your games and reveal your moves, kept in the salt store, as soon as
+the games are full. Failed reveals are retried on the next polls, --dry-run prints them
+instead of broadcasting them.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			interval, err := cmd.Flags().GetDuration(flagPollInterval)
+			if err != nil {
+				return err
+			}
+
+			maxRetries, err := cmd.Flags().GetInt(flagMaxRetries)
+			if err != nil {
+				return err
+			}
+
+			once, err := cmd.Flags().GetBool(flagOnce)
+			if err != nil {
+				return err
+			}
+
+			store, err := openSaltStore(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			// reveals are broadcast unattended
+			txCtx := clientCtx.WithSkipConfirmation(true).WithOutput(io.Discard)
+			queryClient := rps.NewQueryClient(clientCtx)
+			w := &watcher{
+				chainID:    clientCtx.ChainID,
+				player:     clientCtx.GetFromAddress().String(),
+				store:      store,
+				dryRun:     clientCtx.Simulate,
+				maxRetries: maxRetries,
+				out:        cmd.OutOrStdout(),
+				games: func(ctx context.Context) ([]rps.Game, error) {
+					res, err := queryClient.Games(ctx, &rps.QueryGamesRequest{})
+					if err != nil {
+						return nil, err
+					}
+
+					return res.Games, nil
+				},
+				moveCommit: queryMoveCommit(queryClient, clientCtx.GetFromAddress().String()),
+				reveal: func(_ context.Context, msg *rps.MsgRevealMove) error {
+					_, err := broadcastAndWait(txCtx, cmd.Flags(), msg)
+					return err
+				},
+				attempts: map[uint64]int{},
+				done:     map[uint64]bool{},
+			}
+
+			for {
+				if err := w.poll(cmd.Context()); err != nil {
+					// the node may be temporarily unreachable
+					if once {
+						return err
+					}
+
+					cmd.PrintErrln("failed to poll the games:", err)
+				}
+
+				if once {
+					return nil
+				}
+
+				select {
+				case <-cmd.Context().Done():
+					return nil
+				case <-time.After(interval):
+				}
+			}
+		},
+	}
+
+	cmd.Flags().Duration(flagPollInterval, 5*time.Second, "How often to poll the node for games")
+	cmd.Flags().Int(flagMaxRetries, 3, "Number of attempts to reveal a move before giving up")
+	cmd.Flags().Bool(flagOnce, false, "Poll once and exit")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// revealMsg returns the message revealing the move of a salt store entry.
+func revealMsg(player string, gameID uint64, entry saltstore.Entry) (*rps.MsgRevealMove, error) {
+	move, err := rps.ParseMove(entry.Move)
+	if err != nil {
+		return nil, err
+	}
+
+	salt, err := rps.ParseHexBytes(entry.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid salt: %w", err)
+	}
+
+	return &rps.MsgRevealMove{Player: player, GameId: gameID, Move: move, Salt: salt}, nil
+}
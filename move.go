@@ -0,0 +1,124 @@
+package rps
+
+import (
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// moveNames are the names of the moves in the commitments.
+var moveNames = map[Move]string{
+	Move_MOVE_ROCK:     "rock",
+	Move_MOVE_PAPER:    "paper",
+	Move_MOVE_SCISSORS: "scissors",
+}
+
+// beats maps each move to the move it beats.
+var beats = map[Move]Move{
+	Move_MOVE_ROCK:     Move_MOVE_SCISSORS,
+	Move_MOVE_SCISSORS: Move_MOVE_PAPER,
+	Move_MOVE_PAPER:    Move_MOVE_ROCK,
+}
+
+// ParseMove parses a move from its name, e.g. "rock", or its enum name, e.g.
+// "MOVE_ROCK".
+func ParseMove(s string) (Move, error) {
+	for move, name := range moveNames {
+		if s == name || s == move.String() {
+			return move, nil
+		}
+	}
+
+	return Move_MOVE_UNSPECIFIED, fmt.Errorf("invalid move %q, must be rock, paper or scissors", s)
+}
+
+// IsValid returns whether the move is rock, paper or scissors.
+func (m Move) IsValid() bool {
+	_, ok := moveNames[m]
+	return ok
+}
+
+// Name returns the name of the move the commitments are computed over: "rock",
+// "paper" or "scissors". It's empty for invalid moves.
+func (m Move) Name() string {
+	return moveNames[m]
+}
+
+// Beats returns whether the move beats another.
+func (m Move) Beats(other Move) bool {
+	beaten, ok := beats[m]
+	return ok && beaten == other
+}
+
+// The moves of MsgRevealMove and MsgAgentRevealMove are string fields encoded as
+// the move name, e.g. "rock", as before moves were an enum, so the wire format,
+// JSON and amino JSON sign bytes of legacy clients are unchanged. The methods
+// below implement the gogoproto custom type of those fields.
+
+// Marshal encodes the move as its name.
+func (m Move) Marshal() ([]byte, error) {
+	return []byte(m.Name()), nil
+}
+
+// MarshalTo encodes the move as its name into data.
+func (m *Move) MarshalTo(data []byte) (int, error) {
+	return copy(data, m.Name()), nil
+}
+
+// Size returns the length of the encoded move.
+func (m *Move) Size() int {
+	return len(m.Name())
+}
+
+// Unmarshal decodes a move from its name, as accepted by ParseMove. An empty name
+// is the unspecified move.
+func (m *Move) Unmarshal(data []byte) error {
+	if len(data) == 0 {
+		*m = Move_MOVE_UNSPECIFIED
+		return nil
+	}
+
+	move, err := ParseMove(string(data))
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidMove, err.Error())
+	}
+
+	*m = move
+	return nil
+}
+
+// MarshalText encodes the move as its name in JSON.
+func (m Move) MarshalText() ([]byte, error) {
+	return m.Marshal()
+}
+
+// UnmarshalJSON decodes a move from its name, its enum name or its number.
+func (m *Move) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		var number int32
+		if err := json.Unmarshal(data, &number); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMove, "invalid move %s", data)
+		}
+
+		if _, ok := Move_name[number]; !ok {
+			return errorsmod.Wrapf(ErrInvalidMove, "invalid move %d", number)
+		}
+
+		*m = Move(number)
+		return nil
+	}
+
+	return m.Unmarshal([]byte(name))
+}
+
+// MarshalAmino encodes the move as its name in the legacy amino codec.
+func (m Move) MarshalAmino() (string, error) {
+	return m.Name(), nil
+}
+
+// UnmarshalAmino decodes a move from its name in the legacy amino codec.
+func (m *Move) UnmarshalAmino(name string) error {
+	return m.Unmarshal([]byte(name))
+}
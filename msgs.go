@@ -0,0 +1,360 @@
+package rps
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// MaxEncryptedRevealSize is the maximum size of an escrowed reveal, which only
+// has to fit a move and a salt along with the encryption overhead.
+const MaxEncryptedRevealSize = 1024
+
+var (
+	_ sdk.HasValidateBasic = &MsgNewGame{}
+	_ sdk.HasValidateBasic = &MsgCreateAndJoin{}
+	_ sdk.HasValidateBasic = &MsgCommitMove{}
+	_ sdk.HasValidateBasic = &MsgRevealMove{}
+	_ sdk.HasValidateBasic = &MsgSetRevealAgent{}
+	_ sdk.HasValidateBasic = &MsgEscrowReveal{}
+	_ sdk.HasValidateBasic = &MsgAgentRevealMove{}
+	_ sdk.HasValidateBasic = &MsgJoinQueue{}
+	_ sdk.HasValidateBasic = &MsgLeaveQueue{}
+	_ sdk.HasValidateBasic = &MsgPlaceBet{}
+	_ sdk.HasValidateBasic = &MsgPlayHouse{}
+	_ sdk.HasValidateBasic = &MsgFundHouse{}
+	_ sdk.HasValidateBasic = &MsgOfferRematch{}
+	_ sdk.HasValidateBasic = &MsgUpdateParams{}
+	_ sdk.HasValidateBasic = &MsgResolveStuckGame{}
+)
+
+// The ValidateBasic methods check the messages before they reach the keeper,
+// without access to the state: the addresses, commitments, moves, salts and
+// coins. The checks depending on the params, like the allowed denoms, are left
+// to the keeper. Game IDs aren't checked, zero is the ID of the first game.
+// Addresses are only checked to be set, they're decoded by the keeper with the
+// address codec of the app rather than the global bech32 prefix.
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (msg *MsgNewGame) ValidateBasic() error {
+	if err := validateAddress("player", msg.Player); err != nil {
+		return err
+	}
+
+	if err := ValidateCommit(msg.Commit); err != nil {
+		return err
+	}
+
+	if err := validateCommitmentScheme(msg.CommitmentScheme); err != nil {
+		return err
+	}
+
+	if err := validateAmount("entry fee", msg.EntryFee); err != nil {
+		return err
+	}
+
+	if msg.ChallengerFee.Empty() {
+		return nil
+	}
+
+	return validateAmount("challenger fee", msg.ChallengerFee)
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (msg *MsgCreateAndJoin) ValidateBasic() error {
+	if err := validateAddress("creator", msg.Creator); err != nil {
+		return err
+	}
+
+	if err := validateAddress("challenger", msg.Challenger); err != nil {
+		return err
+	}
+
+	if msg.Creator == msg.Challenger {
+		return errorsmod.Wrap(ErrInvalidPlayers, "creator and challenger must be different players")
+	}
+
+	if err := ValidateCommit(msg.CreatorCommit); err != nil {
+		return errorsmod.Wrap(err, "invalid creator commit")
+	}
+
+	if err := ValidateCommit(msg.ChallengerCommit); err != nil {
+		return errorsmod.Wrap(err, "invalid challenger commit")
+	}
+
+	if err := validateCommitmentScheme(msg.CreatorCommitmentScheme); err != nil {
+		return errorsmod.Wrap(err, "invalid creator commitment scheme")
+	}
+
+	if err := validateCommitmentScheme(msg.ChallengerCommitmentScheme); err != nil {
+		return errorsmod.Wrap(err, "invalid challenger commitment scheme")
+	}
+
+	if err := validateAmount("entry fee", msg.EntryFee); err != nil {
+		return err
+	}
+
+	if msg.ChallengerFee.Empty() {
+		return nil
+	}
+
+	return validateAmount("challenger fee", msg.ChallengerFee)
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (msg *MsgCommitMove) ValidateBasic() error {
+	if err := validateAddress("player", msg.Player); err != nil {
+		return err
+	}
+
+	if err := ValidateCommit(msg.Commit); err != nil {
+		return err
+	}
+
+	if err := validateCommitmentScheme(msg.CommitmentScheme); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (msg *MsgRevealMove) ValidateBasic() error {
+	if err := validateAddress("player", msg.Player); err != nil {
+		return err
+	}
+
+	return validateReveal(msg.Move, msg.Salt)
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (msg *MsgSetRevealAgent) ValidateBasic() error {
+	if err := validateAddress("player", msg.Player); err != nil {
+		return err
+	}
+
+	// an empty agent removes the current one
+	if msg.Agent == "" {
+		return nil
+	}
+
+	if err := validateAddress("agent", msg.Agent); err != nil {
+		return err
+	}
+
+	if msg.Player == msg.Agent {
+		return errorsmod.Wrap(ErrInvalidPlayers, "player can't be their own reveal agent")
+	}
+
+	return nil
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (msg *MsgEscrowReveal) ValidateBasic() error {
+	if err := validateAddress("player", msg.Player); err != nil {
+		return err
+	}
+
+	if len(msg.EncryptedReveal) == 0 {
+		return errorsmod.Wrap(ErrInvalidEncryptedReveal, "encrypted reveal can't be empty")
+	}
+
+	if len(msg.EncryptedReveal) > MaxEncryptedRevealSize {
+		return errorsmod.Wrapf(ErrInvalidEncryptedReveal, "encrypted reveal is too large, max %d bytes", MaxEncryptedRevealSize)
+	}
+
+	return nil
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (msg *MsgAgentRevealMove) ValidateBasic() error {
+	if err := validateAddress("agent", msg.Agent); err != nil {
+		return err
+	}
+
+	if err := validateAddress("player", msg.Player); err != nil {
+		return err
+	}
+
+	return validateReveal(msg.Move, msg.Salt)
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (msg *MsgJoinQueue) ValidateBasic() error {
+	if err := validateAddress("player", msg.Player); err != nil {
+		return err
+	}
+
+	if err := ValidateCommit(msg.Commit); err != nil {
+		return err
+	}
+
+	if err := validateCommitmentScheme(msg.CommitmentScheme); err != nil {
+		return err
+	}
+
+	if err := validateCoin("entry fee", msg.EntryFee); err != nil {
+		return err
+	}
+
+	// without a minimum, the player only accepts games for exactly the entry fee
+	if msg.MinEntryFee.Amount.IsNil() || msg.MinEntryFee.IsZero() {
+		return nil
+	}
+
+	if err := validateCoin("min entry fee", msg.MinEntryFee); err != nil {
+		return err
+	}
+
+	if msg.MinEntryFee.Denom != msg.EntryFee.Denom {
+		return errorsmod.Wrapf(ErrInvalidCoins, "min entry fee denom %s doesn't match entry fee denom %s", msg.MinEntryFee.Denom, msg.EntryFee.Denom)
+	}
+
+	if msg.EntryFee.IsLT(msg.MinEntryFee) {
+		return errorsmod.Wrapf(ErrInvalidCoins, "min entry fee %s is greater than entry fee %s", msg.MinEntryFee, msg.EntryFee)
+	}
+
+	return nil
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (msg *MsgLeaveQueue) ValidateBasic() error {
+	return validateAddress("player", msg.Player)
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (msg *MsgPlaceBet) ValidateBasic() error {
+	if err := validateAddress("bettor", msg.Bettor); err != nil {
+		return err
+	}
+
+	if _, ok := BetOutcome_name[int32(msg.Outcome)]; !ok || msg.Outcome == BetOutcome_BET_OUTCOME_UNSPECIFIED {
+		return errorsmod.Wrapf(ErrInvalidBetOutcome, "bet outcome must be specified, got %s", msg.Outcome)
+	}
+
+	return validateAmount("bet amount", msg.Amount)
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (msg *MsgPlayHouse) ValidateBasic() error {
+	if err := validateAddress("player", msg.Player); err != nil {
+		return err
+	}
+
+	if err := ValidateCommit(msg.Commit); err != nil {
+		return err
+	}
+
+	if err := validateCommitmentScheme(msg.CommitmentScheme); err != nil {
+		return err
+	}
+
+	return validateAmount("entry fee", msg.EntryFee)
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (msg *MsgFundHouse) ValidateBasic() error {
+	if err := validateAddress("authority", msg.Authority); err != nil {
+		return err
+	}
+
+	return validateAmount("amount", msg.Amount)
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (msg *MsgOfferRematch) ValidateBasic() error {
+	if err := validateAddress("player", msg.Player); err != nil {
+		return err
+	}
+
+	if err := ValidateCommit(msg.Commit); err != nil {
+		return err
+	}
+
+	return validateCommitmentScheme(msg.CommitmentScheme)
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if err := validateAddress("authority", msg.Authority); err != nil {
+		return err
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidParams, err.Error())
+	}
+
+	return nil
+}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (msg *MsgResolveStuckGame) ValidateBasic() error {
+	return validateAddress("authority", msg.Authority)
+}
+
+// validateAddress checks that an address is well-formed bech32. Its prefix is
+// checked by the keeper with the address codec of the app.
+func validateAddress(name, addr string) error {
+	if strings.TrimSpace(addr) == "" {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid %s address: empty address string is not allowed", name)
+	}
+
+	_, bz, err := bech32.DecodeAndConvert(addr)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid %s address: %s", name, err)
+	}
+
+	if err := sdk.VerifyAddressFormat(bz); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid %s address: %s", name, err)
+	}
+
+	return nil
+}
+
+// validateCommitmentScheme checks that a commitment scheme is supported, whether
+// it's enabled is checked by the keeper against the params.
+func validateCommitmentScheme(scheme CommitmentScheme) error {
+	if !scheme.IsSupported() {
+		return errorsmod.Wrapf(ErrInvalidCommitmentScheme, "unsupported commitment scheme %s", scheme)
+	}
+
+	return nil
+}
+
+// validateReveal checks the move and salt of a reveal.
+func validateReveal(move Move, salt []byte) error {
+	if !move.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidMove, "move must be rock, paper or scissors, got %s", move)
+	}
+
+	return ValidateSalt(salt)
+}
+
+// validateAmount checks that an amount of coins is valid and positive.
+func validateAmount(name string, amount sdk.Coins) error {
+	if err := amount.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCoins, "invalid %s: %s", name, err)
+	}
+
+	if amount.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidCoins, "%s must be positive", name)
+	}
+
+	return nil
+}
+
+// validateCoin checks that a coin is valid and positive.
+func validateCoin(name string, coin sdk.Coin) error {
+	if err := coin.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCoins, "invalid %s: %s", name, err)
+	}
+
+	if !coin.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidCoins, "%s must be positive", name)
+	}
+
+	return nil
+}
@@ -0,0 +1,268 @@
+package rps_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/facundomedica/rps"
+	"github.com/facundomedica/rps/utils"
+)
+
+func TestValidateBasic(t *testing.T) {
+	player := sdk.AccAddress("player______________").String()
+	other := sdk.AccAddress("other_______________").String()
+	salt := []byte("salt of player 0")
+	commit := utils.CalculateCommitment("rock", salt)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	invalidFee := sdk.Coins{{Denom: "1stake", Amount: math.NewInt(10)}}
+
+	testCases := []struct {
+		name         string
+		msg          sdk.HasValidateBasic
+		expectErr    error
+		expectErrMsg string
+	}{
+		{
+			name: "new game",
+			msg:  &rps.MsgNewGame{Player: player, Commit: commit, EntryFee: fee},
+		},
+		{
+			name:         "new game without player",
+			msg:          &rps.MsgNewGame{Commit: commit, EntryFee: fee},
+			expectErr:    rps.ErrInvalidAddress,
+			expectErrMsg: "invalid player address",
+		},
+		{
+			name:         "new game with malformed player",
+			msg:          &rps.MsgNewGame{Player: "foo", Commit: commit, EntryFee: fee},
+			expectErr:    rps.ErrInvalidAddress,
+			expectErrMsg: "invalid player address: decoding bech32 failed",
+		},
+		{
+			name:         "new game with empty commit",
+			msg:          &rps.MsgNewGame{Player: player, EntryFee: fee},
+			expectErr:    rps.ErrInvalidCommit,
+			expectErrMsg: "commit must be 32 bytes, got 0",
+		},
+		{
+			name:         "new game with unsupported commitment scheme",
+			msg:          &rps.MsgNewGame{Player: player, Commit: commit, EntryFee: fee, CommitmentScheme: rps.CommitmentScheme(42)},
+			expectErr:    rps.ErrInvalidCommitmentScheme,
+			expectErrMsg: "unsupported commitment scheme 42",
+		},
+		{
+			name:         "new game without entry fee",
+			msg:          &rps.MsgNewGame{Player: player, Commit: commit},
+			expectErr:    rps.ErrInvalidCoins,
+			expectErrMsg: "entry fee must be positive",
+		},
+		{
+			name:         "new game with invalid challenger fee",
+			msg:          &rps.MsgNewGame{Player: player, Commit: commit, EntryFee: fee, ChallengerFee: invalidFee},
+			expectErr:    rps.ErrInvalidCoins,
+			expectErrMsg: "invalid challenger fee",
+		},
+		{
+			name: "create and join",
+			msg:  &rps.MsgCreateAndJoin{Creator: player, CreatorCommit: commit, Challenger: other, ChallengerCommit: commit, EntryFee: fee},
+		},
+		{
+			name:         "create and join with the same players",
+			msg:          &rps.MsgCreateAndJoin{Creator: player, CreatorCommit: commit, Challenger: player, ChallengerCommit: commit, EntryFee: fee},
+			expectErr:    rps.ErrInvalidPlayers,
+			expectErrMsg: "creator and challenger must be different players",
+		},
+		{
+			name:         "create and join with invalid challenger commit",
+			msg:          &rps.MsgCreateAndJoin{Creator: player, CreatorCommit: commit, Challenger: other, ChallengerCommit: commit[:16], EntryFee: fee},
+			expectErr:    rps.ErrInvalidCommit,
+			expectErrMsg: "invalid challenger commit: commit must be 32 bytes, got 16",
+		},
+		{
+			name:         "create and join with unsupported challenger commitment scheme",
+			msg:          &rps.MsgCreateAndJoin{Creator: player, CreatorCommit: commit, Challenger: other, ChallengerCommit: commit, EntryFee: fee, ChallengerCommitmentScheme: rps.CommitmentScheme(42)},
+			expectErr:    rps.ErrInvalidCommitmentScheme,
+			expectErrMsg: "invalid challenger commitment scheme: unsupported commitment scheme 42",
+		},
+		{
+			name: "commit move to the first game",
+			msg:  &rps.MsgCommitMove{Player: player, GameId: 0, Commit: commit},
+		},
+		{
+			name:         "commit move with hex commit",
+			msg:          &rps.MsgCommitMove{Player: player, Commit: []byte(rps.HexBytes(commit).String())},
+			expectErr:    rps.ErrInvalidCommit,
+			expectErrMsg: "commit must be 32 bytes, got 64",
+		},
+		{
+			name: "reveal move",
+			msg:  &rps.MsgRevealMove{Player: player, Move: rps.Move_MOVE_ROCK, Salt: salt},
+		},
+		{
+			name:         "reveal move without move",
+			msg:          &rps.MsgRevealMove{Player: player, Salt: salt},
+			expectErr:    rps.ErrInvalidMove,
+			expectErrMsg: "move must be rock, paper or scissors, got MOVE_UNSPECIFIED",
+		},
+		{
+			name:         "reveal move with short salt",
+			msg:          &rps.MsgRevealMove{Player: player, Move: rps.Move_MOVE_ROCK, Salt: salt[:8]},
+			expectErr:    rps.ErrInvalidSalt,
+			expectErrMsg: "salt must be at least 16 bytes, got 8",
+		},
+		{
+			name: "remove reveal agent",
+			msg:  &rps.MsgSetRevealAgent{Player: player},
+		},
+		{
+			name:         "set self as reveal agent",
+			msg:          &rps.MsgSetRevealAgent{Player: player, Agent: player},
+			expectErr:    rps.ErrInvalidPlayers,
+			expectErrMsg: "player can't be their own reveal agent",
+		},
+		{
+			name:         "set reveal agent without player",
+			msg:          &rps.MsgSetRevealAgent{Agent: other},
+			expectErr:    rps.ErrInvalidAddress,
+			expectErrMsg: "invalid player address",
+		},
+		{
+			name:         "escrow empty reveal",
+			msg:          &rps.MsgEscrowReveal{Player: player},
+			expectErr:    rps.ErrInvalidEncryptedReveal,
+			expectErrMsg: "encrypted reveal can't be empty",
+		},
+		{
+			name:         "escrow too large reveal",
+			msg:          &rps.MsgEscrowReveal{Player: player, EncryptedReveal: make([]byte, rps.MaxEncryptedRevealSize+1)},
+			expectErr:    rps.ErrInvalidEncryptedReveal,
+			expectErrMsg: "encrypted reveal is too large",
+		},
+		{
+			name:         "agent reveal move without agent",
+			msg:          &rps.MsgAgentRevealMove{Agent: "", Player: player, Move: rps.Move_MOVE_ROCK, Salt: salt},
+			expectErr:    rps.ErrInvalidAddress,
+			expectErrMsg: "invalid agent address",
+		},
+		{
+			name:         "agent reveal move with short salt",
+			msg:          &rps.MsgAgentRevealMove{Agent: other, Player: player, Move: rps.Move_MOVE_ROCK},
+			expectErr:    rps.ErrInvalidSalt,
+			expectErrMsg: "salt must be at least 16 bytes, got 0",
+		},
+		{
+			name: "join queue with a stake range",
+			msg:  &rps.MsgJoinQueue{Player: player, Commit: commit, EntryFee: sdk.NewInt64Coin("stake", 10), MinEntryFee: sdk.NewInt64Coin("stake", 5)},
+		},
+		{
+			name:         "join queue without entry fee",
+			msg:          &rps.MsgJoinQueue{Player: player, Commit: commit},
+			expectErr:    rps.ErrInvalidCoins,
+			expectErrMsg: "invalid entry fee",
+		},
+		{
+			name:         "join queue with min entry fee in another denom",
+			msg:          &rps.MsgJoinQueue{Player: player, Commit: commit, EntryFee: sdk.NewInt64Coin("stake", 10), MinEntryFee: sdk.NewInt64Coin("atom", 5)},
+			expectErr:    rps.ErrInvalidCoins,
+			expectErrMsg: "min entry fee denom atom doesn't match entry fee denom stake",
+		},
+		{
+			name:         "join queue with min entry fee over the entry fee",
+			msg:          &rps.MsgJoinQueue{Player: player, Commit: commit, EntryFee: sdk.NewInt64Coin("stake", 10), MinEntryFee: sdk.NewInt64Coin("stake", 20)},
+			expectErr:    rps.ErrInvalidCoins,
+			expectErrMsg: "min entry fee 20stake is greater than entry fee 10stake",
+		},
+		{
+			name:         "join queue with unsupported commitment scheme",
+			msg:          &rps.MsgJoinQueue{Player: player, Commit: commit, EntryFee: sdk.NewInt64Coin("stake", 10), CommitmentScheme: rps.CommitmentScheme(42)},
+			expectErr:    rps.ErrInvalidCommitmentScheme,
+			expectErrMsg: "unsupported commitment scheme 42",
+		},
+		{
+			name:         "leave queue without player",
+			msg:          &rps.MsgLeaveQueue{},
+			expectErr:    rps.ErrInvalidAddress,
+			expectErrMsg: "invalid player address",
+		},
+		{
+			name: "place bet",
+			msg:  &rps.MsgPlaceBet{Bettor: player, Outcome: rps.BetOutcome_BET_OUTCOME_DRAW, Amount: fee},
+		},
+		{
+			name:         "place bet without outcome",
+			msg:          &rps.MsgPlaceBet{Bettor: player, Amount: fee},
+			expectErr:    rps.ErrInvalidBetOutcome,
+			expectErrMsg: "bet outcome must be specified",
+		},
+		{
+			name:         "place bet with invalid denom",
+			msg:          &rps.MsgPlaceBet{Bettor: player, Outcome: rps.BetOutcome_BET_OUTCOME_DRAW, Amount: invalidFee},
+			expectErr:    rps.ErrInvalidCoins,
+			expectErrMsg: "invalid bet amount",
+		},
+		{
+			name:         "play house with empty commit",
+			msg:          &rps.MsgPlayHouse{Player: player, EntryFee: fee},
+			expectErr:    rps.ErrInvalidCommit,
+			expectErrMsg: "commit must be 32 bytes",
+		},
+		{
+			name:         "play house with unsupported commitment scheme",
+			msg:          &rps.MsgPlayHouse{Player: player, Commit: commit, EntryFee: fee, CommitmentScheme: rps.CommitmentScheme(42)},
+			expectErr:    rps.ErrInvalidCommitmentScheme,
+			expectErrMsg: "unsupported commitment scheme 42",
+		},
+		{
+			name:         "fund house without amount",
+			msg:          &rps.MsgFundHouse{Authority: player},
+			expectErr:    rps.ErrInvalidCoins,
+			expectErrMsg: "amount must be positive",
+		},
+		{
+			name:         "offer rematch with empty commit",
+			msg:          &rps.MsgOfferRematch{Player: player},
+			expectErr:    rps.ErrInvalidCommit,
+			expectErrMsg: "commit must be 32 bytes",
+		},
+		{
+			name:         "offer rematch with unsupported commitment scheme",
+			msg:          &rps.MsgOfferRematch{Player: player, Commit: commit, CommitmentScheme: rps.CommitmentScheme(42)},
+			expectErr:    rps.ErrInvalidCommitmentScheme,
+			expectErrMsg: "unsupported commitment scheme 42",
+		},
+		{
+			name: "update params",
+			msg:  &rps.MsgUpdateParams{Authority: player, Params: rps.DefaultParams()},
+		},
+		{
+			name:         "update params with invalid params",
+			msg:          &rps.MsgUpdateParams{Authority: player, Params: rps.Params{TimeoutMode: rps.TimeoutMode_TIMEOUT_MODE_HEIGHT}},
+			expectErr:    rps.ErrInvalidParams,
+			expectErrMsg: "commit and reveal timeouts in blocks must be positive",
+		},
+		{
+			name:         "resolve stuck game without authority",
+			msg:          &rps.MsgResolveStuckGame{},
+			expectErr:    rps.ErrInvalidAddress,
+			expectErrMsg: "invalid authority address",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, tc.expectErr)
+			require.ErrorContains(t, err, tc.expectErrMsg)
+		})
+	}
+}
@@ -1,14 +1,171 @@
 package rps
 
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultParams returns default module parameters.
 func DefaultParams() Params {
 	return Params{
-		// Set default values here.
+		MaxSettlementsPerBlock: 100,
+		NoShowPenalty:          math.LegacyZeroDec(),
+		BettingFee:             math.LegacyZeroDec(),
+		JackpotFee:             math.LegacyZeroDec(),
+		CommitmentSchemes:      []CommitmentScheme{CommitmentScheme_COMMITMENT_SCHEME_KECCAK256},
+		QueueMatchGrace:        300,
 	}
 }
 
 // Validate does the sanity check on the params.
 func (p Params) Validate() error {
-	// Sanity check goes here.
+	if _, ok := TimeoutMode_name[int32(p.TimeoutMode)]; !ok {
+		return fmt.Errorf("invalid timeout mode: %d", p.TimeoutMode)
+	}
+
+	if p.TimeoutMode == TimeoutMode_TIMEOUT_MODE_HEIGHT && (p.CommitTimeoutBlocks == 0 || p.RevealTimeoutBlocks == 0) {
+		return fmt.Errorf("commit and reveal timeouts in blocks must be positive when using %s", p.TimeoutMode)
+	}
+
+	if p.MaxTimeout != 0 && p.MinTimeout > p.MaxTimeout {
+		return fmt.Errorf("min timeout %d is greater than max timeout %d", p.MinTimeout, p.MaxTimeout)
+	}
+
+	if p.MaxTimeoutBlocks != 0 && p.MinTimeoutBlocks > p.MaxTimeoutBlocks {
+		return fmt.Errorf("min timeout blocks %d is greater than max timeout blocks %d", p.MinTimeoutBlocks, p.MaxTimeoutBlocks)
+	}
+
+	if !p.NoShowPenalty.IsNil() && (p.NoShowPenalty.IsNegative() || p.NoShowPenalty.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("no show penalty must be between 0 and 1: %s", p.NoShowPenalty)
+	}
+
+	if !p.BettingFee.IsNil() && (p.BettingFee.IsNegative() || p.BettingFee.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("betting fee must be between 0 and 1: %s", p.BettingFee)
+	}
+
+	if !p.JackpotFee.IsNil() && (p.JackpotFee.IsNegative() || p.JackpotFee.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("jackpot fee must be between 0 and 1: %s", p.JackpotFee)
+	}
+
+	if err := p.JackpotMinStake.Validate(); err != nil {
+		return fmt.Errorf("invalid jackpot min stake: %w", err)
+	}
+
+	if err := p.HouseMaxExposure.Validate(); err != nil {
+		return fmt.Errorf("invalid house max exposure: %w", err)
+	}
+
+	if err := p.HouseMaxOpenExposure.Validate(); err != nil {
+		return fmt.Errorf("invalid house max open exposure: %w", err)
+	}
+
+	for _, denom := range p.AllowedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid allowed denom: %w", err)
+		}
+	}
+
+	for _, scheme := range p.CommitmentSchemes {
+		if scheme == CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED || !scheme.IsSupported() {
+			return fmt.Errorf("invalid commitment scheme: %s", scheme)
+		}
+	}
+
+	return nil
+}
+
+// ValidateEntryFee checks that the entry fee is valid, positive and only made
+// of allowed denoms.
+func (p Params) ValidateEntryFee(fee sdk.Coins) error {
+	if err := fee.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCoins, "invalid entry fee: %s", err)
+	}
+
+	if fee.IsZero() {
+		return errorsmod.Wrap(ErrInvalidCoins, "entry fee must be positive")
+	}
+
+	for _, coin := range fee {
+		if !p.IsDenomAllowed(coin.Denom) {
+			return errorsmod.Wrapf(ErrInvalidCoins, "denom %s is not allowed in entry fees", coin.Denom)
+		}
+	}
+
 	return nil
 }
+
+// IsDenomAllowed returns whether the denom can be used to play, an empty list
+// of allowed denoms allows any denom.
+func (p Params) IsDenomAllowed(denom string) bool {
+	if len(p.AllowedDenoms) == 0 {
+		return true
+	}
+
+	for _, allowed := range p.AllowedDenoms {
+		if denom == allowed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateCommitmentScheme checks that players can commit with the scheme,
+// sha256 is always enabled.
+func (p Params) ValidateCommitmentScheme(scheme CommitmentScheme) error {
+	if !scheme.IsSupported() {
+		return errorsmod.Wrapf(ErrInvalidCommitmentScheme, "unsupported commitment scheme %s", scheme)
+	}
+
+	if scheme == CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED || scheme == CommitmentScheme_COMMITMENT_SCHEME_SHA256 {
+		return nil
+	}
+
+	for _, enabled := range p.CommitmentSchemes {
+		if scheme == enabled {
+			return nil
+		}
+	}
+
+	return errorsmod.Wrapf(ErrInvalidCommitmentScheme, "commitment scheme %s is not enabled", scheme)
+}
+
+// GameDurations returns the commit and reveal durations for a new game, in the
+// unit of the params timeout mode. Zero overrides fall back to the module
+// timeouts, non-zero ones must be within the min and max timeouts.
+func (p Params) GameDurations(commitOverride, revealOverride uint64) (commit, reveal uint64, err error) {
+	commit, reveal = p.CommitTimeout, p.RevealTimeout
+	minTimeout, maxTimeout := p.MinTimeout, p.MaxTimeout
+	if p.TimeoutMode == TimeoutMode_TIMEOUT_MODE_HEIGHT {
+		commit, reveal = p.CommitTimeoutBlocks, p.RevealTimeoutBlocks
+		minTimeout, maxTimeout = p.MinTimeoutBlocks, p.MaxTimeoutBlocks
+	}
+
+	for _, override := range []uint64{commitOverride, revealOverride} {
+		if override == 0 {
+			continue
+		}
+
+		if maxTimeout == 0 {
+			return 0, 0, fmt.Errorf("timeout overrides are disabled")
+		}
+
+		if override < minTimeout || override > maxTimeout {
+			return 0, 0, fmt.Errorf("timeout %d out of bounds, must be between %d and %d", override, minTimeout, maxTimeout)
+		}
+	}
+
+	if commitOverride != 0 {
+		commit = commitOverride
+	}
+
+	if revealOverride != 0 {
+		reveal = revealOverride
+	}
+
+	return commit, reveal, nil
+}
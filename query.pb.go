@@ -6,6 +6,9 @@ package rps
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -199,22 +202,23 @@ func (m *QueryCountResponse) GetCount() uint64 {
 	return 0
 }
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+// QueryStuckGamesRequest is the request type for the Query/StuckGames RPC
+// method.
+type QueryStuckGamesRequest struct {
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryStuckGamesRequest) Reset()         { *m = QueryStuckGamesRequest{} }
+func (m *QueryStuckGamesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStuckGamesRequest) ProtoMessage()    {}
+func (*QueryStuckGamesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8c6bb3f451e9b612, []int{4}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryStuckGamesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStuckGamesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStuckGamesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -224,36 +228,36 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryStuckGamesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStuckGamesRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryStuckGamesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryStuckGamesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStuckGamesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryStuckGamesRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params defines the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+// QueryStuckGamesResponse is the response type for the Query/StuckGames RPC
+// method.
+type QueryStuckGamesResponse struct {
+	Games []Game `protobuf:"bytes,1,rep,name=games,proto3" json:"games"`
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryStuckGamesResponse) Reset()         { *m = QueryStuckGamesResponse{} }
+func (m *QueryStuckGamesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStuckGamesResponse) ProtoMessage()    {}
+func (*QueryStuckGamesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8c6bb3f451e9b612, []int{5}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStuckGamesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStuckGamesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStuckGamesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -263,473 +267,6369 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryStuckGamesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStuckGamesResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryStuckGamesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryStuckGamesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStuckGamesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryStuckGamesResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryStuckGamesResponse) GetGames() []Game {
 	if m != nil {
-		return m.Params
+		return m.Games
 	}
-	return Params{}
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryGamesRequest)(nil), "facundomedica.rps.v1.QueryGamesRequest")
-	proto.RegisterType((*QueryGamesResponse)(nil), "facundomedica.rps.v1.QueryGamesResponse")
-	proto.RegisterType((*QueryCountRequest)(nil), "facundomedica.rps.v1.QueryCountRequest")
-	proto.RegisterType((*QueryCountResponse)(nil), "facundomedica.rps.v1.QueryCountResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "facundomedica.rps.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "facundomedica.rps.v1.QueryParamsResponse")
+// QuerySettlementBacklogRequest is the request type for the
+// Query/SettlementBacklog RPC method.
+type QuerySettlementBacklogRequest struct {
 }
 
-func init() { proto.RegisterFile("facundomedica/rps/v1/query.proto", fileDescriptor_8c6bb3f451e9b612) }
-
-var fileDescriptor_8c6bb3f451e9b612 = []byte{
-	// 419 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbf, 0x6e, 0xda, 0x40,
-	0x1c, 0xf6, 0x95, 0x1a, 0xa9, 0xc7, 0xc4, 0xe1, 0x01, 0x19, 0xd7, 0x45, 0x56, 0xa5, 0xba, 0x0c,
-	0x3e, 0x41, 0xa5, 0x2e, 0x1d, 0x2a, 0xd1, 0xa1, 0x6b, 0x61, 0xe8, 0xd0, 0xed, 0x30, 0x57, 0xd7,
-	0x52, 0xed, 0x33, 0x3e, 0x1b, 0x89, 0xb5, 0xed, 0xd0, 0x31, 0x52, 0xc6, 0xbc, 0x40, 0xc6, 0x3c,
-	0x06, 0x23, 0x52, 0x96, 0x4c, 0x51, 0x04, 0x91, 0xf2, 0x1a, 0x91, 0xef, 0x0e, 0x84, 0x83, 0x45,
-	0x58, 0xac, 0xf3, 0xe7, 0xdf, 0xf7, 0xc7, 0xdf, 0xef, 0x60, 0xf7, 0x27, 0xf1, 0xf3, 0x78, 0xca,
-	0x22, 0x3a, 0x0d, 0x7d, 0x82, 0xd3, 0x84, 0xe3, 0x79, 0x1f, 0xcf, 0x72, 0x9a, 0x2e, 0xbc, 0x24,
-	0x65, 0x19, 0x43, 0x46, 0x69, 0xc2, 0x4b, 0x13, 0xee, 0xcd, 0xfb, 0x66, 0x35, 0x2f, 0x5b, 0x24,
-	0x94, 0x4b, 0x9e, 0x69, 0x05, 0x8c, 0x05, 0xbf, 0x29, 0x26, 0x49, 0x88, 0x49, 0x1c, 0xb3, 0x8c,
-	0x64, 0x21, 0x8b, 0xb7, 0x5f, 0x3b, 0x3e, 0xe3, 0x11, 0xe3, 0xd2, 0xe9, 0x89, 0xa5, 0xd9, 0x24,
-	0x51, 0x18, 0x33, 0x2c, 0x9e, 0x0a, 0x32, 0x02, 0x16, 0x30, 0x71, 0xc4, 0xc5, 0x49, 0xa2, 0x4e,
-	0x0b, 0x36, 0x47, 0x05, 0xef, 0x2b, 0x89, 0x28, 0x1f, 0xd3, 0x59, 0x4e, 0x79, 0xe6, 0x8c, 0x20,
-	0xda, 0x07, 0x79, 0xc2, 0x62, 0x4e, 0xd1, 0x27, 0xa8, 0x07, 0x05, 0xd0, 0x06, 0xdd, 0x9a, 0xdb,
-	0x18, 0x98, 0x5e, 0xd5, 0x6f, 0x79, 0x05, 0x67, 0xf8, 0x6a, 0x79, 0xfb, 0x46, 0xbb, 0x7c, 0xb8,
-	0xea, 0x81, 0xb1, 0xe4, 0xec, 0x7c, 0xbe, 0xb0, 0x3c, 0xce, 0xb6, 0x3e, 0x3d, 0xe5, 0xa3, 0x40,
-	0xe5, 0x63, 0x40, 0xdd, 0x2f, 0x80, 0x36, 0xe8, 0x02, 0xf7, 0xe5, 0x58, 0xbe, 0x38, 0x86, 0x9a,
-	0xfd, 0x46, 0x52, 0x12, 0xed, 0x92, 0x7e, 0x87, 0xad, 0x12, 0xaa, 0x24, 0x3e, 0xc3, 0x7a, 0x22,
-	0x10, 0xa1, 0xd1, 0x18, 0x58, 0xd5, 0x59, 0x25, 0x6b, 0x3f, 0xad, 0xa2, 0x0d, 0x2e, 0x6a, 0x50,
-	0x17, 0xc2, 0xe8, 0x1f, 0x80, 0xba, 0xe8, 0x01, 0xbd, 0xab, 0x16, 0x39, 0xa8, 0xcf, 0x74, 0x9f,
-	0x1f, 0x94, 0x39, 0x1d, 0xf7, 0x7f, 0xe1, 0xfa, 0xe7, 0xfa, 0xfe, 0xfc, 0xc5, 0x6b, 0xd4, 0xc1,
-	0x95, 0x37, 0x42, 0xf4, 0x27, 0x62, 0x88, 0x9a, 0x8e, 0xc6, 0xd8, 0x6f, 0xf7, 0x68, 0x8c, 0x52,
-	0xe3, 0x27, 0xc5, 0x10, 0x5b, 0x40, 0x7f, 0x01, 0xac, 0xcb, 0xd6, 0xd0, 0x31, 0xf9, 0xd2, 0x92,
-	0xcc, 0xf7, 0x27, 0x4c, 0xaa, 0x24, 0x6f, 0x45, 0x08, 0x1b, 0x59, 0xd5, 0x21, 0xe4, 0x76, 0x86,
-	0x1f, 0x97, 0x6b, 0x1b, 0xac, 0xd6, 0x36, 0xb8, 0x5b, 0xdb, 0xe0, 0x6c, 0x63, 0x6b, 0xab, 0x8d,
-	0xad, 0xdd, 0x6c, 0x6c, 0xed, 0x87, 0x15, 0x84, 0xd9, 0xaf, 0x7c, 0xe2, 0xf9, 0x2c, 0x3a, 0x54,
-	0x98, 0xd4, 0xc5, 0x9d, 0xff, 0xf0, 0x18, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x9d, 0xe0, 0xb9, 0xb3,
-	0x03, 0x00, 0x00,
+func (m *QuerySettlementBacklogRequest) Reset()         { *m = QuerySettlementBacklogRequest{} }
+func (m *QuerySettlementBacklogRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySettlementBacklogRequest) ProtoMessage()    {}
+func (*QuerySettlementBacklogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{6}
+}
+func (m *QuerySettlementBacklogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettlementBacklogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettlementBacklogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySettlementBacklogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettlementBacklogRequest.Merge(m, src)
+}
+func (m *QuerySettlementBacklogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettlementBacklogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettlementBacklogRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QuerySettlementBacklogRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Games(ctx context.Context, in *QueryGamesRequest, opts ...grpc.CallOption) (*QueryGamesResponse, error)
-	// Count returns the historical number of games played.
-	Count(ctx context.Context, in *QueryCountRequest, opts ...grpc.CallOption) (*QueryCountResponse, error)
-	// Params returns the module parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+// QuerySettlementBacklogResponse is the response type for the
+// Query/SettlementBacklog RPC method.
+type QuerySettlementBacklogResponse struct {
+	// count is the amount of games waiting to be settled.
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// truncated is true when more games than count are waiting, the count is
+	// capped so the query doesn't walk the whole backlog.
+	Truncated bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QuerySettlementBacklogResponse) Reset()         { *m = QuerySettlementBacklogResponse{} }
+func (m *QuerySettlementBacklogResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySettlementBacklogResponse) ProtoMessage()    {}
+func (*QuerySettlementBacklogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{7}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QuerySettlementBacklogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Games(ctx context.Context, in *QueryGamesRequest, opts ...grpc.CallOption) (*QueryGamesResponse, error) {
-	out := new(QueryGamesResponse)
-	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/Games", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySettlementBacklogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettlementBacklogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySettlementBacklogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettlementBacklogResponse.Merge(m, src)
+}
+func (m *QuerySettlementBacklogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettlementBacklogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettlementBacklogResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Count(ctx context.Context, in *QueryCountRequest, opts ...grpc.CallOption) (*QueryCountResponse, error) {
-	out := new(QueryCountResponse)
-	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/Count", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySettlementBacklogResponse proto.InternalMessageInfo
+
+func (m *QuerySettlementBacklogResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySettlementBacklogResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
 	}
-	return out, nil
+	return false
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Games(context.Context, *QueryGamesRequest) (*QueryGamesResponse, error)
-	// Count returns the historical number of games played.
-	Count(context.Context, *QueryCountRequest) (*QueryCountResponse, error)
-	// Params returns the module parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// QueryPlayerStatsRequest is the request type for the Query/PlayerStats RPC
+// method.
+type QueryPlayerStatsRequest struct {
+	// address is the address of the player.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryPlayerStatsRequest) Reset()         { *m = QueryPlayerStatsRequest{} }
+func (m *QueryPlayerStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPlayerStatsRequest) ProtoMessage()    {}
+func (*QueryPlayerStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{8}
 }
-
-func (*UnimplementedQueryServer) Games(ctx context.Context, req *QueryGamesRequest) (*QueryGamesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Games not implemented")
+func (m *QueryPlayerStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Count(ctx context.Context, req *QueryCountRequest) (*QueryCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
+func (m *QueryPlayerStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlayerStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryPlayerStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlayerStatsRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryPlayerStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlayerStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlayerStatsRequest.DiscardUnknown(m)
 }
 
-func _Query_Games_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGamesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Games(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/facundomedica.rps.v1.Query/Games",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Games(ctx, req.(*QueryGamesRequest))
+var xxx_messageInfo_QueryPlayerStatsRequest proto.InternalMessageInfo
+
+func (m *QueryPlayerStatsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Count(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/facundomedica.rps.v1.Query/Count",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Count(ctx, req.(*QueryCountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryPlayerStatsResponse is the response type for the Query/PlayerStats RPC
+// method.
+type QueryPlayerStatsResponse struct {
+	Stats PlayerStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/facundomedica.rps.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryPlayerStatsResponse) Reset()         { *m = QueryPlayerStatsResponse{} }
+func (m *QueryPlayerStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPlayerStatsResponse) ProtoMessage()    {}
+func (*QueryPlayerStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{9}
+}
+func (m *QueryPlayerStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlayerStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlayerStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "facundomedica.rps.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Games",
-			Handler:    _Query_Games_Handler,
-		},
-		{
-			MethodName: "Count",
-			Handler:    _Query_Count_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "facundomedica/rps/v1/query.proto",
+func (m *QueryPlayerStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlayerStatsResponse.Merge(m, src)
+}
+func (m *QueryPlayerStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlayerStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlayerStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGamesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPlayerStatsResponse proto.InternalMessageInfo
+
+func (m *QueryPlayerStatsResponse) GetStats() PlayerStats {
+	if m != nil {
+		return m.Stats
 	}
-	return dAtA[:n], nil
+	return PlayerStats{}
 }
 
-func (m *QueryGamesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryQueueRequest is the request type for the Query/Queue RPC method.
+type QueryQueueRequest struct {
 }
 
-func (m *QueryGamesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryQueueRequest) Reset()         { *m = QueryQueueRequest{} }
+func (m *QueryQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueueRequest) ProtoMessage()    {}
+func (*QueryQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{10}
 }
-
-func (m *QueryGamesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryQueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueueRequest.Merge(m, src)
+}
+func (m *QueryQueueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueueRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGamesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryQueueRequest proto.InternalMessageInfo
+
+// QueryQueueResponse is the response type for the Query/Queue RPC method.
+type QueryQueueResponse struct {
+	Entries []QueueEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
 }
 
-func (m *QueryGamesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Games) > 0 {
-		for iNdEx := len(m.Games) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Games[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryQueueResponse) Reset()         { *m = QueryQueueResponse{} }
+func (m *QueryQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueueResponse) ProtoMessage()    {}
+func (*QueryQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{11}
+}
+func (m *QueryQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueueResponse.Merge(m, src)
+}
+func (m *QueryQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueueResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryQueueResponse proto.InternalMessageInfo
+
+func (m *QueryQueueResponse) GetEntries() []QueueEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBetPoolsRequest is the request type for the Query/BetPools RPC method.
+type QueryBetPoolsRequest struct {
+	GameId uint64 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (m *QueryCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryBetPoolsRequest) Reset()         { *m = QueryBetPoolsRequest{} }
+func (m *QueryBetPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBetPoolsRequest) ProtoMessage()    {}
+func (*QueryBetPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{12}
+}
+func (m *QueryBetPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBetPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBetPoolsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBetPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBetPoolsRequest.Merge(m, src)
+}
+func (m *QueryBetPoolsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBetPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBetPoolsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBetPoolsRequest proto.InternalMessageInfo
+
+func (m *QueryBetPoolsRequest) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryCountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBetPoolsResponse is the response type for the Query/BetPools RPC
+// method.
+type QueryBetPoolsResponse struct {
+	// pools has an entry for every outcome, including the empty ones.
+	Pools []BetPool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools"`
 }
 
-func (m *QueryCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Count != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryBetPoolsResponse) Reset()         { *m = QueryBetPoolsResponse{} }
+func (m *QueryBetPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBetPoolsResponse) ProtoMessage()    {}
+func (*QueryBetPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{13}
+}
+func (m *QueryBetPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBetPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBetPoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBetPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBetPoolsResponse.Merge(m, src)
+}
+func (m *QueryBetPoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBetPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBetPoolsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBetPoolsResponse proto.InternalMessageInfo
+
+func (m *QueryBetPoolsResponse) GetPools() []BetPool {
+	if m != nil {
+		return m.Pools
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BetPool is the pool of the bets on an outcome of a game.
+type BetPool struct {
+	Outcome BetOutcome `protobuf:"varint,1,opt,name=outcome,proto3,enum=facundomedica.rps.v1.BetOutcome" json:"outcome,omitempty"`
+	// amount is the sum of the bets on the outcome.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// odds is, for each denom, what a winning bet of one unit would get paid
+	// if the game was settled now (protocol fee included).
+	Odds github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=odds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"odds"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *BetPool) Reset()         { *m = BetPool{} }
+func (m *BetPool) String() string { return proto.CompactTextString(m) }
+func (*BetPool) ProtoMessage()    {}
+func (*BetPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{14}
+}
+func (m *BetPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BetPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BetPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BetPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BetPool.Merge(m, src)
+}
+func (m *BetPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *BetPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_BetPool.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BetPool proto.InternalMessageInfo
+
+func (m *BetPool) GetOutcome() BetOutcome {
+	if m != nil {
+		return m.Outcome
 	}
-	return dAtA[:n], nil
+	return BetOutcome_BET_OUTCOME_UNSPECIFIED
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BetPool) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *BetPool) GetOdds() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Odds
+	}
+	return nil
+}
+
+// QueryJackpotRequest is the request type for the Query/Jackpot RPC method.
+type QueryJackpotRequest struct {
+}
+
+func (m *QueryJackpotRequest) Reset()         { *m = QueryJackpotRequest{} }
+func (m *QueryJackpotRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryJackpotRequest) ProtoMessage()    {}
+func (*QueryJackpotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{15}
+}
+func (m *QueryJackpotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryJackpotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryJackpotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryJackpotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryJackpotRequest.Merge(m, src)
+}
+func (m *QueryJackpotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryJackpotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryJackpotRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+var xxx_messageInfo_QueryJackpotRequest proto.InternalMessageInfo
+
+// QueryJackpotResponse is the response type for the Query/Jackpot RPC method.
+type QueryJackpotResponse struct {
+	// jackpot is the balance of the jackpot, for each denom.
+	Jackpot github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=jackpot,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"jackpot"`
+	// streak is the number of games a player has to win in a row to take it.
+	Streak uint64 `protobuf:"varint,2,opt,name=streak,proto3" json:"streak,omitempty"`
 }
-func (m *QueryGamesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryJackpotResponse) Reset()         { *m = QueryJackpotResponse{} }
+func (m *QueryJackpotResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryJackpotResponse) ProtoMessage()    {}
+func (*QueryJackpotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{16}
+}
+func (m *QueryJackpotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryJackpotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryJackpotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryJackpotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryJackpotResponse.Merge(m, src)
+}
+func (m *QueryJackpotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryJackpotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryJackpotResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGamesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryJackpotResponse proto.InternalMessageInfo
+
+func (m *QueryJackpotResponse) GetJackpot() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Jackpot
 	}
-	var l int
+	return nil
+}
+
+func (m *QueryJackpotResponse) GetStreak() uint64 {
+	if m != nil {
+		return m.Streak
+	}
+	return 0
+}
+
+// QueryHouseBankrollRequest is the request type for the Query/HouseBankroll
+// RPC method.
+type QueryHouseBankrollRequest struct {
+}
+
+func (m *QueryHouseBankrollRequest) Reset()         { *m = QueryHouseBankrollRequest{} }
+func (m *QueryHouseBankrollRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHouseBankrollRequest) ProtoMessage()    {}
+func (*QueryHouseBankrollRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{17}
+}
+func (m *QueryHouseBankrollRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHouseBankrollRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHouseBankrollRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHouseBankrollRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHouseBankrollRequest.Merge(m, src)
+}
+func (m *QueryHouseBankrollRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHouseBankrollRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHouseBankrollRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHouseBankrollRequest proto.InternalMessageInfo
+
+// QueryHouseBankrollResponse is the response type for the Query/HouseBankroll
+// RPC method.
+type QueryHouseBankrollResponse struct {
+	// bankroll is what the house can put into new games, the stakes of the
+	// games in progress are not included.
+	Bankroll github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=bankroll,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bankroll"`
+	// max_exposure is the maximum stake the house puts into a single game.
+	MaxExposure github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=max_exposure,json=maxExposure,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_exposure"`
+	// exposure is the total stake the house has in the games in progress.
+	Exposure github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=exposure,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"exposure"`
+	// max_open_exposure is the maximum total stake the house puts into the
+	// games in progress.
+	MaxOpenExposure github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=max_open_exposure,json=maxOpenExposure,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_open_exposure"`
+}
+
+func (m *QueryHouseBankrollResponse) Reset()         { *m = QueryHouseBankrollResponse{} }
+func (m *QueryHouseBankrollResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHouseBankrollResponse) ProtoMessage()    {}
+func (*QueryHouseBankrollResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{18}
+}
+func (m *QueryHouseBankrollResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHouseBankrollResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHouseBankrollResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHouseBankrollResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHouseBankrollResponse.Merge(m, src)
+}
+func (m *QueryHouseBankrollResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHouseBankrollResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHouseBankrollResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHouseBankrollResponse proto.InternalMessageInfo
+
+func (m *QueryHouseBankrollResponse) GetBankroll() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Bankroll
+	}
+	return nil
+}
+
+func (m *QueryHouseBankrollResponse) GetMaxExposure() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxExposure
+	}
+	return nil
+}
+
+func (m *QueryHouseBankrollResponse) GetExposure() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Exposure
+	}
+	return nil
+}
+
+func (m *QueryHouseBankrollResponse) GetMaxOpenExposure() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxOpenExposure
+	}
+	return nil
+}
+
+// QuerySettledGameRequest is the request type for the Query/SettledGame RPC
+// method.
+type QuerySettledGameRequest struct {
+	GameId uint64 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (m *QuerySettledGameRequest) Reset()         { *m = QuerySettledGameRequest{} }
+func (m *QuerySettledGameRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySettledGameRequest) ProtoMessage()    {}
+func (*QuerySettledGameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{19}
+}
+func (m *QuerySettledGameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettledGameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettledGameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySettledGameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettledGameRequest.Merge(m, src)
+}
+func (m *QuerySettledGameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettledGameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettledGameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySettledGameRequest proto.InternalMessageInfo
+
+func (m *QuerySettledGameRequest) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
+	}
+	return 0
+}
+
+// QuerySettledGameResponse is the response type for the Query/SettledGame RPC
+// method.
+type QuerySettledGameResponse struct {
+	Game SettledGame `protobuf:"bytes,1,opt,name=game,proto3" json:"game"`
+}
+
+func (m *QuerySettledGameResponse) Reset()         { *m = QuerySettledGameResponse{} }
+func (m *QuerySettledGameResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySettledGameResponse) ProtoMessage()    {}
+func (*QuerySettledGameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{20}
+}
+func (m *QuerySettledGameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettledGameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettledGameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySettledGameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettledGameResponse.Merge(m, src)
+}
+func (m *QuerySettledGameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettledGameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettledGameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySettledGameResponse proto.InternalMessageInfo
+
+func (m *QuerySettledGameResponse) GetGame() SettledGame {
+	if m != nil {
+		return m.Game
+	}
+	return SettledGame{}
+}
+
+// QueryRevealAgentRequest is the request type for the Query/RevealAgent RPC
+// method.
+type QueryRevealAgentRequest struct {
+	// address is the address of the player.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryRevealAgentRequest) Reset()         { *m = QueryRevealAgentRequest{} }
+func (m *QueryRevealAgentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRevealAgentRequest) ProtoMessage()    {}
+func (*QueryRevealAgentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{21}
+}
+func (m *QueryRevealAgentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRevealAgentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRevealAgentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRevealAgentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRevealAgentRequest.Merge(m, src)
+}
+func (m *QueryRevealAgentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRevealAgentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRevealAgentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRevealAgentRequest proto.InternalMessageInfo
+
+func (m *QueryRevealAgentRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryRevealAgentResponse is the response type for the Query/RevealAgent RPC
+// method.
+type QueryRevealAgentResponse struct {
+	// agent is empty if the player has no reveal agent.
+	Agent string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+}
+
+func (m *QueryRevealAgentResponse) Reset()         { *m = QueryRevealAgentResponse{} }
+func (m *QueryRevealAgentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRevealAgentResponse) ProtoMessage()    {}
+func (*QueryRevealAgentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{22}
+}
+func (m *QueryRevealAgentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRevealAgentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRevealAgentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRevealAgentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRevealAgentResponse.Merge(m, src)
+}
+func (m *QueryRevealAgentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRevealAgentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRevealAgentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRevealAgentResponse proto.InternalMessageInfo
+
+func (m *QueryRevealAgentResponse) GetAgent() string {
+	if m != nil {
+		return m.Agent
+	}
+	return ""
+}
+
+// QueryEscrowedRevealRequest is the request type for the Query/EscrowedReveal
+// RPC method.
+type QueryEscrowedRevealRequest struct {
+	GameId uint64 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Player string `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+}
+
+func (m *QueryEscrowedRevealRequest) Reset()         { *m = QueryEscrowedRevealRequest{} }
+func (m *QueryEscrowedRevealRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowedRevealRequest) ProtoMessage()    {}
+func (*QueryEscrowedRevealRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{23}
+}
+func (m *QueryEscrowedRevealRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowedRevealRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowedRevealRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowedRevealRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowedRevealRequest.Merge(m, src)
+}
+func (m *QueryEscrowedRevealRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowedRevealRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowedRevealRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowedRevealRequest proto.InternalMessageInfo
+
+func (m *QueryEscrowedRevealRequest) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
+	}
+	return 0
+}
+
+func (m *QueryEscrowedRevealRequest) GetPlayer() string {
+	if m != nil {
+		return m.Player
+	}
+	return ""
+}
+
+// QueryEscrowedRevealResponse is the response type for the
+// Query/EscrowedReveal RPC method.
+type QueryEscrowedRevealResponse struct {
+	// agent is the current reveal agent of the player.
+	Agent           string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	EncryptedReveal []byte `protobuf:"bytes,2,opt,name=encrypted_reveal,json=encryptedReveal,proto3" json:"encrypted_reveal,omitempty"`
+}
+
+func (m *QueryEscrowedRevealResponse) Reset()         { *m = QueryEscrowedRevealResponse{} }
+func (m *QueryEscrowedRevealResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowedRevealResponse) ProtoMessage()    {}
+func (*QueryEscrowedRevealResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{24}
+}
+func (m *QueryEscrowedRevealResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowedRevealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowedRevealResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowedRevealResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowedRevealResponse.Merge(m, src)
+}
+func (m *QueryEscrowedRevealResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowedRevealResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowedRevealResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowedRevealResponse proto.InternalMessageInfo
+
+func (m *QueryEscrowedRevealResponse) GetAgent() string {
+	if m != nil {
+		return m.Agent
+	}
+	return ""
+}
+
+func (m *QueryEscrowedRevealResponse) GetEncryptedReveal() []byte {
+	if m != nil {
+		return m.EncryptedReveal
+	}
+	return nil
+}
+
+// QueryMoveCommitRequest is the request type for the Query/MoveCommit RPC
+// method.
+type QueryMoveCommitRequest struct {
+	GameId uint64 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Player string `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+}
+
+func (m *QueryMoveCommitRequest) Reset()         { *m = QueryMoveCommitRequest{} }
+func (m *QueryMoveCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMoveCommitRequest) ProtoMessage()    {}
+func (*QueryMoveCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{25}
+}
+func (m *QueryMoveCommitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMoveCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMoveCommitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMoveCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMoveCommitRequest.Merge(m, src)
+}
+func (m *QueryMoveCommitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMoveCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMoveCommitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMoveCommitRequest proto.InternalMessageInfo
+
+func (m *QueryMoveCommitRequest) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
+	}
+	return 0
+}
+
+func (m *QueryMoveCommitRequest) GetPlayer() string {
+	if m != nil {
+		return m.Player
+	}
+	return ""
+}
+
+// QueryMoveCommitResponse is the response type for the Query/MoveCommit RPC
+// method.
+type QueryMoveCommitResponse struct {
+	MoveCommit MoveCommit `protobuf:"bytes,1,opt,name=move_commit,json=moveCommit,proto3" json:"move_commit"`
+}
+
+func (m *QueryMoveCommitResponse) Reset()         { *m = QueryMoveCommitResponse{} }
+func (m *QueryMoveCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMoveCommitResponse) ProtoMessage()    {}
+func (*QueryMoveCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{26}
+}
+func (m *QueryMoveCommitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMoveCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMoveCommitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMoveCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMoveCommitResponse.Merge(m, src)
+}
+func (m *QueryMoveCommitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMoveCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMoveCommitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMoveCommitResponse proto.InternalMessageInfo
+
+func (m *QueryMoveCommitResponse) GetMoveCommit() MoveCommit {
+	if m != nil {
+		return m.MoveCommit
+	}
+	return MoveCommit{}
+}
+
+// QueryGamePlayersRequest is the request type for the Query/GamePlayers RPC
+// method.
+type QueryGamePlayersRequest struct {
+	GameId uint64 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (m *QueryGamePlayersRequest) Reset()         { *m = QueryGamePlayersRequest{} }
+func (m *QueryGamePlayersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGamePlayersRequest) ProtoMessage()    {}
+func (*QueryGamePlayersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{27}
+}
+func (m *QueryGamePlayersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGamePlayersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGamePlayersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGamePlayersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGamePlayersRequest.Merge(m, src)
+}
+func (m *QueryGamePlayersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGamePlayersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGamePlayersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGamePlayersRequest proto.InternalMessageInfo
+
+func (m *QueryGamePlayersRequest) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
+	}
+	return 0
+}
+
+// QueryGamePlayersResponse is the response type for the Query/GamePlayers RPC
+// method.
+type QueryGamePlayersResponse struct {
+	Players []string `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+}
+
+func (m *QueryGamePlayersResponse) Reset()         { *m = QueryGamePlayersResponse{} }
+func (m *QueryGamePlayersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGamePlayersResponse) ProtoMessage()    {}
+func (*QueryGamePlayersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{28}
+}
+func (m *QueryGamePlayersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGamePlayersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGamePlayersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGamePlayersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGamePlayersResponse.Merge(m, src)
+}
+func (m *QueryGamePlayersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGamePlayersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGamePlayersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGamePlayersResponse proto.InternalMessageInfo
+
+func (m *QueryGamePlayersResponse) GetPlayers() []string {
+	if m != nil {
+		return m.Players
+	}
+	return nil
+}
+
+// QueryComputeCommitmentRequest is the request type for the
+// Query/ComputeCommitment RPC method.
+type QueryComputeCommitmentRequest struct {
+	Move Move     `protobuf:"varint,1,opt,name=move,proto3,enum=facundomedica.rps.v1.Move" json:"move,omitempty"`
+	Salt HexBytes `protobuf:"bytes,2,opt,name=salt,proto3,casttype=HexBytes" json:"salt,omitempty"`
+	// scheme is the commitment scheme to use, unspecified means sha256.
+	Scheme CommitmentScheme `protobuf:"varint,3,opt,name=scheme,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"scheme,omitempty"`
+}
+
+func (m *QueryComputeCommitmentRequest) Reset()         { *m = QueryComputeCommitmentRequest{} }
+func (m *QueryComputeCommitmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryComputeCommitmentRequest) ProtoMessage()    {}
+func (*QueryComputeCommitmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{29}
+}
+func (m *QueryComputeCommitmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryComputeCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryComputeCommitmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryComputeCommitmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryComputeCommitmentRequest.Merge(m, src)
+}
+func (m *QueryComputeCommitmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryComputeCommitmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryComputeCommitmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryComputeCommitmentRequest proto.InternalMessageInfo
+
+func (m *QueryComputeCommitmentRequest) GetMove() Move {
+	if m != nil {
+		return m.Move
+	}
+	return Move_MOVE_UNSPECIFIED
+}
+
+func (m *QueryComputeCommitmentRequest) GetSalt() HexBytes {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
+func (m *QueryComputeCommitmentRequest) GetScheme() CommitmentScheme {
+	if m != nil {
+		return m.Scheme
+	}
+	return CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED
+}
+
+// QueryComputeCommitmentResponse is the response type for the
+// Query/ComputeCommitment RPC method.
+type QueryComputeCommitmentResponse struct {
+	// commit is the hash of "move:salt", see MoveCommit.
+	Commit HexBytes `protobuf:"bytes,1,opt,name=commit,proto3,casttype=HexBytes" json:"commit,omitempty"`
+}
+
+func (m *QueryComputeCommitmentResponse) Reset()         { *m = QueryComputeCommitmentResponse{} }
+func (m *QueryComputeCommitmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryComputeCommitmentResponse) ProtoMessage()    {}
+func (*QueryComputeCommitmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{30}
+}
+func (m *QueryComputeCommitmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryComputeCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryComputeCommitmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryComputeCommitmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryComputeCommitmentResponse.Merge(m, src)
+}
+func (m *QueryComputeCommitmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryComputeCommitmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryComputeCommitmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryComputeCommitmentResponse proto.InternalMessageInfo
+
+func (m *QueryComputeCommitmentResponse) GetCommit() HexBytes {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+// QueryVerifyRevealRequest is the request type for the Query/VerifyReveal RPC
+// method.
+type QueryVerifyRevealRequest struct {
+	GameId uint64   `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Player string   `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	Move   Move     `protobuf:"varint,3,opt,name=move,proto3,enum=facundomedica.rps.v1.Move" json:"move,omitempty"`
+	Salt   HexBytes `protobuf:"bytes,4,opt,name=salt,proto3,casttype=HexBytes" json:"salt,omitempty"`
+}
+
+func (m *QueryVerifyRevealRequest) Reset()         { *m = QueryVerifyRevealRequest{} }
+func (m *QueryVerifyRevealRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyRevealRequest) ProtoMessage()    {}
+func (*QueryVerifyRevealRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{31}
+}
+func (m *QueryVerifyRevealRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyRevealRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyRevealRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyRevealRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyRevealRequest.Merge(m, src)
+}
+func (m *QueryVerifyRevealRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyRevealRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyRevealRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyRevealRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyRevealRequest) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
+	}
+	return 0
+}
+
+func (m *QueryVerifyRevealRequest) GetPlayer() string {
+	if m != nil {
+		return m.Player
+	}
+	return ""
+}
+
+func (m *QueryVerifyRevealRequest) GetMove() Move {
+	if m != nil {
+		return m.Move
+	}
+	return Move_MOVE_UNSPECIFIED
+}
+
+func (m *QueryVerifyRevealRequest) GetSalt() HexBytes {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
+// QueryVerifyRevealResponse is the response type for the Query/VerifyReveal
+// RPC method.
+type QueryVerifyRevealResponse struct {
+	// valid is true if the reveal would be accepted in the current block.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// reason is why the reveal would be rejected, empty if it's valid.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *QueryVerifyRevealResponse) Reset()         { *m = QueryVerifyRevealResponse{} }
+func (m *QueryVerifyRevealResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyRevealResponse) ProtoMessage()    {}
+func (*QueryVerifyRevealResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{32}
+}
+func (m *QueryVerifyRevealResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyRevealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyRevealResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyRevealResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyRevealResponse.Merge(m, src)
+}
+func (m *QueryVerifyRevealResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyRevealResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyRevealResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyRevealResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyRevealResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *QueryVerifyRevealResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{33}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6bb3f451e9b612, []int{34}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*QueryGamesRequest)(nil), "facundomedica.rps.v1.QueryGamesRequest")
+	proto.RegisterType((*QueryGamesResponse)(nil), "facundomedica.rps.v1.QueryGamesResponse")
+	proto.RegisterType((*QueryCountRequest)(nil), "facundomedica.rps.v1.QueryCountRequest")
+	proto.RegisterType((*QueryCountResponse)(nil), "facundomedica.rps.v1.QueryCountResponse")
+	proto.RegisterType((*QueryStuckGamesRequest)(nil), "facundomedica.rps.v1.QueryStuckGamesRequest")
+	proto.RegisterType((*QueryStuckGamesResponse)(nil), "facundomedica.rps.v1.QueryStuckGamesResponse")
+	proto.RegisterType((*QuerySettlementBacklogRequest)(nil), "facundomedica.rps.v1.QuerySettlementBacklogRequest")
+	proto.RegisterType((*QuerySettlementBacklogResponse)(nil), "facundomedica.rps.v1.QuerySettlementBacklogResponse")
+	proto.RegisterType((*QueryPlayerStatsRequest)(nil), "facundomedica.rps.v1.QueryPlayerStatsRequest")
+	proto.RegisterType((*QueryPlayerStatsResponse)(nil), "facundomedica.rps.v1.QueryPlayerStatsResponse")
+	proto.RegisterType((*QueryQueueRequest)(nil), "facundomedica.rps.v1.QueryQueueRequest")
+	proto.RegisterType((*QueryQueueResponse)(nil), "facundomedica.rps.v1.QueryQueueResponse")
+	proto.RegisterType((*QueryBetPoolsRequest)(nil), "facundomedica.rps.v1.QueryBetPoolsRequest")
+	proto.RegisterType((*QueryBetPoolsResponse)(nil), "facundomedica.rps.v1.QueryBetPoolsResponse")
+	proto.RegisterType((*BetPool)(nil), "facundomedica.rps.v1.BetPool")
+	proto.RegisterType((*QueryJackpotRequest)(nil), "facundomedica.rps.v1.QueryJackpotRequest")
+	proto.RegisterType((*QueryJackpotResponse)(nil), "facundomedica.rps.v1.QueryJackpotResponse")
+	proto.RegisterType((*QueryHouseBankrollRequest)(nil), "facundomedica.rps.v1.QueryHouseBankrollRequest")
+	proto.RegisterType((*QueryHouseBankrollResponse)(nil), "facundomedica.rps.v1.QueryHouseBankrollResponse")
+	proto.RegisterType((*QuerySettledGameRequest)(nil), "facundomedica.rps.v1.QuerySettledGameRequest")
+	proto.RegisterType((*QuerySettledGameResponse)(nil), "facundomedica.rps.v1.QuerySettledGameResponse")
+	proto.RegisterType((*QueryRevealAgentRequest)(nil), "facundomedica.rps.v1.QueryRevealAgentRequest")
+	proto.RegisterType((*QueryRevealAgentResponse)(nil), "facundomedica.rps.v1.QueryRevealAgentResponse")
+	proto.RegisterType((*QueryEscrowedRevealRequest)(nil), "facundomedica.rps.v1.QueryEscrowedRevealRequest")
+	proto.RegisterType((*QueryEscrowedRevealResponse)(nil), "facundomedica.rps.v1.QueryEscrowedRevealResponse")
+	proto.RegisterType((*QueryMoveCommitRequest)(nil), "facundomedica.rps.v1.QueryMoveCommitRequest")
+	proto.RegisterType((*QueryMoveCommitResponse)(nil), "facundomedica.rps.v1.QueryMoveCommitResponse")
+	proto.RegisterType((*QueryGamePlayersRequest)(nil), "facundomedica.rps.v1.QueryGamePlayersRequest")
+	proto.RegisterType((*QueryGamePlayersResponse)(nil), "facundomedica.rps.v1.QueryGamePlayersResponse")
+	proto.RegisterType((*QueryComputeCommitmentRequest)(nil), "facundomedica.rps.v1.QueryComputeCommitmentRequest")
+	proto.RegisterType((*QueryComputeCommitmentResponse)(nil), "facundomedica.rps.v1.QueryComputeCommitmentResponse")
+	proto.RegisterType((*QueryVerifyRevealRequest)(nil), "facundomedica.rps.v1.QueryVerifyRevealRequest")
+	proto.RegisterType((*QueryVerifyRevealResponse)(nil), "facundomedica.rps.v1.QueryVerifyRevealResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "facundomedica.rps.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "facundomedica.rps.v1.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("facundomedica/rps/v1/query.proto", fileDescriptor_8c6bb3f451e9b612) }
+
+var fileDescriptor_8c6bb3f451e9b612 = []byte{
+	// 1707 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xdd, 0x6f, 0x13, 0x57,
+	0x16, 0xcf, 0x24, 0x8e, 0x1d, 0x4e, 0xb2, 0xb0, 0xb9, 0x04, 0xe2, 0x4c, 0x12, 0x27, 0xcc, 0x22,
+	0xd6, 0x09, 0x64, 0x86, 0x84, 0x8f, 0xe5, 0x43, 0x62, 0x17, 0x67, 0xc3, 0x97, 0xc4, 0x02, 0xce,
+	0x8a, 0x95, 0xb6, 0x55, 0xad, 0xf1, 0xf8, 0x62, 0x4c, 0x3c, 0x73, 0xcd, 0xcc, 0xd8, 0x8d, 0x15,
+	0xe5, 0xa5, 0xed, 0x43, 0x1f, 0x5a, 0xa9, 0x52, 0x9f, 0xda, 0x3e, 0x57, 0x45, 0xfd, 0x90, 0x40,
+	0xa2, 0xea, 0x53, 0xdf, 0x79, 0x44, 0xad, 0x2a, 0xf5, 0x89, 0x56, 0x50, 0x89, 0xff, 0xa1, 0x4f,
+	0xd5, 0xdc, 0x7b, 0xec, 0x19, 0xc7, 0xe3, 0xb1, 0xdd, 0x96, 0xbc, 0x80, 0xe7, 0xdc, 0x73, 0xce,
+	0xef, 0x77, 0xcf, 0x3d, 0xf7, 0x9e, 0x73, 0x00, 0xe6, 0xef, 0xe8, 0x46, 0xd5, 0x2a, 0x30, 0x93,
+	0x16, 0x4a, 0x86, 0xae, 0xd9, 0x15, 0x47, 0xab, 0x2d, 0x6b, 0xf7, 0xab, 0xd4, 0xae, 0xab, 0x15,
+	0x9b, 0xb9, 0x8c, 0x4c, 0xb4, 0x68, 0xa8, 0x76, 0xc5, 0x51, 0x6b, 0xcb, 0x72, 0xb8, 0x9d, 0x5b,
+	0xaf, 0x50, 0x47, 0xd8, 0xc9, 0x33, 0x45, 0xc6, 0x8a, 0x65, 0xaa, 0xe9, 0x95, 0x92, 0xa6, 0x5b,
+	0x16, 0x73, 0x75, 0xb7, 0xc4, 0xac, 0xc6, 0xea, 0xb4, 0xc1, 0x1c, 0x93, 0x39, 0x02, 0x69, 0x07,
+	0xa4, 0x3c, 0xae, 0x9b, 0x25, 0x8b, 0x69, 0xfc, 0x4f, 0x14, 0x4d, 0x14, 0x59, 0x91, 0xf1, 0x9f,
+	0x9a, 0xf7, 0x0b, 0xa5, 0x53, 0xc2, 0x4b, 0x4e, 0x2c, 0x88, 0x0f, 0x5c, 0x4a, 0x21, 0x40, 0x5e,
+	0x77, 0xa8, 0x56, 0x5b, 0xce, 0x53, 0x57, 0x5f, 0xd6, 0x0c, 0x56, 0xb2, 0xc4, 0xba, 0xb2, 0x1f,
+	0xc6, 0x6f, 0x79, 0x90, 0x97, 0x75, 0x93, 0x3a, 0x59, 0x7a, 0xbf, 0x4a, 0x1d, 0x57, 0xb9, 0x05,
+	0x24, 0x28, 0x74, 0x2a, 0xcc, 0x72, 0x28, 0x39, 0x0f, 0xc3, 0x45, 0x4f, 0x90, 0x94, 0xe6, 0x87,
+	0xd2, 0xa3, 0x2b, 0xb2, 0x1a, 0x16, 0x11, 0xd5, 0xb3, 0xc9, 0xec, 0x79, 0xf2, 0x6c, 0x6e, 0xe0,
+	0xc1, 0xcb, 0x87, 0x8b, 0x52, 0x56, 0xd8, 0x34, 0x71, 0x56, 0x59, 0xd5, 0x72, 0x1b, 0x38, 0x8b,
+	0x40, 0x82, 0x42, 0xc4, 0x99, 0x80, 0x61, 0xc3, 0x13, 0x24, 0xa5, 0x79, 0x29, 0x1d, 0xcb, 0x8a,
+	0x0f, 0x25, 0x09, 0x07, 0xb9, 0xee, 0xba, 0x5b, 0x35, 0x36, 0x5a, 0xd8, 0xde, 0x86, 0xc9, 0xb6,
+	0x95, 0x3f, 0x83, 0xf2, 0x1c, 0xcc, 0x0a, 0xbf, 0xd4, 0x75, 0xcb, 0xd4, 0xa4, 0x96, 0x9b, 0xd1,
+	0x8d, 0x8d, 0x32, 0x2b, 0x36, 0x80, 0xff, 0x0b, 0xa9, 0x4e, 0x0a, 0x51, 0x5b, 0x21, 0x33, 0xb0,
+	0xc7, 0xb5, 0xab, 0x96, 0xa1, 0xbb, 0xb4, 0x90, 0x1c, 0x9c, 0x97, 0xd2, 0x23, 0x59, 0x5f, 0xa0,
+	0x5c, 0xc7, 0xed, 0xdc, 0x2c, 0xeb, 0x75, 0x6a, 0xaf, 0xbb, 0xba, 0xdb, 0xd8, 0x29, 0x59, 0x81,
+	0x84, 0x5e, 0x28, 0xd8, 0xd4, 0x71, 0xb8, 0xc3, 0x3d, 0x99, 0xe4, 0x77, 0x8f, 0x97, 0x26, 0xf0,
+	0xbc, 0x2f, 0x8a, 0x95, 0x75, 0xd7, 0x2e, 0x59, 0xc5, 0x6c, 0x43, 0x51, 0x79, 0x03, 0x92, 0xed,
+	0xee, 0x90, 0x5e, 0x06, 0x86, 0x1d, 0x4f, 0xc0, 0xbd, 0x8d, 0xae, 0x1c, 0x0a, 0x0f, 0x4f, 0xc0,
+	0xb2, 0x25, 0x4a, 0xdc, 0xb4, 0x79, 0xb0, 0xb7, 0xaa, 0xb4, 0x4a, 0x1b, 0x91, 0x79, 0x0d, 0x48,
+	0x50, 0x88, 0x70, 0x6b, 0x90, 0xa0, 0x96, 0x6b, 0x97, 0x9a, 0xe7, 0x31, 0x1f, 0x0e, 0xc8, 0xad,
+	0xd6, 0x2c, 0xd7, 0xae, 0x07, 0xf1, 0x1a, 0xb6, 0x8a, 0x06, 0x13, 0xdc, 0x79, 0x86, 0xba, 0x37,
+	0x19, 0x2b, 0x37, 0xa3, 0x33, 0x09, 0x09, 0xef, 0xe0, 0x72, 0xa5, 0x02, 0x86, 0x3b, 0xee, 0x7d,
+	0x5e, 0x2d, 0x28, 0xff, 0x83, 0x03, 0x3b, 0x0c, 0x90, 0xd0, 0x05, 0x18, 0xae, 0x78, 0x02, 0xa4,
+	0x33, 0x1b, 0x4e, 0x07, 0xcd, 0x5a, 0xf6, 0xce, 0xcd, 0x94, 0x2f, 0x07, 0x21, 0x81, 0xab, 0xe4,
+	0x1c, 0x24, 0x58, 0xd5, 0x35, 0x98, 0x49, 0x39, 0xfa, 0xde, 0x4e, 0x9b, 0xcb, 0x50, 0xf7, 0x86,
+	0xd0, 0xcb, 0x36, 0x0c, 0x48, 0x1d, 0xe2, 0xba, 0xc9, 0xf3, 0x64, 0x90, 0x13, 0x99, 0x52, 0xf1,
+	0x4c, 0xbd, 0x5b, 0xab, 0xe2, 0xad, 0x55, 0x57, 0x59, 0xc9, 0xca, 0x5c, 0xf2, 0x48, 0x7c, 0xfe,
+	0xd3, 0x5c, 0xba, 0x58, 0x72, 0xef, 0x56, 0xf3, 0xaa, 0xc1, 0x4c, 0xbc, 0xf0, 0xf8, 0xd7, 0x92,
+	0x53, 0xd8, 0xc0, 0x07, 0xc8, 0x33, 0x70, 0x3e, 0x7e, 0xf9, 0x70, 0x71, 0xac, 0x4c, 0x8b, 0xba,
+	0x51, 0xcf, 0x79, 0xf7, 0xde, 0x11, 0x3b, 0x40, 0x40, 0x72, 0x0f, 0x62, 0xac, 0x50, 0x70, 0x92,
+	0x43, 0x1c, 0x78, 0x26, 0x14, 0xf8, 0xdf, 0xd4, 0xe0, 0xd8, 0x67, 0x10, 0xfb, 0x68, 0x0f, 0xd8,
+	0x68, 0x83, 0x68, 0x1c, 0x43, 0x39, 0x00, 0xfb, 0xf9, 0x39, 0x5c, 0xd3, 0x8d, 0x8d, 0x0a, 0x6b,
+	0xbe, 0x02, 0x5f, 0x48, 0x30, 0xd1, 0x2a, 0xc7, 0xe3, 0xd9, 0x82, 0xc4, 0x3d, 0x21, 0x4a, 0x4a,
+	0xbb, 0x15, 0x97, 0x06, 0x22, 0x39, 0x08, 0x71, 0xc7, 0xb5, 0xa9, 0xbe, 0xc1, 0x6f, 0x68, 0x2c,
+	0x8b, 0x5f, 0xca, 0x34, 0x4c, 0x71, 0xb2, 0x57, 0x58, 0xd5, 0xa1, 0x19, 0xdd, 0xda, 0xb0, 0x59,
+	0xb9, 0xdc, 0xd8, 0xca, 0xa3, 0x18, 0xc8, 0x61, 0xab, 0xb8, 0xa1, 0x6d, 0x18, 0xc9, 0xa3, 0x6c,
+	0xf7, 0x76, 0xd4, 0x84, 0x24, 0xef, 0x48, 0x30, 0x66, 0xea, 0x9b, 0x39, 0xba, 0x59, 0x61, 0x4e,
+	0xd5, 0xa6, 0xbb, 0x97, 0x6d, 0xa3, 0xa6, 0xbe, 0xb9, 0x86, 0xa8, 0x5e, 0x14, 0x9a, 0x0c, 0x86,
+	0x76, 0x2d, 0x0a, 0x0d, 0x48, 0xf2, 0xbe, 0x04, 0xe3, 0x5e, 0x14, 0x58, 0x85, 0x5a, 0x7e, 0x28,
+	0x62, 0xbb, 0x45, 0x64, 0x9f, 0xa9, 0x6f, 0xde, 0xa8, 0x50, 0xab, 0x11, 0x0e, 0x65, 0x05, 0x26,
+	0x03, 0x55, 0xa4, 0xe0, 0x15, 0xa3, 0xae, 0x2f, 0xda, 0xeb, 0x90, 0x6c, 0xb7, 0xc1, 0x24, 0xfb,
+	0x17, 0xc4, 0x3c, 0xad, 0xe8, 0x37, 0x3d, 0x60, 0x18, 0x7c, 0xd7, 0xb8, 0x65, 0xb3, 0x02, 0x65,
+	0x69, 0x8d, 0xea, 0xe5, 0x8b, 0x45, 0x6a, 0xb9, 0x7f, 0xa4, 0x02, 0x5d, 0x83, 0x64, 0xbb, 0x3b,
+	0x24, 0xab, 0xc2, 0xb0, 0xee, 0x09, 0xba, 0x7a, 0x13, 0x6a, 0x4a, 0x11, 0xef, 0xd7, 0x9a, 0x63,
+	0xd8, 0xec, 0x4d, 0x5a, 0x10, 0x3e, 0xbb, 0xc5, 0x8b, 0x1c, 0x87, 0x78, 0x85, 0x57, 0xb1, 0xe4,
+	0x60, 0x17, 0x1c, 0xd4, 0x53, 0x36, 0x61, 0x3a, 0x14, 0xe8, 0xf7, 0xf1, 0x26, 0x0b, 0xf0, 0x57,
+	0x6a, 0x19, 0x76, 0xbd, 0xe2, 0xd2, 0x42, 0xce, 0xe6, 0xbe, 0x38, 0x95, 0xb1, 0xec, 0xbe, 0xa6,
+	0x5c, 0x40, 0x28, 0x06, 0x36, 0x3a, 0xd7, 0x59, 0x8d, 0xae, 0x32, 0xd3, 0x2c, 0xb9, 0xaf, 0x60,
+	0x7b, 0x79, 0x98, 0x6c, 0x03, 0xc1, 0xad, 0x5d, 0x86, 0x51, 0x93, 0xd5, 0x68, 0xce, 0xe0, 0x62,
+	0x4c, 0xa3, 0x0e, 0xc5, 0xcc, 0x37, 0xcf, 0xc4, 0xbc, 0x2c, 0xca, 0x82, 0xd9, 0x94, 0x34, 0x13,
+	0xdb, 0x4b, 0x32, 0xd1, 0x43, 0x74, 0x2f, 0xd5, 0xff, 0x81, 0x64, 0xbb, 0x0d, 0x12, 0x5b, 0x81,
+	0x84, 0x60, 0x2f, 0xea, 0x75, 0x64, 0xee, 0xa1, 0xa2, 0xf2, 0x48, 0xc2, 0x26, 0x6e, 0x95, 0x99,
+	0x95, 0xaa, 0x8b, 0xd4, 0xcc, 0x40, 0x46, 0xab, 0x10, 0xf3, 0x38, 0x63, 0xd1, 0x96, 0x3b, 0xef,
+	0x33, 0xcb, 0xf5, 0xc8, 0x3c, 0xc4, 0x1c, 0xbd, 0xec, 0x8a, 0xd3, 0xcb, 0x8c, 0xfd, 0xfa, 0x6c,
+	0x6e, 0xe4, 0x0a, 0xdd, 0xcc, 0xd4, 0x5d, 0xea, 0x64, 0xf9, 0x0a, 0xb9, 0x00, 0x71, 0xc7, 0xb8,
+	0x4b, 0x4d, 0xef, 0x75, 0xf3, 0x7c, 0x1e, 0x09, 0xf7, 0xe9, 0x53, 0x59, 0xe7, 0xda, 0x59, 0xb4,
+	0x52, 0x2e, 0x41, 0xaa, 0x13, 0x65, 0x8c, 0xc4, 0x61, 0x88, 0x07, 0x4e, 0x67, 0x27, 0x0b, 0x5c,
+	0x53, 0xbe, 0x91, 0x30, 0x98, 0xb7, 0xa9, 0x5d, 0xba, 0x53, 0x7f, 0x55, 0x57, 0xa5, 0x19, 0xc1,
+	0xa1, 0x3e, 0x23, 0x18, 0xeb, 0x14, 0x41, 0xe5, 0x2a, 0x4c, 0x85, 0x10, 0xf7, 0x7b, 0xea, 0x9a,
+	0x5e, 0x46, 0xde, 0x23, 0x59, 0xf1, 0xe1, 0x95, 0x6b, 0x9b, 0xea, 0x0e, 0xb3, 0x04, 0xed, 0x2c,
+	0x7e, 0x29, 0x13, 0xd8, 0x89, 0xde, 0xd4, 0x6d, 0xdd, 0x0c, 0x8c, 0x0c, 0xfb, 0x5b, 0xa4, 0xe8,
+	0xfa, 0x9f, 0x10, 0xaf, 0x70, 0x09, 0x66, 0xfd, 0x4c, 0x87, 0x86, 0x98, 0xeb, 0x04, 0xdf, 0x4d,
+	0x34, 0x5b, 0xf9, 0xe1, 0x00, 0x0c, 0x73, 0xc7, 0x5e, 0xad, 0x1d, 0xe6, 0xb3, 0x08, 0xf9, 0x7b,
+	0xc7, 0x26, 0xb7, 0x75, 0xea, 0x92, 0xd3, 0xdd, 0x15, 0x05, 0x4f, 0x25, 0xfd, 0xae, 0x87, 0xfa,
+	0xd6, 0xf7, 0xbf, 0x7c, 0x38, 0x38, 0x4b, 0xa6, 0xb5, 0xd0, 0x19, 0x94, 0xcf, 0x30, 0x9c, 0x06,
+	0x9f, 0xae, 0x22, 0x69, 0x04, 0x87, 0x32, 0x39, 0xdd, 0x5d, 0xb1, 0x0f, 0x1a, 0x62, 0xe2, 0xf9,
+	0x48, 0x02, 0xf0, 0xc7, 0x33, 0x72, 0x2c, 0x02, 0xa2, 0x6d, 0xbe, 0x93, 0x97, 0x7a, 0xd4, 0x46,
+	0x56, 0xaa, 0xcf, 0xea, 0x6f, 0xe4, 0x50, 0x38, 0x2b, 0xc7, 0x33, 0xcb, 0x89, 0x10, 0x7d, 0x2d,
+	0xc1, 0x78, 0xdb, 0x04, 0x47, 0x4e, 0x44, 0x81, 0x76, 0x18, 0x08, 0xe5, 0x93, 0xfd, 0x19, 0x21,
+	0xe1, 0x53, 0x3e, 0xe1, 0x45, 0x92, 0xee, 0x40, 0xb8, 0x69, 0x9d, 0xcb, 0x23, 0xc3, 0xcf, 0x24,
+	0x18, 0x0d, 0x8c, 0x66, 0x24, 0x2a, 0x4c, 0xed, 0xb3, 0xa4, 0xac, 0xf6, 0xaa, 0x8e, 0x2c, 0xcf,
+	0xfa, 0x2c, 0x55, 0x72, 0x2c, 0x9c, 0xa5, 0x78, 0x10, 0x72, 0x7c, 0x30, 0xd4, 0xb6, 0xb0, 0xfe,
+	0x6f, 0xf3, 0x24, 0xe4, 0x33, 0x5d, 0x64, 0x12, 0x06, 0x07, 0x48, 0x39, 0xdd, 0x5d, 0xb1, 0x8f,
+	0x24, 0xbc, 0xcf, 0xc1, 0x3f, 0x91, 0x60, 0xa4, 0x31, 0x02, 0x92, 0xc5, 0x08, 0x80, 0x1d, 0x83,
+	0xa5, 0x7c, 0xb4, 0x27, 0x5d, 0xe4, 0x73, 0xda, 0xe7, 0x73, 0x94, 0x2c, 0x84, 0xf3, 0xc9, 0x53,
+	0x37, 0xc7, 0x27, 0x48, 0x6d, 0x0b, 0x1f, 0xe1, 0x6d, 0xf2, 0x9e, 0x04, 0x09, 0x1c, 0x80, 0xc8,
+	0x42, 0x04, 0x60, 0xeb, 0xf0, 0x24, 0x2f, 0xf6, 0xa2, 0x8a, 0xd4, 0x16, 0x7d, 0x6a, 0x73, 0x64,
+	0x36, 0x9c, 0x5a, 0x63, 0xfc, 0xf9, 0x54, 0x82, 0xbf, 0xb4, 0x0c, 0x31, 0x44, 0x8b, 0x40, 0x0a,
+	0x1b, 0x86, 0xe4, 0xe3, 0xbd, 0x1b, 0x20, 0xc1, 0x65, 0x9f, 0xe0, 0x11, 0x72, 0x38, 0x9c, 0xe0,
+	0x5d, 0xcf, 0x32, 0xd7, 0x9c, 0x69, 0x1e, 0x48, 0x30, 0x1a, 0x68, 0x66, 0x23, 0x6f, 0x41, 0x7b,
+	0x87, 0x2d, 0xab, 0xbd, 0xaa, 0x23, 0xc3, 0x73, 0x3e, 0x43, 0x8d, 0x2c, 0x45, 0xdd, 0xd5, 0x82,
+	0x78, 0x5e, 0x02, 0x27, 0xec, 0x51, 0x0d, 0xf4, 0xc0, 0x91, 0x54, 0xdb, 0x5b, 0x6f, 0x59, 0xed,
+	0x55, 0xbd, 0x0f, 0xaa, 0xa2, 0x13, 0xcd, 0xf1, 0x1e, 0x35, 0x78, 0x63, 0xbf, 0x95, 0x60, 0x6f,
+	0x6b, 0xe7, 0x4b, 0xa2, 0x4e, 0x33, 0xb4, 0x1b, 0x97, 0x97, 0xfb, 0xb0, 0x40, 0xce, 0x97, 0x7c,
+	0xce, 0xe7, 0xc9, 0xd9, 0x70, 0xce, 0x14, 0x4d, 0xb1, 0x8d, 0x0e, 0x44, 0x58, 0xdb, 0x12, 0x0f,
+	0xd0, 0x36, 0xf9, 0x4a, 0x02, 0xf0, 0x7b, 0xd3, 0xc8, 0x7a, 0xd3, 0xd6, 0x66, 0xcb, 0x4b, 0x3d,
+	0x6a, 0x23, 0xe7, 0x8c, 0xcf, 0xf9, 0x1f, 0xe4, 0x54, 0x38, 0xe7, 0x40, 0x43, 0x1d, 0xca, 0xd7,
+	0x4b, 0x8d, 0x40, 0xcb, 0x1b, 0x99, 0x1a, 0xed, 0xed, 0xb4, 0xac, 0xf6, 0xaa, 0xde, 0x47, 0x6a,
+	0xec, 0xc8, 0x5e, 0x7c, 0xdb, 0x45, 0xb9, 0x6c, 0xeb, 0x4c, 0x23, 0xcb, 0x65, 0xa7, 0xd6, 0x5b,
+	0x3e, 0xd9, 0x9f, 0x51, 0x1f, 0xe5, 0xd2, 0x10, 0xd6, 0x39, 0xa3, 0x69, 0x4e, 0x1e, 0x4b, 0x30,
+	0x16, 0xec, 0x27, 0x49, 0x54, 0xd0, 0x42, 0x3a, 0x66, 0x59, 0xeb, 0x59, 0x1f, 0x89, 0xae, 0xfa,
+	0x44, 0xcf, 0x90, 0xd3, 0xe1, 0x44, 0x6b, 0xdc, 0x10, 0x53, 0x39, 0x2c, 0x33, 0xde, 0x96, 0x20,
+	0x2e, 0xfa, 0x4d, 0x12, 0x55, 0x13, 0x5b, 0xda, 0x5b, 0x79, 0xa1, 0x07, 0x4d, 0x24, 0x79, 0x98,
+	0xf3, 0x4b, 0x91, 0x99, 0x0e, 0x15, 0x5d, 0xb4, 0xba, 0xa7, 0x9f, 0x3c, 0x4f, 0x49, 0x4f, 0x9f,
+	0xa7, 0xa4, 0x9f, 0x9f, 0xa7, 0xa4, 0x0f, 0x5e, 0xa4, 0x06, 0x9e, 0xbe, 0x48, 0x0d, 0xfc, 0xf8,
+	0x22, 0x35, 0xf0, 0xff, 0x99, 0xc0, 0x3f, 0x87, 0xb4, 0x79, 0xc8, 0xc7, 0xf9, 0x7f, 0x32, 0x9c,
+	0xf8, 0x6d, 0x00, 0x21, 0xd4, 0x6c, 0x8b, 0x5f, 0x19, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Games(ctx context.Context, in *QueryGamesRequest, opts ...grpc.CallOption) (*QueryGamesResponse, error)
+	// Count returns the historical number of games played.
+	Count(ctx context.Context, in *QueryCountRequest, opts ...grpc.CallOption) (*QueryCountResponse, error)
+	// StuckGames returns the games that were quarantined because they couldn't
+	// be settled.
+	StuckGames(ctx context.Context, in *QueryStuckGamesRequest, opts ...grpc.CallOption) (*QueryStuckGamesResponse, error)
+	// SettlementBacklog returns the number of games that are due but haven't
+	// been settled yet, counting up to 1000 of them.
+	SettlementBacklog(ctx context.Context, in *QuerySettlementBacklogRequest, opts ...grpc.CallOption) (*QuerySettlementBacklogResponse, error)
+	// PlayerStats returns the track record of a player.
+	PlayerStats(ctx context.Context, in *QueryPlayerStatsRequest, opts ...grpc.CallOption) (*QueryPlayerStatsResponse, error)
+	// Queue returns the players waiting in the matchmaking queue.
+	Queue(ctx context.Context, in *QueryQueueRequest, opts ...grpc.CallOption) (*QueryQueueResponse, error)
+	// BetPools returns the spectator betting pools of a game and their implied
+	// odds.
+	BetPools(ctx context.Context, in *QueryBetPoolsRequest, opts ...grpc.CallOption) (*QueryBetPoolsResponse, error)
+	// Jackpot returns the progressive jackpot.
+	Jackpot(ctx context.Context, in *QueryJackpotRequest, opts ...grpc.CallOption) (*QueryJackpotResponse, error)
+	// HouseBankroll returns the funds available to the house.
+	HouseBankroll(ctx context.Context, in *QueryHouseBankrollRequest, opts ...grpc.CallOption) (*QueryHouseBankrollResponse, error)
+	// SettledGame returns a recently settled game that can still be rematched.
+	SettledGame(ctx context.Context, in *QuerySettledGameRequest, opts ...grpc.CallOption) (*QuerySettledGameResponse, error)
+	// RevealAgent returns the reveal agent of a player.
+	RevealAgent(ctx context.Context, in *QueryRevealAgentRequest, opts ...grpc.CallOption) (*QueryRevealAgentResponse, error)
+	// EscrowedReveal returns the encrypted reveal a player escrowed for their
+	// reveal agent in a game.
+	EscrowedReveal(ctx context.Context, in *QueryEscrowedRevealRequest, opts ...grpc.CallOption) (*QueryEscrowedRevealResponse, error)
+	// MoveCommit returns the move commitment of a player in a game.
+	MoveCommit(ctx context.Context, in *QueryMoveCommitRequest, opts ...grpc.CallOption) (*QueryMoveCommitResponse, error)
+	// GamePlayers returns the players that committed a move to a game.
+	GamePlayers(ctx context.Context, in *QueryGamePlayersRequest, opts ...grpc.CallOption) (*QueryGamePlayersResponse, error)
+	// ComputeCommitment returns the commitment of a move with a salt, as
+	// expected by the messages committing a move.
+	ComputeCommitment(ctx context.Context, in *QueryComputeCommitmentRequest, opts ...grpc.CallOption) (*QueryComputeCommitmentResponse, error)
+	// VerifyReveal returns whether a player revealing a move with a salt in a
+	// game would be accepted, and why not otherwise.
+	VerifyReveal(ctx context.Context, in *QueryVerifyRevealRequest, opts ...grpc.CallOption) (*QueryVerifyRevealResponse, error)
+	// Params returns the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Games(ctx context.Context, in *QueryGamesRequest, opts ...grpc.CallOption) (*QueryGamesResponse, error) {
+	out := new(QueryGamesResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/Games", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Count(ctx context.Context, in *QueryCountRequest, opts ...grpc.CallOption) (*QueryCountResponse, error) {
+	out := new(QueryCountResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/Count", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StuckGames(ctx context.Context, in *QueryStuckGamesRequest, opts ...grpc.CallOption) (*QueryStuckGamesResponse, error) {
+	out := new(QueryStuckGamesResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/StuckGames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SettlementBacklog(ctx context.Context, in *QuerySettlementBacklogRequest, opts ...grpc.CallOption) (*QuerySettlementBacklogResponse, error) {
+	out := new(QuerySettlementBacklogResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/SettlementBacklog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PlayerStats(ctx context.Context, in *QueryPlayerStatsRequest, opts ...grpc.CallOption) (*QueryPlayerStatsResponse, error) {
+	out := new(QueryPlayerStatsResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/PlayerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Queue(ctx context.Context, in *QueryQueueRequest, opts ...grpc.CallOption) (*QueryQueueResponse, error) {
+	out := new(QueryQueueResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/Queue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BetPools(ctx context.Context, in *QueryBetPoolsRequest, opts ...grpc.CallOption) (*QueryBetPoolsResponse, error) {
+	out := new(QueryBetPoolsResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/BetPools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Jackpot(ctx context.Context, in *QueryJackpotRequest, opts ...grpc.CallOption) (*QueryJackpotResponse, error) {
+	out := new(QueryJackpotResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/Jackpot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HouseBankroll(ctx context.Context, in *QueryHouseBankrollRequest, opts ...grpc.CallOption) (*QueryHouseBankrollResponse, error) {
+	out := new(QueryHouseBankrollResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/HouseBankroll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SettledGame(ctx context.Context, in *QuerySettledGameRequest, opts ...grpc.CallOption) (*QuerySettledGameResponse, error) {
+	out := new(QuerySettledGameResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/SettledGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RevealAgent(ctx context.Context, in *QueryRevealAgentRequest, opts ...grpc.CallOption) (*QueryRevealAgentResponse, error) {
+	out := new(QueryRevealAgentResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/RevealAgent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowedReveal(ctx context.Context, in *QueryEscrowedRevealRequest, opts ...grpc.CallOption) (*QueryEscrowedRevealResponse, error) {
+	out := new(QueryEscrowedRevealResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/EscrowedReveal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MoveCommit(ctx context.Context, in *QueryMoveCommitRequest, opts ...grpc.CallOption) (*QueryMoveCommitResponse, error) {
+	out := new(QueryMoveCommitResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/MoveCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GamePlayers(ctx context.Context, in *QueryGamePlayersRequest, opts ...grpc.CallOption) (*QueryGamePlayersResponse, error) {
+	out := new(QueryGamePlayersResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/GamePlayers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ComputeCommitment(ctx context.Context, in *QueryComputeCommitmentRequest, opts ...grpc.CallOption) (*QueryComputeCommitmentResponse, error) {
+	out := new(QueryComputeCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/ComputeCommitment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyReveal(ctx context.Context, in *QueryVerifyRevealRequest, opts ...grpc.CallOption) (*QueryVerifyRevealResponse, error) {
+	out := new(QueryVerifyRevealResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/VerifyReveal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Games(context.Context, *QueryGamesRequest) (*QueryGamesResponse, error)
+	// Count returns the historical number of games played.
+	Count(context.Context, *QueryCountRequest) (*QueryCountResponse, error)
+	// StuckGames returns the games that were quarantined because they couldn't
+	// be settled.
+	StuckGames(context.Context, *QueryStuckGamesRequest) (*QueryStuckGamesResponse, error)
+	// SettlementBacklog returns the number of games that are due but haven't
+	// been settled yet, counting up to 1000 of them.
+	SettlementBacklog(context.Context, *QuerySettlementBacklogRequest) (*QuerySettlementBacklogResponse, error)
+	// PlayerStats returns the track record of a player.
+	PlayerStats(context.Context, *QueryPlayerStatsRequest) (*QueryPlayerStatsResponse, error)
+	// Queue returns the players waiting in the matchmaking queue.
+	Queue(context.Context, *QueryQueueRequest) (*QueryQueueResponse, error)
+	// BetPools returns the spectator betting pools of a game and their implied
+	// odds.
+	BetPools(context.Context, *QueryBetPoolsRequest) (*QueryBetPoolsResponse, error)
+	// Jackpot returns the progressive jackpot.
+	Jackpot(context.Context, *QueryJackpotRequest) (*QueryJackpotResponse, error)
+	// HouseBankroll returns the funds available to the house.
+	HouseBankroll(context.Context, *QueryHouseBankrollRequest) (*QueryHouseBankrollResponse, error)
+	// SettledGame returns a recently settled game that can still be rematched.
+	SettledGame(context.Context, *QuerySettledGameRequest) (*QuerySettledGameResponse, error)
+	// RevealAgent returns the reveal agent of a player.
+	RevealAgent(context.Context, *QueryRevealAgentRequest) (*QueryRevealAgentResponse, error)
+	// EscrowedReveal returns the encrypted reveal a player escrowed for their
+	// reveal agent in a game.
+	EscrowedReveal(context.Context, *QueryEscrowedRevealRequest) (*QueryEscrowedRevealResponse, error)
+	// MoveCommit returns the move commitment of a player in a game.
+	MoveCommit(context.Context, *QueryMoveCommitRequest) (*QueryMoveCommitResponse, error)
+	// GamePlayers returns the players that committed a move to a game.
+	GamePlayers(context.Context, *QueryGamePlayersRequest) (*QueryGamePlayersResponse, error)
+	// ComputeCommitment returns the commitment of a move with a salt, as
+	// expected by the messages committing a move.
+	ComputeCommitment(context.Context, *QueryComputeCommitmentRequest) (*QueryComputeCommitmentResponse, error)
+	// VerifyReveal returns whether a player revealing a move with a salt in a
+	// game would be accepted, and why not otherwise.
+	VerifyReveal(context.Context, *QueryVerifyRevealRequest) (*QueryVerifyRevealResponse, error)
+	// Params returns the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Games(ctx context.Context, req *QueryGamesRequest) (*QueryGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Games not implemented")
+}
+func (*UnimplementedQueryServer) Count(ctx context.Context, req *QueryCountRequest) (*QueryCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+func (*UnimplementedQueryServer) StuckGames(ctx context.Context, req *QueryStuckGamesRequest) (*QueryStuckGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StuckGames not implemented")
+}
+func (*UnimplementedQueryServer) SettlementBacklog(ctx context.Context, req *QuerySettlementBacklogRequest) (*QuerySettlementBacklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettlementBacklog not implemented")
+}
+func (*UnimplementedQueryServer) PlayerStats(ctx context.Context, req *QueryPlayerStatsRequest) (*QueryPlayerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayerStats not implemented")
+}
+func (*UnimplementedQueryServer) Queue(ctx context.Context, req *QueryQueueRequest) (*QueryQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Queue not implemented")
+}
+func (*UnimplementedQueryServer) BetPools(ctx context.Context, req *QueryBetPoolsRequest) (*QueryBetPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BetPools not implemented")
+}
+func (*UnimplementedQueryServer) Jackpot(ctx context.Context, req *QueryJackpotRequest) (*QueryJackpotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Jackpot not implemented")
+}
+func (*UnimplementedQueryServer) HouseBankroll(ctx context.Context, req *QueryHouseBankrollRequest) (*QueryHouseBankrollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HouseBankroll not implemented")
+}
+func (*UnimplementedQueryServer) SettledGame(ctx context.Context, req *QuerySettledGameRequest) (*QuerySettledGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettledGame not implemented")
+}
+func (*UnimplementedQueryServer) RevealAgent(ctx context.Context, req *QueryRevealAgentRequest) (*QueryRevealAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealAgent not implemented")
+}
+func (*UnimplementedQueryServer) EscrowedReveal(ctx context.Context, req *QueryEscrowedRevealRequest) (*QueryEscrowedRevealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowedReveal not implemented")
+}
+func (*UnimplementedQueryServer) MoveCommit(ctx context.Context, req *QueryMoveCommitRequest) (*QueryMoveCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCommit not implemented")
+}
+func (*UnimplementedQueryServer) GamePlayers(ctx context.Context, req *QueryGamePlayersRequest) (*QueryGamePlayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GamePlayers not implemented")
+}
+func (*UnimplementedQueryServer) ComputeCommitment(ctx context.Context, req *QueryComputeCommitmentRequest) (*QueryComputeCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComputeCommitment not implemented")
+}
+func (*UnimplementedQueryServer) VerifyReveal(ctx context.Context, req *QueryVerifyRevealRequest) (*QueryVerifyRevealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyReveal not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Games_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Games(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Query/Games",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Games(ctx, req.(*QueryGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Count(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Query/Count",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Count(ctx, req.(*QueryCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StuckGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStuckGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StuckGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Query/StuckGames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StuckGames(ctx, req.(*QueryStuckGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SettlementBacklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySettlementBacklogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SettlementBacklog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Query/SettlementBacklog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SettlementBacklog(ctx, req.(*QuerySettlementBacklogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PlayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Query/PlayerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlayerStats(ctx, req.(*QueryPlayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Queue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Queue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Query/Queue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Queue(ctx, req.(*QueryQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BetPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBetPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BetPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Query/BetPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BetPools(ctx, req.(*QueryBetPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Jackpot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryJackpotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Jackpot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Query/Jackpot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Jackpot(ctx, req.(*QueryJackpotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HouseBankroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHouseBankrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HouseBankroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Query/HouseBankroll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HouseBankroll(ctx, req.(*QueryHouseBankrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SettledGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySettledGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SettledGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Query/SettledGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SettledGame(ctx, req.(*QuerySettledGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RevealAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRevealAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RevealAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Query/RevealAgent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RevealAgent(ctx, req.(*QueryRevealAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowedReveal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowedRevealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowedReveal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Query/EscrowedReveal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowedReveal(ctx, req.(*QueryEscrowedRevealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MoveCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMoveCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MoveCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Query/MoveCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MoveCommit(ctx, req.(*QueryMoveCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GamePlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGamePlayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GamePlayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Query/GamePlayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GamePlayers(ctx, req.(*QueryGamePlayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ComputeCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryComputeCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ComputeCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Query/ComputeCommitment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ComputeCommitment(ctx, req.(*QueryComputeCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyReveal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyRevealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyReveal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Query/VerifyReveal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyReveal(ctx, req.(*QueryVerifyRevealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "facundomedica.rps.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Games",
+			Handler:    _Query_Games_Handler,
+		},
+		{
+			MethodName: "Count",
+			Handler:    _Query_Count_Handler,
+		},
+		{
+			MethodName: "StuckGames",
+			Handler:    _Query_StuckGames_Handler,
+		},
+		{
+			MethodName: "SettlementBacklog",
+			Handler:    _Query_SettlementBacklog_Handler,
+		},
+		{
+			MethodName: "PlayerStats",
+			Handler:    _Query_PlayerStats_Handler,
+		},
+		{
+			MethodName: "Queue",
+			Handler:    _Query_Queue_Handler,
+		},
+		{
+			MethodName: "BetPools",
+			Handler:    _Query_BetPools_Handler,
+		},
+		{
+			MethodName: "Jackpot",
+			Handler:    _Query_Jackpot_Handler,
+		},
+		{
+			MethodName: "HouseBankroll",
+			Handler:    _Query_HouseBankroll_Handler,
+		},
+		{
+			MethodName: "SettledGame",
+			Handler:    _Query_SettledGame_Handler,
+		},
+		{
+			MethodName: "RevealAgent",
+			Handler:    _Query_RevealAgent_Handler,
+		},
+		{
+			MethodName: "EscrowedReveal",
+			Handler:    _Query_EscrowedReveal_Handler,
+		},
+		{
+			MethodName: "MoveCommit",
+			Handler:    _Query_MoveCommit_Handler,
+		},
+		{
+			MethodName: "GamePlayers",
+			Handler:    _Query_GamePlayers_Handler,
+		},
+		{
+			MethodName: "ComputeCommitment",
+			Handler:    _Query_ComputeCommitment_Handler,
+		},
+		{
+			MethodName: "VerifyReveal",
+			Handler:    _Query_VerifyReveal_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "facundomedica/rps/v1/query.proto",
+}
+
+func (m *QueryGamesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGamesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGamesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGamesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGamesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGamesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Games) > 0 {
+		for iNdEx := len(m.Games) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Games[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStuckGamesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStuckGamesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStuckGamesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStuckGamesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStuckGamesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStuckGamesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Games) > 0 {
+		for iNdEx := len(m.Games) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Games[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettlementBacklogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettlementBacklogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettlementBacklogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettlementBacklogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettlementBacklogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettlementBacklogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlayerStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlayerStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlayerStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlayerStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlayerStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlayerStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBetPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBetPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBetPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBetPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBetPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBetPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BetPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BetPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BetPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Odds) > 0 {
+		for iNdEx := len(m.Odds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Odds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryJackpotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryJackpotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryJackpotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryJackpotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryJackpotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryJackpotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Streak != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Streak))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Jackpot) > 0 {
+		for iNdEx := len(m.Jackpot) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jackpot[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHouseBankrollRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHouseBankrollRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHouseBankrollRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHouseBankrollResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHouseBankrollResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHouseBankrollResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxOpenExposure) > 0 {
+		for iNdEx := len(m.MaxOpenExposure) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxOpenExposure[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Exposure) > 0 {
+		for iNdEx := len(m.Exposure) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Exposure[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MaxExposure) > 0 {
+		for iNdEx := len(m.MaxExposure) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxExposure[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Bankroll) > 0 {
+		for iNdEx := len(m.Bankroll) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bankroll[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettledGameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettledGameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettledGameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettledGameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettledGameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettledGameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Game.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRevealAgentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRevealAgentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevealAgentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRevealAgentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRevealAgentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevealAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowedRevealRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowedRevealRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowedRevealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GameId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowedRevealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowedRevealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowedRevealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncryptedReveal) > 0 {
+		i -= len(m.EncryptedReveal)
+		copy(dAtA[i:], m.EncryptedReveal)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EncryptedReveal)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMoveCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMoveCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMoveCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GameId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMoveCommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMoveCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMoveCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MoveCommit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGamePlayersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGamePlayersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGamePlayersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGamePlayersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGamePlayersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGamePlayersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Players) > 0 {
+		for iNdEx := len(m.Players) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Players[iNdEx])
+			copy(dAtA[i:], m.Players[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Players[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryComputeCommitmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryComputeCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryComputeCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Scheme != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Scheme))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Move != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Move))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryComputeCommitmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryComputeCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryComputeCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyRevealRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyRevealRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyRevealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Move != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Move))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GameId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyRevealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyRevealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyRevealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGamesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGamesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Games) > 0 {
+		for _, e := range m.Games {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryStuckGamesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStuckGamesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Games) > 0 {
+		for _, e := range m.Games {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySettlementBacklogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySettlementBacklogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPlayerStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlayerStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBetPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		n += 1 + sovQuery(uint64(m.GameId))
+	}
+	return n
+}
+
+func (m *QueryBetPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BetPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Outcome != 0 {
+		n += 1 + sovQuery(uint64(m.Outcome))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Odds) > 0 {
+		for _, e := range m.Odds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryJackpotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryJackpotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Jackpot) > 0 {
+		for _, e := range m.Jackpot {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Streak != 0 {
+		n += 1 + sovQuery(uint64(m.Streak))
+	}
+	return n
+}
+
+func (m *QueryHouseBankrollRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHouseBankrollResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bankroll) > 0 {
+		for _, e := range m.Bankroll {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.MaxExposure) > 0 {
+		for _, e := range m.MaxExposure {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Exposure) > 0 {
+		for _, e := range m.Exposure {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.MaxOpenExposure) > 0 {
+		for _, e := range m.MaxOpenExposure {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySettledGameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		n += 1 + sovQuery(uint64(m.GameId))
+	}
+	return n
+}
+
+func (m *QuerySettledGameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Game.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRevealAgentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
-	if len(m.Games) > 0 {
-		for _, e := range m.Games {
-			l = e.Size()
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRevealAgentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowedRevealRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		n += 1 + sovQuery(uint64(m.GameId))
+	}
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowedRevealResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EncryptedReveal)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMoveCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		n += 1 + sovQuery(uint64(m.GameId))
+	}
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMoveCommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MoveCommit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGamePlayersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		n += 1 + sovQuery(uint64(m.GameId))
+	}
+	return n
+}
+
+func (m *QueryGamePlayersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Players) > 0 {
+		for _, s := range m.Players {
+			l = len(s)
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *QueryCountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryCountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Count != 0 {
-		n += 1 + sovQuery(uint64(m.Count))
-	}
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
+	return n
+}
+
+func (m *QueryComputeCommitmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Move != 0 {
+		n += 1 + sovQuery(uint64(m.Move))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Scheme != 0 {
+		n += 1 + sovQuery(uint64(m.Scheme))
+	}
+	return n
+}
+
+func (m *QueryComputeCommitmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyRevealRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		n += 1 + sovQuery(uint64(m.GameId))
+	}
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Move != 0 {
+		n += 1 + sovQuery(uint64(m.Move))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyRevealResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGamesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGamesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGamesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGamesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGamesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGamesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Games", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Games = append(m.Games, Game{})
+			if err := m.Games[len(m.Games)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStuckGamesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStuckGamesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStuckGamesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStuckGamesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStuckGamesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStuckGamesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Games", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Games = append(m.Games, Game{})
+			if err := m.Games[len(m.Games)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettlementBacklogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettlementBacklogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettlementBacklogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettlementBacklogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettlementBacklogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettlementBacklogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlayerStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlayerStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlayerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlayerStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlayerStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlayerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, QueueEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBetPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBetPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBetPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBetPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBetPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBetPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, BetPool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BetPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BetPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BetPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= BetOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Odds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Odds = append(m.Odds, types.DecCoin{})
+			if err := m.Odds[len(m.Odds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryJackpotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryJackpotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryJackpotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryJackpotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryJackpotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryJackpotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jackpot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jackpot = append(m.Jackpot, types.Coin{})
+			if err := m.Jackpot[len(m.Jackpot)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streak", wireType)
+			}
+			m.Streak = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Streak |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHouseBankrollRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHouseBankrollRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHouseBankrollRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHouseBankrollResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHouseBankrollResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHouseBankrollResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bankroll", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bankroll = append(m.Bankroll, types.Coin{})
+			if err := m.Bankroll[len(m.Bankroll)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExposure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxExposure = append(m.MaxExposure, types.Coin{})
+			if err := m.MaxExposure[len(m.MaxExposure)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exposure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exposure = append(m.Exposure, types.Coin{})
+			if err := m.Exposure[len(m.Exposure)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenExposure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxOpenExposure = append(m.MaxOpenExposure, types.Coin{})
+			if err := m.MaxOpenExposure[len(m.MaxOpenExposure)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettledGameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettledGameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettledGameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettledGameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettledGameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettledGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Game", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Game.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRevealAgentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRevealAgentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRevealAgentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRevealAgentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRevealAgentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRevealAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowedRevealRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowedRevealRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowedRevealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowedRevealResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowedRevealResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowedRevealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedReveal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedReveal = append(m.EncryptedReveal[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedReveal == nil {
+				m.EncryptedReveal = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMoveCommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMoveCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMoveCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMoveCommitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMoveCommitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMoveCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MoveCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGamePlayersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGamePlayersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGamePlayersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGamePlayersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGamePlayersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGamePlayersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Players", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Players = append(m.Players, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryGamesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryComputeCommitmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -752,12 +6652,84 @@ func (m *QueryGamesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGamesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryComputeCommitmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGamesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryComputeCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
+			}
+			m.Move = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Move |= Move(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
+			}
+			m.Scheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scheme |= CommitmentScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -779,7 +6751,7 @@ func (m *QueryGamesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGamesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryComputeCommitmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -802,17 +6774,17 @@ func (m *QueryGamesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGamesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryComputeCommitmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGamesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryComputeCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Games", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -822,24 +6794,24 @@ func (m *QueryGamesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Games = append(m.Games, Game{})
-			if err := m.Games[len(m.Games)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Commit = append(m.Commit[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commit == nil {
+				m.Commit = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -863,7 +6835,7 @@ func (m *QueryGamesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyRevealRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -886,12 +6858,116 @@ func (m *QueryCountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyRevealRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyRevealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
+			}
+			m.Move = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Move |= Move(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -913,7 +6989,7 @@ func (m *QueryCountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyRevealResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -936,17 +7012,17 @@ func (m *QueryCountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyRevealResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyRevealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-			m.Count = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -956,11 +7032,44 @@ func (m *QueryCountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
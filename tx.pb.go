@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -34,12 +35,23 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type MsgNewGame struct {
 	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
-	// commit is the hex encoded commitment to the first move.
-	// hex encoded sha256 of "salt:move" where move is one of "rock", "paper",
-	// "scissors"
-	Commit string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
-	// entry_fee is the amount to put into stake for the game.
-	EntryFee types.Coin `protobuf:"bytes,3,opt,name=entry_fee,json=entryFee,proto3" json:"entry_fee"`
+	// commit is the commitment to the first move, see MoveCommit.
+	Commit HexBytes `protobuf:"bytes,2,opt,name=commit,proto3,casttype=HexBytes" json:"commit,omitempty"`
+	// entry_fee is the amount to put into stake for the game, it can be made of
+	// multiple denoms.
+	EntryFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=entry_fee,json=entryFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"entry_fee"`
+	// commit_timeout and reveal_timeout optionally override the module timeouts
+	// for this game, in seconds or blocks depending on the module timeout mode.
+	// Zero uses the module default.
+	CommitTimeout uint64 `protobuf:"varint,4,opt,name=commit_timeout,json=commitTimeout,proto3" json:"commit_timeout,omitempty"`
+	RevealTimeout uint64 `protobuf:"varint,5,opt,name=reveal_timeout,json=revealTimeout,proto3" json:"reveal_timeout,omitempty"`
+	// challenger_fee optionally sets a different stake for the challenger, so
+	// the creator can offer odds (e.g. staking 3 against 1). Empty means the
+	// challenger matches the entry_fee.
+	ChallengerFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=challenger_fee,json=challengerFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"challenger_fee"`
+	// commitment_scheme is the scheme commit was computed with, it must be
+	// enabled in the params. Unspecified means sha256.
+	CommitmentScheme CommitmentScheme `protobuf:"varint,7,opt,name=commitment_scheme,json=commitmentScheme,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"commitment_scheme,omitempty"`
 }
 
 func (m *MsgNewGame) Reset()         { *m = MsgNewGame{} }
@@ -82,18 +94,46 @@ func (m *MsgNewGame) GetPlayer() string {
 	return ""
 }
 
-func (m *MsgNewGame) GetCommit() string {
+func (m *MsgNewGame) GetCommit() HexBytes {
 	if m != nil {
 		return m.Commit
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgNewGame) GetEntryFee() types.Coin {
+func (m *MsgNewGame) GetEntryFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.EntryFee
 	}
-	return types.Coin{}
+	return nil
+}
+
+func (m *MsgNewGame) GetCommitTimeout() uint64 {
+	if m != nil {
+		return m.CommitTimeout
+	}
+	return 0
+}
+
+func (m *MsgNewGame) GetRevealTimeout() uint64 {
+	if m != nil {
+		return m.RevealTimeout
+	}
+	return 0
+}
+
+func (m *MsgNewGame) GetChallengerFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ChallengerFee
+	}
+	return nil
+}
+
+func (m *MsgNewGame) GetCommitmentScheme() CommitmentScheme {
+	if m != nil {
+		return m.CommitmentScheme
+	}
+	return CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED
 }
 
 type MsgNewGameResponse struct {
@@ -141,21 +181,184 @@ func (m *MsgNewGameResponse) GetGameId() uint64 {
 	return 0
 }
 
+type MsgCreateAndJoin struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// creator_commit is the commitment to the creator's move.
+	CreatorCommit HexBytes `protobuf:"bytes,2,opt,name=creator_commit,json=creatorCommit,proto3,casttype=HexBytes" json:"creator_commit,omitempty"`
+	Challenger    string   `protobuf:"bytes,3,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	// challenger_commit is the commitment to the challenger's move.
+	ChallengerCommit HexBytes `protobuf:"bytes,4,opt,name=challenger_commit,json=challengerCommit,proto3,casttype=HexBytes" json:"challenger_commit,omitempty"`
+	// entry_fee is the creator's stake.
+	EntryFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=entry_fee,json=entryFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"entry_fee"`
+	// challenger_fee is the challenger's stake, empty means it matches the
+	// entry_fee.
+	ChallengerFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=challenger_fee,json=challengerFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"challenger_fee"`
+	// reveal_timeout optionally overrides the module reveal timeout, as in
+	// MsgNewGame. The game starts full, so there's no commit timeout.
+	RevealTimeout uint64 `protobuf:"varint,7,opt,name=reveal_timeout,json=revealTimeout,proto3" json:"reveal_timeout,omitempty"`
+	// creator_commitment_scheme and challenger_commitment_scheme are the
+	// schemes each commitment was computed with, see MsgNewGame.
+	CreatorCommitmentScheme    CommitmentScheme `protobuf:"varint,8,opt,name=creator_commitment_scheme,json=creatorCommitmentScheme,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"creator_commitment_scheme,omitempty"`
+	ChallengerCommitmentScheme CommitmentScheme `protobuf:"varint,9,opt,name=challenger_commitment_scheme,json=challengerCommitmentScheme,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"challenger_commitment_scheme,omitempty"`
+}
+
+func (m *MsgCreateAndJoin) Reset()         { *m = MsgCreateAndJoin{} }
+func (m *MsgCreateAndJoin) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateAndJoin) ProtoMessage()    {}
+func (*MsgCreateAndJoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{2}
+}
+func (m *MsgCreateAndJoin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateAndJoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateAndJoin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateAndJoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateAndJoin.Merge(m, src)
+}
+func (m *MsgCreateAndJoin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateAndJoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateAndJoin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateAndJoin proto.InternalMessageInfo
+
+func (m *MsgCreateAndJoin) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCreateAndJoin) GetCreatorCommit() HexBytes {
+	if m != nil {
+		return m.CreatorCommit
+	}
+	return nil
+}
+
+func (m *MsgCreateAndJoin) GetChallenger() string {
+	if m != nil {
+		return m.Challenger
+	}
+	return ""
+}
+
+func (m *MsgCreateAndJoin) GetChallengerCommit() HexBytes {
+	if m != nil {
+		return m.ChallengerCommit
+	}
+	return nil
+}
+
+func (m *MsgCreateAndJoin) GetEntryFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EntryFee
+	}
+	return nil
+}
+
+func (m *MsgCreateAndJoin) GetChallengerFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ChallengerFee
+	}
+	return nil
+}
+
+func (m *MsgCreateAndJoin) GetRevealTimeout() uint64 {
+	if m != nil {
+		return m.RevealTimeout
+	}
+	return 0
+}
+
+func (m *MsgCreateAndJoin) GetCreatorCommitmentScheme() CommitmentScheme {
+	if m != nil {
+		return m.CreatorCommitmentScheme
+	}
+	return CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED
+}
+
+func (m *MsgCreateAndJoin) GetChallengerCommitmentScheme() CommitmentScheme {
+	if m != nil {
+		return m.ChallengerCommitmentScheme
+	}
+	return CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED
+}
+
+type MsgCreateAndJoinResponse struct {
+	// game_id is the ID of the created game.
+	GameId uint64 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (m *MsgCreateAndJoinResponse) Reset()         { *m = MsgCreateAndJoinResponse{} }
+func (m *MsgCreateAndJoinResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateAndJoinResponse) ProtoMessage()    {}
+func (*MsgCreateAndJoinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{3}
+}
+func (m *MsgCreateAndJoinResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateAndJoinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateAndJoinResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateAndJoinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateAndJoinResponse.Merge(m, src)
+}
+func (m *MsgCreateAndJoinResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateAndJoinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateAndJoinResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateAndJoinResponse proto.InternalMessageInfo
+
+func (m *MsgCreateAndJoinResponse) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
+	}
+	return 0
+}
+
 type MsgCommitMove struct {
 	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
 	// game_id is the ID of the game to commit the move to.
 	GameId uint64 `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	// commit is the hex encoded commitment to the move.
-	// SHA256("32byte-salt" + "move") where move is one of "rock", "paper",
-	// "scissors"
-	Commit string `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	// commit is the commitment to the move, see MoveCommit.
+	Commit HexBytes `protobuf:"bytes,3,opt,name=commit,proto3,casttype=HexBytes" json:"commit,omitempty"`
+	// commitment_scheme is the scheme commit was computed with, see
+	// MsgNewGame.
+	CommitmentScheme CommitmentScheme `protobuf:"varint,4,opt,name=commitment_scheme,json=commitmentScheme,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"commitment_scheme,omitempty"`
 }
 
 func (m *MsgCommitMove) Reset()         { *m = MsgCommitMove{} }
 func (m *MsgCommitMove) String() string { return proto.CompactTextString(m) }
 func (*MsgCommitMove) ProtoMessage()    {}
 func (*MsgCommitMove) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10e7630811a18157, []int{2}
+	return fileDescriptor_10e7630811a18157, []int{4}
 }
 func (m *MsgCommitMove) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -198,11 +401,18 @@ func (m *MsgCommitMove) GetGameId() uint64 {
 	return 0
 }
 
-func (m *MsgCommitMove) GetCommit() string {
+func (m *MsgCommitMove) GetCommit() HexBytes {
 	if m != nil {
 		return m.Commit
 	}
-	return ""
+	return nil
+}
+
+func (m *MsgCommitMove) GetCommitmentScheme() CommitmentScheme {
+	if m != nil {
+		return m.CommitmentScheme
+	}
+	return CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED
 }
 
 type MsgCommitMoveResponse struct {
@@ -212,7 +422,7 @@ func (m *MsgCommitMoveResponse) Reset()         { *m = MsgCommitMoveResponse{} }
 func (m *MsgCommitMoveResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCommitMoveResponse) ProtoMessage()    {}
 func (*MsgCommitMoveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10e7630811a18157, []int{3}
+	return fileDescriptor_10e7630811a18157, []int{5}
 }
 func (m *MsgCommitMoveResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -245,18 +455,19 @@ type MsgRevealMove struct {
 	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
 	// game_id is the ID of the game to reveal the move for.
 	GameId uint64 `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	// move is the move to reveal.
-	// Must be one of "rock", "paper", "scissors"
-	Move string `protobuf:"bytes,3,opt,name=move,proto3" json:"move,omitempty"`
-	// salt is the salt used to create the commitment.
-	Salt string `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
+	// move is the move to reveal. It's encoded as its name, e.g. "rock", like
+	// before moves were an enum, so legacy clients and their signatures keep
+	// working.
+	Move Move `protobuf:"bytes,3,opt,name=move,proto3,customtype=Move" json:"move"`
+	// salt is the salt used to create the commitment, at least 16 bytes.
+	Salt HexBytes `protobuf:"bytes,4,opt,name=salt,proto3,casttype=HexBytes" json:"salt,omitempty"`
 }
 
 func (m *MsgRevealMove) Reset()         { *m = MsgRevealMove{} }
 func (m *MsgRevealMove) String() string { return proto.CompactTextString(m) }
 func (*MsgRevealMove) ProtoMessage()    {}
 func (*MsgRevealMove) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10e7630811a18157, []int{4}
+	return fileDescriptor_10e7630811a18157, []int{6}
 }
 func (m *MsgRevealMove) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,18 +510,11 @@ func (m *MsgRevealMove) GetGameId() uint64 {
 	return 0
 }
 
-func (m *MsgRevealMove) GetMove() string {
-	if m != nil {
-		return m.Move
-	}
-	return ""
-}
-
-func (m *MsgRevealMove) GetSalt() string {
+func (m *MsgRevealMove) GetSalt() HexBytes {
 	if m != nil {
 		return m.Salt
 	}
-	return ""
+	return nil
 }
 
 type MsgRevealMoveResponse struct {
@@ -320,7 +524,7 @@ func (m *MsgRevealMoveResponse) Reset()         { *m = MsgRevealMoveResponse{} }
 func (m *MsgRevealMoveResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevealMoveResponse) ProtoMessage()    {}
 func (*MsgRevealMoveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10e7630811a18157, []int{5}
+	return fileDescriptor_10e7630811a18157, []int{7}
 }
 func (m *MsgRevealMoveResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,28 +553,25 @@ func (m *MsgRevealMoveResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevealMoveResponse proto.InternalMessageInfo
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-type MsgUpdateParams struct {
-	// authority is the address that controls the module
-	// NOTE: Defaults to the governance module unless overwritten.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the module parameters to update.
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+type MsgSetRevealAgent struct {
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// agent is the address allowed to reveal the player's moves, an empty agent
+	// removes the current one.
+	Agent string `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10e7630811a18157, []int{6}
+func (m *MsgSetRevealAgent) Reset()         { *m = MsgSetRevealAgent{} }
+func (m *MsgSetRevealAgent) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRevealAgent) ProtoMessage()    {}
+func (*MsgSetRevealAgent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{8}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetRevealAgent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetRevealAgent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetRevealAgent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -380,49 +581,47 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgSetRevealAgent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRevealAgent.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgSetRevealAgent) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgSetRevealAgent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRevealAgent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetRevealAgent proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgSetRevealAgent) GetPlayer() string {
 	if m != nil {
-		return m.Authority
+		return m.Player
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgSetRevealAgent) GetAgent() string {
 	if m != nil {
-		return m.Params
+		return m.Agent
 	}
-	return Params{}
+	return ""
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-type MsgUpdateParamsResponse struct {
+type MsgSetRevealAgentResponse struct {
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10e7630811a18157, []int{7}
+func (m *MsgSetRevealAgentResponse) Reset()         { *m = MsgSetRevealAgentResponse{} }
+func (m *MsgSetRevealAgentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRevealAgentResponse) ProtoMessage()    {}
+func (*MsgSetRevealAgentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{9}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetRevealAgentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetRevealAgentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetRevealAgentResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -432,674 +631,6118 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgSetRevealAgentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRevealAgentResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgSetRevealAgentResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgSetRevealAgentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRevealAgentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetRevealAgentResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgNewGame)(nil), "facundomedica.rps.v1.MsgNewGame")
-	proto.RegisterType((*MsgNewGameResponse)(nil), "facundomedica.rps.v1.MsgNewGameResponse")
-	proto.RegisterType((*MsgCommitMove)(nil), "facundomedica.rps.v1.MsgCommitMove")
-	proto.RegisterType((*MsgCommitMoveResponse)(nil), "facundomedica.rps.v1.MsgCommitMoveResponse")
-	proto.RegisterType((*MsgRevealMove)(nil), "facundomedica.rps.v1.MsgRevealMove")
-	proto.RegisterType((*MsgRevealMoveResponse)(nil), "facundomedica.rps.v1.MsgRevealMoveResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "facundomedica.rps.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "facundomedica.rps.v1.MsgUpdateParamsResponse")
+type MsgEscrowReveal struct {
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// game_id is the ID of the game the move was committed to.
+	GameId uint64 `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// encrypted_reveal is the move and salt encrypted for the reveal agent, the
+	// module doesn't read it.
+	EncryptedReveal []byte `protobuf:"bytes,3,opt,name=encrypted_reveal,json=encryptedReveal,proto3" json:"encrypted_reveal,omitempty"`
 }
 
-func init() { proto.RegisterFile("facundomedica/rps/v1/tx.proto", fileDescriptor_10e7630811a18157) }
-
-var fileDescriptor_10e7630811a18157 = []byte{
-	// 617 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x8f, 0xd2, 0x4c,
-	0x1c, 0xc6, 0xe9, 0xc2, 0xcb, 0xbe, 0xcc, 0x6a, 0x8c, 0xcd, 0x2a, 0x6c, 0x83, 0x5d, 0xac, 0x31,
-	0x21, 0x28, 0xad, 0xa0, 0xd9, 0x03, 0x31, 0x31, 0xb2, 0x89, 0xc6, 0x03, 0xc6, 0xd4, 0xec, 0xc5,
-	0x83, 0x64, 0x68, 0x67, 0xbb, 0x4d, 0x98, 0x4e, 0xd3, 0x19, 0xaa, 0xdc, 0x8c, 0x37, 0x3d, 0xf9,
-	0x1d, 0xbc, 0x78, 0x24, 0xd1, 0x0f, 0x60, 0x3c, 0xed, 0x71, 0xe3, 0xc9, 0x93, 0x31, 0x70, 0xe0,
-	0x6b, 0x98, 0x69, 0x87, 0x6d, 0x59, 0xc0, 0x25, 0xc6, 0x0b, 0x99, 0xf9, 0xcf, 0xf3, 0x7f, 0xe6,
-	0xf9, 0x75, 0x66, 0x00, 0xd7, 0x0e, 0xa1, 0x35, 0xf0, 0x6c, 0x82, 0x91, 0xed, 0x5a, 0xd0, 0x08,
-	0x7c, 0x6a, 0x84, 0x0d, 0x83, 0xbd, 0xd6, 0xfd, 0x80, 0x30, 0x22, 0x6f, 0xcf, 0x2d, 0xeb, 0x81,
-	0x4f, 0xf5, 0xb0, 0xa1, 0x14, 0x2d, 0x42, 0x31, 0xa1, 0x06, 0xa6, 0x0e, 0x57, 0x63, 0xea, 0xc4,
-	0x72, 0x45, 0x15, 0x0b, 0x3d, 0x48, 0x91, 0x11, 0x36, 0x7a, 0x88, 0xc1, 0x86, 0x61, 0x11, 0xd7,
-	0x13, 0xeb, 0xdb, 0x0e, 0x71, 0x48, 0x34, 0x34, 0xf8, 0x48, 0x54, 0x2f, 0x43, 0xec, 0x7a, 0xc4,
-	0x88, 0x7e, 0x45, 0xa9, 0xb2, 0x3c, 0xd6, 0xd0, 0x47, 0x54, 0x28, 0x76, 0xe2, 0xad, 0xba, 0xb1,
-	0x5b, 0x3c, 0x89, 0x97, 0xb4, 0x6f, 0x12, 0x00, 0x1d, 0xea, 0x3c, 0x45, 0xaf, 0x1e, 0x43, 0x8c,
-	0xe4, 0x3b, 0x20, 0xef, 0xf7, 0xe1, 0x10, 0x05, 0x25, 0xa9, 0x22, 0x55, 0x0b, 0xed, 0xd2, 0xf7,
-	0x2f, 0xf5, 0x6d, 0xd1, 0xf0, 0xd0, 0xb6, 0x03, 0x44, 0xe9, 0x73, 0x16, 0xb8, 0x9e, 0x63, 0x0a,
-	0x9d, 0x7c, 0x15, 0xe4, 0x2d, 0x82, 0xb1, 0xcb, 0x4a, 0x1b, 0xbc, 0xc3, 0x14, 0x33, 0xf9, 0x3e,
-	0x28, 0x20, 0x8f, 0x05, 0xc3, 0xee, 0x21, 0x42, 0xa5, 0x6c, 0x45, 0xaa, 0x6e, 0x35, 0x77, 0x74,
-	0xe1, 0xc4, 0x91, 0x75, 0x81, 0xac, 0xef, 0x13, 0xd7, 0x6b, 0xe7, 0x8e, 0x7f, 0xee, 0x66, 0xcc,
-	0xff, 0xa3, 0x8e, 0x47, 0x08, 0xb5, 0x6e, 0xbf, 0x9d, 0x8e, 0x6a, 0x62, 0x8b, 0xf7, 0xd3, 0x51,
-	0xad, 0xbc, 0xc8, 0x98, 0xa4, 0xd6, 0xea, 0x40, 0x4e, 0x66, 0x26, 0xa2, 0x3e, 0xf1, 0x28, 0x92,
-	0x8b, 0x60, 0xd3, 0x81, 0x18, 0x75, 0x5d, 0x3b, 0x82, 0xc9, 0x99, 0x79, 0x3e, 0x7d, 0x62, 0x6b,
-	0x1f, 0x25, 0x70, 0xb1, 0x43, 0x9d, 0xfd, 0x28, 0x68, 0x87, 0x84, 0x7f, 0x83, 0x9d, 0x32, 0xdf,
-	0x48, 0x9b, 0xa7, 0xbe, 0x47, 0x36, 0xfd, 0x3d, 0x5a, 0xc6, 0x19, 0xa2, 0xdd, 0xa5, 0x44, 0x49,
-	0x26, 0xad, 0x08, 0xae, 0xcc, 0x15, 0x66, 0x5c, 0xda, 0xe7, 0x38, 0xbe, 0x89, 0x42, 0x04, 0xfb,
-	0xff, 0x3a, 0xbe, 0x0c, 0x72, 0x98, 0x84, 0x48, 0x84, 0x8f, 0xc6, 0xbc, 0x46, 0x61, 0x9f, 0x95,
-	0x72, 0x71, 0x8d, 0x8f, 0xd7, 0xc4, 0x49, 0x32, 0x0a, 0x9c, 0xa4, 0x70, 0x8a, 0xf3, 0x55, 0x02,
-	0x97, 0x3a, 0xd4, 0x39, 0xf0, 0x6d, 0xc8, 0xd0, 0x33, 0x18, 0x40, 0x4c, 0xe5, 0x3d, 0x50, 0x80,
-	0x03, 0x76, 0x44, 0x02, 0x97, 0x0d, 0xcf, 0x65, 0x4a, 0xa4, 0xf2, 0x03, 0x90, 0xf7, 0x23, 0x87,
-	0x88, 0x6a, 0xab, 0x59, 0xd6, 0x97, 0xbd, 0x49, 0x3d, 0xde, 0xa5, 0x5d, 0xe0, 0x97, 0xee, 0xd3,
-	0x74, 0x54, 0x93, 0x4c, 0xd1, 0xd6, 0xba, 0xc7, 0xb1, 0x12, 0x43, 0x4e, 0x76, 0x7d, 0x29, 0x59,
-	0x3a, 0xae, 0xb6, 0x03, 0x8a, 0x67, 0x4a, 0x33, 0xba, 0xe6, 0xbb, 0x2c, 0xc8, 0x76, 0xa8, 0x23,
-	0x1f, 0x80, 0xcd, 0xd9, 0x1b, 0xab, 0x2c, 0x0f, 0x95, 0xdc, 0x60, 0xa5, 0x7a, 0x9e, 0xe2, 0xf4,
-	0x8e, 0xbf, 0x04, 0x20, 0x75, 0x8d, 0x6f, 0xac, 0xec, 0x4b, 0x44, 0xca, 0xad, 0x35, 0x44, 0x69,
-	0xff, 0xd4, 0x3d, 0x5b, 0xed, 0x9f, 0x88, 0xfe, 0xe0, 0xbf, 0x78, 0xf8, 0xb2, 0x0d, 0x2e, 0xcc,
-	0x1d, 0xfc, 0xcd, 0x95, 0xcd, 0x69, 0x99, 0x52, 0x5f, 0x4b, 0x36, 0xdb, 0x45, 0xf9, 0xef, 0x0d,
-	0x3f, 0xe4, 0xf6, 0xde, 0xf1, 0x58, 0x95, 0x4e, 0xc6, 0xaa, 0xf4, 0x6b, 0xac, 0x4a, 0x1f, 0x26,
-	0x6a, 0xe6, 0x64, 0xa2, 0x66, 0x7e, 0x4c, 0xd4, 0xcc, 0x8b, 0xb2, 0xe3, 0xb2, 0xa3, 0x41, 0x4f,
-	0xb7, 0x08, 0x36, 0x16, 0x8e, 0xbb, 0x97, 0x8f, 0xfe, 0x2a, 0xef, 0xfe, 0x0e, 0x00, 0x00, 0xff,
-	0xff, 0xab, 0x17, 0x23, 0xbf, 0x00, 0x06, 0x00, 0x00,
+func (m *MsgEscrowReveal) Reset()         { *m = MsgEscrowReveal{} }
+func (m *MsgEscrowReveal) String() string { return proto.CompactTextString(m) }
+func (*MsgEscrowReveal) ProtoMessage()    {}
+func (*MsgEscrowReveal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{10}
+}
+func (m *MsgEscrowReveal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEscrowReveal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEscrowReveal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEscrowReveal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEscrowReveal.Merge(m, src)
+}
+func (m *MsgEscrowReveal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEscrowReveal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEscrowReveal.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgEscrowReveal proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	NewGame(ctx context.Context, in *MsgNewGame, opts ...grpc.CallOption) (*MsgNewGameResponse, error)
-	CommitMove(ctx context.Context, in *MsgCommitMove, opts ...grpc.CallOption) (*MsgCommitMoveResponse, error)
-	RevealMove(ctx context.Context, in *MsgRevealMove, opts ...grpc.CallOption) (*MsgRevealMoveResponse, error)
-	// UpdateParams updates the module parameters.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgEscrowReveal) GetPlayer() string {
+	if m != nil {
+		return m.Player
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgEscrowReveal) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
+	}
+	return 0
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgEscrowReveal) GetEncryptedReveal() []byte {
+	if m != nil {
+		return m.EncryptedReveal
+	}
+	return nil
 }
 
-func (c *msgClient) NewGame(ctx context.Context, in *MsgNewGame, opts ...grpc.CallOption) (*MsgNewGameResponse, error) {
-	out := new(MsgNewGameResponse)
-	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Msg/NewGame", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgEscrowRevealResponse struct {
 }
 
-func (c *msgClient) CommitMove(ctx context.Context, in *MsgCommitMove, opts ...grpc.CallOption) (*MsgCommitMoveResponse, error) {
-	out := new(MsgCommitMoveResponse)
-	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Msg/CommitMove", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgEscrowRevealResponse) Reset()         { *m = MsgEscrowRevealResponse{} }
+func (m *MsgEscrowRevealResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEscrowRevealResponse) ProtoMessage()    {}
+func (*MsgEscrowRevealResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{11}
 }
-
-func (c *msgClient) RevealMove(ctx context.Context, in *MsgRevealMove, opts ...grpc.CallOption) (*MsgRevealMoveResponse, error) {
-	out := new(MsgRevealMoveResponse)
-	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Msg/RevealMove", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgEscrowRevealResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgEscrowRevealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEscrowRevealResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	NewGame(context.Context, *MsgNewGame) (*MsgNewGameResponse, error)
-	CommitMove(context.Context, *MsgCommitMove) (*MsgCommitMoveResponse, error)
-	RevealMove(context.Context, *MsgRevealMove) (*MsgRevealMoveResponse, error)
-	// UpdateParams updates the module parameters.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgEscrowRevealResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEscrowRevealResponse.Merge(m, src)
+}
+func (m *MsgEscrowRevealResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEscrowRevealResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEscrowRevealResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgEscrowRevealResponse proto.InternalMessageInfo
+
+type MsgAgentRevealMove struct {
+	// agent is the reveal agent of the player, it signs the message.
+	Agent string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	// player is the player the move is revealed for.
+	Player string `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	GameId uint64 `protobuf:"varint,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// move is encoded as its name, like in MsgRevealMove.
+	Move Move     `protobuf:"bytes,4,opt,name=move,proto3,customtype=Move" json:"move"`
+	Salt HexBytes `protobuf:"bytes,5,opt,name=salt,proto3,casttype=HexBytes" json:"salt,omitempty"`
 }
 
-func (*UnimplementedMsgServer) NewGame(ctx context.Context, req *MsgNewGame) (*MsgNewGameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewGame not implemented")
+func (m *MsgAgentRevealMove) Reset()         { *m = MsgAgentRevealMove{} }
+func (m *MsgAgentRevealMove) String() string { return proto.CompactTextString(m) }
+func (*MsgAgentRevealMove) ProtoMessage()    {}
+func (*MsgAgentRevealMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{12}
 }
-func (*UnimplementedMsgServer) CommitMove(ctx context.Context, req *MsgCommitMove) (*MsgCommitMoveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CommitMove not implemented")
+func (m *MsgAgentRevealMove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RevealMove(ctx context.Context, req *MsgRevealMove) (*MsgRevealMoveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevealMove not implemented")
+func (m *MsgAgentRevealMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAgentRevealMove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgAgentRevealMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAgentRevealMove.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgAgentRevealMove) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAgentRevealMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAgentRevealMove.DiscardUnknown(m)
 }
 
-func _Msg_NewGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgNewGame)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).NewGame(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/facundomedica.rps.v1.Msg/NewGame",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).NewGame(ctx, req.(*MsgNewGame))
+var xxx_messageInfo_MsgAgentRevealMove proto.InternalMessageInfo
+
+func (m *MsgAgentRevealMove) GetAgent() string {
+	if m != nil {
+		return m.Agent
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CommitMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCommitMove)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CommitMove(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/facundomedica.rps.v1.Msg/CommitMove",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CommitMove(ctx, req.(*MsgCommitMove))
+func (m *MsgAgentRevealMove) GetPlayer() string {
+	if m != nil {
+		return m.Player
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RevealMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevealMove)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevealMove(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/facundomedica.rps.v1.Msg/RevealMove",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevealMove(ctx, req.(*MsgRevealMove))
+func (m *MsgAgentRevealMove) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/facundomedica.rps.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgAgentRevealMove) GetSalt() HexBytes {
+	if m != nil {
+		return m.Salt
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "facundomedica.rps.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "NewGame",
-			Handler:    _Msg_NewGame_Handler,
-		},
-		{
-			MethodName: "CommitMove",
-			Handler:    _Msg_CommitMove_Handler,
-		},
-		{
-			MethodName: "RevealMove",
-			Handler:    _Msg_RevealMove_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "facundomedica/rps/v1/tx.proto",
+type MsgAgentRevealMoveResponse struct {
 }
 
-func (m *MsgNewGame) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAgentRevealMoveResponse) Reset()         { *m = MsgAgentRevealMoveResponse{} }
+func (m *MsgAgentRevealMoveResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAgentRevealMoveResponse) ProtoMessage()    {}
+func (*MsgAgentRevealMoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{13}
+}
+func (m *MsgAgentRevealMoveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAgentRevealMoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAgentRevealMoveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAgentRevealMoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAgentRevealMoveResponse.Merge(m, src)
+}
+func (m *MsgAgentRevealMoveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAgentRevealMoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAgentRevealMoveResponse.DiscardUnknown(m)
 }
 
-func (m *MsgNewGame) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAgentRevealMoveResponse proto.InternalMessageInfo
+
+type MsgJoinQueue struct {
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// commit is the commitment to the move, see MoveCommit.
+	Commit HexBytes `protobuf:"bytes,2,opt,name=commit,proto3,casttype=HexBytes" json:"commit,omitempty"`
+	// entry_fee is the maximum amount to put into stake for the game, it's
+	// escrowed while waiting and the excess is refunded when matched.
+	EntryFee types.Coin `protobuf:"bytes,3,opt,name=entry_fee,json=entryFee,proto3" json:"entry_fee"`
+	// min_entry_fee is the minimum amount the player is willing to play for,
+	// it must have the same denom as entry_fee. If empty, only games for exactly
+	// entry_fee are accepted.
+	MinEntryFee types.Coin `protobuf:"bytes,4,opt,name=min_entry_fee,json=minEntryFee,proto3" json:"min_entry_fee"`
+	// commitment_scheme is the scheme commit was computed with, see
+	// MsgNewGame.
+	CommitmentScheme CommitmentScheme `protobuf:"varint,5,opt,name=commitment_scheme,json=commitmentScheme,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"commitment_scheme,omitempty"`
 }
 
-func (m *MsgNewGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.EntryFee.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgJoinQueue) Reset()         { *m = MsgJoinQueue{} }
+func (m *MsgJoinQueue) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinQueue) ProtoMessage()    {}
+func (*MsgJoinQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{14}
+}
+func (m *MsgJoinQueue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgJoinQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgJoinQueue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Commit) > 0 {
-		i -= len(m.Commit)
-		copy(dAtA[i:], m.Commit)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Commit)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgJoinQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinQueue.Merge(m, src)
+}
+func (m *MsgJoinQueue) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgJoinQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgJoinQueue proto.InternalMessageInfo
+
+func (m *MsgJoinQueue) GetPlayer() string {
+	if m != nil {
+		return m.Player
 	}
-	if len(m.Player) > 0 {
-		i -= len(m.Player)
-		copy(dAtA[i:], m.Player)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgJoinQueue) GetCommit() HexBytes {
+	if m != nil {
+		return m.Commit
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgNewGameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgJoinQueue) GetEntryFee() types.Coin {
+	if m != nil {
+		return m.EntryFee
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgNewGameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgJoinQueue) GetMinEntryFee() types.Coin {
+	if m != nil {
+		return m.MinEntryFee
+	}
+	return types.Coin{}
 }
 
-func (m *MsgNewGameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GameId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GameId))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgJoinQueue) GetCommitmentScheme() CommitmentScheme {
+	if m != nil {
+		return m.CommitmentScheme
 	}
-	return len(dAtA) - i, nil
+	return CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED
 }
 
-func (m *MsgCommitMove) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type MsgJoinQueueResponse struct {
+	// matched is true if the player was paired with an opponent.
+	Matched bool `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	// game_id is the ID of the created game, only set if matched.
+	GameId uint64 `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// entry_id is the ID of the queue entry, only set if not matched.
+	EntryId uint64 `protobuf:"varint,3,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
 }
 
-func (m *MsgCommitMove) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgJoinQueueResponse) Reset()         { *m = MsgJoinQueueResponse{} }
+func (m *MsgJoinQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinQueueResponse) ProtoMessage()    {}
+func (*MsgJoinQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{15}
 }
-
-func (m *MsgCommitMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Commit) > 0 {
-		i -= len(m.Commit)
-		copy(dAtA[i:], m.Commit)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Commit)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.GameId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GameId))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgJoinQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgJoinQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgJoinQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Player) > 0 {
-		i -= len(m.Player)
-		copy(dAtA[i:], m.Player)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgJoinQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinQueueResponse.Merge(m, src)
+}
+func (m *MsgJoinQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgJoinQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinQueueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgJoinQueueResponse proto.InternalMessageInfo
+
+func (m *MsgJoinQueueResponse) GetMatched() bool {
+	if m != nil {
+		return m.Matched
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *MsgCommitMoveResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgJoinQueueResponse) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCommitMoveResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgJoinQueueResponse) GetEntryId() uint64 {
+	if m != nil {
+		return m.EntryId
+	}
+	return 0
 }
 
-func (m *MsgCommitMoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgLeaveQueue struct {
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// entry_id is the ID of the queue entry to remove.
+	EntryId uint64 `protobuf:"varint,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
 }
 
-func (m *MsgRevealMove) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgLeaveQueue) Reset()         { *m = MsgLeaveQueue{} }
+func (m *MsgLeaveQueue) String() string { return proto.CompactTextString(m) }
+func (*MsgLeaveQueue) ProtoMessage()    {}
+func (*MsgLeaveQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{16}
+}
+func (m *MsgLeaveQueue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLeaveQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeaveQueue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRevealMove) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgLeaveQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLeaveQueue.Merge(m, src)
+}
+func (m *MsgLeaveQueue) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLeaveQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLeaveQueue.DiscardUnknown(m)
 }
 
-func (m *MsgRevealMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Salt) > 0 {
-		i -= len(m.Salt)
-		copy(dAtA[i:], m.Salt)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Move) > 0 {
-		i -= len(m.Move)
-		copy(dAtA[i:], m.Move)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Move)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.GameId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GameId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Player) > 0 {
-		i -= len(m.Player)
-		copy(dAtA[i:], m.Player)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgLeaveQueue proto.InternalMessageInfo
+
+func (m *MsgLeaveQueue) GetPlayer() string {
+	if m != nil {
+		return m.Player
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRevealMoveResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgLeaveQueue) GetEntryId() uint64 {
+	if m != nil {
+		return m.EntryId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgRevealMoveResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgLeaveQueueResponse struct {
 }
 
-func (m *MsgRevealMoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgLeaveQueueResponse) Reset()         { *m = MsgLeaveQueueResponse{} }
+func (m *MsgLeaveQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLeaveQueueResponse) ProtoMessage()    {}
+func (*MsgLeaveQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{17}
 }
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgLeaveQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLeaveQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeaveQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgLeaveQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLeaveQueueResponse.Merge(m, src)
+}
+func (m *MsgLeaveQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLeaveQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLeaveQueueResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgLeaveQueueResponse proto.InternalMessageInfo
+
+type MsgPlaceBet struct {
+	Bettor string `protobuf:"bytes,1,opt,name=bettor,proto3" json:"bettor,omitempty"`
+	GameId uint64 `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// outcome is the outcome of the game the bet is on. Additional bets on the
+	// same game must be on the same outcome.
+	Outcome BetOutcome `protobuf:"varint,3,opt,name=outcome,proto3,enum=facundomedica.rps.v1.BetOutcome" json:"outcome,omitempty"`
+	// amount is the amount to bet, it's added to any previous bet on the game.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgPlaceBet) Reset()         { *m = MsgPlaceBet{} }
+func (m *MsgPlaceBet) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceBet) ProtoMessage()    {}
+func (*MsgPlaceBet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{18}
+}
+func (m *MsgPlaceBet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceBet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceBet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgPlaceBet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceBet.Merge(m, src)
+}
+func (m *MsgPlaceBet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceBet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceBet.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgPlaceBet proto.InternalMessageInfo
+
+func (m *MsgPlaceBet) GetBettor() string {
+	if m != nil {
+		return m.Bettor
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPlaceBet) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
+	}
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgPlaceBet) GetOutcome() BetOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return BetOutcome_BET_OUTCOME_UNSPECIFIED
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgPlaceBet) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgNewGame) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Player)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Commit)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+type MsgPlaceBetResponse struct {
+}
+
+func (m *MsgPlaceBetResponse) Reset()         { *m = MsgPlaceBetResponse{} }
+func (m *MsgPlaceBetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceBetResponse) ProtoMessage()    {}
+func (*MsgPlaceBetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{19}
+}
+func (m *MsgPlaceBetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceBetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceBetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.EntryFee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgPlaceBetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceBetResponse.Merge(m, src)
+}
+func (m *MsgPlaceBetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceBetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceBetResponse.DiscardUnknown(m)
 }
 
-func (m *MsgNewGameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgPlaceBetResponse proto.InternalMessageInfo
+
+type MsgPlayHouse struct {
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// commit is the commitment to the move, see MoveCommit.
+	Commit HexBytes `protobuf:"bytes,2,opt,name=commit,proto3,casttype=HexBytes" json:"commit,omitempty"`
+	// entry_fee is the stake of the player, the house matches it.
+	EntryFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=entry_fee,json=entryFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"entry_fee"`
+	// commitment_scheme is the scheme commit was computed with, see
+	// MsgNewGame.
+	CommitmentScheme CommitmentScheme `protobuf:"varint,4,opt,name=commitment_scheme,json=commitmentScheme,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"commitment_scheme,omitempty"`
+}
+
+func (m *MsgPlayHouse) Reset()         { *m = MsgPlayHouse{} }
+func (m *MsgPlayHouse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlayHouse) ProtoMessage()    {}
+func (*MsgPlayHouse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{20}
+}
+func (m *MsgPlayHouse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlayHouse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlayHouse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.GameId != 0 {
-		n += 1 + sovTx(uint64(m.GameId))
+}
+func (m *MsgPlayHouse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlayHouse.Merge(m, src)
+}
+func (m *MsgPlayHouse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlayHouse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlayHouse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlayHouse proto.InternalMessageInfo
+
+func (m *MsgPlayHouse) GetPlayer() string {
+	if m != nil {
+		return m.Player
 	}
-	return n
+	return ""
 }
 
-func (m *MsgCommitMove) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPlayHouse) GetCommit() HexBytes {
+	if m != nil {
+		return m.Commit
 	}
-	var l int
-	_ = l
-	l = len(m.Player)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *MsgPlayHouse) GetEntryFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EntryFee
 	}
-	if m.GameId != 0 {
-		n += 1 + sovTx(uint64(m.GameId))
+	return nil
+}
+
+func (m *MsgPlayHouse) GetCommitmentScheme() CommitmentScheme {
+	if m != nil {
+		return m.CommitmentScheme
 	}
-	l = len(m.Commit)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED
+}
+
+type MsgPlayHouseResponse struct {
+	// game_id is the ID of the created game.
+	GameId uint64 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (m *MsgPlayHouseResponse) Reset()         { *m = MsgPlayHouseResponse{} }
+func (m *MsgPlayHouseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlayHouseResponse) ProtoMessage()    {}
+func (*MsgPlayHouseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{21}
+}
+func (m *MsgPlayHouseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlayHouseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlayHouseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgPlayHouseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlayHouseResponse.Merge(m, src)
+}
+func (m *MsgPlayHouseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlayHouseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlayHouseResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCommitMoveResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgPlayHouseResponse proto.InternalMessageInfo
+
+func (m *MsgPlayHouseResponse) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *MsgRevealMove) Size() (n int) {
-	if m == nil {
-		return 0
+type MsgFundHouse struct {
+	// authority is the address that controls the module (defaults to x/gov
+	// unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// amount is added to the house bankroll, it can't be withdrawn.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgFundHouse) Reset()         { *m = MsgFundHouse{} }
+func (m *MsgFundHouse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundHouse) ProtoMessage()    {}
+func (*MsgFundHouse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{22}
+}
+func (m *MsgFundHouse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundHouse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundHouse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Player)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgFundHouse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundHouse.Merge(m, src)
+}
+func (m *MsgFundHouse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundHouse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundHouse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundHouse proto.InternalMessageInfo
+
+func (m *MsgFundHouse) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if m.GameId != 0 {
-		n += 1 + sovTx(uint64(m.GameId))
+	return ""
+}
+
+func (m *MsgFundHouse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	l = len(m.Move)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+type MsgFundHouseResponse struct {
+}
+
+func (m *MsgFundHouseResponse) Reset()         { *m = MsgFundHouseResponse{} }
+func (m *MsgFundHouseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundHouseResponse) ProtoMessage()    {}
+func (*MsgFundHouseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{23}
+}
+func (m *MsgFundHouseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundHouseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundHouseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Salt)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgFundHouseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundHouseResponse.Merge(m, src)
+}
+func (m *MsgFundHouseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundHouseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundHouseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundHouseResponse proto.InternalMessageInfo
+
+type MsgOfferRematch struct {
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// game_id is the ID of the settled game.
+	GameId uint64 `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// commit is the commitment to the move, see MoveCommit.
+	Commit HexBytes `protobuf:"bytes,3,opt,name=commit,proto3,casttype=HexBytes" json:"commit,omitempty"`
+	// commitment_scheme is the scheme commit was computed with, see
+	// MsgNewGame.
+	CommitmentScheme CommitmentScheme `protobuf:"varint,4,opt,name=commitment_scheme,json=commitmentScheme,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"commitment_scheme,omitempty"`
+}
+
+func (m *MsgOfferRematch) Reset()         { *m = MsgOfferRematch{} }
+func (m *MsgOfferRematch) String() string { return proto.CompactTextString(m) }
+func (*MsgOfferRematch) ProtoMessage()    {}
+func (*MsgOfferRematch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{24}
+}
+func (m *MsgOfferRematch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOfferRematch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOfferRematch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgOfferRematch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOfferRematch.Merge(m, src)
+}
+func (m *MsgOfferRematch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOfferRematch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOfferRematch.DiscardUnknown(m)
 }
 
-func (m *MsgRevealMoveResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgOfferRematch proto.InternalMessageInfo
+
+func (m *MsgOfferRematch) GetPlayer() string {
+	if m != nil {
+		return m.Player
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgOfferRematch) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *MsgOfferRematch) GetCommit() HexBytes {
+	if m != nil {
+		return m.Commit
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgOfferRematch) GetCommitmentScheme() CommitmentScheme {
+	if m != nil {
+		return m.CommitmentScheme
 	}
-	var l int
-	_ = l
-	return n
+	return CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+type MsgOfferRematchResponse struct {
+	// game_id is the ID of the created game.
+	GameId uint64 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgOfferRematchResponse) Reset()         { *m = MsgOfferRematchResponse{} }
+func (m *MsgOfferRematchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOfferRematchResponse) ProtoMessage()    {}
+func (*MsgOfferRematchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{25}
 }
-func (m *MsgNewGame) Unmarshal(dAtA []byte) error {
+func (m *MsgOfferRematchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOfferRematchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOfferRematchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOfferRematchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOfferRematchResponse.Merge(m, src)
+}
+func (m *MsgOfferRematchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOfferRematchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOfferRematchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOfferRematchResponse proto.InternalMessageInfo
+
+func (m *MsgOfferRematchResponse) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
+	}
+	return 0
+}
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module
+	// NOTE: Defaults to the governance module unless overwritten.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the module parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{26}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{27}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgResolveStuckGame is the Msg/ResolveStuckGame request type.
+type MsgResolveStuckGame struct {
+	// authority is the address that controls the module
+	// NOTE: Defaults to the governance module unless overwritten.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// game_id is the ID of the stuck game to resolve.
+	GameId uint64 `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// refund defines whether the entry fee must be refunded to every player that
+	// committed a move. If false, the game is discarded and the escrowed funds
+	// stay in the module account.
+	Refund bool `protobuf:"varint,3,opt,name=refund,proto3" json:"refund,omitempty"`
+}
+
+func (m *MsgResolveStuckGame) Reset()         { *m = MsgResolveStuckGame{} }
+func (m *MsgResolveStuckGame) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveStuckGame) ProtoMessage()    {}
+func (*MsgResolveStuckGame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{28}
+}
+func (m *MsgResolveStuckGame) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveStuckGame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveStuckGame.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveStuckGame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveStuckGame.Merge(m, src)
+}
+func (m *MsgResolveStuckGame) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveStuckGame) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveStuckGame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveStuckGame proto.InternalMessageInfo
+
+func (m *MsgResolveStuckGame) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgResolveStuckGame) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
+	}
+	return 0
+}
+
+func (m *MsgResolveStuckGame) GetRefund() bool {
+	if m != nil {
+		return m.Refund
+	}
+	return false
+}
+
+// MsgResolveStuckGameResponse defines the response structure for executing a
+// MsgResolveStuckGame message.
+type MsgResolveStuckGameResponse struct {
+}
+
+func (m *MsgResolveStuckGameResponse) Reset()         { *m = MsgResolveStuckGameResponse{} }
+func (m *MsgResolveStuckGameResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveStuckGameResponse) ProtoMessage()    {}
+func (*MsgResolveStuckGameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e7630811a18157, []int{29}
+}
+func (m *MsgResolveStuckGameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveStuckGameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveStuckGameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveStuckGameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveStuckGameResponse.Merge(m, src)
+}
+func (m *MsgResolveStuckGameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveStuckGameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveStuckGameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveStuckGameResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgNewGame)(nil), "facundomedica.rps.v1.MsgNewGame")
+	proto.RegisterType((*MsgNewGameResponse)(nil), "facundomedica.rps.v1.MsgNewGameResponse")
+	proto.RegisterType((*MsgCreateAndJoin)(nil), "facundomedica.rps.v1.MsgCreateAndJoin")
+	proto.RegisterType((*MsgCreateAndJoinResponse)(nil), "facundomedica.rps.v1.MsgCreateAndJoinResponse")
+	proto.RegisterType((*MsgCommitMove)(nil), "facundomedica.rps.v1.MsgCommitMove")
+	proto.RegisterType((*MsgCommitMoveResponse)(nil), "facundomedica.rps.v1.MsgCommitMoveResponse")
+	proto.RegisterType((*MsgRevealMove)(nil), "facundomedica.rps.v1.MsgRevealMove")
+	proto.RegisterType((*MsgRevealMoveResponse)(nil), "facundomedica.rps.v1.MsgRevealMoveResponse")
+	proto.RegisterType((*MsgSetRevealAgent)(nil), "facundomedica.rps.v1.MsgSetRevealAgent")
+	proto.RegisterType((*MsgSetRevealAgentResponse)(nil), "facundomedica.rps.v1.MsgSetRevealAgentResponse")
+	proto.RegisterType((*MsgEscrowReveal)(nil), "facundomedica.rps.v1.MsgEscrowReveal")
+	proto.RegisterType((*MsgEscrowRevealResponse)(nil), "facundomedica.rps.v1.MsgEscrowRevealResponse")
+	proto.RegisterType((*MsgAgentRevealMove)(nil), "facundomedica.rps.v1.MsgAgentRevealMove")
+	proto.RegisterType((*MsgAgentRevealMoveResponse)(nil), "facundomedica.rps.v1.MsgAgentRevealMoveResponse")
+	proto.RegisterType((*MsgJoinQueue)(nil), "facundomedica.rps.v1.MsgJoinQueue")
+	proto.RegisterType((*MsgJoinQueueResponse)(nil), "facundomedica.rps.v1.MsgJoinQueueResponse")
+	proto.RegisterType((*MsgLeaveQueue)(nil), "facundomedica.rps.v1.MsgLeaveQueue")
+	proto.RegisterType((*MsgLeaveQueueResponse)(nil), "facundomedica.rps.v1.MsgLeaveQueueResponse")
+	proto.RegisterType((*MsgPlaceBet)(nil), "facundomedica.rps.v1.MsgPlaceBet")
+	proto.RegisterType((*MsgPlaceBetResponse)(nil), "facundomedica.rps.v1.MsgPlaceBetResponse")
+	proto.RegisterType((*MsgPlayHouse)(nil), "facundomedica.rps.v1.MsgPlayHouse")
+	proto.RegisterType((*MsgPlayHouseResponse)(nil), "facundomedica.rps.v1.MsgPlayHouseResponse")
+	proto.RegisterType((*MsgFundHouse)(nil), "facundomedica.rps.v1.MsgFundHouse")
+	proto.RegisterType((*MsgFundHouseResponse)(nil), "facundomedica.rps.v1.MsgFundHouseResponse")
+	proto.RegisterType((*MsgOfferRematch)(nil), "facundomedica.rps.v1.MsgOfferRematch")
+	proto.RegisterType((*MsgOfferRematchResponse)(nil), "facundomedica.rps.v1.MsgOfferRematchResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "facundomedica.rps.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "facundomedica.rps.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgResolveStuckGame)(nil), "facundomedica.rps.v1.MsgResolveStuckGame")
+	proto.RegisterType((*MsgResolveStuckGameResponse)(nil), "facundomedica.rps.v1.MsgResolveStuckGameResponse")
+}
+
+func init() { proto.RegisterFile("facundomedica/rps/v1/tx.proto", fileDescriptor_10e7630811a18157) }
+
+var fileDescriptor_10e7630811a18157 = []byte{
+	// 1591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x3a, 0x8e, 0x9d, 0xbc, 0x7c, 0x34, 0x59, 0xd2, 0xc6, 0xd9, 0xa6, 0x8e, 0xbb, 0xfd,
+	0x20, 0x4d, 0x89, 0xdd, 0x38, 0xa8, 0x2a, 0x16, 0x12, 0x8a, 0xa3, 0x96, 0x16, 0x61, 0x5a, 0x36,
+	0x54, 0x42, 0x20, 0x61, 0x6d, 0x76, 0x27, 0x1b, 0x53, 0xef, 0xae, 0xb5, 0xb3, 0x76, 0xeb, 0x0b,
+	0x42, 0x20, 0x21, 0x54, 0x09, 0x89, 0x33, 0x7f, 0x01, 0xea, 0x85, 0x1c, 0x38, 0x20, 0x90, 0x10,
+	0xc7, 0xde, 0xa8, 0x38, 0x21, 0x84, 0x0a, 0x6a, 0x0f, 0x39, 0x20, 0x71, 0xe2, 0xc4, 0x09, 0xed,
+	0xce, 0x7a, 0x76, 0xd6, 0xde, 0xcd, 0x6e, 0xd3, 0x0f, 0xa1, 0x5e, 0x5a, 0xcf, 0x9b, 0xdf, 0xbc,
+	0x37, 0xef, 0xbd, 0xdf, 0xbc, 0x79, 0xb3, 0x81, 0x63, 0xdb, 0xb2, 0xd2, 0x36, 0x54, 0x53, 0x47,
+	0x6a, 0x43, 0x91, 0x4b, 0x56, 0x0b, 0x97, 0x3a, 0xab, 0x25, 0xfb, 0x56, 0xb1, 0x65, 0x99, 0xb6,
+	0xc9, 0xcf, 0x06, 0xa6, 0x8b, 0x56, 0x0b, 0x17, 0x3b, 0xab, 0xc2, 0x9c, 0x62, 0x62, 0xdd, 0xc4,
+	0x25, 0x1d, 0x6b, 0x0e, 0x5a, 0xc7, 0x1a, 0x81, 0x0b, 0x79, 0x6f, 0x62, 0x4b, 0xc6, 0xa8, 0xd4,
+	0x59, 0xdd, 0x42, 0xb6, 0xbc, 0x5a, 0x52, 0xcc, 0x86, 0xe1, 0xcd, 0xcf, 0x6a, 0xa6, 0x66, 0xba,
+	0x3f, 0x4b, 0xce, 0x2f, 0x4f, 0x3a, 0x23, 0xeb, 0x0d, 0xc3, 0x2c, 0xb9, 0xff, 0x7a, 0xa2, 0x42,
+	0xf8, 0xb6, 0xba, 0x2d, 0x84, 0x3d, 0xc4, 0x3c, 0x31, 0x55, 0x27, 0xda, 0xc8, 0x80, 0x4c, 0x89,
+	0x3f, 0xa4, 0x01, 0x6a, 0x58, 0x7b, 0x0b, 0xdd, 0x7c, 0x5d, 0xd6, 0x11, 0x7f, 0x0e, 0x32, 0xad,
+	0xa6, 0xdc, 0x45, 0x56, 0x8e, 0x2b, 0x70, 0x4b, 0x63, 0xd5, 0xdc, 0x2f, 0xdf, 0xae, 0xcc, 0x7a,
+	0x0b, 0xd6, 0x55, 0xd5, 0x42, 0x18, 0x6f, 0xda, 0x56, 0xc3, 0xd0, 0x24, 0x0f, 0xc7, 0x9f, 0x84,
+	0x8c, 0x62, 0xea, 0x7a, 0xc3, 0xce, 0xa5, 0x0a, 0xdc, 0xd2, 0x44, 0x75, 0xe2, 0xdf, 0xfb, 0x8b,
+	0xa3, 0x97, 0xd1, 0xad, 0x6a, 0xd7, 0x46, 0x58, 0xf2, 0xe6, 0xf8, 0x8f, 0x60, 0x0c, 0x19, 0xb6,
+	0xd5, 0xad, 0x6f, 0x23, 0x94, 0x1b, 0x2e, 0x0c, 0x2f, 0x8d, 0x97, 0xe7, 0x8b, 0x9e, 0x5e, 0x27,
+	0x00, 0x45, 0x2f, 0x00, 0xc5, 0x0d, 0xb3, 0x61, 0x54, 0x2f, 0xdd, 0xbd, 0xbf, 0x38, 0x74, 0xe7,
+	0x8f, 0xc5, 0x25, 0xad, 0x61, 0xef, 0xb4, 0xb7, 0x8a, 0x8a, 0xa9, 0x7b, 0xbb, 0xf6, 0xfe, 0x5b,
+	0xc1, 0xea, 0x0d, 0xcf, 0x43, 0x67, 0x01, 0xfe, 0x6a, 0x6f, 0x77, 0x79, 0xa2, 0x89, 0x34, 0x59,
+	0xe9, 0xd6, 0x9d, 0x10, 0xe2, 0xaf, 0xf7, 0x76, 0x97, 0x39, 0x69, 0xd4, 0xb5, 0x79, 0x09, 0x21,
+	0xfe, 0x14, 0x4c, 0x91, 0x9d, 0xd4, 0xed, 0x86, 0x8e, 0xcc, 0xb6, 0x9d, 0x4b, 0x17, 0xb8, 0xa5,
+	0xb4, 0x34, 0x49, 0xa4, 0xef, 0x10, 0xa1, 0x03, 0xb3, 0x50, 0x07, 0xc9, 0x4d, 0x0a, 0x1b, 0x21,
+	0x30, 0x22, 0xed, 0xc1, 0x3e, 0xe5, 0x60, 0x4a, 0xd9, 0x91, 0x9b, 0x4d, 0x64, 0x68, 0xc8, 0x72,
+	0x7d, 0xca, 0xc4, 0xf9, 0xb4, 0xfe, 0xd8, 0x3e, 0x49, 0x93, 0xbe, 0x4d, 0xc7, 0xa7, 0x4d, 0x98,
+	0x21, 0xbb, 0xd7, 0x91, 0x61, 0xd7, 0xb1, 0xb2, 0x83, 0x74, 0x94, 0xcb, 0x16, 0xb8, 0xa5, 0xa9,
+	0xf2, 0xe9, 0x62, 0x18, 0x17, 0x8b, 0x1b, 0x14, 0xbe, 0xe9, 0xa2, 0xa5, 0x69, 0xa5, 0x4f, 0x52,
+	0x79, 0xe9, 0x93, 0xbd, 0xdd, 0x65, 0x2f, 0xb7, 0xb7, 0xf7, 0x76, 0x97, 0x17, 0x06, 0xc9, 0xe5,
+	0xd3, 0x45, 0x5c, 0x01, 0xde, 0x1f, 0x49, 0x08, 0xb7, 0x4c, 0x03, 0x23, 0x7e, 0x0e, 0xb2, 0x9a,
+	0xac, 0xa3, 0x7a, 0x43, 0x75, 0x59, 0x94, 0x96, 0x32, 0xce, 0xf0, 0x8a, 0x2a, 0x7e, 0x93, 0x81,
+	0xe9, 0x1a, 0xd6, 0x36, 0x2c, 0x24, 0xdb, 0x68, 0xdd, 0x50, 0xdf, 0x30, 0x1b, 0x06, 0x5f, 0x86,
+	0xac, 0xe2, 0x08, 0xcc, 0x78, 0xce, 0xf5, 0x80, 0xfc, 0x1a, 0x4c, 0x79, 0x3f, 0xeb, 0xfb, 0x90,
+	0x6f, 0xd2, 0xc3, 0x10, 0xb7, 0xf9, 0x0b, 0x00, 0x7e, 0x00, 0x73, 0xc3, 0x31, 0xb6, 0x18, 0x2c,
+	0xff, 0x0a, 0xcc, 0x30, 0xe9, 0xf6, 0x2c, 0xa6, 0x43, 0x2c, 0x4e, 0xfb, 0xb0, 0x8d, 0x10, 0xe2,
+	0x8f, 0x3c, 0x7b, 0xe2, 0xff, 0x3f, 0xa8, 0x3a, 0x78, 0xae, 0xb2, 0x61, 0xe7, 0x6a, 0x0b, 0xe6,
+	0x83, 0x69, 0x65, 0x99, 0x3d, 0xfa, 0x48, 0xcc, 0x9e, 0x0b, 0xe4, 0xde, 0x9f, 0xe0, 0x77, 0x60,
+	0x61, 0x20, 0x97, 0xac, 0x99, 0xb1, 0x47, 0x32, 0x23, 0xf4, 0x27, 0x9c, 0x39, 0x4a, 0x55, 0xe7,
+	0x28, 0xf5, 0x28, 0xeb, 0xfc, 0x66, 0x28, 0xe5, 0x1c, 0x2d, 0x31, 0xf4, 0x68, 0x05, 0x0e, 0x87,
+	0xb8, 0x06, 0xb9, 0x7e, 0x59, 0xfc, 0x31, 0xfb, 0x2c, 0x05, 0x93, 0xce, 0x2a, 0x77, 0x43, 0x35,
+	0xb3, 0x73, 0x90, 0xb2, 0xce, 0x28, 0x4f, 0xb1, 0xca, 0x99, 0x7a, 0x3f, 0xbc, 0x4f, 0xbd, 0x0f,
+	0xad, 0x4d, 0xe9, 0xc7, 0xac, 0x4d, 0xa5, 0xbe, 0xda, 0xb4, 0x18, 0x1e, 0x40, 0xea, 0xb6, 0x38,
+	0x07, 0x87, 0x03, 0x82, 0x5e, 0xe8, 0xc4, 0x9f, 0x39, 0x37, 0x42, 0x92, 0xcb, 0xbe, 0x27, 0x1d,
+	0xa1, 0x02, 0xa4, 0x75, 0xb3, 0x83, 0xbc, 0x0a, 0x33, 0xe1, 0x1c, 0xa6, 0xdf, 0xee, 0x2f, 0xa6,
+	0xdd, 0x0d, 0xb8, 0x33, 0x0e, 0x02, 0xcb, 0xcd, 0xf0, 0x12, 0xe2, 0xce, 0x24, 0x74, 0xd5, 0xdf,
+	0xbf, 0xe7, 0xaa, 0x2f, 0xa0, 0xae, 0xde, 0xe1, 0x60, 0xa6, 0x86, 0xb5, 0x4d, 0x64, 0x93, 0xc9,
+	0x75, 0x0d, 0x19, 0xf6, 0x01, 0xdc, 0x2d, 0xc2, 0x88, 0xec, 0x2c, 0xcd, 0xa5, 0x62, 0x16, 0x10,
+	0x58, 0x65, 0xad, 0xcf, 0x83, 0x13, 0xa1, 0x1e, 0x04, 0xb7, 0x25, 0x1e, 0x85, 0xf9, 0x01, 0x21,
+	0xf5, 0xe4, 0x7b, 0x0e, 0x0e, 0xd5, 0xb0, 0x76, 0x11, 0x2b, 0x96, 0x79, 0x93, 0x00, 0x9e, 0x64,
+	0xda, 0xce, 0xc0, 0x34, 0x32, 0x14, 0xab, 0xdb, 0xb2, 0x91, 0x5a, 0x27, 0x75, 0x89, 0x50, 0x5c,
+	0x3a, 0x44, 0xe5, 0xc4, 0x6a, 0x65, 0xb5, 0xcf, 0xb7, 0xe3, 0xa1, 0xbe, 0xb1, 0x1b, 0x15, 0xe7,
+	0x61, 0xae, 0x4f, 0x44, 0xfd, 0xfa, 0x3c, 0xe5, 0xde, 0xa2, 0x9e, 0xb3, 0x94, 0x91, 0x34, 0xe0,
+	0x5c, 0xa2, 0x80, 0x33, 0xa1, 0x48, 0x3d, 0x7a, 0x28, 0x86, 0x43, 0x19, 0x9c, 0x8e, 0x65, 0xf0,
+	0x48, 0x24, 0x83, 0xdd, 0xfc, 0x93, 0xad, 0x39, 0x21, 0x3a, 0x19, 0x1a, 0xa2, 0x3e, 0x9f, 0xc5,
+	0x05, 0x10, 0x06, 0xa5, 0x34, 0x50, 0x7f, 0xa7, 0x60, 0xa2, 0x86, 0x35, 0xa7, 0x08, 0xbe, 0xdd,
+	0x46, 0xed, 0xa7, 0xd7, 0xad, 0xbe, 0x1a, 0xec, 0x56, 0xb9, 0xfd, 0xaf, 0xcb, 0xb4, 0x13, 0x1f,
+	0xe6, 0xca, 0xdd, 0x80, 0x49, 0xbd, 0x61, 0xd4, 0x7d, 0x0d, 0xe9, 0x64, 0x1a, 0xc6, 0xf5, 0x86,
+	0x71, 0xb1, 0xa7, 0x24, 0xb4, 0x80, 0x8e, 0x3c, 0x66, 0x01, 0x2d, 0xf6, 0xf1, 0x36, 0x1f, 0x9a,
+	0x14, 0x1a, 0x5f, 0x51, 0x85, 0x59, 0x76, 0x4c, 0x6f, 0x9e, 0x1c, 0x64, 0x75, 0xd9, 0x56, 0x76,
+	0x10, 0xb9, 0x79, 0x46, 0xa5, 0xde, 0x30, 0xfa, 0x74, 0xcd, 0x03, 0x09, 0x90, 0x4f, 0xb6, 0xac,
+	0x3b, 0xbe, 0xa2, 0x8a, 0x5f, 0x90, 0x62, 0xfc, 0x26, 0x92, 0x3b, 0xe8, 0xa0, 0x79, 0x65, 0xd5,
+	0xa7, 0x02, 0xea, 0x13, 0x96, 0x52, 0xdf, 0xba, 0x57, 0x4a, 0x7d, 0x01, 0xe5, 0xdf, 0x8f, 0x29,
+	0x18, 0xaf, 0x61, 0xed, 0x5a, 0x53, 0x56, 0x50, 0x15, 0xb9, 0x27, 0x6e, 0x0b, 0xd9, 0x49, 0x1a,
+	0x57, 0x0f, 0x17, 0x1d, 0x9e, 0x0a, 0x64, 0xcd, 0xb6, 0xad, 0x98, 0x3a, 0xe1, 0xdb, 0x54, 0xb9,
+	0x10, 0x9e, 0xe4, 0x2a, 0xb2, 0xaf, 0x12, 0x9c, 0xd4, 0x5b, 0xc0, 0x77, 0x21, 0x23, 0xeb, 0x66,
+	0xdb, 0x70, 0xee, 0x93, 0x67, 0xd4, 0x5f, 0x7a, 0x06, 0x2b, 0x2b, 0x6e, 0x6c, 0x89, 0x73, 0x4e,
+	0x6c, 0x8f, 0x85, 0xc6, 0xb6, 0x17, 0x30, 0xf1, 0x30, 0xbc, 0xc0, 0x0c, 0x69, 0x5c, 0xff, 0x22,
+	0xe7, 0xfa, 0x5a, 0x53, 0xee, 0x5e, 0x36, 0xdb, 0xf8, 0xf9, 0x7d, 0x85, 0x3e, 0x95, 0xae, 0x28,
+	0xd9, 0xa1, 0xa6, 0xc1, 0x15, 0x4b, 0x30, 0xcb, 0x8e, 0xe3, 0xdb, 0xc9, 0x7f, 0x38, 0x37, 0x3d,
+	0x97, 0xda, 0x86, 0x4a, 0xd2, 0x73, 0x1e, 0xc6, 0xe4, 0xb6, 0xbd, 0x63, 0x5a, 0x0d, 0xbb, 0x1b,
+	0x9b, 0x21, 0x1f, 0xca, 0x10, 0x35, 0xf5, 0xac, 0x89, 0xea, 0xde, 0xd8, 0xfe, 0x56, 0xa2, 0xe3,
+	0x44, 0xbd, 0x14, 0x8f, 0xc0, 0x2c, 0x3b, 0xa6, 0x6c, 0xbd, 0x9d, 0x72, 0xdb, 0x90, 0xab, 0xdb,
+	0xdb, 0xc8, 0x92, 0x90, 0x5b, 0xf8, 0x9e, 0x93, 0xfe, 0x3a, 0x59, 0x5b, 0xc3, 0x3a, 0x2e, 0x96,
+	0x61, 0xae, 0x4f, 0x14, 0xcf, 0xa7, 0x9f, 0x48, 0x1f, 0x77, 0xbd, 0xa5, 0xca, 0x36, 0xba, 0x26,
+	0x5b, 0xb2, 0x8e, 0x0f, 0x4c, 0xa9, 0xd7, 0x20, 0xd3, 0x72, 0x35, 0xb8, 0x51, 0x1c, 0x2f, 0x2f,
+	0x84, 0x3b, 0x4f, 0xac, 0x54, 0xc7, 0x1c, 0x56, 0x79, 0xc4, 0x20, 0xcb, 0x2a, 0x2f, 0x0f, 0x12,
+	0x23, 0xdc, 0x6d, 0x76, 0xbb, 0x5e, 0x37, 0xc7, 0x8a, 0x28, 0x3d, 0xbe, 0xe3, 0xdc, 0x22, 0x27,
+	0x21, 0x6c, 0x36, 0x3b, 0x68, 0xd3, 0x6e, 0x2b, 0x37, 0xdc, 0x2f, 0x6b, 0x07, 0xf5, 0x30, 0x92,
+	0x28, 0x47, 0x20, 0x63, 0xa1, 0xed, 0xb6, 0x41, 0xee, 0xd3, 0x51, 0xc9, 0x1b, 0x55, 0x2e, 0x0c,
+	0x7a, 0x74, 0x2a, 0xe2, 0xf5, 0x10, 0xdc, 0xa2, 0x78, 0x0c, 0x8e, 0x86, 0x88, 0x7b, 0x9e, 0x95,
+	0x7f, 0x1f, 0x87, 0xe1, 0x1a, 0xd6, 0xf8, 0xeb, 0x90, 0xed, 0x7d, 0x2e, 0x8c, 0xb8, 0xa5, 0xfc,
+	0x6f, 0x42, 0xc2, 0x52, 0x1c, 0x82, 0xf2, 0xe5, 0x03, 0x00, 0xe6, 0xc5, 0x7a, 0x22, 0x72, 0x9d,
+	0x0f, 0x12, 0xce, 0x26, 0x00, 0x51, 0xfd, 0x1a, 0x4c, 0x06, 0x3f, 0x3c, 0x9d, 0x8e, 0x5e, 0xcd,
+	0xe2, 0x84, 0x62, 0x32, 0x1c, 0xeb, 0x08, 0xd3, 0xc6, 0x47, 0x3b, 0xe2, 0x83, 0x84, 0xb3, 0x09,
+	0x40, 0x54, 0xff, 0x87, 0x30, 0xd5, 0xf7, 0x9a, 0x7b, 0x31, 0x72, 0x79, 0x10, 0x28, 0x94, 0x12,
+	0x02, 0xa9, 0x2d, 0x15, 0x26, 0x02, 0xef, 0xad, 0x53, 0x91, 0x0a, 0x58, 0x98, 0xb0, 0x92, 0x08,
+	0x46, 0xad, 0xe8, 0x70, 0xa8, 0xff, 0xf5, 0x13, 0xcd, 0x9b, 0x3e, 0xa4, 0x70, 0x2e, 0x29, 0x92,
+	0x9a, 0x7b, 0x1f, 0xc6, 0xfc, 0x37, 0x84, 0x18, 0xb9, 0x9c, 0x62, 0x84, 0xe5, 0x78, 0x0c, 0x9b,
+	0x7d, 0xa6, 0x93, 0x8d, 0xce, 0xbe, 0x0f, 0x12, 0xce, 0x26, 0x00, 0x51, 0xfd, 0xef, 0xc2, 0x28,
+	0x6d, 0x40, 0x8f, 0x47, 0x2e, 0xec, 0x41, 0x84, 0x33, 0xb1, 0x10, 0x36, 0x2c, 0x7e, 0x0b, 0x26,
+	0xee, 0xb7, 0x8e, 0x60, 0x84, 0xe5, 0x78, 0x0c, 0xab, 0xdc, 0x6f, 0x20, 0xa2, 0x95, 0x53, 0x8c,
+	0xb0, 0x1c, 0x8f, 0x61, 0x59, 0x1a, 0xb8, 0x8e, 0xa3, 0x59, 0xca, 0xc2, 0x84, 0x95, 0x44, 0x30,
+	0xd6, 0x4a, 0xe0, 0xce, 0x8a, 0xb6, 0xc2, 0xc2, 0x84, 0x95, 0x44, 0x30, 0x6a, 0xa5, 0x05, 0xd3,
+	0x03, 0x77, 0xc7, 0x99, 0x7d, 0xca, 0x43, 0x10, 0x2a, 0xac, 0x26, 0x86, 0xf6, 0x2c, 0x0a, 0x23,
+	0x1f, 0x3b, 0x37, 0x62, 0xf5, 0xfc, 0xdd, 0x07, 0x79, 0xee, 0xde, 0x83, 0x3c, 0xf7, 0xe7, 0x83,
+	0x3c, 0xf7, 0xe5, 0xc3, 0xfc, 0xd0, 0xbd, 0x87, 0xf9, 0xa1, 0x5f, 0x1f, 0xe6, 0x87, 0xde, 0x5b,
+	0x60, 0x9a, 0xb0, 0x81, 0x9b, 0x64, 0x2b, 0xe3, 0xfe, 0x21, 0x69, 0xed, 0xbf, 0x01, 0x00, 0x75,
+	0x8e, 0x9e, 0xa8, 0x1e, 0x1b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	NewGame(ctx context.Context, in *MsgNewGame, opts ...grpc.CallOption) (*MsgNewGameResponse, error)
+	CommitMove(ctx context.Context, in *MsgCommitMove, opts ...grpc.CallOption) (*MsgCommitMoveResponse, error)
+	// CreateAndJoin creates a game between two players that already agreed to
+	// play, committing both moves at once. It must be signed by both players.
+	CreateAndJoin(ctx context.Context, in *MsgCreateAndJoin, opts ...grpc.CallOption) (*MsgCreateAndJoinResponse, error)
+	RevealMove(ctx context.Context, in *MsgRevealMove, opts ...grpc.CallOption) (*MsgRevealMoveResponse, error)
+	// SetRevealAgent registers the address allowed to reveal moves on behalf of
+	// the player.
+	SetRevealAgent(ctx context.Context, in *MsgSetRevealAgent, opts ...grpc.CallOption) (*MsgSetRevealAgentResponse, error)
+	// EscrowReveal stores the reveal of a move encrypted for the reveal agent,
+	// so the agent can reveal it if the player goes offline.
+	EscrowReveal(ctx context.Context, in *MsgEscrowReveal, opts ...grpc.CallOption) (*MsgEscrowRevealResponse, error)
+	// AgentRevealMove reveals the move of a player, signed by their reveal
+	// agent. The reveal is checked against the player's commitment.
+	AgentRevealMove(ctx context.Context, in *MsgAgentRevealMove, opts ...grpc.CallOption) (*MsgAgentRevealMoveResponse, error)
+	// JoinQueue joins the matchmaking queue, the player is paired with the
+	// player waiting with an overlapping stake range for the highest stake, or
+	// enqueued if there's none. A player can only wait once for each denom.
+	JoinQueue(ctx context.Context, in *MsgJoinQueue, opts ...grpc.CallOption) (*MsgJoinQueueResponse, error)
+	// LeaveQueue leaves the matchmaking queue, refunding the entry fee.
+	LeaveQueue(ctx context.Context, in *MsgLeaveQueue, opts ...grpc.CallOption) (*MsgLeaveQueueResponse, error)
+	// PlaceBet places a spectator bet on the outcome of a game that is still in
+	// its commit phase.
+	PlaceBet(ctx context.Context, in *MsgPlaceBet, opts ...grpc.CallOption) (*MsgPlaceBetResponse, error)
+	// PlayHouse starts a game against the house, which matches the entry fee
+	// with funds from the house bankroll.
+	PlayHouse(ctx context.Context, in *MsgPlayHouse, opts ...grpc.CallOption) (*MsgPlayHouseResponse, error)
+	// FundHouse moves funds from the community pool to the house bankroll, it
+	// can only be executed by the module authority.
+	FundHouse(ctx context.Context, in *MsgFundHouse, opts ...grpc.CallOption) (*MsgFundHouseResponse, error)
+	// OfferRematch creates a game reserved for the opponent of a settled game,
+	// with the same stakes and rules. The opponent accepts it with
+	// MsgCommitMove.
+	OfferRematch(ctx context.Context, in *MsgOfferRematch, opts ...grpc.CallOption) (*MsgOfferRematchResponse, error)
+	// UpdateParams updates the module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ResolveStuckGame resolves a game that was quarantined by the EndBlocker
+	// because it couldn't be settled.
+	ResolveStuckGame(ctx context.Context, in *MsgResolveStuckGame, opts ...grpc.CallOption) (*MsgResolveStuckGameResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) NewGame(ctx context.Context, in *MsgNewGame, opts ...grpc.CallOption) (*MsgNewGameResponse, error) {
+	out := new(MsgNewGameResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Msg/NewGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommitMove(ctx context.Context, in *MsgCommitMove, opts ...grpc.CallOption) (*MsgCommitMoveResponse, error) {
+	out := new(MsgCommitMoveResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Msg/CommitMove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateAndJoin(ctx context.Context, in *MsgCreateAndJoin, opts ...grpc.CallOption) (*MsgCreateAndJoinResponse, error) {
+	out := new(MsgCreateAndJoinResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Msg/CreateAndJoin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealMove(ctx context.Context, in *MsgRevealMove, opts ...grpc.CallOption) (*MsgRevealMoveResponse, error) {
+	out := new(MsgRevealMoveResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Msg/RevealMove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRevealAgent(ctx context.Context, in *MsgSetRevealAgent, opts ...grpc.CallOption) (*MsgSetRevealAgentResponse, error) {
+	out := new(MsgSetRevealAgentResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Msg/SetRevealAgent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EscrowReveal(ctx context.Context, in *MsgEscrowReveal, opts ...grpc.CallOption) (*MsgEscrowRevealResponse, error) {
+	out := new(MsgEscrowRevealResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Msg/EscrowReveal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AgentRevealMove(ctx context.Context, in *MsgAgentRevealMove, opts ...grpc.CallOption) (*MsgAgentRevealMoveResponse, error) {
+	out := new(MsgAgentRevealMoveResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Msg/AgentRevealMove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) JoinQueue(ctx context.Context, in *MsgJoinQueue, opts ...grpc.CallOption) (*MsgJoinQueueResponse, error) {
+	out := new(MsgJoinQueueResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Msg/JoinQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LeaveQueue(ctx context.Context, in *MsgLeaveQueue, opts ...grpc.CallOption) (*MsgLeaveQueueResponse, error) {
+	out := new(MsgLeaveQueueResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Msg/LeaveQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlaceBet(ctx context.Context, in *MsgPlaceBet, opts ...grpc.CallOption) (*MsgPlaceBetResponse, error) {
+	out := new(MsgPlaceBetResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Msg/PlaceBet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlayHouse(ctx context.Context, in *MsgPlayHouse, opts ...grpc.CallOption) (*MsgPlayHouseResponse, error) {
+	out := new(MsgPlayHouseResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Msg/PlayHouse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundHouse(ctx context.Context, in *MsgFundHouse, opts ...grpc.CallOption) (*MsgFundHouseResponse, error) {
+	out := new(MsgFundHouseResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Msg/FundHouse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OfferRematch(ctx context.Context, in *MsgOfferRematch, opts ...grpc.CallOption) (*MsgOfferRematchResponse, error) {
+	out := new(MsgOfferRematchResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Msg/OfferRematch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResolveStuckGame(ctx context.Context, in *MsgResolveStuckGame, opts ...grpc.CallOption) (*MsgResolveStuckGameResponse, error) {
+	out := new(MsgResolveStuckGameResponse)
+	err := c.cc.Invoke(ctx, "/facundomedica.rps.v1.Msg/ResolveStuckGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	NewGame(context.Context, *MsgNewGame) (*MsgNewGameResponse, error)
+	CommitMove(context.Context, *MsgCommitMove) (*MsgCommitMoveResponse, error)
+	// CreateAndJoin creates a game between two players that already agreed to
+	// play, committing both moves at once. It must be signed by both players.
+	CreateAndJoin(context.Context, *MsgCreateAndJoin) (*MsgCreateAndJoinResponse, error)
+	RevealMove(context.Context, *MsgRevealMove) (*MsgRevealMoveResponse, error)
+	// SetRevealAgent registers the address allowed to reveal moves on behalf of
+	// the player.
+	SetRevealAgent(context.Context, *MsgSetRevealAgent) (*MsgSetRevealAgentResponse, error)
+	// EscrowReveal stores the reveal of a move encrypted for the reveal agent,
+	// so the agent can reveal it if the player goes offline.
+	EscrowReveal(context.Context, *MsgEscrowReveal) (*MsgEscrowRevealResponse, error)
+	// AgentRevealMove reveals the move of a player, signed by their reveal
+	// agent. The reveal is checked against the player's commitment.
+	AgentRevealMove(context.Context, *MsgAgentRevealMove) (*MsgAgentRevealMoveResponse, error)
+	// JoinQueue joins the matchmaking queue, the player is paired with the
+	// player waiting with an overlapping stake range for the highest stake, or
+	// enqueued if there's none. A player can only wait once for each denom.
+	JoinQueue(context.Context, *MsgJoinQueue) (*MsgJoinQueueResponse, error)
+	// LeaveQueue leaves the matchmaking queue, refunding the entry fee.
+	LeaveQueue(context.Context, *MsgLeaveQueue) (*MsgLeaveQueueResponse, error)
+	// PlaceBet places a spectator bet on the outcome of a game that is still in
+	// its commit phase.
+	PlaceBet(context.Context, *MsgPlaceBet) (*MsgPlaceBetResponse, error)
+	// PlayHouse starts a game against the house, which matches the entry fee
+	// with funds from the house bankroll.
+	PlayHouse(context.Context, *MsgPlayHouse) (*MsgPlayHouseResponse, error)
+	// FundHouse moves funds from the community pool to the house bankroll, it
+	// can only be executed by the module authority.
+	FundHouse(context.Context, *MsgFundHouse) (*MsgFundHouseResponse, error)
+	// OfferRematch creates a game reserved for the opponent of a settled game,
+	// with the same stakes and rules. The opponent accepts it with
+	// MsgCommitMove.
+	OfferRematch(context.Context, *MsgOfferRematch) (*MsgOfferRematchResponse, error)
+	// UpdateParams updates the module parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ResolveStuckGame resolves a game that was quarantined by the EndBlocker
+	// because it couldn't be settled.
+	ResolveStuckGame(context.Context, *MsgResolveStuckGame) (*MsgResolveStuckGameResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) NewGame(ctx context.Context, req *MsgNewGame) (*MsgNewGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewGame not implemented")
+}
+func (*UnimplementedMsgServer) CommitMove(ctx context.Context, req *MsgCommitMove) (*MsgCommitMoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitMove not implemented")
+}
+func (*UnimplementedMsgServer) CreateAndJoin(ctx context.Context, req *MsgCreateAndJoin) (*MsgCreateAndJoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAndJoin not implemented")
+}
+func (*UnimplementedMsgServer) RevealMove(ctx context.Context, req *MsgRevealMove) (*MsgRevealMoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealMove not implemented")
+}
+func (*UnimplementedMsgServer) SetRevealAgent(ctx context.Context, req *MsgSetRevealAgent) (*MsgSetRevealAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRevealAgent not implemented")
+}
+func (*UnimplementedMsgServer) EscrowReveal(ctx context.Context, req *MsgEscrowReveal) (*MsgEscrowRevealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowReveal not implemented")
+}
+func (*UnimplementedMsgServer) AgentRevealMove(ctx context.Context, req *MsgAgentRevealMove) (*MsgAgentRevealMoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AgentRevealMove not implemented")
+}
+func (*UnimplementedMsgServer) JoinQueue(ctx context.Context, req *MsgJoinQueue) (*MsgJoinQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinQueue not implemented")
+}
+func (*UnimplementedMsgServer) LeaveQueue(ctx context.Context, req *MsgLeaveQueue) (*MsgLeaveQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveQueue not implemented")
+}
+func (*UnimplementedMsgServer) PlaceBet(ctx context.Context, req *MsgPlaceBet) (*MsgPlaceBetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBet not implemented")
+}
+func (*UnimplementedMsgServer) PlayHouse(ctx context.Context, req *MsgPlayHouse) (*MsgPlayHouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayHouse not implemented")
+}
+func (*UnimplementedMsgServer) FundHouse(ctx context.Context, req *MsgFundHouse) (*MsgFundHouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundHouse not implemented")
+}
+func (*UnimplementedMsgServer) OfferRematch(ctx context.Context, req *MsgOfferRematch) (*MsgOfferRematchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OfferRematch not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) ResolveStuckGame(ctx context.Context, req *MsgResolveStuckGame) (*MsgResolveStuckGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveStuckGame not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_NewGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgNewGame)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).NewGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Msg/NewGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).NewGame(ctx, req.(*MsgNewGame))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommitMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitMove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Msg/CommitMove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitMove(ctx, req.(*MsgCommitMove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateAndJoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAndJoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAndJoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Msg/CreateAndJoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAndJoin(ctx, req.(*MsgCreateAndJoin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealMove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Msg/RevealMove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealMove(ctx, req.(*MsgRevealMove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRevealAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRevealAgent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRevealAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Msg/SetRevealAgent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRevealAgent(ctx, req.(*MsgSetRevealAgent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EscrowReveal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEscrowReveal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EscrowReveal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Msg/EscrowReveal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EscrowReveal(ctx, req.(*MsgEscrowReveal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AgentRevealMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAgentRevealMove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AgentRevealMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Msg/AgentRevealMove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AgentRevealMove(ctx, req.(*MsgAgentRevealMove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_JoinQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinQueue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).JoinQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Msg/JoinQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinQueue(ctx, req.(*MsgJoinQueue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LeaveQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLeaveQueue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LeaveQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Msg/LeaveQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LeaveQueue(ctx, req.(*MsgLeaveQueue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceBet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceBet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceBet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Msg/PlaceBet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceBet(ctx, req.(*MsgPlaceBet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlayHouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlayHouse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlayHouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Msg/PlayHouse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlayHouse(ctx, req.(*MsgPlayHouse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundHouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundHouse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundHouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Msg/FundHouse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundHouse(ctx, req.(*MsgFundHouse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OfferRematch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOfferRematch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OfferRematch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Msg/OfferRematch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OfferRematch(ctx, req.(*MsgOfferRematch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResolveStuckGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveStuckGame)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveStuckGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/facundomedica.rps.v1.Msg/ResolveStuckGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveStuckGame(ctx, req.(*MsgResolveStuckGame))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "facundomedica.rps.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NewGame",
+			Handler:    _Msg_NewGame_Handler,
+		},
+		{
+			MethodName: "CommitMove",
+			Handler:    _Msg_CommitMove_Handler,
+		},
+		{
+			MethodName: "CreateAndJoin",
+			Handler:    _Msg_CreateAndJoin_Handler,
+		},
+		{
+			MethodName: "RevealMove",
+			Handler:    _Msg_RevealMove_Handler,
+		},
+		{
+			MethodName: "SetRevealAgent",
+			Handler:    _Msg_SetRevealAgent_Handler,
+		},
+		{
+			MethodName: "EscrowReveal",
+			Handler:    _Msg_EscrowReveal_Handler,
+		},
+		{
+			MethodName: "AgentRevealMove",
+			Handler:    _Msg_AgentRevealMove_Handler,
+		},
+		{
+			MethodName: "JoinQueue",
+			Handler:    _Msg_JoinQueue_Handler,
+		},
+		{
+			MethodName: "LeaveQueue",
+			Handler:    _Msg_LeaveQueue_Handler,
+		},
+		{
+			MethodName: "PlaceBet",
+			Handler:    _Msg_PlaceBet_Handler,
+		},
+		{
+			MethodName: "PlayHouse",
+			Handler:    _Msg_PlayHouse_Handler,
+		},
+		{
+			MethodName: "FundHouse",
+			Handler:    _Msg_FundHouse_Handler,
+		},
+		{
+			MethodName: "OfferRematch",
+			Handler:    _Msg_OfferRematch_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ResolveStuckGame",
+			Handler:    _Msg_ResolveStuckGame_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "facundomedica/rps/v1/tx.proto",
+}
+
+func (m *MsgNewGame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewGame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNewGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommitmentScheme != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CommitmentScheme))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ChallengerFee) > 0 {
+		for iNdEx := len(m.ChallengerFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChallengerFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.RevealTimeout != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RevealTimeout))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CommitTimeout != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CommitTimeout))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EntryFee) > 0 {
+		for iNdEx := len(m.EntryFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EntryFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgNewGameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewGameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNewGameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAndJoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAndJoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAndJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChallengerCommitmentScheme != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChallengerCommitmentScheme))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CreatorCommitmentScheme != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CreatorCommitmentScheme))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RevealTimeout != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RevealTimeout))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ChallengerFee) > 0 {
+		for iNdEx := len(m.ChallengerFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChallengerFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.EntryFee) > 0 {
+		for iNdEx := len(m.EntryFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EntryFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ChallengerCommit) > 0 {
+		i -= len(m.ChallengerCommit)
+		copy(dAtA[i:], m.ChallengerCommit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChallengerCommit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Challenger) > 0 {
+		i -= len(m.Challenger)
+		copy(dAtA[i:], m.Challenger)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Challenger)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CreatorCommit) > 0 {
+		i -= len(m.CreatorCommit)
+		copy(dAtA[i:], m.CreatorCommit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CreatorCommit)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAndJoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAndJoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAndJoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitMove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommitmentScheme != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CommitmentScheme))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GameId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitMoveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitMoveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitMoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealMove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Move.Size()
+		i -= size
+		if _, err := m.Move.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.GameId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealMoveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealMoveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealMoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRevealAgent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRevealAgent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRevealAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRevealAgentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRevealAgentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRevealAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEscrowReveal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEscrowReveal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEscrowReveal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncryptedReveal) > 0 {
+		i -= len(m.EncryptedReveal)
+		copy(dAtA[i:], m.EncryptedReveal)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EncryptedReveal)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GameId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEscrowRevealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEscrowRevealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEscrowRevealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAgentRevealMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAgentRevealMove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAgentRevealMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Move.Size()
+		i -= size
+		if _, err := m.Move.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.GameId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAgentRevealMoveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAgentRevealMoveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAgentRevealMoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommitmentScheme != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CommitmentScheme))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.MinEntryFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.EntryFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EntryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EntryId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GameId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Matched {
+		i--
+		if m.Matched {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeaveQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EntryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EntryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeaveQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceBet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GameId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Bettor) > 0 {
+		i -= len(m.Bettor)
+		copy(dAtA[i:], m.Bettor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bettor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceBetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlayHouse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlayHouse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlayHouse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommitmentScheme != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CommitmentScheme))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EntryFee) > 0 {
+		for iNdEx := len(m.EntryFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EntryFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlayHouseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlayHouseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlayHouseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundHouse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundHouse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundHouse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundHouseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundHouseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundHouseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOfferRematch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOfferRematch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOfferRematch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommitmentScheme != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CommitmentScheme))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GameId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOfferRematchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOfferRematchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOfferRematchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveStuckGame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveStuckGame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveStuckGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Refund {
+		i--
+		if m.Refund {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GameId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveStuckGameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveStuckGameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveStuckGameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgNewGame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.EntryFee) > 0 {
+		for _, e := range m.EntryFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.CommitTimeout != 0 {
+		n += 1 + sovTx(uint64(m.CommitTimeout))
+	}
+	if m.RevealTimeout != 0 {
+		n += 1 + sovTx(uint64(m.RevealTimeout))
+	}
+	if len(m.ChallengerFee) > 0 {
+		for _, e := range m.ChallengerFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.CommitmentScheme != 0 {
+		n += 1 + sovTx(uint64(m.CommitmentScheme))
+	}
+	return n
+}
+
+func (m *MsgNewGameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		n += 1 + sovTx(uint64(m.GameId))
+	}
+	return n
+}
+
+func (m *MsgCreateAndJoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CreatorCommit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Challenger)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChallengerCommit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.EntryFee) > 0 {
+		for _, e := range m.EntryFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ChallengerFee) > 0 {
+		for _, e := range m.ChallengerFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.RevealTimeout != 0 {
+		n += 1 + sovTx(uint64(m.RevealTimeout))
+	}
+	if m.CreatorCommitmentScheme != 0 {
+		n += 1 + sovTx(uint64(m.CreatorCommitmentScheme))
+	}
+	if m.ChallengerCommitmentScheme != 0 {
+		n += 1 + sovTx(uint64(m.ChallengerCommitmentScheme))
+	}
+	return n
+}
+
+func (m *MsgCreateAndJoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		n += 1 + sovTx(uint64(m.GameId))
+	}
+	return n
+}
+
+func (m *MsgCommitMove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GameId != 0 {
+		n += 1 + sovTx(uint64(m.GameId))
+	}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CommitmentScheme != 0 {
+		n += 1 + sovTx(uint64(m.CommitmentScheme))
+	}
+	return n
+}
+
+func (m *MsgCommitMoveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealMove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GameId != 0 {
+		n += 1 + sovTx(uint64(m.GameId))
+	}
+	l = m.Move.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealMoveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRevealAgent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRevealAgentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEscrowReveal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GameId != 0 {
+		n += 1 + sovTx(uint64(m.GameId))
+	}
+	l = len(m.EncryptedReveal)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEscrowRevealResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAgentRevealMove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GameId != 0 {
+		n += 1 + sovTx(uint64(m.GameId))
+	}
+	l = m.Move.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAgentRevealMoveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgJoinQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.EntryFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinEntryFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CommitmentScheme != 0 {
+		n += 1 + sovTx(uint64(m.CommitmentScheme))
+	}
+	return n
+}
+
+func (m *MsgJoinQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Matched {
+		n += 2
+	}
+	if m.GameId != 0 {
+		n += 1 + sovTx(uint64(m.GameId))
+	}
+	if m.EntryId != 0 {
+		n += 1 + sovTx(uint64(m.EntryId))
+	}
+	return n
+}
+
+func (m *MsgLeaveQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EntryId != 0 {
+		n += 1 + sovTx(uint64(m.EntryId))
+	}
+	return n
+}
+
+func (m *MsgLeaveQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlaceBet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bettor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GameId != 0 {
+		n += 1 + sovTx(uint64(m.GameId))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovTx(uint64(m.Outcome))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPlaceBetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlayHouse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.EntryFee) > 0 {
+		for _, e := range m.EntryFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.CommitmentScheme != 0 {
+		n += 1 + sovTx(uint64(m.CommitmentScheme))
+	}
+	return n
+}
+
+func (m *MsgPlayHouseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		n += 1 + sovTx(uint64(m.GameId))
+	}
+	return n
+}
+
+func (m *MsgFundHouse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundHouseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOfferRematch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GameId != 0 {
+		n += 1 + sovTx(uint64(m.GameId))
+	}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CommitmentScheme != 0 {
+		n += 1 + sovTx(uint64(m.CommitmentScheme))
+	}
+	return n
+}
+
+func (m *MsgOfferRematchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		n += 1 + sovTx(uint64(m.GameId))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResolveStuckGame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GameId != 0 {
+		n += 1 + sovTx(uint64(m.GameId))
+	}
+	if m.Refund {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgResolveStuckGameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgNewGame) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewGame: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewGame: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = append(m.Commit[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commit == nil {
+				m.Commit = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntryFee = append(m.EntryFee, types.Coin{})
+			if err := m.EntryFee[len(m.EntryFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTimeout", wireType)
+			}
+			m.CommitTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealTimeout", wireType)
+			}
+			m.RevealTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengerFee = append(m.ChallengerFee, types.Coin{})
+			if err := m.ChallengerFee[len(m.ChallengerFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentScheme", wireType)
+			}
+			m.CommitmentScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitmentScheme |= CommitmentScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNewGameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewGameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAndJoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAndJoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAndJoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorCommit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorCommit = append(m.CreatorCommit[:0], dAtA[iNdEx:postIndex]...)
+			if m.CreatorCommit == nil {
+				m.CreatorCommit = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerCommit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengerCommit = append(m.ChallengerCommit[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChallengerCommit == nil {
+				m.ChallengerCommit = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntryFee = append(m.EntryFee, types.Coin{})
+			if err := m.EntryFee[len(m.EntryFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengerFee = append(m.ChallengerFee, types.Coin{})
+			if err := m.ChallengerFee[len(m.ChallengerFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealTimeout", wireType)
+			}
+			m.RevealTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorCommitmentScheme", wireType)
+			}
+			m.CreatorCommitmentScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatorCommitmentScheme |= CommitmentScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerCommitmentScheme", wireType)
+			}
+			m.ChallengerCommitmentScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengerCommitmentScheme |= CommitmentScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAndJoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAndJoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAndJoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitMove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitMove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitMove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = append(m.Commit[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commit == nil {
+				m.Commit = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentScheme", wireType)
+			}
+			m.CommitmentScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitmentScheme |= CommitmentScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitMoveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitMoveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitMoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealMove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealMove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealMove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Move.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealMoveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealMoveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealMoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRevealAgent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRevealAgent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRevealAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRevealAgentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRevealAgentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRevealAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEscrowReveal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEscrowReveal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEscrowReveal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedReveal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedReveal = append(m.EncryptedReveal[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedReveal == nil {
+				m.EncryptedReveal = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEscrowRevealResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEscrowRevealResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEscrowRevealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAgentRevealMove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAgentRevealMove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAgentRevealMove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Move.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAgentRevealMoveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAgentRevealMoveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAgentRevealMoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = append(m.Commit[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commit == nil {
+				m.Commit = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EntryFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEntryFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinEntryFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentScheme", wireType)
+			}
+			m.CommitmentScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitmentScheme |= CommitmentScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matched", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Matched = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryId", wireType)
+			}
+			m.EntryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLeaveQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLeaveQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLeaveQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryId", wireType)
+			}
+			m.EntryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLeaveQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLeaveQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLeaveQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceBet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bettor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bettor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= BetOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceBetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlayHouse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlayHouse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlayHouse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = append(m.Commit[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commit == nil {
+				m.Commit = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntryFee = append(m.EntryFee, types.Coin{})
+			if err := m.EntryFee[len(m.EntryFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentScheme", wireType)
+			}
+			m.CommitmentScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitmentScheme |= CommitmentScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlayHouseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1122,17 +6765,17 @@ func (m *MsgNewGame) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewGame: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlayHouseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewGame: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlayHouseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
 			}
-			var stringLen uint64
+			m.GameId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1142,27 +6785,64 @@ func (m *MsgNewGame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GameId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Player = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundHouse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundHouse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundHouse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1190,11 +6870,11 @@ func (m *MsgNewGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EntryFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1221,7 +6901,8 @@ func (m *MsgNewGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EntryFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1246,7 +6927,7 @@ func (m *MsgNewGame) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewGameResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundHouseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1269,31 +6950,12 @@ func (m *MsgNewGameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewGameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundHouseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundHouseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
-			}
-			m.GameId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GameId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1315,7 +6977,7 @@ func (m *MsgNewGameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCommitMove) Unmarshal(dAtA []byte) error {
+func (m *MsgOfferRematch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1338,10 +7000,10 @@ func (m *MsgCommitMove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCommitMove: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOfferRematch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCommitMove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOfferRematch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1399,7 +7061,7 @@ func (m *MsgCommitMove) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1409,24 +7071,45 @@ func (m *MsgCommitMove) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
+			m.Commit = append(m.Commit[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commit == nil {
+				m.Commit = []byte{}
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentScheme", wireType)
+			}
+			m.CommitmentScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitmentScheme |= CommitmentScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1448,7 +7131,7 @@ func (m *MsgCommitMove) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCommitMoveResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgOfferRematchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1471,12 +7154,31 @@ func (m *MsgCommitMoveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCommitMoveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOfferRematchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCommitMoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOfferRematchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1498,7 +7200,7 @@ func (m *MsgCommitMoveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevealMove) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1521,15 +7223,15 @@ func (m *MsgRevealMove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevealMove: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevealMove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1557,32 +7259,13 @@ func (m *MsgRevealMove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Player = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
-			}
-			m.GameId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GameId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1592,55 +7275,24 @@ func (m *MsgRevealMove) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Move = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1663,7 +7315,7 @@ func (m *MsgRevealMove) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevealMoveResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1686,10 +7338,10 @@ func (m *MsgRevealMoveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevealMoveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevealMoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1713,7 +7365,7 @@ func (m *MsgRevealMoveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveStuckGame) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1736,10 +7388,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveStuckGame: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveStuckGame: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1775,10 +7427,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
 			}
-			var msglen int
+			m.GameId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1788,25 +7440,31 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GameId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Refund = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1828,7 +7486,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveStuckGameResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1851,10 +7509,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveStuckGameResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveStuckGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4,8 +4,10 @@
 package rps
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -30,10 +32,201 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TimeoutMode defines how the deadlines of a game are expressed.
+type TimeoutMode int32
+
+const (
+	// TIMEOUT_MODE_UNSPECIFIED defaults to TIMEOUT_MODE_TIME.
+	TimeoutMode_TIMEOUT_MODE_UNSPECIFIED TimeoutMode = 0
+	// TIMEOUT_MODE_TIME uses deadlines expressed as timestamps, calculated by
+	// adding seconds to the block time.
+	TimeoutMode_TIMEOUT_MODE_TIME TimeoutMode = 1
+	// TIMEOUT_MODE_HEIGHT uses deadlines expressed as block heights, calculated
+	// by adding blocks to the block height.
+	TimeoutMode_TIMEOUT_MODE_HEIGHT TimeoutMode = 2
+)
+
+var TimeoutMode_name = map[int32]string{
+	0: "TIMEOUT_MODE_UNSPECIFIED",
+	1: "TIMEOUT_MODE_TIME",
+	2: "TIMEOUT_MODE_HEIGHT",
+}
+
+var TimeoutMode_value = map[string]int32{
+	"TIMEOUT_MODE_UNSPECIFIED": 0,
+	"TIMEOUT_MODE_TIME":        1,
+	"TIMEOUT_MODE_HEIGHT":      2,
+}
+
+func (x TimeoutMode) String() string {
+	return proto.EnumName(TimeoutMode_name, int32(x))
+}
+
+func (TimeoutMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ba9c952fdeac2baf, []int{0}
+}
+
+// Move is a move of rock, paper, scissors. Commitments are computed over the
+// lowercase name of the move without the prefix, e.g. "rock:salt".
+type Move int32
+
+const (
+	Move_MOVE_UNSPECIFIED Move = 0
+	Move_MOVE_ROCK        Move = 1
+	Move_MOVE_PAPER       Move = 2
+	Move_MOVE_SCISSORS    Move = 3
+)
+
+var Move_name = map[int32]string{
+	0: "MOVE_UNSPECIFIED",
+	1: "MOVE_ROCK",
+	2: "MOVE_PAPER",
+	3: "MOVE_SCISSORS",
+}
+
+var Move_value = map[string]int32{
+	"MOVE_UNSPECIFIED": 0,
+	"MOVE_ROCK":        1,
+	"MOVE_PAPER":       2,
+	"MOVE_SCISSORS":    3,
+}
+
+func (x Move) String() string {
+	return proto.EnumName(Move_name, int32(x))
+}
+
+func (Move) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ba9c952fdeac2baf, []int{1}
+}
+
+// CommitmentScheme is the hash function a commitment is computed with, over
+// "move:salt" like in MoveCommit.
+type CommitmentScheme int32
+
+const (
+	// COMMITMENT_SCHEME_UNSPECIFIED defaults to COMMITMENT_SCHEME_SHA256.
+	CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED CommitmentScheme = 0
+	// COMMITMENT_SCHEME_SHA256 uses SHA-256, it's always enabled.
+	CommitmentScheme_COMMITMENT_SCHEME_SHA256 CommitmentScheme = 1
+	// COMMITMENT_SCHEME_KECCAK256 uses Keccak-256, as in the EVM.
+	CommitmentScheme_COMMITMENT_SCHEME_KECCAK256 CommitmentScheme = 2
+)
+
+var CommitmentScheme_name = map[int32]string{
+	0: "COMMITMENT_SCHEME_UNSPECIFIED",
+	1: "COMMITMENT_SCHEME_SHA256",
+	2: "COMMITMENT_SCHEME_KECCAK256",
+}
+
+var CommitmentScheme_value = map[string]int32{
+	"COMMITMENT_SCHEME_UNSPECIFIED": 0,
+	"COMMITMENT_SCHEME_SHA256":      1,
+	"COMMITMENT_SCHEME_KECCAK256":   2,
+}
+
+func (x CommitmentScheme) String() string {
+	return proto.EnumName(CommitmentScheme_name, int32(x))
+}
+
+func (CommitmentScheme) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ba9c952fdeac2baf, []int{2}
+}
+
+// BetOutcome is the outcome of a game spectators can bet on.
+type BetOutcome int32
+
+const (
+	BetOutcome_BET_OUTCOME_UNSPECIFIED BetOutcome = 0
+	// BET_OUTCOME_CREATOR is a win of the player that created the game.
+	BetOutcome_BET_OUTCOME_CREATOR BetOutcome = 1
+	// BET_OUTCOME_CHALLENGER is a win of the player that joined the game.
+	BetOutcome_BET_OUTCOME_CHALLENGER BetOutcome = 2
+	// BET_OUTCOME_DRAW is a draw, both players revealed the same move.
+	BetOutcome_BET_OUTCOME_DRAW BetOutcome = 3
+)
+
+var BetOutcome_name = map[int32]string{
+	0: "BET_OUTCOME_UNSPECIFIED",
+	1: "BET_OUTCOME_CREATOR",
+	2: "BET_OUTCOME_CHALLENGER",
+	3: "BET_OUTCOME_DRAW",
+}
+
+var BetOutcome_value = map[string]int32{
+	"BET_OUTCOME_UNSPECIFIED": 0,
+	"BET_OUTCOME_CREATOR":     1,
+	"BET_OUTCOME_CHALLENGER":  2,
+	"BET_OUTCOME_DRAW":        3,
+}
+
+func (x BetOutcome) String() string {
+	return proto.EnumName(BetOutcome_name, int32(x))
+}
+
+func (BetOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ba9c952fdeac2baf, []int{3}
+}
+
 // Params defines the parameters of the module.
 type Params struct {
 	CommitTimeout uint64 `protobuf:"varint,1,opt,name=commit_timeout,json=commitTimeout,proto3" json:"commit_timeout,omitempty"`
 	RevealTimeout uint64 `protobuf:"varint,2,opt,name=reveal_timeout,json=revealTimeout,proto3" json:"reveal_timeout,omitempty"`
+	// max_settlements_per_block is the maximum number of games settled by the
+	// EndBlocker in a single block, the rest are carried to the next block.
+	// The settled games whose rematch window is over are pruned up to the
+	// same limit. Zero means no limit.
+	MaxSettlementsPerBlock uint64 `protobuf:"varint,3,opt,name=max_settlements_per_block,json=maxSettlementsPerBlock,proto3" json:"max_settlements_per_block,omitempty"`
+	// timeout_mode is the mode used for the deadlines of new games.
+	TimeoutMode         TimeoutMode `protobuf:"varint,4,opt,name=timeout_mode,json=timeoutMode,proto3,enum=facundomedica.rps.v1.TimeoutMode" json:"timeout_mode,omitempty"`
+	CommitTimeoutBlocks uint64      `protobuf:"varint,5,opt,name=commit_timeout_blocks,json=commitTimeoutBlocks,proto3" json:"commit_timeout_blocks,omitempty"`
+	RevealTimeoutBlocks uint64      `protobuf:"varint,6,opt,name=reveal_timeout_blocks,json=revealTimeoutBlocks,proto3" json:"reveal_timeout_blocks,omitempty"`
+	// bounds for the timeouts chosen by game creators, a zero max disables
+	// the overrides.
+	MinTimeout       uint64 `protobuf:"varint,7,opt,name=min_timeout,json=minTimeout,proto3" json:"min_timeout,omitempty"`
+	MaxTimeout       uint64 `protobuf:"varint,8,opt,name=max_timeout,json=maxTimeout,proto3" json:"max_timeout,omitempty"`
+	MinTimeoutBlocks uint64 `protobuf:"varint,9,opt,name=min_timeout_blocks,json=minTimeoutBlocks,proto3" json:"min_timeout_blocks,omitempty"`
+	MaxTimeoutBlocks uint64 `protobuf:"varint,10,opt,name=max_timeout_blocks,json=maxTimeoutBlocks,proto3" json:"max_timeout_blocks,omitempty"`
+	// no_show_penalty is the fraction of the stake of a player that didn't
+	// reveal their move which is sent to the community pool. It only applies
+	// when nobody revealed, otherwise the opponent takes the whole stake.
+	NoShowPenalty cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=no_show_penalty,json=noShowPenalty,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"no_show_penalty"`
+	// no_show_cooldown is the time a player that didn't reveal their move
+	// must wait before joining a new game.
+	NoShowCooldown uint64 `protobuf:"varint,12,opt,name=no_show_cooldown,json=noShowCooldown,proto3" json:"no_show_cooldown,omitempty"`
+	// allowed_denoms are the denoms that can be used in entry fees, if empty
+	// any denom is allowed.
+	AllowedDenoms []string `protobuf:"bytes,13,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// betting_fee is the fraction of the spectator betting pools of a game
+	// that is sent to the community pool when the bets are settled.
+	BettingFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=betting_fee,json=bettingFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"betting_fee"`
+	// jackpot_fee is the fraction of the pot of every game won by a player
+	// that goes into the progressive jackpot.
+	JackpotFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,15,opt,name=jackpot_fee,json=jackpotFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"jackpot_fee"`
+	// jackpot_streak is the number of games a player has to win in a row to
+	// take the jackpot. Zero disables the payouts.
+	JackpotStreak uint64 `protobuf:"varint,16,opt,name=jackpot_streak,json=jackpotStreak,proto3" json:"jackpot_streak,omitempty"`
+	// house_max_exposure is the maximum stake the house puts into a single
+	// game against a player, for each denom. Empty disables house games.
+	HouseMaxExposure github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,17,rep,name=house_max_exposure,json=houseMaxExposure,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"house_max_exposure"`
+	// rematch_window is how long the players of a settled game can offer a
+	// rematch, in seconds. Zero disables rematches.
+	RematchWindow uint64 `protobuf:"varint,18,opt,name=rematch_window,json=rematchWindow,proto3" json:"rematch_window,omitempty"`
+	// commitment_schemes are the commitment schemes players can use besides
+	// COMMITMENT_SCHEME_SHA256, which is always enabled.
+	CommitmentSchemes []CommitmentScheme `protobuf:"varint,19,rep,packed,name=commitment_schemes,json=commitmentSchemes,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"commitment_schemes,omitempty"`
+	// queue_match_grace is added to the reveal window of the games created by
+	// the matchmaking queue, as the player that was waiting may not be online
+	// when matched.
+	QueueMatchGrace       uint64 `protobuf:"varint,20,opt,name=queue_match_grace,json=queueMatchGrace,proto3" json:"queue_match_grace,omitempty"`
+	QueueMatchGraceBlocks uint64 `protobuf:"varint,21,opt,name=queue_match_grace_blocks,json=queueMatchGraceBlocks,proto3" json:"queue_match_grace_blocks,omitempty"`
+	// jackpot_min_stake is the minimum stake both players of a game must put
+	// in, in any of its denoms, for a win to count toward the jackpot streak.
+	// Empty disables the minimum.
+	JackpotMinStake github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,22,rep,name=jackpot_min_stake,json=jackpotMinStake,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"jackpot_min_stake"`
+	// house_max_open_exposure is the maximum total stake the house puts into
+	// the games in progress, for each denom. Empty only bounds it by the
+	// bankroll.
+	HouseMaxOpenExposure github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,23,rep,name=house_max_open_exposure,json=houseMaxOpenExposure,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"house_max_open_exposure"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -83,11 +276,167 @@ func (m *Params) GetRevealTimeout() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxSettlementsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxSettlementsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetTimeoutMode() TimeoutMode {
+	if m != nil {
+		return m.TimeoutMode
+	}
+	return TimeoutMode_TIMEOUT_MODE_UNSPECIFIED
+}
+
+func (m *Params) GetCommitTimeoutBlocks() uint64 {
+	if m != nil {
+		return m.CommitTimeoutBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetRevealTimeoutBlocks() uint64 {
+	if m != nil {
+		return m.RevealTimeoutBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMinTimeout() uint64 {
+	if m != nil {
+		return m.MinTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTimeout() uint64 {
+	if m != nil {
+		return m.MaxTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetMinTimeoutBlocks() uint64 {
+	if m != nil {
+		return m.MinTimeoutBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTimeoutBlocks() uint64 {
+	if m != nil {
+		return m.MaxTimeoutBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetNoShowCooldown() uint64 {
+	if m != nil {
+		return m.NoShowCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetJackpotStreak() uint64 {
+	if m != nil {
+		return m.JackpotStreak
+	}
+	return 0
+}
+
+func (m *Params) GetHouseMaxExposure() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.HouseMaxExposure
+	}
+	return nil
+}
+
+func (m *Params) GetRematchWindow() uint64 {
+	if m != nil {
+		return m.RematchWindow
+	}
+	return 0
+}
+
+func (m *Params) GetCommitmentSchemes() []CommitmentScheme {
+	if m != nil {
+		return m.CommitmentSchemes
+	}
+	return nil
+}
+
+func (m *Params) GetQueueMatchGrace() uint64 {
+	if m != nil {
+		return m.QueueMatchGrace
+	}
+	return 0
+}
+
+func (m *Params) GetQueueMatchGraceBlocks() uint64 {
+	if m != nil {
+		return m.QueueMatchGraceBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetJackpotMinStake() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.JackpotMinStake
+	}
+	return nil
+}
+
+func (m *Params) GetHouseMaxOpenExposure() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.HouseMaxOpenExposure
+	}
+	return nil
+}
+
 type Game struct {
-	Id            uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	EntryFee      types.Coin `protobuf:"bytes,2,opt,name=entry_fee,json=entryFee,proto3" json:"entry_fee"`
-	CommitTimeout time.Time  `protobuf:"bytes,3,opt,name=commit_timeout,json=commitTimeout,proto3,stdtime" json:"commit_timeout"`
-	RevealTimeout time.Time  `protobuf:"bytes,4,opt,name=reveal_timeout,json=revealTimeout,proto3,stdtime" json:"reveal_timeout"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// entry_fee is the stake the creator puts into the game, it can be made
+	// of multiple denoms.
+	EntryFee      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=entry_fee,json=entryFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"entry_fee"`
+	CommitTimeout time.Time                                `protobuf:"bytes,3,opt,name=commit_timeout,json=commitTimeout,proto3,stdtime" json:"commit_timeout"`
+	RevealTimeout time.Time                                `protobuf:"bytes,4,opt,name=reveal_timeout,json=revealTimeout,proto3,stdtime" json:"reveal_timeout"`
+	// timeout_mode is the mode the game was created with, it defines which
+	// deadlines are used.
+	TimeoutMode         TimeoutMode `protobuf:"varint,5,opt,name=timeout_mode,json=timeoutMode,proto3,enum=facundomedica.rps.v1.TimeoutMode" json:"timeout_mode,omitempty"`
+	CommitTimeoutHeight int64       `protobuf:"varint,6,opt,name=commit_timeout_height,json=commitTimeoutHeight,proto3" json:"commit_timeout_height,omitempty"`
+	RevealTimeoutHeight int64       `protobuf:"varint,7,opt,name=reveal_timeout_height,json=revealTimeoutHeight,proto3" json:"reveal_timeout_height,omitempty"`
+	// commit_duration and reveal_duration are the length of the commit and
+	// reveal windows of this game, in seconds or blocks depending on the
+	// timeout_mode.
+	CommitDuration uint64 `protobuf:"varint,8,opt,name=commit_duration,json=commitDuration,proto3" json:"commit_duration,omitempty"`
+	RevealDuration uint64 `protobuf:"varint,9,opt,name=reveal_duration,json=revealDuration,proto3" json:"reveal_duration,omitempty"`
+	// challenger_fee is the stake the challenger puts into the game. When
+	// empty the challenger matches the entry_fee, otherwise the creator is
+	// offering odds.
+	ChallengerFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=challenger_fee,json=challengerFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"challenger_fee"`
+	// creator is the player that created the game, games created before it
+	// was tracked leave it empty and don't accept bets.
+	Creator string `protobuf:"bytes,11,opt,name=creator,proto3" json:"creator,omitempty"`
+	// house is set for games played against the module, the house is the
+	// challenger and its stake is taken from the house bankroll.
+	House bool `protobuf:"varint,12,opt,name=house,proto3" json:"house,omitempty"`
+	// house_seed is the hash of the block after the one the house game was
+	// created in, set by the EndBlocker of that block so it's unknown when the
+	// player commits. The house move is derived from it and the salt of the
+	// player, see utils.HouseMove.
+	HouseSeed []byte `protobuf:"bytes,13,opt,name=house_seed,json=houseSeed,proto3" json:"house_seed,omitempty"`
+	// reserved_for is the only player that can join the game, set for
+	// rematches.
+	ReservedFor string `protobuf:"bytes,14,opt,name=reserved_for,json=reservedFor,proto3" json:"reserved_for,omitempty"`
 }
 
 func (m *Game) Reset()         { *m = Game{} }
@@ -130,11 +479,11 @@ func (m *Game) GetId() uint64 {
 	return 0
 }
 
-func (m *Game) GetEntryFee() types.Coin {
+func (m *Game) GetEntryFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.EntryFee
 	}
-	return types.Coin{}
+	return nil
 }
 
 func (m *Game) GetCommitTimeout() time.Time {
@@ -151,9 +500,90 @@ func (m *Game) GetRevealTimeout() time.Time {
 	return time.Time{}
 }
 
+func (m *Game) GetTimeoutMode() TimeoutMode {
+	if m != nil {
+		return m.TimeoutMode
+	}
+	return TimeoutMode_TIMEOUT_MODE_UNSPECIFIED
+}
+
+func (m *Game) GetCommitTimeoutHeight() int64 {
+	if m != nil {
+		return m.CommitTimeoutHeight
+	}
+	return 0
+}
+
+func (m *Game) GetRevealTimeoutHeight() int64 {
+	if m != nil {
+		return m.RevealTimeoutHeight
+	}
+	return 0
+}
+
+func (m *Game) GetCommitDuration() uint64 {
+	if m != nil {
+		return m.CommitDuration
+	}
+	return 0
+}
+
+func (m *Game) GetRevealDuration() uint64 {
+	if m != nil {
+		return m.RevealDuration
+	}
+	return 0
+}
+
+func (m *Game) GetChallengerFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ChallengerFee
+	}
+	return nil
+}
+
+func (m *Game) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Game) GetHouse() bool {
+	if m != nil {
+		return m.House
+	}
+	return false
+}
+
+func (m *Game) GetHouseSeed() []byte {
+	if m != nil {
+		return m.HouseSeed
+	}
+	return nil
+}
+
+func (m *Game) GetReservedFor() string {
+	if m != nil {
+		return m.ReservedFor
+	}
+	return ""
+}
+
 type MoveCommit struct {
-	Commit    string    `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// commit is the hash of "move:salt", where move is the name of the
+	// move, e.g. "rock", and salt the lowercase hex encoding of the salt. The
+	// hash function is the one of the scheme, sha256 by default.
+	Commit    HexBytes  `protobuf:"bytes,1,opt,name=commit,proto3,casttype=HexBytes" json:"commit,omitempty"`
 	CreatedAt time.Time `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// stake is what the player put into the game. Commits made before stakes
+	// were tracked per player leave it empty and use the game entry_fee.
+	Stake github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=stake,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"stake"`
+	// encrypted_reveal is the reveal escrowed for the player's reveal agent,
+	// if any.
+	EncryptedReveal []byte `protobuf:"bytes,5,opt,name=encrypted_reveal,json=encryptedReveal,proto3" json:"encrypted_reveal,omitempty"`
+	// scheme is the commitment scheme the commit was computed with.
+	Scheme CommitmentScheme `protobuf:"varint,6,opt,name=scheme,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"scheme,omitempty"`
 }
 
 func (m *MoveCommit) Reset()         { *m = MoveCommit{} }
@@ -189,11 +619,11 @@ func (m *MoveCommit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MoveCommit proto.InternalMessageInfo
 
-func (m *MoveCommit) GetCommit() string {
+func (m *MoveCommit) GetCommit() HexBytes {
 	if m != nil {
 		return m.Commit
 	}
-	return ""
+	return nil
 }
 
 func (m *MoveCommit) GetCreatedAt() time.Time {
@@ -203,9 +633,30 @@ func (m *MoveCommit) GetCreatedAt() time.Time {
 	return time.Time{}
 }
 
+func (m *MoveCommit) GetStake() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Stake
+	}
+	return nil
+}
+
+func (m *MoveCommit) GetEncryptedReveal() []byte {
+	if m != nil {
+		return m.EncryptedReveal
+	}
+	return nil
+}
+
+func (m *MoveCommit) GetScheme() CommitmentScheme {
+	if m != nil {
+		return m.Scheme
+	}
+	return CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED
+}
+
 type MoveReveal struct {
-	Move      string    `protobuf:"bytes,1,opt,name=move,proto3" json:"move,omitempty"`
-	Salt      string    `protobuf:"bytes,2,opt,name=salt,proto3" json:"salt,omitempty"`
+	Move      Move      `protobuf:"varint,1,opt,name=move,proto3,enum=facundomedica.rps.v1.Move" json:"move,omitempty"`
+	Salt      HexBytes  `protobuf:"bytes,2,opt,name=salt,proto3,casttype=HexBytes" json:"salt,omitempty"`
 	CreatedAt time.Time `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
 }
 
@@ -242,18 +693,18 @@ func (m *MoveReveal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MoveReveal proto.InternalMessageInfo
 
-func (m *MoveReveal) GetMove() string {
+func (m *MoveReveal) GetMove() Move {
 	if m != nil {
 		return m.Move
 	}
-	return ""
+	return Move_MOVE_UNSPECIFIED
 }
 
-func (m *MoveReveal) GetSalt() string {
+func (m *MoveReveal) GetSalt() HexBytes {
 	if m != nil {
 		return m.Salt
 	}
-	return ""
+	return nil
 }
 
 func (m *MoveReveal) GetCreatedAt() time.Time {
@@ -263,302 +714,2919 @@ func (m *MoveReveal) GetCreatedAt() time.Time {
 	return time.Time{}
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "facundomedica.rps.v1.Params")
-	proto.RegisterType((*Game)(nil), "facundomedica.rps.v1.Game")
-	proto.RegisterType((*MoveCommit)(nil), "facundomedica.rps.v1.MoveCommit")
-	proto.RegisterType((*MoveReveal)(nil), "facundomedica.rps.v1.MoveReveal")
+// PlayerStats holds the track record of a player.
+type PlayerStats struct {
+	// no_shows is the number of games in which the player didn't reveal their
+	// move.
+	NoShows uint64 `protobuf:"varint,1,opt,name=no_shows,json=noShows,proto3" json:"no_shows,omitempty"`
+	// cooldown_until is the time until which the player can't join new games.
+	CooldownUntil time.Time `protobuf:"bytes,2,opt,name=cooldown_until,json=cooldownUntil,proto3,stdtime" json:"cooldown_until"`
+	// wins is the number of games the player won with both moves revealed.
+	Wins uint64 `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	// win_streak is the number of games the player won in a row, it's reset
+	// by losses, draws and no-shows, and when the jackpot is paid out. Wins of
+	// games that didn't feed the jackpot or with a stake below
+	// Params.jackpot_min_stake don't count.
+	WinStreak uint64 `protobuf:"varint,4,opt,name=win_streak,json=winStreak,proto3" json:"win_streak,omitempty"`
 }
 
-func init() { proto.RegisterFile("facundomedica/rps/v1/types.proto", fileDescriptor_ba9c952fdeac2baf) }
-
-var fileDescriptor_ba9c952fdeac2baf = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0xc4, 0x25, 0x98, 0x91, 0x16, 0x1c, 0x8a, 0xb4, 0x41, 0x37, 0x25, 0x20, 0x48, 0x0f,
-	0x33, 0x44, 0xc1, 0x83, 0x78, 0x31, 0x05, 0xf5, 0x22, 0x84, 0xa5, 0x27, 0x2f, 0x61, 0x76, 0xf7,
-	0x65, 0x1d, 0xc8, 0xec, 0x5b, 0x76, 0x66, 0x17, 0x7a, 0xf1, 0x07, 0x78, 0xea, 0xcf, 0xf0, 0xd8,
-	0x9f, 0xd1, 0x63, 0x8f, 0x9e, 0x54, 0x92, 0x43, 0xff, 0x82, 0x47, 0xd9, 0x99, 0xa9, 0x10, 0x73,
-	0x52, 0x7a, 0x59, 0xde, 0xfb, 0xe6, 0x7b, 0xdf, 0x37, 0xef, 0xdb, 0xa1, 0xc7, 0x4b, 0x99, 0x35,
-	0x65, 0x8e, 0x1a, 0x72, 0x95, 0x49, 0x51, 0x57, 0x46, 0xb4, 0x53, 0x61, 0xcf, 0x2b, 0x30, 0xbc,
-	0xaa, 0xd1, 0x22, 0x3b, 0xd8, 0x62, 0xf0, 0xba, 0x32, 0xbc, 0x9d, 0x8e, 0x1e, 0x4a, 0xad, 0x4a,
-	0x14, 0xee, 0xeb, 0x89, 0xa3, 0x38, 0x43, 0xa3, 0xd1, 0x88, 0x54, 0x1a, 0x10, 0xed, 0x34, 0x05,
-	0x2b, 0xa7, 0x22, 0x43, 0x55, 0x86, 0xf3, 0x83, 0x02, 0x0b, 0x74, 0xa5, 0xe8, 0xaa, 0x80, 0x8e,
-	0x0b, 0xc4, 0x62, 0x05, 0xc2, 0x75, 0x69, 0xb3, 0x14, 0x56, 0x69, 0x30, 0x56, 0xea, 0x2a, 0x10,
-	0x8e, 0xbc, 0xec, 0xc2, 0x4f, 0xfa, 0xc6, 0x1f, 0x4d, 0x1a, 0x3a, 0x98, 0xcb, 0x5a, 0x6a, 0xc3,
-	0x9e, 0xd2, 0xfd, 0x0c, 0xb5, 0x56, 0x76, 0xd1, 0x8d, 0x63, 0x63, 0x0f, 0xc9, 0x31, 0x79, 0x16,
-	0x25, 0x7b, 0x1e, 0x3d, 0xf3, 0x60, 0x47, 0xab, 0xa1, 0x05, 0xb9, 0xfa, 0x43, 0xeb, 0x7b, 0x9a,
-	0x47, 0x03, 0xed, 0xd5, 0x93, 0x2f, 0x37, 0x97, 0x27, 0x87, 0xbb, 0xc9, 0x78, 0xb3, 0xc9, 0x2f,
-	0x42, 0xa3, 0x77, 0x52, 0x03, 0xdb, 0xa7, 0x7d, 0x95, 0x07, 0xa7, 0xbe, 0xca, 0xd9, 0x6b, 0x3a,
-	0x84, 0xd2, 0xd6, 0xe7, 0x8b, 0x25, 0x80, 0x53, 0x7e, 0xf0, 0xfc, 0x88, 0x87, 0x1b, 0x77, 0xa9,
-	0xf0, 0x90, 0x0a, 0x3f, 0x45, 0x55, 0xce, 0xa2, 0xab, 0xef, 0xe3, 0x5e, 0x72, 0xdf, 0x4d, 0xbc,
-	0x05, 0x60, 0xf3, 0x9d, 0x1d, 0xee, 0x39, 0x89, 0x11, 0xf7, 0x11, 0xf1, 0xdb, 0x88, 0xf8, 0xd9,
-	0x6d, 0x44, 0xb3, 0xbd, 0x4e, 0xe3, 0xe2, 0xc7, 0x98, 0x7c, 0xbd, 0xb9, 0x3c, 0x21, 0x7f, 0xaf,
-	0x3b, 0xdf, 0x59, 0x37, 0xfa, 0x67, 0xc5, 0xad, 0x64, 0x26, 0x25, 0xa5, 0x1f, 0xb0, 0x85, 0x53,
-	0x67, 0xc3, 0x1e, 0xd1, 0x81, 0x37, 0x74, 0x19, 0x0c, 0x93, 0xd0, 0xb1, 0xf7, 0x94, 0x66, 0x35,
-	0x48, 0x0b, 0xf9, 0x42, 0xfe, 0xc7, 0x16, 0xc3, 0x30, 0xfc, 0xc6, 0x4e, 0x3e, 0x7b, 0xbf, 0xc4,
-	0x5d, 0x82, 0x31, 0x1a, 0x69, 0x6c, 0x21, 0xb8, 0xb9, 0xba, 0xc3, 0x8c, 0x5c, 0xf9, 0x1f, 0x39,
-	0x4c, 0x5c, 0x7d, 0x77, 0xfe, 0xb3, 0x97, 0x57, 0xeb, 0x98, 0x5c, 0xaf, 0x63, 0xf2, 0x73, 0x1d,
-	0x93, 0x8b, 0x4d, 0xdc, 0xbb, 0xde, 0xc4, 0xbd, 0x6f, 0x9b, 0xb8, 0xf7, 0xf1, 0x71, 0xa1, 0xec,
-	0xa7, 0x26, 0xe5, 0x19, 0x6a, 0xb1, 0xf3, 0x52, 0xd2, 0x81, 0x73, 0x79, 0xf1, 0x3b, 0x00, 0x00,
-	0xff, 0xff, 0x55, 0x8d, 0x51, 0x4a, 0x5f, 0x03, 0x00, 0x00,
+func (m *PlayerStats) Reset()         { *m = PlayerStats{} }
+func (m *PlayerStats) String() string { return proto.CompactTextString(m) }
+func (*PlayerStats) ProtoMessage()    {}
+func (*PlayerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9c952fdeac2baf, []int{4}
+}
+func (m *PlayerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlayerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlayerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlayerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlayerStats.Merge(m, src)
+}
+func (m *PlayerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlayerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlayerStats.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PlayerStats proto.InternalMessageInfo
+
+func (m *PlayerStats) GetNoShows() uint64 {
+	if m != nil {
+		return m.NoShows
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PlayerStats) GetCooldownUntil() time.Time {
+	if m != nil {
+		return m.CooldownUntil
+	}
+	return time.Time{}
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RevealTimeout != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.RevealTimeout))
-		i--
-		dAtA[i] = 0x10
+func (m *PlayerStats) GetWins() uint64 {
+	if m != nil {
+		return m.Wins
 	}
-	if m.CommitTimeout != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CommitTimeout))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Game) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PlayerStats) GetWinStreak() uint64 {
+	if m != nil {
+		return m.WinStreak
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Game) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueueEntry is a player waiting in the matchmaking queue for an opponent.
+type QueueEntry struct {
+	Id     uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Player string   `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	Commit HexBytes `protobuf:"bytes,3,opt,name=commit,proto3,casttype=HexBytes" json:"commit,omitempty"`
+	// entry_fee is the escrowed stake, it's the maximum stake the player is
+	// willing to play for.
+	EntryFee  types.Coin `protobuf:"bytes,4,opt,name=entry_fee,json=entryFee,proto3" json:"entry_fee"`
+	CreatedAt time.Time  `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// min_entry_fee is the minimum stake the player is willing to play for.
+	MinEntryFee types.Coin `protobuf:"bytes,6,opt,name=min_entry_fee,json=minEntryFee,proto3" json:"min_entry_fee"`
+	// scheme is the scheme commit was computed with, stored with the commit
+	// once the player is matched.
+	Scheme CommitmentScheme `protobuf:"varint,7,opt,name=scheme,proto3,enum=facundomedica.rps.v1.CommitmentScheme" json:"scheme,omitempty"`
 }
 
-func (m *Game) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RevealTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevealTimeout):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintTypes(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CommitTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CommitTimeout):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintTypes(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.EntryFee.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueueEntry) Reset()         { *m = QueueEntry{} }
+func (m *QueueEntry) String() string { return proto.CompactTextString(m) }
+func (*QueueEntry) ProtoMessage()    {}
+func (*QueueEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9c952fdeac2baf, []int{5}
+}
+func (m *QueueEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.Id != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueEntry.Merge(m, src)
+}
+func (m *QueueEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueEntry proto.InternalMessageInfo
+
+func (m *QueueEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MoveCommit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueEntry) GetPlayer() string {
+	if m != nil {
+		return m.Player
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MoveCommit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueueEntry) GetCommit() HexBytes {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
 }
 
-func (m *MoveCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err4 != nil {
-		return 0, err4
+func (m *QueueEntry) GetEntryFee() types.Coin {
+	if m != nil {
+		return m.EntryFee
 	}
-	i -= n4
-	i = encodeVarintTypes(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Commit) > 0 {
-		i -= len(m.Commit)
-		copy(dAtA[i:], m.Commit)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Commit)))
-		i--
-		dAtA[i] = 0xa
+	return types.Coin{}
+}
+
+func (m *QueueEntry) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *MoveReveal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueEntry) GetMinEntryFee() types.Coin {
+	if m != nil {
+		return m.MinEntryFee
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MoveReveal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueueEntry) GetScheme() CommitmentScheme {
+	if m != nil {
+		return m.Scheme
+	}
+	return CommitmentScheme_COMMITMENT_SCHEME_UNSPECIFIED
 }
 
-func (m *MoveReveal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err5 != nil {
-		return 0, err5
+// Bet is the bet of a spectator on the outcome of a game.
+type Bet struct {
+	Outcome BetOutcome `protobuf:"varint,1,opt,name=outcome,proto3,enum=facundomedica.rps.v1.BetOutcome" json:"outcome,omitempty"`
+	// amount is the escrowed amount of the bet, each denom is settled in its
+	// own pool.
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	CreatedAt time.Time                                `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+}
+
+func (m *Bet) Reset()         { *m = Bet{} }
+func (m *Bet) String() string { return proto.CompactTextString(m) }
+func (*Bet) ProtoMessage()    {}
+func (*Bet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9c952fdeac2baf, []int{6}
+}
+func (m *Bet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n5
-	i = encodeVarintTypes(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Salt) > 0 {
-		i -= len(m.Salt)
-		copy(dAtA[i:], m.Salt)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Salt)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *Bet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bet.Merge(m, src)
+}
+func (m *Bet) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bet) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bet proto.InternalMessageInfo
+
+func (m *Bet) GetOutcome() BetOutcome {
+	if m != nil {
+		return m.Outcome
 	}
-	if len(m.Move) > 0 {
-		i -= len(m.Move)
-		copy(dAtA[i:], m.Move)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Move)))
-		i--
-		dAtA[i] = 0xa
+	return BetOutcome_BET_OUTCOME_UNSPECIFIED
+}
+
+func (m *Bet) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Bet) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return time.Time{}
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+// SettledGame holds the participants and rules of a settled game for a while,
+// so its players can offer a rematch.
+type SettledGame struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator    string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Challenger string `protobuf:"bytes,3,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	// creator_stake and challenger_stake are what each player put into the
+	// game.
+	CreatorStake    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=creator_stake,json=creatorStake,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"creator_stake"`
+	ChallengerStake github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=challenger_stake,json=challengerStake,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"challenger_stake"`
+	TimeoutMode     TimeoutMode                              `protobuf:"varint,6,opt,name=timeout_mode,json=timeoutMode,proto3,enum=facundomedica.rps.v1.TimeoutMode" json:"timeout_mode,omitempty"`
+	CommitDuration  uint64                                   `protobuf:"varint,7,opt,name=commit_duration,json=commitDuration,proto3" json:"commit_duration,omitempty"`
+	RevealDuration  uint64                                   `protobuf:"varint,8,opt,name=reveal_duration,json=revealDuration,proto3" json:"reveal_duration,omitempty"`
+	// expires_at is the time after which a rematch can't be offered anymore.
+	ExpiresAt time.Time `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+}
+
+func (m *SettledGame) Reset()         { *m = SettledGame{} }
+func (m *SettledGame) String() string { return proto.CompactTextString(m) }
+func (*SettledGame) ProtoMessage()    {}
+func (*SettledGame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9c952fdeac2baf, []int{7}
+}
+func (m *SettledGame) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettledGame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettledGame.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.CommitTimeout != 0 {
-		n += 1 + sovTypes(uint64(m.CommitTimeout))
+}
+func (m *SettledGame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettledGame.Merge(m, src)
+}
+func (m *SettledGame) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettledGame) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettledGame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettledGame proto.InternalMessageInfo
+
+func (m *SettledGame) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.RevealTimeout != 0 {
-		n += 1 + sovTypes(uint64(m.RevealTimeout))
+	return 0
+}
+
+func (m *SettledGame) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return n
+	return ""
 }
 
-func (m *Game) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SettledGame) GetChallenger() string {
+	if m != nil {
+		return m.Challenger
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTypes(uint64(m.Id))
+	return ""
+}
+
+func (m *SettledGame) GetCreatorStake() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CreatorStake
 	}
-	l = m.EntryFee.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CommitTimeout)
-	n += 1 + l + sovTypes(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevealTimeout)
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return nil
 }
 
-func (m *MoveCommit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SettledGame) GetChallengerStake() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ChallengerStake
 	}
-	var l int
-	_ = l
-	l = len(m.Commit)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+func (m *SettledGame) GetTimeoutMode() TimeoutMode {
+	if m != nil {
+		return m.TimeoutMode
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return TimeoutMode_TIMEOUT_MODE_UNSPECIFIED
 }
 
-func (m *MoveReveal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SettledGame) GetCommitDuration() uint64 {
+	if m != nil {
+		return m.CommitDuration
 	}
-	var l int
-	_ = l
-	l = len(m.Move)
-	if l > 0 {
+	return 0
+}
+
+func (m *SettledGame) GetRevealDuration() uint64 {
+	if m != nil {
+		return m.RevealDuration
+	}
+	return 0
+}
+
+func (m *SettledGame) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("facundomedica.rps.v1.TimeoutMode", TimeoutMode_name, TimeoutMode_value)
+	proto.RegisterEnum("facundomedica.rps.v1.Move", Move_name, Move_value)
+	proto.RegisterEnum("facundomedica.rps.v1.CommitmentScheme", CommitmentScheme_name, CommitmentScheme_value)
+	proto.RegisterEnum("facundomedica.rps.v1.BetOutcome", BetOutcome_name, BetOutcome_value)
+	proto.RegisterType((*Params)(nil), "facundomedica.rps.v1.Params")
+	proto.RegisterType((*Game)(nil), "facundomedica.rps.v1.Game")
+	proto.RegisterType((*MoveCommit)(nil), "facundomedica.rps.v1.MoveCommit")
+	proto.RegisterType((*MoveReveal)(nil), "facundomedica.rps.v1.MoveReveal")
+	proto.RegisterType((*PlayerStats)(nil), "facundomedica.rps.v1.PlayerStats")
+	proto.RegisterType((*QueueEntry)(nil), "facundomedica.rps.v1.QueueEntry")
+	proto.RegisterType((*Bet)(nil), "facundomedica.rps.v1.Bet")
+	proto.RegisterType((*SettledGame)(nil), "facundomedica.rps.v1.SettledGame")
+}
+
+func init() { proto.RegisterFile("facundomedica/rps/v1/types.proto", fileDescriptor_ba9c952fdeac2baf) }
+
+var fileDescriptor_ba9c952fdeac2baf = []byte{
+	// 1718 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x92, 0x14, 0x25, 0x3d, 0x7e, 0x68, 0x35, 0x96, 0xad, 0xb5, 0x6c, 0x4b, 0x8c, 0xd0,
+	0xb4, 0xac, 0x50, 0x93, 0x95, 0x8a, 0xa6, 0x6d, 0x5a, 0x14, 0x20, 0x29, 0xda, 0x14, 0x6c, 0x9a,
+	0xec, 0x92, 0x6a, 0xd0, 0xf6, 0xb0, 0x58, 0xed, 0x8e, 0xc9, 0xad, 0xb8, 0x3b, 0xec, 0xce, 0x50,
+	0xa4, 0x2e, 0x05, 0x8a, 0xa2, 0x41, 0x10, 0xf4, 0x10, 0xa0, 0xb7, 0xfe, 0x05, 0x41, 0x81, 0x02,
+	0x3e, 0xe4, 0x8f, 0xc8, 0x31, 0xc8, 0xa9, 0x68, 0x01, 0xa7, 0xb0, 0x81, 0xfa, 0x7f, 0xe8, 0x29,
+	0x98, 0x8f, 0x15, 0xbf, 0x84, 0x58, 0x56, 0x0c, 0x5d, 0xa4, 0x9d, 0xf7, 0x7e, 0xef, 0x63, 0x66,
+	0xde, 0x9b, 0xdf, 0x23, 0xe4, 0x9e, 0xda, 0xce, 0x20, 0x70, 0x89, 0x8f, 0x5d, 0xcf, 0xb1, 0x8b,
+	0x61, 0x9f, 0x16, 0x4f, 0xf7, 0x8a, 0xec, 0xac, 0x8f, 0x69, 0xa1, 0x1f, 0x12, 0x46, 0xd0, 0xfa,
+	0x14, 0xa2, 0x10, 0xf6, 0x69, 0xe1, 0x74, 0x6f, 0x73, 0xcd, 0xf6, 0xbd, 0x80, 0x14, 0xc5, 0x5f,
+	0x09, 0xdc, 0xdc, 0x72, 0x08, 0xf5, 0x09, 0x2d, 0x1e, 0xdb, 0x14, 0x17, 0x4f, 0xf7, 0x8e, 0x31,
+	0xb3, 0xf7, 0x8a, 0x0e, 0xf1, 0x02, 0xa5, 0x5f, 0xef, 0x90, 0x0e, 0x11, 0x9f, 0x45, 0xfe, 0xa5,
+	0xa4, 0xdb, 0x1d, 0x42, 0x3a, 0x3d, 0x5c, 0x14, 0xab, 0xe3, 0xc1, 0xd3, 0x22, 0xf3, 0x7c, 0x4c,
+	0x99, 0xed, 0xf7, 0x15, 0xe0, 0xb6, 0x74, 0x6b, 0x49, 0x4b, 0xb9, 0x90, 0xaa, 0x9d, 0x8f, 0xd2,
+	0x90, 0x6c, 0xda, 0xa1, 0xed, 0x53, 0xf4, 0x2e, 0x64, 0x1d, 0xe2, 0xfb, 0x1e, 0xb3, 0xb8, 0x3d,
+	0x19, 0x30, 0x43, 0xcb, 0x69, 0xf9, 0x84, 0x99, 0x91, 0xd2, 0xb6, 0x14, 0x72, 0x58, 0x88, 0x4f,
+	0xb1, 0xdd, 0x3b, 0x87, 0xc5, 0x24, 0x4c, 0x4a, 0x23, 0xd8, 0xcf, 0xe0, 0xb6, 0x6f, 0x8f, 0x2c,
+	0x8a, 0x19, 0xeb, 0x61, 0x1f, 0x07, 0x8c, 0x5a, 0x7d, 0x1c, 0x5a, 0xc7, 0x3d, 0xe2, 0x9c, 0x18,
+	0x71, 0x61, 0x71, 0xcb, 0xb7, 0x47, 0xad, 0xb1, 0xbe, 0x89, 0xc3, 0x32, 0xd7, 0xa2, 0x03, 0x48,
+	0x2b, 0xd7, 0x96, 0x4f, 0x5c, 0x6c, 0x24, 0x72, 0x5a, 0x3e, 0xbb, 0xff, 0x4e, 0xe1, 0xa2, 0x53,
+	0x2c, 0xa8, 0x78, 0x75, 0xe2, 0x62, 0x33, 0xc5, 0xc6, 0x0b, 0xb4, 0x0f, 0x37, 0xa7, 0xb7, 0x23,
+	0x63, 0x53, 0x63, 0x51, 0x04, 0xbf, 0x31, 0xb5, 0x2b, 0x11, 0x98, 0x72, 0x9b, 0xe9, 0xbd, 0x45,
+	0x36, 0x49, 0x69, 0x33, 0xb5, 0x45, 0x65, 0xb3, 0x0d, 0x29, 0xdf, 0x0b, 0xce, 0x0f, 0x63, 0x49,
+	0x20, 0xc1, 0xf7, 0x82, 0xe8, 0x24, 0x38, 0xc0, 0x1e, 0x9d, 0x03, 0x96, 0x15, 0xc0, 0x1e, 0x45,
+	0x80, 0x1f, 0x00, 0x9a, 0xf0, 0x10, 0x85, 0x5c, 0x11, 0x38, 0x7d, 0xec, 0x48, 0xc5, 0xe3, 0x68,
+	0x7b, 0x34, 0x8b, 0x06, 0x85, 0xb6, 0x47, 0xd3, 0xe8, 0xdf, 0xc0, 0x6a, 0x40, 0x2c, 0xda, 0x25,
+	0x43, 0xab, 0x8f, 0x03, 0xbb, 0xc7, 0xce, 0x8c, 0x54, 0x4e, 0xcb, 0xaf, 0x94, 0xf7, 0x3e, 0x7f,
+	0xbe, 0xbd, 0xf0, 0xef, 0xe7, 0xdb, 0x77, 0x64, 0x39, 0x50, 0xf7, 0xa4, 0xe0, 0x91, 0xa2, 0x6f,
+	0xb3, 0x6e, 0xe1, 0x31, 0xee, 0xd8, 0xce, 0xd9, 0x01, 0x76, 0xbe, 0xfc, 0xec, 0x3e, 0x48, 0x75,
+	0xe1, 0x00, 0x3b, 0x66, 0x26, 0x20, 0xad, 0x2e, 0x19, 0x36, 0xa5, 0x1f, 0x94, 0x07, 0x3d, 0x72,
+	0xed, 0x10, 0xd2, 0x73, 0xc9, 0x30, 0x30, 0xd2, 0x22, 0x8d, 0xac, 0x04, 0x56, 0x94, 0x94, 0x97,
+	0x8c, 0xdd, 0xeb, 0x91, 0x21, 0x76, 0x2d, 0x17, 0x07, 0xc4, 0xa7, 0x46, 0x26, 0x17, 0xcf, 0xaf,
+	0x98, 0x19, 0x25, 0x3d, 0x10, 0x42, 0x64, 0x42, 0xea, 0x18, 0x33, 0xe6, 0x05, 0x1d, 0xeb, 0x29,
+	0xc6, 0x46, 0xf6, 0xaa, 0x79, 0x82, 0xf2, 0xf2, 0x00, 0x63, 0xee, 0xf3, 0xf7, 0xb6, 0x73, 0xd2,
+	0x27, 0x4c, 0xf8, 0x5c, 0xbd, 0xb2, 0x4f, 0xe5, 0x85, 0xfb, 0x7c, 0x17, 0xb2, 0x91, 0x4f, 0xca,
+	0x42, 0x6c, 0x9f, 0x18, 0xba, 0xec, 0x00, 0x25, 0x6d, 0x09, 0x21, 0xfa, 0x58, 0x03, 0xd4, 0x25,
+	0x03, 0x8a, 0x2d, 0x7e, 0x5f, 0x78, 0xd4, 0x27, 0x74, 0x10, 0x62, 0x63, 0x2d, 0x17, 0xcf, 0xa7,
+	0xf6, 0x6f, 0x17, 0x94, 0x73, 0xde, 0xea, 0x05, 0xd5, 0xea, 0x85, 0x0a, 0xf1, 0x82, 0x72, 0x89,
+	0x67, 0xf7, 0x8f, 0xaf, 0xb6, 0xf3, 0x1d, 0x8f, 0x75, 0x07, 0xc7, 0x05, 0x87, 0xf8, 0xaa, 0x67,
+	0xd5, 0xbf, 0xfb, 0xd4, 0x3d, 0x51, 0xef, 0x0b, 0x37, 0xa0, 0x7f, 0x7f, 0xf5, 0x6c, 0x37, 0xdd,
+	0x13, 0x89, 0x5b, 0xfc, 0xb1, 0xa0, 0xa6, 0x2e, 0xe2, 0xd6, 0xed, 0x51, 0x55, 0x45, 0x95, 0x5d,
+	0xeb, 0xdb, 0xcc, 0xe9, 0x5a, 0x43, 0x2f, 0x70, 0xc9, 0xd0, 0x40, 0x51, 0xd7, 0x0a, 0xe9, 0x07,
+	0x42, 0x88, 0x8e, 0x00, 0xc9, 0xbe, 0xe0, 0x1d, 0x69, 0x51, 0xa7, 0x8b, 0x7d, 0x4c, 0x8d, 0x1b,
+	0xb9, 0x78, 0x3e, 0xbb, 0xff, 0xdd, 0x8b, 0x1b, 0xb0, 0x72, 0x8e, 0x6f, 0x09, 0xb8, 0xb9, 0xe6,
+	0xcc, 0x48, 0x28, 0xda, 0x85, 0xb5, 0x3f, 0x0c, 0xf0, 0x00, 0x5b, 0x32, 0x83, 0x4e, 0x68, 0x3b,
+	0xd8, 0x58, 0x17, 0x09, 0xac, 0x0a, 0x45, 0x9d, 0xcb, 0x1f, 0x72, 0x31, 0xfa, 0x09, 0x18, 0x73,
+	0xd8, 0xa8, 0xca, 0x6f, 0x0a, 0x93, 0x9b, 0x33, 0x26, 0xaa, 0xd4, 0x3f, 0xd2, 0x60, 0x2d, 0xba,
+	0x17, 0xde, 0x4f, 0x94, 0xd9, 0x27, 0xd8, 0xb8, 0x75, 0x0d, 0xc7, 0xbd, 0xaa, 0xc2, 0xd6, 0xbd,
+	0xa0, 0xc5, 0x83, 0xa2, 0xbf, 0x69, 0xb0, 0x31, 0xbe, 0x7a, 0xd2, 0xc7, 0xc1, 0xf8, 0xfe, 0x37,
+	0xae, 0x21, 0xa1, 0xf5, 0xe8, 0xfe, 0x1b, 0x7d, 0x1c, 0x44, 0x35, 0xf0, 0xfe, 0xbd, 0x8f, 0x5f,
+	0x3d, 0xdb, 0x35, 0xe6, 0xd9, 0x4a, 0xbe, 0xff, 0x3b, 0xff, 0x4b, 0x42, 0xe2, 0xa1, 0xed, 0x63,
+	0x94, 0x85, 0x98, 0xe7, 0xaa, 0xc7, 0x3f, 0xe6, 0xb9, 0xe8, 0x8f, 0xb0, 0x82, 0x03, 0x16, 0x9e,
+	0x89, 0x0e, 0x8a, 0xbd, 0x2e, 0xfd, 0x07, 0xdf, 0x3a, 0xfd, 0x4f, 0x5f, 0x3d, 0xdb, 0xd5, 0xcc,
+	0x65, 0x11, 0x93, 0xf7, 0x5b, 0x73, 0x8e, 0x98, 0x38, 0x7f, 0xa4, 0xf6, 0x37, 0x0b, 0x92, 0xf8,
+	0x0a, 0x11, 0xf1, 0x15, 0xda, 0x11, 0xf1, 0x95, 0x33, 0x3c, 0x8b, 0x4f, 0xbe, 0xda, 0xd6, 0xa4,
+	0xb3, 0x19, 0x0e, 0x6b, 0xce, 0x71, 0x58, 0xe2, 0x8d, 0x3d, 0x4e, 0xd3, 0xdd, 0x2c, 0x67, 0x2d,
+	0xbe, 0x25, 0xce, 0xea, 0x62, 0xaf, 0xd3, 0x65, 0x82, 0x7f, 0xe2, 0x33, 0x9c, 0x55, 0x13, 0xaa,
+	0x0b, 0x38, 0x4b, 0xd9, 0x2c, 0x49, 0x9b, 0xa9, 0x3c, 0x95, 0xcd, 0xf7, 0x60, 0x55, 0xc5, 0x71,
+	0x07, 0xa1, 0xcd, 0x3c, 0x12, 0x28, 0x5a, 0x52, 0x07, 0x7d, 0xa0, 0xa4, 0x1c, 0xa8, 0x9c, 0x9f,
+	0x03, 0x25, 0x2f, 0xa9, 0xf3, 0x3b, 0x07, 0xfe, 0x59, 0x83, 0xac, 0xd3, 0xb5, 0x7b, 0x3d, 0x1c,
+	0x74, 0x70, 0x28, 0x2a, 0x05, 0xae, 0xa1, 0xd0, 0x33, 0xe3, 0x98, 0xbc, 0x52, 0xf6, 0x61, 0xc9,
+	0x09, 0xb1, 0xcd, 0x48, 0xa8, 0x58, 0xce, 0xf8, 0xf2, 0xb3, 0xfb, 0xeb, 0x2a, 0x81, 0x92, 0xeb,
+	0x86, 0x98, 0xd2, 0x16, 0x0b, 0xbd, 0xa0, 0x63, 0x46, 0x40, 0xb4, 0x0e, 0x8b, 0xa2, 0x5b, 0x04,
+	0x77, 0x2d, 0x9b, 0x72, 0x81, 0xee, 0x01, 0x88, 0x0f, 0x8b, 0x62, 0xec, 0x1a, 0x99, 0x9c, 0x96,
+	0x4f, 0x9b, 0x2b, 0x42, 0xd2, 0xc2, 0xd8, 0x45, 0x3f, 0x87, 0x74, 0x88, 0x29, 0x0e, 0x4f, 0xb1,
+	0x6b, 0x3d, 0x25, 0xa1, 0x91, 0x7d, 0x4d, 0xb4, 0x54, 0x84, 0x7e, 0x40, 0xc2, 0x9d, 0xff, 0xc4,
+	0x00, 0xea, 0xe4, 0x14, 0xcb, 0x97, 0x13, 0x7d, 0x07, 0x92, 0xf2, 0xd4, 0x45, 0xcb, 0xa5, 0xcb,
+	0xe9, 0xff, 0x3f, 0xdf, 0x5e, 0xae, 0xe1, 0x51, 0xf9, 0x8c, 0x61, 0x6a, 0x2a, 0x1d, 0xaa, 0x01,
+	0x88, 0x8c, 0xb1, 0x6b, 0xd9, 0x57, 0x68, 0x80, 0x15, 0x65, 0x5c, 0x62, 0x28, 0x84, 0x45, 0xf9,
+	0x34, 0x26, 0xae, 0xe1, 0x82, 0x64, 0x28, 0xf4, 0x7d, 0xd0, 0x71, 0xe0, 0x84, 0x67, 0x7d, 0x9e,
+	0xbf, 0x2c, 0x1d, 0xd1, 0x22, 0x69, 0x73, 0xf5, 0x5c, 0x6e, 0x0a, 0x31, 0xfa, 0x25, 0x24, 0x25,
+	0xef, 0x88, 0xa2, 0xbf, 0x3c, 0xed, 0x28, 0xab, 0x9d, 0x4f, 0x35, 0x79, 0xba, 0xca, 0x5d, 0x01,
+	0x12, 0x3e, 0x39, 0xc5, 0xe2, 0x6c, 0xb3, 0xfb, 0x9b, 0x17, 0x3b, 0x13, 0x78, 0x81, 0x43, 0x39,
+	0x48, 0x50, 0xbb, 0x27, 0x87, 0xda, 0xd9, 0xbb, 0x10, 0x9a, 0xb7, 0x77, 0x13, 0x3b, 0xff, 0xd4,
+	0x20, 0xd5, 0xec, 0xd9, 0x67, 0x38, 0x6c, 0x31, 0x9b, 0x51, 0x74, 0x1b, 0x96, 0xd5, 0x44, 0x45,
+	0xd5, 0xf3, 0xbb, 0x24, 0x27, 0x29, 0x2a, 0xdf, 0x40, 0x39, 0x4e, 0x59, 0x83, 0x80, 0x79, 0x3d,
+	0x23, 0xf6, 0xa6, 0x81, 0x33, 0x91, 0x83, 0x23, 0x6e, 0x8f, 0x10, 0x24, 0x86, 0x5e, 0x40, 0xd5,
+	0x2c, 0x2e, 0xbe, 0x79, 0xd5, 0x0f, 0x05, 0x73, 0x8a, 0xa9, 0x26, 0x21, 0x34, 0x2b, 0x43, 0xce,
+	0x6a, 0x5c, 0xb0, 0xf3, 0xa7, 0x38, 0xc0, 0xaf, 0x38, 0xf7, 0x56, 0xf9, 0xd3, 0x3c, 0xc7, 0x13,
+	0x3f, 0x84, 0x64, 0x5f, 0xec, 0xc6, 0x88, 0xbd, 0xa6, 0x1d, 0x14, 0x6e, 0xa2, 0xf4, 0xe3, 0xdf,
+	0x50, 0xfa, 0xbf, 0x98, 0xe4, 0x1f, 0xf9, 0x50, 0x7f, 0x43, 0xd1, 0x26, 0xf8, 0xae, 0x27, 0xd8,
+	0x63, 0xfa, 0xba, 0x16, 0xbf, 0x45, 0xe3, 0x54, 0x20, 0xc3, 0xe7, 0x8a, 0x71, 0x2e, 0xc9, 0xcb,
+	0xe5, 0xc2, 0x7f, 0x1f, 0x54, 0xa3, 0x74, 0xc6, 0xe5, 0xbd, 0x74, 0xa5, 0xf2, 0xfe, 0x30, 0x06,
+	0xf1, 0x32, 0x66, 0xe8, 0x7d, 0x58, 0x22, 0x03, 0xe6, 0x10, 0x3f, 0x2a, 0xed, 0xdc, 0xc5, 0x8e,
+	0xca, 0x98, 0x35, 0x24, 0xce, 0x8c, 0x0c, 0xd0, 0x19, 0x24, 0x6d, 0x9f, 0x0c, 0x02, 0x76, 0x7d,
+	0x6c, 0xae, 0x02, 0xbe, 0xc5, 0xe6, 0xf9, 0xcb, 0x22, 0xa4, 0xe4, 0xaf, 0x47, 0xf7, 0xc2, 0xa9,
+	0x65, 0x82, 0x0b, 0x62, 0x97, 0xe5, 0x82, 0x9f, 0x02, 0x8c, 0x09, 0xc5, 0x88, 0xbf, 0xc6, 0x6c,
+	0x02, 0x8b, 0x3e, 0xd4, 0x20, 0xa3, 0xbc, 0x58, 0x97, 0x7c, 0x5d, 0xdf, 0xd6, 0xd1, 0xa6, 0x55,
+	0x5c, 0x39, 0x7a, 0xfe, 0x55, 0x03, 0x7d, 0x82, 0x88, 0x65, 0x2e, 0x8b, 0xd7, 0x95, 0xcb, 0xea,
+	0x38, 0xb4, 0x4c, 0x67, 0x76, 0x2e, 0x4a, 0x5e, 0x69, 0x2e, 0xba, 0x60, 0x5e, 0x59, 0xba, 0xec,
+	0xbc, 0xb2, 0x7c, 0xe1, 0xbc, 0x52, 0x03, 0xc0, 0xa3, 0xbe, 0x17, 0x62, 0xca, 0xeb, 0x70, 0xe5,
+	0x8d, 0xeb, 0x50, 0x19, 0x97, 0xd8, 0xee, 0xef, 0x20, 0x35, 0x91, 0x37, 0xba, 0x0b, 0x46, 0xfb,
+	0xb0, 0x5e, 0x6d, 0x1c, 0xb5, 0xad, 0x7a, 0xe3, 0xa0, 0x6a, 0x1d, 0x3d, 0x69, 0x35, 0xab, 0x95,
+	0xc3, 0x07, 0x87, 0xd5, 0x03, 0x7d, 0x01, 0xdd, 0x84, 0xb5, 0x29, 0x2d, 0x5f, 0xe8, 0x1a, 0xda,
+	0x80, 0x1b, 0x53, 0xe2, 0x5a, 0xf5, 0xf0, 0x61, 0xad, 0xad, 0xc7, 0x76, 0x9f, 0x40, 0x82, 0x73,
+	0x13, 0x5a, 0x07, 0xbd, 0xde, 0xf8, 0xf5, 0xac, 0xb7, 0x0c, 0xac, 0x08, 0xa9, 0xd9, 0xa8, 0x3c,
+	0xd2, 0x35, 0x94, 0x05, 0x10, 0xcb, 0x66, 0xa9, 0x59, 0x35, 0xf5, 0x18, 0x5a, 0x83, 0x8c, 0x58,
+	0xb7, 0x2a, 0x87, 0xad, 0x56, 0xc3, 0x6c, 0xe9, 0xf1, 0x5d, 0x06, 0xfa, 0xec, 0xcb, 0x82, 0xde,
+	0x81, 0x7b, 0x95, 0x46, 0xbd, 0x7e, 0xd8, 0xae, 0x57, 0x9f, 0xb4, 0xad, 0x56, 0xa5, 0x56, 0xad,
+	0xcf, 0x06, 0xba, 0x0b, 0xc6, 0x3c, 0xa4, 0x55, 0x2b, 0xed, 0xff, 0xf8, 0x3d, 0x5d, 0x43, 0xdb,
+	0x70, 0x67, 0x5e, 0xfb, 0xa8, 0x5a, 0xa9, 0x94, 0x1e, 0x71, 0x40, 0x6c, 0x97, 0x01, 0x8c, 0x9f,
+	0x21, 0x74, 0x07, 0x36, 0xca, 0xd5, 0xb6, 0xd5, 0x38, 0x6a, 0x57, 0x1a, 0x73, 0x91, 0x36, 0xe0,
+	0xc6, 0xa4, 0xb2, 0x62, 0x56, 0x4b, 0xed, 0x86, 0xa9, 0x6b, 0x68, 0x13, 0x6e, 0x4d, 0x29, 0x6a,
+	0xa5, 0xc7, 0x8f, 0xab, 0x4f, 0x1e, 0x8a, 0x8d, 0xae, 0x83, 0x3e, 0xa9, 0x3b, 0x30, 0x4b, 0x1f,
+	0xe8, 0xf1, 0xf2, 0x7b, 0x9f, 0xbf, 0xd8, 0xd2, 0xbe, 0x78, 0xb1, 0xa5, 0xfd, 0xf7, 0xc5, 0x96,
+	0xf6, 0xc9, 0xcb, 0xad, 0x85, 0x2f, 0x5e, 0x6e, 0x2d, 0xfc, 0xeb, 0xe5, 0xd6, 0xc2, 0x6f, 0xef,
+	0x4e, 0x54, 0xf9, 0xdc, 0xcf, 0xa1, 0xe3, 0xa4, 0xb8, 0xfe, 0x1f, 0x7d, 0x3d, 0x00, 0xaf, 0x4b,
+	0xb1, 0x2a, 0xd8, 0x13, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HouseMaxOpenExposure) > 0 {
+		for iNdEx := len(m.HouseMaxOpenExposure) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HouseMaxOpenExposure[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.JackpotMinStake) > 0 {
+		for iNdEx := len(m.JackpotMinStake) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JackpotMinStake[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.QueueMatchGraceBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.QueueMatchGraceBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.QueueMatchGrace != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.QueueMatchGrace))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.CommitmentSchemes) > 0 {
+		dAtA2 := make([]byte, len(m.CommitmentSchemes)*10)
+		var j1 int
+		for _, num := range m.CommitmentSchemes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTypes(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.RematchWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RematchWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.HouseMaxExposure) > 0 {
+		for iNdEx := len(m.HouseMaxExposure) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HouseMaxExposure[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.JackpotStreak != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.JackpotStreak))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size := m.JackpotFee.Size()
+		i -= size
+		if _, err := m.JackpotFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.BettingFee.Size()
+		i -= size
+		if _, err := m.BettingFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.NoShowCooldown != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NoShowCooldown))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.NoShowPenalty.Size()
+		i -= size
+		if _, err := m.NoShowPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.MaxTimeoutBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxTimeoutBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MinTimeoutBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinTimeoutBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxTimeout != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxTimeout))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinTimeout != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinTimeout))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RevealTimeoutBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RevealTimeoutBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CommitTimeoutBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CommitTimeoutBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TimeoutMode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TimeoutMode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxSettlementsPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxSettlementsPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RevealTimeout != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RevealTimeout))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CommitTimeout != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CommitTimeout))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Game) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Game) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Game) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReservedFor) > 0 {
+		i -= len(m.ReservedFor)
+		copy(dAtA[i:], m.ReservedFor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ReservedFor)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.HouseSeed) > 0 {
+		i -= len(m.HouseSeed)
+		copy(dAtA[i:], m.HouseSeed)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.HouseSeed)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.House {
+		i--
+		if m.House {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ChallengerFee) > 0 {
+		for iNdEx := len(m.ChallengerFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChallengerFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.RevealDuration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RevealDuration))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CommitDuration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CommitDuration))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RevealTimeoutHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RevealTimeoutHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CommitTimeoutHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CommitTimeoutHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TimeoutMode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TimeoutMode))
+		i--
+		dAtA[i] = 0x28
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RevealTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevealTimeout):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CommitTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CommitTimeout):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.EntryFee) > 0 {
+		for iNdEx := len(m.EntryFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EntryFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MoveCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MoveCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Scheme != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Scheme))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.EncryptedReveal) > 0 {
+		i -= len(m.EncryptedReveal)
+		copy(dAtA[i:], m.EncryptedReveal)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EncryptedReveal)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Stake) > 0 {
+		for iNdEx := len(m.Stake) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stake[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MoveReveal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MoveReveal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveReveal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Move != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Move))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlayerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlayerStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlayerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WinStreak != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WinStreak))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Wins != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Wins))
+		i--
+		dAtA[i] = 0x18
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CooldownUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CooldownUntil):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if m.NoShows != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NoShows))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Scheme != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Scheme))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.MinEntryFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTypes(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.EntryFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Bet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SettledGame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettledGame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettledGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x4a
+	if m.RevealDuration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RevealDuration))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CommitDuration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CommitDuration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TimeoutMode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TimeoutMode))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ChallengerStake) > 0 {
+		for iNdEx := len(m.ChallengerStake) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChallengerStake[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CreatorStake) > 0 {
+		for iNdEx := len(m.CreatorStake) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreatorStake[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Challenger) > 0 {
+		i -= len(m.Challenger)
+		copy(dAtA[i:], m.Challenger)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Challenger)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitTimeout != 0 {
+		n += 1 + sovTypes(uint64(m.CommitTimeout))
+	}
+	if m.RevealTimeout != 0 {
+		n += 1 + sovTypes(uint64(m.RevealTimeout))
+	}
+	if m.MaxSettlementsPerBlock != 0 {
+		n += 1 + sovTypes(uint64(m.MaxSettlementsPerBlock))
+	}
+	if m.TimeoutMode != 0 {
+		n += 1 + sovTypes(uint64(m.TimeoutMode))
+	}
+	if m.CommitTimeoutBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.CommitTimeoutBlocks))
+	}
+	if m.RevealTimeoutBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.RevealTimeoutBlocks))
+	}
+	if m.MinTimeout != 0 {
+		n += 1 + sovTypes(uint64(m.MinTimeout))
+	}
+	if m.MaxTimeout != 0 {
+		n += 1 + sovTypes(uint64(m.MaxTimeout))
+	}
+	if m.MinTimeoutBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.MinTimeoutBlocks))
+	}
+	if m.MaxTimeoutBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.MaxTimeoutBlocks))
+	}
+	l = m.NoShowPenalty.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.NoShowCooldown != 0 {
+		n += 1 + sovTypes(uint64(m.NoShowCooldown))
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.BettingFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.JackpotFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.JackpotStreak != 0 {
+		n += 2 + sovTypes(uint64(m.JackpotStreak))
+	}
+	if len(m.HouseMaxExposure) > 0 {
+		for _, e := range m.HouseMaxExposure {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.RematchWindow != 0 {
+		n += 2 + sovTypes(uint64(m.RematchWindow))
+	}
+	if len(m.CommitmentSchemes) > 0 {
+		l = 0
+		for _, e := range m.CommitmentSchemes {
+			l += sovTypes(uint64(e))
+		}
+		n += 2 + sovTypes(uint64(l)) + l
+	}
+	if m.QueueMatchGrace != 0 {
+		n += 2 + sovTypes(uint64(m.QueueMatchGrace))
+	}
+	if m.QueueMatchGraceBlocks != 0 {
+		n += 2 + sovTypes(uint64(m.QueueMatchGraceBlocks))
+	}
+	if len(m.JackpotMinStake) > 0 {
+		for _, e := range m.JackpotMinStake {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.HouseMaxOpenExposure) > 0 {
+		for _, e := range m.HouseMaxOpenExposure {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Game) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if len(m.EntryFee) > 0 {
+		for _, e := range m.EntryFee {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CommitTimeout)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevealTimeout)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.TimeoutMode != 0 {
+		n += 1 + sovTypes(uint64(m.TimeoutMode))
+	}
+	if m.CommitTimeoutHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CommitTimeoutHeight))
+	}
+	if m.RevealTimeoutHeight != 0 {
+		n += 1 + sovTypes(uint64(m.RevealTimeoutHeight))
+	}
+	if m.CommitDuration != 0 {
+		n += 1 + sovTypes(uint64(m.CommitDuration))
+	}
+	if m.RevealDuration != 0 {
+		n += 1 + sovTypes(uint64(m.RevealDuration))
+	}
+	if len(m.ChallengerFee) > 0 {
+		for _, e := range m.ChallengerFee {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.House {
+		n += 2
+	}
+	l = len(m.HouseSeed)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ReservedFor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MoveCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Stake) > 0 {
+		for _, e := range m.Stake {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.EncryptedReveal)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Scheme != 0 {
+		n += 1 + sovTypes(uint64(m.Scheme))
+	}
+	return n
+}
+
+func (m *MoveReveal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Move != 0 {
+		n += 1 + sovTypes(uint64(m.Move))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PlayerStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoShows != 0 {
+		n += 1 + sovTypes(uint64(m.NoShows))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CooldownUntil)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Wins != 0 {
+		n += 1 + sovTypes(uint64(m.Wins))
+	}
+	if m.WinStreak != 0 {
+		n += 1 + sovTypes(uint64(m.WinStreak))
+	}
+	return n
+}
+
+func (m *QueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.EntryFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MinEntryFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Scheme != 0 {
+		n += 1 + sovTypes(uint64(m.Scheme))
+	}
+	return n
+}
+
+func (m *Bet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Outcome != 0 {
+		n += 1 + sovTypes(uint64(m.Outcome))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *SettledGame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Challenger)
+	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = len(m.Salt)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.CreatorStake) > 0 {
+		for _, e := range m.CreatorStake {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ChallengerStake) > 0 {
+		for _, e := range m.ChallengerStake {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.TimeoutMode != 0 {
+		n += 1 + sovTypes(uint64(m.TimeoutMode))
+	}
+	if m.CommitDuration != 0 {
+		n += 1 + sovTypes(uint64(m.CommitDuration))
+	}
+	if m.RevealDuration != 0 {
+		n += 1 + sovTypes(uint64(m.RevealDuration))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTimeout", wireType)
+			}
+			m.CommitTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealTimeout", wireType)
+			}
+			m.RevealTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSettlementsPerBlock", wireType)
+			}
+			m.MaxSettlementsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSettlementsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutMode", wireType)
+			}
+			m.TimeoutMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutMode |= TimeoutMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTimeoutBlocks", wireType)
+			}
+			m.CommitTimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitTimeoutBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealTimeoutBlocks", wireType)
+			}
+			m.RevealTimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealTimeoutBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTimeout", wireType)
+			}
+			m.MinTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeout", wireType)
+			}
+			m.MaxTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTimeoutBlocks", wireType)
+			}
+			m.MinTimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTimeoutBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeoutBlocks", wireType)
+			}
+			m.MaxTimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTimeoutBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoShowPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NoShowPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoShowCooldown", wireType)
+			}
+			m.NoShowCooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NoShowCooldown |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BettingFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BettingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JackpotFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JackpotFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JackpotStreak", wireType)
+			}
+			m.JackpotStreak = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JackpotStreak |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HouseMaxExposure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HouseMaxExposure = append(m.HouseMaxExposure, types.Coin{})
+			if err := m.HouseMaxExposure[len(m.HouseMaxExposure)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RematchWindow", wireType)
+			}
+			m.RematchWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RematchWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType == 0 {
+				var v CommitmentScheme
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= CommitmentScheme(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CommitmentSchemes = append(m.CommitmentSchemes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.CommitmentSchemes) == 0 {
+					m.CommitmentSchemes = make([]CommitmentScheme, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v CommitmentScheme
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= CommitmentScheme(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CommitmentSchemes = append(m.CommitmentSchemes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentSchemes", wireType)
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueMatchGrace", wireType)
+			}
+			m.QueueMatchGrace = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueMatchGrace |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueMatchGraceBlocks", wireType)
+			}
+			m.QueueMatchGraceBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueMatchGraceBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JackpotMinStake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JackpotMinStake = append(m.JackpotMinStake, types.Coin{})
+			if err := m.JackpotMinStake[len(m.JackpotMinStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HouseMaxOpenExposure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HouseMaxOpenExposure = append(m.HouseMaxOpenExposure, types.Coin{})
+			if err := m.HouseMaxOpenExposure[len(m.HouseMaxOpenExposure)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Game) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Game: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Game: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntryFee = append(m.EntryFee, types.Coin{})
+			if err := m.EntryFee[len(m.EntryFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CommitTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RevealTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutMode", wireType)
+			}
+			m.TimeoutMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutMode |= TimeoutMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTimeoutHeight", wireType)
+			}
+			m.CommitTimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitTimeoutHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealTimeoutHeight", wireType)
+			}
+			m.RevealTimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealTimeoutHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitDuration", wireType)
+			}
+			m.CommitDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealDuration", wireType)
+			}
+			m.RevealDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengerFee = append(m.ChallengerFee, types.Coin{})
+			if err := m.ChallengerFee[len(m.ChallengerFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field House", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.House = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HouseSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HouseSeed = append(m.HouseSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.HouseSeed == nil {
+				m.HouseSeed = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedFor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedFor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MoveCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MoveCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MoveCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = append(m.Commit[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commit == nil {
+				m.Commit = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stake = append(m.Stake, types.Coin{})
+			if err := m.Stake[len(m.Stake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedReveal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedReveal = append(m.EncryptedReveal[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedReveal == nil {
+				m.EncryptedReveal = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
+			}
+			m.Scheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scheme |= CommitmentScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return nil
 }
+func (m *MoveReveal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MoveReveal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MoveReveal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
+			}
+			m.Move = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Move |= Move(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PlayerStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -581,17 +3649,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlayerStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlayerStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoShows", wireType)
 			}
-			m.CommitTimeout = 0
+			m.NoShows = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -601,16 +3669,68 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitTimeout |= uint64(b&0x7F) << shift
+				m.NoShows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CooldownUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CooldownUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wins", wireType)
+			}
+			m.Wins = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Wins |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevealTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WinStreak", wireType)
 			}
-			m.RevealTimeout = 0
+			m.WinStreak = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -620,7 +3740,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RevealTimeout |= uint64(b&0x7F) << shift
+				m.WinStreak |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -646,7 +3766,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Game) Unmarshal(dAtA []byte) error {
+func (m *QueueEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -669,10 +3789,10 @@ func (m *Game) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Game: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueueEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Game: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -695,6 +3815,72 @@ func (m *Game) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = append(m.Commit[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commit == nil {
+				m.Commit = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EntryFee", wireType)
 			}
@@ -727,9 +3913,9 @@ func (m *Game) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -756,13 +3942,13 @@ func (m *Game) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CommitTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevealTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEntryFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -789,10 +3975,29 @@ func (m *Game) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RevealTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinEntryFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
+			}
+			m.Scheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scheme |= CommitmentScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -814,7 +4019,7 @@ func (m *Game) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MoveCommit) Unmarshal(dAtA []byte) error {
+func (m *Bet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -837,17 +4042,36 @@ func (m *MoveCommit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MoveCommit: wiretype end group for non-group")
+			return fmt.Errorf("proto: Bet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MoveCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Bet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= BetOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -857,23 +4081,25 @@ func (m *MoveCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -929,7 +4155,7 @@ func (m *MoveCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MoveReveal) Unmarshal(dAtA []byte) error {
+func (m *SettledGame) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -952,15 +4178,34 @@ func (m *MoveReveal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MoveReveal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SettledGame: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MoveReveal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SettledGame: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -988,11 +4233,11 @@ func (m *MoveReveal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Move = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1020,11 +4265,11 @@ func (m *MoveReveal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			m.Challenger = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorStake", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1051,7 +4296,132 @@ func (m *MoveReveal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.CreatorStake = append(m.CreatorStake, types.Coin{})
+			if err := m.CreatorStake[len(m.CreatorStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerStake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengerStake = append(m.ChallengerStake, types.Coin{})
+			if err := m.ChallengerStake[len(m.ChallengerStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutMode", wireType)
+			}
+			m.TimeoutMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutMode |= TimeoutMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitDuration", wireType)
+			}
+			m.CommitDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealDuration", wireType)
+			}
+			m.RevealDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
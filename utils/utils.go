@@ -2,14 +2,32 @@ package utils
 
 import (
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
+	"hash"
 )
 
-func MoveIsValid(m string) bool {
-	return m == "rock" || m == "paper" || m == "scissors"
+var moves = []string{"rock", "paper", "scissors"}
+
+// CalculateCommitment returns the commitment to a move: sha256 of "move:salt",
+// with the salt lowercase hex encoded.
+func CalculateCommitment(move string, salt []byte) []byte {
+	return HashCommitment(sha256.New(), move, salt)
+}
+
+// HashCommitment returns the commitment to a move computed with h, the hash of
+// "move:salt" with the salt lowercase hex encoded.
+func HashCommitment(h hash.Hash, move string, salt []byte) []byte {
+	h.Write([]byte(move + ":" + hex.EncodeToString(salt)))
+	return h.Sum(nil)
 }
 
-func CalculateCommitment(move, salt string) string {
-	h := sha256.Sum256([]byte(move + ":" + salt))
-	return hex.EncodeToString(h[:])
+// HouseMove returns the move of the house in a house game, derived from the
+// hash of the block after the one the game was created in and the salt of the
+// player: the first 8 bytes of sha256(seed || salt), big endian, modulo 3
+// index "rock", "paper" and "scissors". The salt is lowercase hex encoded,
+// like in the commitments.
+func HouseMove(seed, salt []byte) string {
+	h := sha256.Sum256(append(append([]byte{}, seed...), hex.EncodeToString(salt)...))
+	return moves[binary.BigEndian.Uint64(h[:8])%uint64(len(moves))]
 }